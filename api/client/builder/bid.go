@@ -290,3 +290,45 @@ func (b signedBuilderBidDeneb) Version() int {
 func (b signedBuilderBidDeneb) IsNil() bool {
 	return b.p == nil
 }
+
+// builderBidElectra reuses the deneb bid, as the electra execution payload header is unchanged.
+type builderBidElectra struct {
+	builderBidDeneb
+}
+
+// WrappedBuilderBidElectra is a constructor which wraps a protobuf bid into an interface.
+func WrappedBuilderBidElectra(p *ethpb.BuilderBidDeneb) (Bid, error) {
+	w := builderBidElectra{builderBidDeneb{p: p}}
+	if w.IsNil() {
+		return nil, consensus_types.ErrNilObjectWrapped
+	}
+	return w, nil
+}
+
+// Version --
+func (b builderBidElectra) Version() int {
+	return version.Electra
+}
+
+type signedBuilderBidElectra struct {
+	signedBuilderBidDeneb
+}
+
+// WrappedSignedBuilderBidElectra is a constructor which wraps a protobuf signed bid into an interface.
+func WrappedSignedBuilderBidElectra(p *ethpb.SignedBuilderBidDeneb) (SignedBid, error) {
+	w := signedBuilderBidElectra{signedBuilderBidDeneb{p: p}}
+	if w.IsNil() {
+		return nil, consensus_types.ErrNilObjectWrapped
+	}
+	return w, nil
+}
+
+// Message --
+func (b signedBuilderBidElectra) Message() (Bid, error) {
+	return WrappedBuilderBidElectra(b.p.Message)
+}
+
+// Version --
+func (b signedBuilderBidElectra) Version() int {
+	return version.Electra
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	log "github.com/sirupsen/logrus"
@@ -285,6 +286,37 @@ func TestClient_GetHeader(t *testing.T) {
 			require.Equal(t, len(bundle.Proofs[i]) == 48, true)
 		}
 	})
+	t.Run("electra", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleHeaderResponseElectra)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		h, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.Equal(t, version.Electra, h.Version())
+		bid, err := h.Message()
+		require.NoError(t, err)
+		require.Equal(t, version.Electra, bid.Version())
+		bidHeader, err := bid.Header()
+		require.NoError(t, err)
+		withdrawalsRoot, err := bidHeader.WithdrawalsRoot()
+		require.NoError(t, err)
+		expectedWithdrawalsRoot := ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2")
+		require.Equal(t, true, bytes.Equal(expectedWithdrawalsRoot, withdrawalsRoot))
+		bundle, err := bid.BlindedBlobsBundle()
+		require.NoError(t, err)
+		require.Equal(t, len(bundle.BlobRoots) > 0, true)
+	})
 	t.Run("deneb, no bundle", func(t *testing.T) {
 		hc := &http.Client{
 			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
@@ -440,6 +472,63 @@ func TestSubmitBlindedBlock(t *testing.T) {
 		require.Equal(t, hexutil.Encode(blobBundle.KzgCommitments[0]), "0x8dab030c51e16e84be9caab84ee3d0b8bbec1db4a0e4de76439da8424d9b957370a10a78851f97e4b54d2ce1ab0d686f")
 		require.Equal(t, hexutil.Encode(blobBundle.Proofs[0]), "0xb4021b0de10f743893d4f71e1bf830c019e832958efd6795baf2f83b8699a9eccc5dc99015d8d4d8ec370d0cc333c06a")
 	})
+	t.Run("electra", func(t *testing.T) {
+		test := testSignedBlindedBeaconBlockAndBlobsElectra(t)
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				require.Equal(t, "electra", r.Header.Get("Eth-Consensus-Version"))
+				var req shared.SignedBlindedBeaconBlockContentsElectra
+				err := json.NewDecoder(r.Body).Decode(&req)
+				require.NoError(t, err)
+				block, err := req.SignedBlindedBlock.ToConsensus()
+				require.NoError(t, err)
+				require.DeepEqual(t, block, test.SignedBlindedBlock)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadElectra)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+
+		sbb, err := blocks.NewSignedBeaconBlock(test.SignedBlindedBlock)
+		require.NoError(t, err)
+
+		ep, blobBundle, err := c.SubmitBlindedBlock(ctx, sbb, test.SignedBlindedBlobSidecars)
+		require.NoError(t, err)
+		withdrawals, err := ep.Withdrawals()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(withdrawals))
+		assert.Equal(t, uint64(1), withdrawals[0].Index)
+		require.NotNil(t, blobBundle)
+		require.Equal(t, hexutil.Encode(blobBundle.KzgCommitments[0]), "0x8dab030c51e16e84be9caab84ee3d0b8bbec1db4a0e4de76439da8424d9b957370a10a78851f97e4b54d2ce1ab0d686f")
+	})
+	t.Run("mismatched versions, expected electra got deneb", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadDeneb)), // send a Deneb payload
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:      hc,
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+		test := testSignedBlindedBeaconBlockAndBlobsElectra(t)
+		sbb, err := blocks.NewSignedBeaconBlock(test.SignedBlindedBlock)
+		require.NoError(t, err)
+		_, _, err = c.SubmitBlindedBlock(ctx, sbb, test.SignedBlindedBlobSidecars)
+		require.ErrorContains(t, "not an electra payload", err)
+	})
 	t.Run("mismatched versions, expected bellatrix got capella", func(t *testing.T) {
 		hc := &http.Client{
 			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
@@ -860,6 +949,7 @@ func testSignedBlindedBeaconBlockAndBlobsDeneb(t *testing.T) *eth.SignedBlindedB
 							Data: &eth.Deposit_Data{
 								PublicKey:             ezDecode(t, "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"),
 								WithdrawalCredentials: ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"),
+								Contract:              ezDecode(t, "0xabcf8e0d4e9587369b2301d0790347320302cc09"),
 								Amount:                1,
 								Signature:             ezDecode(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"),
 							},
@@ -883,6 +973,7 @@ func testSignedBlindedBeaconBlockAndBlobsDeneb(t *testing.T) *eth.SignedBlindedB
 						FeeRecipient:     ezDecode(t, "0xabcf8e0d4e9587369b2301d0790347320302cc09"),
 						StateRoot:        ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"),
 						ReceiptsRoot:     ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"),
+						ActivitiesRoot:   ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"),
 						LogsBloom:        ezDecode(t, "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
 						PrevRandao:       ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"),
 						BlockNumber:      1,
@@ -919,6 +1010,52 @@ func testSignedBlindedBeaconBlockAndBlobsDeneb(t *testing.T) *eth.SignedBlindedB
 	}
 }
 
+func testSignedBlindedBeaconBlockAndBlobsElectra(t *testing.T) *eth.SignedBlindedBeaconBlockAndBlobsElectra {
+	d := testSignedBlindedBeaconBlockAndBlobsDeneb(t)
+	b := d.SignedBlindedBlock.Message
+	return &eth.SignedBlindedBeaconBlockAndBlobsElectra{
+		SignedBlindedBlock: &eth.SignedBlindedBeaconBlockElectra{
+			Message: &eth.BlindedBeaconBlockElectra{
+				Slot:          b.Slot,
+				ProposerIndex: b.ProposerIndex,
+				ParentRoot:    b.ParentRoot,
+				StateRoot:     b.StateRoot,
+				Body: &eth.BlindedBeaconBlockBodyElectra{
+					RandaoReveal:           b.Body.RandaoReveal,
+					Eth1Data:               b.Body.Eth1Data,
+					Graffiti:               b.Body.Graffiti,
+					ProposerSlashings:      b.Body.ProposerSlashings,
+					AttesterSlashings:      b.Body.AttesterSlashings,
+					Attestations:           b.Body.Attestations,
+					Deposits:               b.Body.Deposits,
+					VoluntaryExits:         b.Body.VoluntaryExits,
+					ActivityChanges:        b.Body.ActivityChanges,
+					TransactionsCount:      b.Body.TransactionsCount,
+					BaseFee:                b.Body.BaseFee,
+					SyncAggregate:          b.Body.SyncAggregate,
+					ExecutionPayloadHeader: b.Body.ExecutionPayloadHeader,
+					BlsToExecutionChanges:  b.Body.BlsToExecutionChanges,
+					BlobKzgCommitments:     b.Body.BlobKzgCommitments,
+					ContractTransfers: []*eth.SignedContractTransfer{
+						{
+							Message: &eth.ContractTransfer{
+								ValidatorIndex:  1,
+								RecipientIndex:  2,
+								Contract:        ezDecode(t, "0xabcf8e0d4e9587369b2301d0790347320302cc09"),
+								ValidUntilEpoch: 10,
+							},
+							Signature:          ezDecode(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"),
+							RecipientSignature: ezDecode(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"),
+						},
+					},
+				},
+			},
+			Signature: d.SignedBlindedBlock.Signature,
+		},
+		SignedBlindedBlobSidecars: d.SignedBlindedBlobSidecars,
+	}
+}
+
 func TestRequestLogger(t *testing.T) {
 	wo := WithObserver(&requestLogger{})
 	c, err := NewClient("localhost:3500", wo)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -190,6 +191,8 @@ var testExampleHeaderResponseDenebNoBundle = `{
   }
 }`
 
+var testExampleHeaderResponseElectra = strings.Replace(testExampleHeaderResponseDeneb, `"version": "deneb"`, `"version": "electra"`, 1)
+
 var testExampleHeaderResponseUnknownVersion = `{
   "version": "bad",
   "data": {
@@ -637,6 +640,8 @@ var testExampleExecutionPayloadDeneb = fmt.Sprintf(`{
   }
 }`, hexutil.Encode(make([]byte, fieldparams.BlobLength)))
 
+var testExampleExecutionPayloadElectra = strings.Replace(testExampleExecutionPayloadDeneb, `"version": "deneb"`, `"version": "electra"`, 1)
+
 func TestExecutionPayloadResponseUnmarshal(t *testing.T) {
 	epr := &ExecPayloadResponse{}
 	require.NoError(t, json.Unmarshal([]byte(testExampleExecutionPayload), epr))
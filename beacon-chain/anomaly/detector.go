@@ -0,0 +1,67 @@
+package anomaly
+
+import (
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// Detector inspects the activity changes of a processed block and reports the anomalies it finds.
+// Detectors must not modify the observation.
+type Detector interface {
+	// Name identifies the detector in logs, metrics and findings.
+	Name() string
+	// Detect returns the anomalies found in the observed block.
+	Detect(obs *Observation) []*Finding
+}
+
+// Baseline summarizes the activity deltas previously credited to a contract.
+type Baseline struct {
+	// Mean is the average delta activity credited to the contract per block.
+	Mean uint64
+	// Samples is the number of blocks the mean was computed from.
+	Samples int
+}
+
+// Observation contains everything a detector may inspect about a processed block.
+type Observation struct {
+	Slot          primitives.Slot
+	BlockRoot     [32]byte
+	ProposerIndex primitives.ValidatorIndex
+	// PayloadProposerIndex is the proposer of the parent block. The activity changes of a block come
+	// from the execution payload of its parent, which that proposer built.
+	PayloadProposerIndex primitives.ValidatorIndex
+	// PayloadProposerContracts are the contracts owned by the payload proposer. It is empty when the
+	// parent block or the post-state of the block was not available.
+	PayloadProposerContracts [][fieldparams.ContractAddressLength]byte
+	ActivityChanges          []*ethpb.ActivityChange
+	// Baselines holds the history of the contracts of the block, not including the block itself.
+	Baselines map[[fieldparams.ContractAddressLength]byte]Baseline
+}
+
+// Finding describes an activity anomaly reported by a detector.
+type Finding struct {
+	Detector  string
+	Slot      primitives.Slot
+	BlockRoot [32]byte
+	// ProposerIndex is the proposer the finding is attributed to.
+	ProposerIndex primitives.ValidatorIndex
+	Contract      [fieldparams.ContractAddressLength]byte
+	DeltaActivity uint64
+	// BaselineActivity is the reference activity the delta was compared against.
+	BaselineActivity uint64
+	Reason           string
+}
+
+// FindingsFetcher retrieves the activity anomalies recently found by the node.
+type FindingsFetcher interface {
+	RecentFindings() []*Finding
+}
+
+// DefaultDetectors returns the detectors used when none are configured.
+func DefaultDetectors() []Detector {
+	return []Detector{
+		NewSpikeDetector(defaultSpikeFactor, defaultSpikeMinSamples),
+		NewSelfDealingDetector(defaultSelfDealingMinShare, defaultSelfDealingMinDelta),
+	}
+}
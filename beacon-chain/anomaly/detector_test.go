@@ -0,0 +1,119 @@
+package anomaly
+
+import (
+	"math"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func contractAddress(b byte) [fieldparams.ContractAddressLength]byte {
+	return bytesutil.ToBytes20([]byte{b})
+}
+
+func activityChange(contract [fieldparams.ContractAddressLength]byte, delta uint64) *ethpb.ActivityChange {
+	return &ethpb.ActivityChange{ContractAddress: contract[:], DeltaActivity: delta}
+}
+
+func TestSpikeDetector(t *testing.T) {
+	a, b, c := contractAddress('a'), contractAddress('b'), contractAddress('c')
+	obs := &Observation{
+		Slot:          10,
+		ProposerIndex: 3,
+		ActivityChanges: []*ethpb.ActivityChange{
+			activityChange(a, 100_000),
+			activityChange(b, 99_999),
+			activityChange(c, math.MaxUint64),
+		},
+		Baselines: map[[fieldparams.ContractAddressLength]byte]Baseline{
+			a: {Mean: 1000, Samples: 8},
+			b: {Mean: 1000, Samples: 8},
+			c: {Mean: 1000, Samples: 7},
+		},
+	}
+
+	findings := NewSpikeDetector(100, 8).Detect(obs)
+	require.Equal(t, 1, len(findings))
+	assert.Equal(t, "spike", findings[0].Detector)
+	assert.Equal(t, a, findings[0].Contract)
+	assert.Equal(t, uint64(100_000), findings[0].DeltaActivity)
+	assert.Equal(t, uint64(1000), findings[0].BaselineActivity)
+	assert.Equal(t, "delta activity is 100x the average of the last 8 blocks", findings[0].Reason)
+
+	// A contract without any history is never reported.
+	obs.Baselines = nil
+	assert.Equal(t, 0, len(NewSpikeDetector(100, 0).Detect(obs)))
+}
+
+func TestSelfDealingDetector(t *testing.T) {
+	own, other := contractAddress('a'), contractAddress('b')
+	tests := []struct {
+		name      string
+		contracts [][fieldparams.ContractAddressLength]byte
+		changes   []*ethpb.ActivityChange
+		wantDelta uint64
+	}{
+		{
+			name:      "proposer contract dominates the block",
+			contracts: [][fieldparams.ContractAddressLength]byte{own},
+			changes:   []*ethpb.ActivityChange{activityChange(own, 3000), activityChange(other, 1000), activityChange(own, 1000)},
+			wantDelta: 4000,
+		},
+		{
+			name:      "proposer contract below the share",
+			contracts: [][fieldparams.ContractAddressLength]byte{own},
+			changes:   []*ethpb.ActivityChange{activityChange(own, 1000), activityChange(other, 1001)},
+		},
+		{
+			name:      "negligible activity",
+			contracts: [][fieldparams.ContractAddressLength]byte{own},
+			changes:   []*ethpb.ActivityChange{activityChange(own, 999)},
+		},
+		{
+			name:    "proposer contracts unknown",
+			changes: []*ethpb.ActivityChange{activityChange(own, 5000)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obs := &Observation{ProposerIndex: 2, PayloadProposerIndex: 1, PayloadProposerContracts: tt.contracts, ActivityChanges: tt.changes}
+			findings := NewSelfDealingDetector(0.5, 1000).Detect(obs)
+			if tt.wantDelta == 0 {
+				assert.Equal(t, 0, len(findings))
+				return
+			}
+			require.Equal(t, 1, len(findings))
+			assert.Equal(t, "self_dealing", findings[0].Detector)
+			assert.Equal(t, own, findings[0].Contract)
+			assert.Equal(t, primitives.ValidatorIndex(1), findings[0].ProposerIndex)
+			assert.Equal(t, tt.wantDelta, findings[0].DeltaActivity)
+			assert.Equal(t, "proposer contracts received 80.00% of the block activity", findings[0].Reason)
+		})
+	}
+}
+
+func TestHistory_Baseline(t *testing.T) {
+	h := newHistory()
+	a := contractAddress('a')
+	_, ok := h.baseline(a)
+	assert.Equal(t, false, ok)
+
+	h.record(a, math.MaxUint64)
+	h.record(a, math.MaxUint64)
+	b, ok := h.baseline(a)
+	require.Equal(t, true, ok)
+	assert.Equal(t, Baseline{Mean: math.MaxUint64, Samples: 2}, b)
+
+	for i := 0; i < historyWindow; i++ {
+		h.record(a, 10)
+	}
+	b, ok = h.baseline(a)
+	require.Equal(t, true, ok)
+	assert.Equal(t, Baseline{Mean: 10, Samples: historyWindow}, b)
+	assert.Equal(t, 1, h.len())
+}
@@ -0,0 +1,9 @@
+/*
+Package anomaly defines a runtime service which inspects the activity changes
+carried by processed beacon blocks and flags statistical outliers, such as sudden
+spikes of a contract's activity or proposers crediting their own contracts.
+Findings are logged, exported as metrics and kept in memory for the REST API, so
+that gas-farming can be investigated before it distorts activity-weighted rewards.
+The detection is purely informational and never affects block processing.
+*/
+package anomaly
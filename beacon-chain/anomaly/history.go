@@ -0,0 +1,79 @@
+package anomaly
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+)
+
+const (
+	// historyWindow is the number of most recent blocks crediting a contract used for its baseline.
+	historyWindow = 64
+	// maxTrackedContracts bounds the number of contracts with a recorded history.
+	maxTrackedContracts = 1 << 14
+)
+
+// contractHistory is a ring buffer of the latest deltas credited to a contract.
+type contractHistory struct {
+	deltas [historyWindow]uint64
+	next   int
+	count  int
+}
+
+func (h *contractHistory) add(delta uint64) {
+	h.deltas[h.next] = delta
+	h.next = (h.next + 1) % historyWindow
+	if h.count < historyWindow {
+		h.count++
+	}
+}
+
+func (h *contractHistory) baseline() Baseline {
+	// Averaging the quotients and remainders separately keeps the sum from overflowing.
+	var quotients, remainders uint64
+	n := uint64(h.count)
+	for i := 0; i < h.count; i++ {
+		quotients += h.deltas[i] / n
+		remainders += h.deltas[i] % n
+	}
+	return Baseline{Mean: quotients + remainders/n, Samples: h.count}
+}
+
+// history keeps the recent activity deltas of the most recently credited contracts.
+// It is not safe for concurrent use.
+type history struct {
+	contracts *lru.Cache
+}
+
+func newHistory() *history {
+	return &history{contracts: lruwrpr.New(maxTrackedContracts)}
+}
+
+// baseline returns the baseline of the contract, if any delta was recorded for it.
+func (h *history) baseline(contract [fieldparams.ContractAddressLength]byte) (Baseline, bool) {
+	item, ok := h.contracts.Get(contract)
+	if !ok {
+		return Baseline{}, false
+	}
+	ch, ok := item.(*contractHistory)
+	if !ok || ch.count == 0 {
+		return Baseline{}, false
+	}
+	return ch.baseline(), true
+}
+
+// record appends the delta to the history of the contract.
+func (h *history) record(contract [fieldparams.ContractAddressLength]byte, delta uint64) {
+	item, ok := h.contracts.Get(contract)
+	ch, isHistory := item.(*contractHistory)
+	if !ok || !isHistory {
+		ch = &contractHistory{}
+		h.contracts.Add(contract, ch)
+	}
+	ch.add(delta)
+}
+
+// len returns the number of contracts with a recorded history.
+func (h *history) len() int {
+	return h.contracts.Len()
+}
@@ -0,0 +1,31 @@
+package anomaly
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "anomaly")
+
+	// findingsCounter used to track the number of activity anomalies reported by each detector
+	findingsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "activity_anomaly",
+			Name:      "findings_total",
+			Help:      "Number of activity anomalies reported by the detector",
+		},
+		[]string{
+			"detector",
+		},
+	)
+	// trackedContractsGauge used to track the number of contracts with a recorded activity history
+	trackedContractsGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "activity_anomaly",
+			Name:      "tracked_contracts",
+			Help:      "Number of contracts with a recorded activity history",
+		},
+	)
+)
@@ -0,0 +1,76 @@
+package anomaly
+
+import (
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+const (
+	defaultSelfDealingMinShare = 0.5
+	defaultSelfDealingMinDelta = 1_000_000
+)
+
+// SelfDealingDetector flags blocks in which the contracts of the payload proposer, who built the
+// execution payload the activity changes come from, receive most of the activity credited by the block.
+type SelfDealingDetector struct {
+	minShare float64
+	minDelta uint64
+}
+
+// NewSelfDealingDetector creates a self-dealing detector. A block is flagged when the share of
+// its activity credited to the proposer contracts is at least minShare and amounts to at least
+// minDelta, which keeps blocks with negligible activity from being reported.
+func NewSelfDealingDetector(minShare float64, minDelta uint64) *SelfDealingDetector {
+	return &SelfDealingDetector{minShare: minShare, minDelta: minDelta}
+}
+
+// Name --
+func (*SelfDealingDetector) Name() string {
+	return "self_dealing"
+}
+
+// Detect reports every payload proposer contract credited in a block dominated by the payload proposer's own activity.
+func (d *SelfDealingDetector) Detect(obs *Observation) []*Finding {
+	if len(obs.PayloadProposerContracts) == 0 {
+		return nil
+	}
+	own := make(map[[fieldparams.ContractAddressLength]byte]bool, len(obs.PayloadProposerContracts))
+	for _, c := range obs.PayloadProposerContracts {
+		own[c] = true
+	}
+
+	var total, ownTotal float64
+	ownDeltas := make(map[[fieldparams.ContractAddressLength]byte]uint64)
+	for _, ac := range obs.ActivityChanges {
+		total += float64(ac.DeltaActivity)
+		contract := bytesutil.ToBytes20(ac.ContractAddress)
+		if own[contract] {
+			ownTotal += float64(ac.DeltaActivity)
+			ownDeltas[contract] += ac.DeltaActivity
+		}
+	}
+	if ownTotal == 0 || ownTotal < float64(d.minDelta) || ownTotal/total < d.minShare {
+		return nil
+	}
+
+	findings := make([]*Finding, 0, len(ownDeltas))
+	for _, contract := range obs.PayloadProposerContracts {
+		delta, ok := ownDeltas[contract]
+		if !ok {
+			continue
+		}
+		findings = append(findings, &Finding{
+			Detector:         d.Name(),
+			Slot:             obs.Slot,
+			BlockRoot:        obs.BlockRoot,
+			ProposerIndex:    obs.PayloadProposerIndex,
+			Contract:         contract,
+			DeltaActivity:    delta,
+			BaselineActivity: uint64(total),
+			Reason:           fmt.Sprintf("proposer contracts received %.2f%% of the block activity", ownTotal/total*100),
+		})
+	}
+	return findings
+}
@@ -0,0 +1,218 @@
+package anomaly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// maxRecentFindings is the number of most recent findings kept in memory.
+const maxRecentFindings = 1024
+
+// Config defines the dependencies of the activity anomaly detection service.
+type Config struct {
+	StateNotifier statefeed.Notifier
+	StateGen      stategen.StateManager
+	BeaconDB      db.ReadOnlyDatabase
+	// Detectors inspecting each processed block. DefaultDetectors are used when empty.
+	Detectors []Detector
+}
+
+// Service inspects the activity changes of verified blocks and reports activity anomalies.
+type Service struct {
+	cfg       *Config
+	ctx       context.Context
+	cancel    context.CancelFunc
+	isRunning bool
+
+	// Locks access to history, findings and isRunning.
+	sync.RWMutex
+	history  *history
+	findings []*Finding
+}
+
+// NewService sets up a new activity anomaly detection service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if len(cfg.Detectors) == 0 {
+		cfg.Detectors = DefaultDetectors()
+	}
+	return &Service{
+		cfg:     cfg,
+		ctx:     ctx,
+		cancel:  cancel,
+		history: newHistory(),
+	}
+}
+
+// Start subscribes to the state feed and inspects the processed blocks.
+func (s *Service) Start() {
+	names := make([]string, len(s.cfg.Detectors))
+	for i, d := range s.cfg.Detectors {
+		names[i] = d.Name()
+	}
+	log.WithField("Detectors", names).Info("Starting activity anomaly detection")
+
+	s.Lock()
+	s.isRunning = true
+	s.Unlock()
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	go s.run(stateChannel, stateSub)
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	s.Lock()
+	s.isRunning = false
+	s.Unlock()
+	return nil
+}
+
+// Status of the service.
+func (s *Service) Status() error {
+	s.RLock()
+	defer s.RUnlock()
+	if s.isRunning {
+		return nil
+	}
+	return errors.New("not running")
+}
+
+// RecentFindings returns the most recent findings, oldest first.
+func (s *Service) RecentFindings() []*Finding {
+	s.RLock()
+	defer s.RUnlock()
+	findings := make([]*Finding, len(s.findings))
+	for i, f := range s.findings {
+		fCopy := *f
+		findings[i] = &fCopy
+	}
+	return findings
+}
+
+func (s *Service) run(stateChannel chan *feed.Event, stateSub event.Subscription) {
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case e := <-stateChannel:
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not of type *statefeed.BlockProcessedData")
+				continue
+			}
+			// Only verified blocks are inspected, others might never become canonical.
+			if data.Verified {
+				s.processBlock(data.BlockRoot, data.SignedBlock)
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// processBlock runs the detectors against the activity changes of the block and records them
+// in the contract history afterwards.
+func (s *Service) processBlock(root [32]byte, b interfaces.ReadOnlySignedBeaconBlock) {
+	if b == nil || b.IsNil() {
+		return
+	}
+	blk := b.Block()
+	changes := blk.Body().ActivityChanges()
+	if len(changes) == 0 {
+		return
+	}
+
+	obs := &Observation{
+		Slot:            blk.Slot(),
+		BlockRoot:       root,
+		ProposerIndex:   blk.ProposerIndex(),
+		ActivityChanges: changes,
+		Baselines:       make(map[[fieldparams.ContractAddressLength]byte]Baseline, len(changes)),
+	}
+	if err := s.setPayloadProposer(obs, blk.ParentRoot()); err != nil {
+		log.WithError(err).WithField("Slot", blk.Slot()).Debug("Could not get payload proposer contracts")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for _, ac := range changes {
+		contract := bytesutil.ToBytes20(ac.ContractAddress)
+		if baseline, ok := s.history.baseline(contract); ok {
+			obs.Baselines[contract] = baseline
+		}
+	}
+	for _, d := range s.cfg.Detectors {
+		for _, f := range d.Detect(obs) {
+			s.reportFinding(f)
+		}
+	}
+	for _, ac := range changes {
+		s.history.record(bytesutil.ToBytes20(ac.ContractAddress), ac.DeltaActivity)
+	}
+	trackedContractsGauge.Set(float64(s.history.len()))
+}
+
+// setPayloadProposer sets the proposer of the parent block, which built the execution payload the activity
+// changes of the block come from, and the contracts it owns in the post-state of the observed block.
+func (s *Service) setPayloadProposer(obs *Observation, parentRoot [32]byte) error {
+	parent, err := s.cfg.BeaconDB.Block(s.ctx, parentRoot)
+	if err != nil {
+		return err
+	}
+	if parent == nil || parent.IsNil() {
+		return fmt.Errorf("parent block %#x not found", parentRoot)
+	}
+	st := s.cfg.StateGen.StateByRootIfCachedNoCopy(obs.BlockRoot)
+	if st == nil {
+		return errors.New("post-state not found in cache")
+	}
+	obs.PayloadProposerIndex = parent.Block().ProposerIndex()
+	contracts, err := st.ContractsAtIndex(obs.PayloadProposerIndex)
+	if err != nil {
+		return err
+	}
+	obs.PayloadProposerContracts = contracts
+	return nil
+}
+
+// reportFinding logs the finding, updates metrics and keeps it in memory.
+// It assumes the caller holds the service Lock.
+func (s *Service) reportFinding(f *Finding) {
+	findingsCounter.WithLabelValues(f.Detector).Inc()
+	log.WithFields(logrus.Fields{
+		"Detector":         f.Detector,
+		"Slot":             f.Slot,
+		"BlockRoot":        fmt.Sprintf("%#x", bytesutil.Trunc(f.BlockRoot[:])),
+		"ProposerIndex":    f.ProposerIndex,
+		"Contract":         fmt.Sprintf("%#x", f.Contract),
+		"DeltaActivity":    f.DeltaActivity,
+		"BaselineActivity": f.BaselineActivity,
+		"Reason":           f.Reason,
+	}).Warn("Activity anomaly detected")
+
+	if len(s.findings) == maxRecentFindings {
+		s.findings = s.findings[1:]
+	}
+	s.findings = append(s.findings, f)
+}
@@ -0,0 +1,93 @@
+package anomaly
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	mockstategen "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func testBlock(t *testing.T, parentRoot [32]byte, changes ...*ethpb.ActivityChange) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = 5
+	b.Block.ProposerIndex = 2
+	b.Block.ParentRoot = parentRoot[:]
+	b.Block.Body.ActivityChanges = changes
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wsb
+}
+
+func TestService_ProcessBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	own, proposer, other := contractAddress('a'), contractAddress('p'), contractAddress('b')
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	// The parent block proposer built the payload the activity changes come from.
+	val, err := st.ValidatorAtIndex(7)
+	require.NoError(t, err)
+	val.Contract = own[:]
+	require.NoError(t, st.UpdateValidatorAtIndex(7, val))
+	val, err = st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	val.Contract = proposer[:]
+	require.NoError(t, st.UpdateValidatorAtIndex(2, val))
+	stateGen := mockstategen.NewMockService()
+	root := [32]byte{'r'}
+	stateGen.AddStateForRoot(st, root)
+	beaconDB := testDB.SetupDB(t)
+	parent := util.NewBeaconBlockAltair()
+	parent.Block.Slot = 4
+	parent.Block.ProposerIndex = 7
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), beaconDB, parent)
+
+	s := NewService(context.Background(), &Config{
+		StateGen:  stateGen,
+		BeaconDB:  beaconDB,
+		Detectors: []Detector{NewSpikeDetector(100, 2), NewSelfDealingDetector(0.5, 1000)},
+	})
+	for i := 0; i < 2; i++ {
+		s.processBlock([32]byte{}, testBlock(t, parentRoot, activityChange(other, 1000)))
+	}
+	require.Equal(t, 0, len(s.RecentFindings()))
+	require.LogsDoNotContain(t, hook, "Activity anomaly detected")
+
+	s.processBlock(root, testBlock(t, parentRoot, activityChange(other, 100_000), activityChange(own, 500_000), activityChange(proposer, 1000)))
+	findings := s.RecentFindings()
+	require.Equal(t, 2, len(findings))
+	assert.Equal(t, "spike", findings[0].Detector)
+	assert.Equal(t, other, findings[0].Contract)
+	assert.Equal(t, "self_dealing", findings[1].Detector)
+	assert.Equal(t, own, findings[1].Contract)
+	assert.Equal(t, primitives.ValidatorIndex(7), findings[1].ProposerIndex)
+	assert.Equal(t, root, findings[1].BlockRoot)
+	require.LogsContain(t, hook, "\"Activity anomaly detected\" BaselineActivity=1000")
+	require.LogsContain(t, hook, "Detector=spike ProposerIndex=2 Reason=\"delta activity is 100x the average of the last 2 blocks\" Slot=5")
+
+	// Findings returned to callers are copies.
+	findings[0].DeltaActivity = 0
+	assert.Equal(t, uint64(100_000), s.RecentFindings()[0].DeltaActivity)
+}
+
+func TestService_RecentFindingsBounded(t *testing.T) {
+	s := NewService(context.Background(), &Config{StateGen: mockstategen.NewMockService(), BeaconDB: testDB.SetupDB(t)})
+	for i := 0; i < maxRecentFindings+10; i++ {
+		s.Lock()
+		s.reportFinding(&Finding{Detector: "test", DeltaActivity: uint64(i)})
+		s.Unlock()
+	}
+	findings := s.RecentFindings()
+	require.Equal(t, maxRecentFindings, len(findings))
+	assert.Equal(t, uint64(10), findings[0].DeltaActivity)
+	assert.Equal(t, uint64(maxRecentFindings+9), findings[maxRecentFindings-1].DeltaActivity)
+}
@@ -0,0 +1,61 @@
+package anomaly
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+const (
+	defaultSpikeFactor     = 100
+	defaultSpikeMinSamples = 8
+)
+
+// SpikeDetector flags activity changes which exceed the average delta previously credited
+// to the same contract by a given factor.
+type SpikeDetector struct {
+	factor     uint64
+	minSamples int
+}
+
+// NewSpikeDetector creates a spike detector. Contracts with fewer than minSamples recorded
+// blocks are not checked, as their average is not representative yet.
+func NewSpikeDetector(factor uint64, minSamples int) *SpikeDetector {
+	if factor == 0 {
+		factor = 1
+	}
+	return &SpikeDetector{factor: factor, minSamples: minSamples}
+}
+
+// Name --
+func (*SpikeDetector) Name() string {
+	return "spike"
+}
+
+// Detect reports every activity change of the block which is at least factor times the
+// average delta of its contract.
+func (d *SpikeDetector) Detect(obs *Observation) []*Finding {
+	var findings []*Finding
+	for _, ac := range obs.ActivityChanges {
+		contract := bytesutil.ToBytes20(ac.ContractAddress)
+		b, ok := obs.Baselines[contract]
+		if !ok || b.Samples < d.minSamples || b.Mean == 0 {
+			continue
+		}
+		// Dividing the delta instead of multiplying the mean keeps the comparison overflow free.
+		if ac.DeltaActivity/d.factor < b.Mean {
+			continue
+		}
+		findings = append(findings, &Finding{
+			Detector:         d.Name(),
+			Slot:             obs.Slot,
+			BlockRoot:        obs.BlockRoot,
+			ProposerIndex:    obs.ProposerIndex,
+			Contract:         contract,
+			DeltaActivity:    ac.DeltaActivity,
+			BaselineActivity: b.Mean,
+			Reason:           fmt.Sprintf("delta activity is %dx the average of the last %d blocks", ac.DeltaActivity/b.Mean, b.Samples),
+		})
+	}
+	return findings
+}
@@ -0,0 +1,134 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// activityHistoryRecords builds the activity history records of the given epoch from the raw
+// activities accumulated during the epoch and the state right after the epoch transition, which
+// the block of the given root has been processed on. Validators that neither have a contract nor
+// any activity are skipped.
+func activityHistoryRecords(
+	epoch primitives.Epoch,
+	blockRoot [32]byte,
+	activities []uint64,
+	postState state.ReadOnlyBeaconState,
+) ([]*ethpb.ActivityHistoryRecord, error) {
+	records := make([]*ethpb.ActivityHistoryRecord, 0)
+	if err := postState.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+		var activity uint64
+		if idx < len(activities) {
+			activity = activities[idx]
+		}
+		contract := val.Contract()
+		if activity == 0 && val.EffectiveActivity() == 0 && contract == params.BeaconConfig().ZeroContract {
+			return nil
+		}
+		records = append(records, &ethpb.ActivityHistoryRecord{
+			ValidatorIndex:    primitives.ValidatorIndex(idx),
+			Epoch:             epoch,
+			Activity:          activity,
+			EffectiveActivity: val.EffectiveActivity(),
+			Contract:          bytesutil.SafeCopyBytes(contract[:]),
+			BlockRoot:         bytesutil.SafeCopyBytes(blockRoot[:]),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// pruneActivityHistory deletes the activity history taken on blocks orphaned by the finalization of the
+// given epoch, along with the history that falls out of the retention period.
+func (s *Service) pruneActivityHistory(ctx context.Context, prevFinalizedEpoch, finalizedEpoch primitives.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.pruneActivityHistory")
+	defer span.End()
+
+	var retainFrom primitives.Epoch
+	if r := s.cfg.ActivityHistoryRetentionEpochs; r > 0 && finalizedEpoch > r {
+		retainFrom = finalizedEpoch - r
+	}
+	return s.cfg.BeaconDB.PruneActivityHistory(ctx, prevFinalizedEpoch, finalizedEpoch, retainFrom)
+}
+
+// epochActivitySummary builds the summary of the network wide activity and power of the state
+// right after an epoch transition, which the block of the given root has been processed on.
+func epochActivitySummary(ctx context.Context, blockRoot [32]byte, postState state.ReadOnlyBeaconState) (*ethpb.EpochActivitySummary, error) {
+	totalPower, totalEffectivePower, err := helpers.Powers(ctx, postState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate powers")
+	}
+	totalEffectiveActivity, err := helpers.TotalEffectiveActivity(postState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate total effective activity")
+	}
+	return &ethpb.EpochActivitySummary{
+		Epoch:                  coreTime.CurrentEpoch(postState),
+		SharedActivity:         postState.SharedActivity(),
+		TotalPower:             totalPower,
+		TotalEffectivePower:    totalEffectivePower,
+		TotalEffectiveActivity: totalEffectiveActivity,
+		BlockRoot:              blockRoot[:],
+	}, nil
+}
+
+// epochTransition is a snapshot of an epoch transition, which the activity history of the ending epoch and
+// the activity summary of the new epoch are built from.
+type epochTransition struct {
+	// epoch is the epoch that ended with the transition.
+	epoch primitives.Epoch
+	// blockRoot is the root of the block processed on top of the transition.
+	blockRoot [32]byte
+	// activities holds the raw activities of the ending epoch, nil if the activity history is not saved.
+	activities []uint64
+	// postState is a copy of the state right after processing the block.
+	postState state.ReadOnlyBeaconState
+}
+
+// saveEpochTransitions saves the activity history and the activity summaries of the given epoch transitions
+// to the DB. Building them walks every validator, so it is meant to run in the background, outside of the
+// forkchoice lock.
+func (s *Service) saveEpochTransitions(ctx context.Context, transitions []*epochTransition) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveEpochTransitions")
+	defer span.End()
+
+	var history []*ethpb.ActivityHistoryRecord
+	summaries := make([]*ethpb.EpochActivitySummary, 0, len(transitions))
+	for _, t := range transitions {
+		if t.activities != nil {
+			records, err := activityHistoryRecords(t.epoch, t.blockRoot, t.activities, t.postState)
+			if err != nil {
+				log.WithError(err).Error("Could not build activity history records")
+			} else {
+				history = append(history, records...)
+			}
+		}
+		summary, err := epochActivitySummary(ctx, t.blockRoot, t.postState)
+		if err != nil {
+			log.WithError(err).Error("Could not build epoch activity summary")
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	if len(history) > 0 {
+		if err := s.cfg.BeaconDB.SaveActivityHistory(ctx, history); err != nil {
+			log.WithError(err).Error("Could not save activity history")
+		}
+	}
+	if len(summaries) > 0 {
+		if err := s.cfg.BeaconDB.SaveEpochActivitySummaries(ctx, summaries); err != nil {
+			log.WithError(err).Error("Could not save epoch activity summaries")
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestActivityHistoryRecords(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 3)
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	vals := st.Validators()
+	for _, v := range vals {
+		v.Contract = params.BeaconConfig().ZeroContract[:]
+		v.EffectiveActivity = 0
+	}
+	vals[1].Contract = contract
+	vals[2].EffectiveActivity = 50
+	require.NoError(t, st.SetValidators(vals))
+
+	root := [32]byte{'r'}
+	records, err := activityHistoryRecords(7, root, []uint64{0, 10, 20}, st)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepEqual(t, &ethpb.ActivityHistoryRecord{
+		ValidatorIndex: 1,
+		Epoch:          7,
+		Activity:       10,
+		Contract:       contract,
+		BlockRoot:      root[:],
+	}, records[0])
+	assert.DeepEqual(t, &ethpb.ActivityHistoryRecord{
+		ValidatorIndex:    2,
+		Epoch:             7,
+		Activity:          20,
+		EffectiveActivity: 50,
+		Contract:          params.BeaconConfig().ZeroContract[:],
+		BlockRoot:         root[:],
+	}, records[1])
+}
+
+func TestEpochActivitySummary(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	vals := st.Validators()
+	for i, v := range vals {
+		v.EffectiveActivity = uint64(i) * 100
+	}
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	shared := &ethpb.SharedActivity{
+		TransactionsGasPerPeriod: 1000,
+		TransactionsGasPerEpoch:  100,
+		BaseFeePerPeriod:         10,
+		BaseFeePerEpoch:          1,
+	}
+	require.NoError(t, st.SetSharedActivity(shared))
+
+	summary, err := epochActivitySummary(context.Background(), [32]byte{'a'}, st)
+	require.NoError(t, err)
+	totalPower, totalEffectivePower, err := helpers.Powers(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(3), summary.Epoch)
+	assert.DeepEqual(t, shared, summary.SharedActivity)
+	assert.Equal(t, uint64(600), summary.TotalEffectiveActivity)
+	assert.Equal(t, totalPower, summary.TotalPower)
+	assert.Equal(t, totalEffectivePower, summary.TotalEffectivePower)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), summary.BlockRoot)
+}
+
+func TestSaveEpochTransitions(t *testing.T) {
+	service, tr := minimalTestService(t)
+	st, _ := util.DeterministicGenesisState(t, 3)
+	vals := st.Validators()
+	for _, v := range vals {
+		v.Contract = params.BeaconConfig().ZeroContract[:]
+		v.EffectiveActivity = 0
+	}
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, st.SetSharedActivity(&ethpb.SharedActivity{}))
+
+	root := [32]byte{'r'}
+	service.saveEpochTransitions(tr.ctx, []*epochTransition{
+		{epoch: 1, blockRoot: root, activities: []uint64{0, 10, 0}, postState: st},
+		// The activity history is not saved without the raw activities, the summary is.
+		{epoch: 2, blockRoot: [32]byte{'s'}, postState: st},
+	})
+
+	records, err := tr.db.ActivityHistory(tr.ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, uint64(10), records[0].Activity)
+	assert.DeepEqual(t, root[:], records[0].BlockRoot)
+	summaries, err := tr.db.EpochActivitySummaries(tr.ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(summaries))
+}
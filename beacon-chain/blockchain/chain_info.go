@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"go.opencensus.io/trace"
@@ -50,6 +51,12 @@ type ForkchoiceFetcher interface {
 	ProposerBoost() [32]byte
 }
 
+// LightClientFetcher retrieves the latest light client updates built from the blocks imported by the node.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate
+}
+
 // TimeFetcher retrieves the Ethereum consensus data that's related to time.
 type TimeFetcher interface {
 	GenesisTime() time.Time
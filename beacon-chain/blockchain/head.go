@@ -374,7 +374,7 @@ func (s *Service) notifyNewHeadEvent(
 	return nil
 }
 
-// This saves the Attestations and BLSToExecChanges between `orphanedRoot` and the common ancestor root that is derived using `newHeadRoot`.
+// This saves the Attestations, BLSToExecChanges and ContractTransfers between `orphanedRoot` and the common ancestor root that is derived using `newHeadRoot`.
 // It also filters out the attestations that is one epoch older as a defense so invalid attestations don't flow into the attestation pool.
 func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]byte, newHeadRoot [32]byte) error {
 	commonAncestorRoot, _, err := s.cfg.ForkChoiceStore.CommonAncestor(ctx, newHeadRoot, orphanedRoot)
@@ -437,6 +437,15 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 				s.cfg.BLSToExecPool.InsertBLSToExecChange(c)
 			}
 		}
+		if orphanedBlk.Version() >= version.Electra {
+			transfers, err := orphanedBlk.Block().Body().ContractTransfers()
+			if err != nil {
+				return errors.Wrap(err, "could not get ContractTransfers")
+			}
+			for _, t := range transfers {
+				s.cfg.ContractTransferPool.InsertContractTransfer(t)
+			}
+		}
 		parentRoot := orphanedBlk.Block().ParentRoot()
 		orphanedRoot = bytesutil.ToBytes32(parentRoot[:])
 	}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -13,7 +16,9 @@ import (
 	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -244,3 +249,204 @@ func NewLightClientUpdateFromOptimisticUpdate(update *ethpbv2.LightClientOptimis
 		SignatureSlot:  update.SignatureSlot,
 	}
 }
+
+// lightClientUpdates keeps the latest finality and optimistic updates built from the imported blocks.
+type lightClientUpdates struct {
+	sync.RWMutex
+	finality   *ethpbv2.LightClientFinalityUpdate
+	optimistic *ethpbv2.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if there is none yet.
+func (s *Service) LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdate {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.finality
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if there is none yet.
+func (s *Service) LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.optimistic
+}
+
+// processLightClientUpdates builds the light client update signed by the sync aggregate of the given block,
+// saves it if it is the best update of its sync committee period, and gossips the finality and optimistic
+// updates derived from it when they are newer than the latest ones. The attested state is the post-state of
+// the parent block, it is only loaded from the state cache once the sync aggregate qualifies for an update.
+func (s *Service) processLightClientUpdates(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) error {
+	if blk.Version() < version.Altair {
+		return nil
+	}
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil
+	}
+	parentRoot := blk.Block().ParentRoot()
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get attested state %#x", parentRoot)
+	}
+	if attestedState.Version() < version.Altair {
+		return nil
+	}
+	// The finalized block is unknown below a checkpoint sync origin until it gets backfilled,
+	// the update then carries no finality.
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	if cp := attestedState.FinalizedCheckpoint(); cp != nil && bytesutil.ToBytes32(cp.Root) != params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			return errors.Wrapf(err, "could not get finalized block %#x", cp.Root)
+		}
+	}
+	update, err := NewLightClientFinalityUpdateFromBeaconState(ctx, postState, blk, attestedState, finalizedBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+	nextSyncCommittee, err := attestedState.NextSyncCommittee()
+	if err != nil {
+		return errors.Wrap(err, "could not get next sync committee")
+	}
+	update.NextSyncCommittee = &ethpbv2.SyncCommittee{
+		Pubkeys:         nextSyncCommittee.Pubkeys,
+		AggregatePubkey: nextSyncCommittee.AggregatePubkey,
+	}
+	update.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get next sync committee proof")
+	}
+	s.lcUpdates.Lock()
+	defer s.lcUpdates.Unlock()
+	if err := s.saveBestLightClientUpdate(ctx, update); err != nil {
+		return err
+	}
+	s.setLatestLightClientUpdates(update)
+	return nil
+}
+
+// saveBestLightClientUpdate saves the update as the best one of the sync committee period of its attested header,
+// unless the saved update of that period is better.
+func (s *Service) saveBestLightClientUpdate(ctx context.Context, update *ethpbv2.LightClientUpdate) error {
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(update.AttestedHeader.Slot))
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrapf(err, "could not get light client update of period %d", period)
+	}
+	if best != nil && !isBetterLightClientUpdate(update, best) {
+		return nil
+	}
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update)
+}
+
+// setLatestLightClientUpdates keeps the finality and optimistic updates derived from the given update when they
+// are newer than the latest ones, and gossips them. The caller must hold the lock of the latest updates.
+func (s *Service) setLatestLightClientUpdates(update *ethpbv2.LightClientUpdate) {
+	var msgs []proto.Message
+	if hasFinality(update) && (s.lcUpdates.finality == nil || update.FinalizedHeader.Slot > s.lcUpdates.finality.FinalizedHeader.Slot) {
+		s.lcUpdates.finality = CreateLightClientFinalityUpdate(update)
+		msgs = append(msgs, s.lcUpdates.finality)
+	}
+	if s.lcUpdates.optimistic == nil || update.AttestedHeader.Slot > s.lcUpdates.optimistic.AttestedHeader.Slot {
+		s.lcUpdates.optimistic = CreateLightClientOptimisticUpdate(update)
+		msgs = append(msgs, s.lcUpdates.optimistic)
+	}
+
+	// Only updates signed in the current slot are worth gossiping, peers ignore them after newer ones.
+	if len(msgs) == 0 || update.SignatureSlot != s.CurrentSlot() {
+		return
+	}
+	go s.broadcastLightClientUpdates(update.SignatureSlot, msgs)
+}
+
+// broadcastLightClientUpdates gossips the light client updates once the block of their signature slot had
+// one third of the slot to propagate, peers ignore updates received earlier.
+func (s *Service) broadcastLightClientUpdates(signatureSlot types.Slot, msgs []proto.Message) {
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second
+	at := slots.StartTime(uint64(s.genesisTime.Unix()), signatureSlot).Add(interval)
+	select {
+	case <-time.After(time.Until(at)):
+	case <-s.ctx.Done():
+		return
+	}
+	for _, msg := range msgs {
+		if err := s.cfg.P2p.Broadcast(s.ctx, msg); err != nil {
+			log.WithError(err).Error("Could not broadcast light client update")
+		}
+	}
+}
+
+// isBetterLightClientUpdate implements https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#is_better_update
+func isBetterLightClientUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	maxParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newSupermajority := newParticipants*3 >= maxParticipants*2
+	oldSupermajority := oldParticipants*3 >= maxParticipants*2
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	// Compare presence of relevant sync committee.
+	newRelevantSyncCommittee := hasNextSyncCommittee(newUpdate) &&
+		slots.SyncCommitteePeriod(slots.ToEpoch(newUpdate.AttestedHeader.Slot)) == slots.SyncCommitteePeriod(slots.ToEpoch(newUpdate.SignatureSlot))
+	oldRelevantSyncCommittee := hasNextSyncCommittee(oldUpdate) &&
+		slots.SyncCommitteePeriod(slots.ToEpoch(oldUpdate.AttestedHeader.Slot)) == slots.SyncCommitteePeriod(slots.ToEpoch(oldUpdate.SignatureSlot))
+	if newRelevantSyncCommittee != oldRelevantSyncCommittee {
+		return newRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality.
+	newFinality := hasFinality(newUpdate)
+	oldFinality := hasFinality(oldUpdate)
+	if newFinality != oldFinality {
+		return newFinality
+	}
+
+	// Compare sync committee finality.
+	if newFinality {
+		newSyncCommitteeFinality := slots.SyncCommitteePeriod(slots.ToEpoch(newUpdate.FinalizedHeader.Slot)) ==
+			slots.SyncCommitteePeriod(slots.ToEpoch(newUpdate.AttestedHeader.Slot))
+		oldSyncCommitteeFinality := slots.SyncCommitteePeriod(slots.ToEpoch(oldUpdate.FinalizedHeader.Slot)) ==
+			slots.SyncCommitteePeriod(slots.ToEpoch(oldUpdate.AttestedHeader.Slot))
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority.
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best).
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+// hasNextSyncCommittee reports whether the update proves the next sync committee, its branch is zeroed otherwise.
+func hasNextSyncCommittee(update *ethpbv2.LightClientUpdate) bool {
+	return !isZeroBranch(update.NextSyncCommitteeBranch)
+}
+
+// hasFinality reports whether the update proves a finalized header, its branch is zeroed otherwise.
+func hasFinality(update *ethpbv2.LightClientUpdate) bool {
+	return update.FinalizedHeader != nil && !isZeroBranch(update.FinalityBranch)
+}
+
+func isZeroBranch(branch [][]byte) bool {
+	for _, b := range branch {
+		if bytesutil.ToBytes32(b) != params.BeaconConfig().ZeroHash {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
@@ -14,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
 
 type testlc struct {
@@ -158,3 +160,137 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestService_processLightClientUpdates(t *testing.T) {
+	l := newTestLc(t).setupTest()
+	s, tr := minimalTestService(t)
+
+	parentRoot := l.block.Block().ParentRoot()
+	require.NoError(t, s.cfg.StateGen.SaveState(l.ctx, parentRoot, l.attestedState))
+
+	require.NoError(t, s.processLightClientUpdates(l.ctx, l.block, l.state))
+
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(l.attestedHeader.Slot))
+	saved, err := tr.db.LightClientUpdate(l.ctx, period)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	l.checkAttestedHeader(saved)
+	l.checkSyncAggregate(saved)
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, saved.NextSyncCommittee.Pubkeys)
+	require.Equal(t, 5, len(saved.NextSyncCommitteeBranch))
+	_, err = saved.MarshalSSZ()
+	require.NoError(t, err)
+
+	optimistic := s.LightClientOptimisticUpdate()
+	require.NotNil(t, optimistic)
+	require.Equal(t, l.block.Block().Slot(), optimistic.SignatureSlot)
+	// Without a finalized block the update carries no finality.
+	require.Equal(t, (*ethpbv2.LightClientFinalityUpdate)(nil), s.LightClientFinalityUpdate())
+}
+
+func TestService_processLightClientUpdates_NotEnoughParticipants(t *testing.T) {
+	l := newTestLc(t).setupTest()
+	s, tr := minimalTestService(t)
+
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = l.block.Block().Slot()
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, s.processLightClientUpdates(l.ctx, wsb, l.state))
+
+	updates, err := tr.db.LightClientUpdates(l.ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(updates))
+	require.Equal(t, (*ethpbv2.LightClientOptimisticUpdate)(nil), s.LightClientOptimisticUpdate())
+}
+
+func TestIsBetterLightClientUpdate(t *testing.T) {
+	periodSlots := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	branch := func(depth int, zero bool) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = make([]byte, 32)
+			if !zero {
+				b[i][0] = 1
+			}
+		}
+		return b
+	}
+	update := func(participants uint64, attested, signature primitives.Slot, syncCommittee, finality bool) *ethpbv2.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		return &ethpbv2.LightClientUpdate{
+			AttestedHeader:          &v1.BeaconBlockHeader{Slot: attested},
+			NextSyncCommitteeBranch: branch(5, !syncCommittee),
+			FinalizedHeader:         &v1.BeaconBlockHeader{Slot: attested - 64},
+			FinalityBranch:          branch(6, !finality),
+			SyncAggregate:           &v1.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signature,
+		}
+	}
+	base := periodSlots + 100
+
+	tests := []struct {
+		name     string
+		new, old *ethpbv2.LightClientUpdate
+		better   bool
+	}{
+		{
+			name:   "supermajority wins",
+			new:    update(342, base, base+1, false, false),
+			old:    update(341, base, base+1, true, true),
+			better: true,
+		},
+		{
+			name:   "more participants without supermajority",
+			new:    update(300, base, base+1, false, false),
+			old:    update(200, base, base+1, true, true),
+			better: true,
+		},
+		{
+			name:   "relevant sync committee wins",
+			new:    update(400, base, base+1, true, false),
+			old:    update(500, base, base+1, false, true),
+			better: true,
+		},
+		{
+			name:   "sync committee of another period is not relevant",
+			new:    update(400, 2*periodSlots-1, 2*periodSlots, true, false),
+			old:    update(400, base, base+1, false, false),
+			better: false,
+		},
+		{
+			name:   "finality wins",
+			new:    update(400, base, base+1, true, true),
+			old:    update(500, base, base+1, true, false),
+			better: true,
+		},
+		{
+			name:   "more participants beyond supermajority",
+			new:    update(500, base, base+1, true, true),
+			old:    update(400, base, base+1, true, true),
+			better: true,
+		},
+		{
+			name:   "older attested header wins",
+			new:    update(400, base, base+1, true, true),
+			old:    update(400, base+1, base+2, true, true),
+			better: true,
+		},
+		{
+			name:   "identical update is not better",
+			new:    update(400, base, base+1, true, true),
+			old:    update(400, base, base+1, true, true),
+			better: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.better, isBetterLightClientUpdate(tt.new, tt.old))
+		})
+	}
+}
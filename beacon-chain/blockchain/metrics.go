@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,6 +16,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -31,6 +34,11 @@ var (
 		Help: "The current slot based on the genesis time and current clock",
 	})
 
+	rejectedContractBindingsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rejected_contract_bindings_total",
+		Help: "Increased when the contract of a deposit is not bound because it is already owned by another live validator",
+	})
+
 	headFinalizedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "head_finalized_epoch",
 		Help: "Last finalized epoch of the head state",
@@ -367,3 +375,48 @@ func reportAttestationInclusion(blk interfaces.ReadOnlyBeaconBlock) {
 		attestationInclusionDelay.Observe(float64(blk.Slot() - att.Data.Slot))
 	}
 }
+
+// reportRejectedContractBindings reports the contract bindings rejected by the deposits of a received block.
+// The rejections are only recorded in the state starting from Electra, the ones of the pre-state are skipped.
+func reportRejectedContractBindings(blockRoot [32]byte, preBindings []*ethpb.RejectedContractBinding, postState state.ReadOnlyBeaconState) error {
+	if postState.Version() < version.Electra {
+		return nil
+	}
+	postBindings, err := postState.RejectedContractBindings()
+	if err != nil {
+		return err
+	}
+	for _, b := range newRejectedContractBindings(preBindings, postBindings) {
+		rejectedContractBindingsCount.Inc()
+		log.WithFields(logrus.Fields{
+			"blockRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:])),
+			"index":      b.ValidatorIndex,
+			"contract":   fmt.Sprintf("%#x", b.Contract),
+			"ownerIndex": b.OwnerIndex,
+		}).Warn("Deposit contract is already bound to another validator, processed the deposit without it")
+	}
+	return nil
+}
+
+// newRejectedContractBindings returns the bindings appended to the pre-state list. The list keeps the most recent
+// rejections only, so the appended bindings are the ones following the longest tail of the pre-state list that
+// starts the post-state list.
+func newRejectedContractBindings(pre, post []*ethpb.RejectedContractBinding) []*ethpb.RejectedContractBinding {
+	for n := 0; n < len(post); n++ {
+		kept := len(post) - n
+		if kept > len(pre) {
+			continue
+		}
+		matched := true
+		for i := 0; i < kept; i++ {
+			if !proto.Equal(post[i], pre[len(pre)-kept+i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return post[kept:]
+		}
+	}
+	return post
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
@@ -39,3 +40,39 @@ func TestReportEpochMetrics_SlashedValidatorOutOfBound(t *testing.T) {
 	err = reportEpochMetrics(context.Background(), h, h)
 	require.ErrorContains(t, "slot 0 out of bounds", err)
 }
+
+func TestNewRejectedContractBindings(t *testing.T) {
+	binding := func(idx primitives.ValidatorIndex) *eth.RejectedContractBinding {
+		return &eth.RejectedContractBinding{ValidatorIndex: idx, Contract: make([]byte, 20)}
+	}
+	bindings := func(idx ...primitives.ValidatorIndex) []*eth.RejectedContractBinding {
+		b := make([]*eth.RejectedContractBinding, len(idx))
+		for i, id := range idx {
+			b[i] = binding(id)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name string
+		pre  []*eth.RejectedContractBinding
+		post []*eth.RejectedContractBinding
+		want []*eth.RejectedContractBinding
+	}{
+		{name: "no rejections", want: []*eth.RejectedContractBinding{}},
+		{name: "first rejections", post: bindings(1, 2), want: bindings(1, 2)},
+		{name: "unchanged", pre: bindings(1, 2), post: bindings(1, 2), want: bindings()},
+		{name: "appended", pre: bindings(1, 2), post: bindings(1, 2, 3), want: bindings(3)},
+		{name: "oldest evicted", pre: bindings(1, 2, 3), post: bindings(2, 3, 4, 5), want: bindings(4, 5)},
+		{name: "all evicted", pre: bindings(1, 2), post: bindings(3, 4), want: bindings(3, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newRejectedContractBindings(tt.pre, tt.post)
+			require.Equal(t, len(tt.want), len(got))
+			for i := range tt.want {
+				require.DeepEqual(t, tt.want[i], got[i])
+			}
+		})
+	}
+}
@@ -5,16 +5,19 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
@@ -36,6 +39,15 @@ func WithWeakSubjectivityCheckpoint(c *ethpb.Checkpoint) Option {
 	}
 }
 
+// WithActivityHistoryRetentionEpochs sets the number of epochs before the finalized epoch for which
+// the activity history is kept, it is kept forever when zero.
+func WithActivityHistoryRetentionEpochs(e primitives.Epoch) Option {
+	return func(s *Service) error {
+		s.cfg.ActivityHistoryRetentionEpochs = e
+		return nil
+	}
+}
+
 // WithDatabase for head access.
 func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	return func(s *Service) error {
@@ -44,6 +56,14 @@ func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	}
 }
 
+// WithBlobStorage for storing and reading blob sidecars.
+func WithBlobStorage(b *filesystem.BlobStorage) Option {
+	return func(s *Service) error {
+		s.cfg.BlobStorage = b
+		return nil
+	}
+}
+
 // WithChainStartFetcher to retrieve information about genesis.
 func WithChainStartFetcher(f execution.ChainStartFetcher) Option {
 	return func(s *Service) error {
@@ -108,6 +128,14 @@ func WithBLSToExecPool(p blstoexec.PoolManager) Option {
 	}
 }
 
+// WithContractTransferPool to keep track of contract transfers.
+func WithContractTransferPool(p contracttransfers.PoolManager) Option {
+	return func(s *Service) error {
+		s.cfg.ContractTransferPool = p
+		return nil
+	}
+}
+
 // WithP2PBroadcaster to broadcast messages after appropriate processing.
 func WithP2PBroadcaster(p p2p.Broadcaster) Option {
 	return func(s *Service) error {
@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
@@ -203,6 +204,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	postVersionAndHeaders := make([]*versionAndHeader, len(blks))
 	var set *bls.SignatureBatch
 	boundaries := make(map[[32]byte]state.BeaconState)
+	var epochTransitions []*epochTransition
+	// Shared activity events of the epoch transitions in the batch, by block position.
+	sharedActivityEvents := make(map[int]*ethpbv1.EventSharedActivity)
 	for i, b := range blks {
 		v, h, err := getStateVersionAndPayload(preState)
 		if err != nil {
@@ -213,10 +217,42 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 			header:  h,
 		}
 
+		// Keep the raw activities of the ending epoch, they are reset by the epoch transition.
+		preEpoch := coreTime.CurrentEpoch(preState)
+		var preActivities []uint64
+		var preEffectiveActivities []uint64
+		if slots.ToEpoch(b.Block().Slot()) > preEpoch {
+			if features.Get().SaveActivityHistory {
+				preActivities = preState.Activities()
+			}
+			if s.hasSharedActivitySubscribers() {
+				preEffectiveActivities, err = effectiveActivities(preState)
+				if err != nil {
+					return errors.Wrap(err, "could not get effective activities")
+				}
+			}
+		}
+
 		set, preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return invalidBlock{error: err}
 		}
+		if coreTime.CurrentEpoch(preState) > preEpoch {
+			epochTransitions = append(epochTransitions, &epochTransition{
+				epoch:      preEpoch,
+				blockRoot:  b.Root(),
+				activities: preActivities,
+				postState:  preState.Copy(),
+			})
+			if preEffectiveActivities != nil {
+				event, err := sharedActivityEvent(b.Root(), preEffectiveActivities, preState, false)
+				if err != nil {
+					log.WithError(err).Error("Could not build shared activity event")
+				} else {
+					sharedActivityEvents[i] = event
+				}
+			}
+		}
 		// Save potential boundary states.
 		if slots.IsEpochStart(preState.Slot()) {
 			boundaries[b.Root()] = preState.Copy()
@@ -259,6 +295,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		if err != nil {
 			return s.handleInvalidExecutionError(ctx, err, root, b.Block().ParentRoot())
 		}
+		if event, ok := sharedActivityEvents[i]; ok {
+			event.ExecutionOptimistic = !isValidPayload
+		}
 		if isValidPayload {
 			if err := s.validateMergeTransitionBlock(ctx, preVersionAndHeaders[i].version,
 				preVersionAndHeaders[i].header, b); err != nil {
@@ -298,6 +337,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 			}
 		}
 	}
+	// The activity history and summaries are built and saved in the background, out of the forkchoice lock.
+	if len(epochTransitions) > 0 {
+		go s.saveEpochTransitions(s.ctx, epochTransitions)
+	}
 	// Save boundary states that will be useful for forkchoice
 	for r, st := range boundaries {
 		if err := s.cfg.StateGen.SaveState(ctx, r, st); err != nil {
@@ -332,7 +375,15 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	if _, err := s.notifyForkchoiceUpdate(ctx, arg); err != nil {
 		return err
 	}
-	return s.saveHeadNoDB(ctx, lastB, lastBR, preState, !isValidPayload)
+	if err := s.saveHeadNoDB(ctx, lastB, lastBR, preState, !isValidPayload); err != nil {
+		return err
+	}
+	for i := range blks {
+		if event, ok := sharedActivityEvents[i]; ok {
+			s.notifySharedActivity(event)
+		}
+	}
+	return nil
 }
 
 func commitmentsToCheck(b consensusblocks.ROBlock, current primitives.Slot) [][]byte {
@@ -355,7 +406,7 @@ func (s *Service) databaseDACheck(ctx context.Context, b consensusblocks.ROBlock
 	if len(commitments) == 0 {
 		return nil
 	}
-	sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, b.Root())
+	sidecars, err := s.cfg.BlobStorage.Sidecars(b.Root())
 	if err != nil {
 		return errors.Wrap(err, "could not get blob sidecars")
 	}
@@ -559,8 +610,8 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 		return nil
 	}
 
-	// Read first from db in case we have the blobs
-	sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, root)
+	// Read first from the blob storage in case we have the blobs
+	sidecars, err := s.cfg.BlobStorage.Sidecars(root)
 	switch {
 	case err == nil:
 		if len(sidecars) >= expected {
@@ -571,11 +622,11 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 			logBlobSidecar(sidecars, t)
 			return nil
 		}
-	case errors.Is(err, db.ErrNotFound):
+	case db.IsNotFound(err):
 		// If the blob sidecars haven't arrived yet, the subsequent code will wait for them.
 		// Note: The system will not exit with an error in this scenario.
 	default:
-		log.WithError(err).Error("could not get blob sidecars from DB")
+		log.WithError(err).Error("could not get blob sidecars from blob storage")
 	}
 
 	found := map[uint64]struct{}{}
@@ -591,7 +642,7 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 				continue
 			}
 			s.blobNotifiers.delete(root)
-			sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, root)
+			sidecars, err := s.cfg.BlobStorage.Sidecars(root)
 			if err != nil {
 				return errors.Wrap(err, "could not get blob sidecars")
 			}
@@ -11,6 +11,7 @@ import (
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -146,6 +147,11 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		if err := s.cfg.StateGen.MigrateToCold(s.ctx, fRoot); err != nil {
 			log.WithError(err).Error("could not migrate to cold")
 		}
+		if features.Get().SaveActivityHistory {
+			if err := s.pruneActivityHistory(s.ctx, currentFinalized.Epoch, cp.Epoch); err != nil {
+				log.WithError(err).Error("could not prune activity history")
+			}
+		}
 	}()
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"net/http/httptest"
 	"strconv"
 	"sync"
 	"testing"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
@@ -2156,3 +2158,87 @@ func driftGenesisTime(s *Service, slot int64, delay int64) {
 	offset := slot*int64(params.BeaconConfig().SecondsPerSlot) - delay
 	s.SetGenesisTime(time.Unix(time.Now().Unix()-offset, 0))
 }
+
+func TestVerifyBlockActivities_ExecutionServer(t *testing.T) {
+	el, err := mockExecution.NewExecutionServer()
+	require.NoError(t, err)
+	srv := httptest.NewServer(el)
+	defer func() {
+		srv.Close()
+		el.Stop()
+	}()
+	rpcClient, err := gethrpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+	ctx := context.Background()
+
+	// Build and import execution payloads until one of them carries activity.
+	parent := el.GenesisHash()
+	var payload *enginev1.ExecutionPayloadCapella
+	for i := uint64(1); payload == nil || len(payload.Transactions) == 0; i++ {
+		fcs := &enginev1.ForkchoiceState{HeadBlockHash: parent.Bytes(), SafeBlockHash: parent.Bytes(), FinalizedBlockHash: parent.Bytes()}
+		attrs := &enginev1.PayloadAttributesV2{
+			Timestamp:             i * 12,
+			PrevRandao:            bytesutil.PadTo(bytesutil.Uint64ToBytesBigEndian(i), 32),
+			SuggestedFeeRecipient: make([]byte, 20),
+			Withdrawals:           []*enginev1.Withdrawal{},
+		}
+		fcu := &execution.ForkchoiceUpdatedResponse{}
+		require.NoError(t, rpcClient.CallContext(ctx, fcu, execution.ForkchoiceUpdatedMethodV2, fcs, attrs))
+		require.NotNil(t, fcu.PayloadId)
+		built := &enginev1.ExecutionPayloadCapellaWithValue{}
+		require.NoError(t, rpcClient.CallContext(ctx, built, execution.GetPayloadMethodV2, fcu.PayloadId))
+		status := &enginev1.PayloadStatus{}
+		require.NoError(t, rpcClient.CallContext(ctx, status, execution.NewPayloadMethodV2, built.Payload))
+		require.Equal(t, enginev1.PayloadStatus_VALID, status.Status)
+		payload = built.Payload
+		parent = common.BytesToHash(payload.BlockHash)
+	}
+
+	wrapped, err := consensusblocks.WrappedExecutionPayloadCapella(payload, 0)
+	require.NoError(t, err)
+	header, err := consensusblocks.PayloadToHeaderCapella(wrapped)
+	require.NoError(t, err)
+	wrappedHeader, err := consensusblocks.WrappedExecutionPayloadHeaderCapella(header, 0)
+	require.NoError(t, err)
+	st, _ := util.DeterministicGenesisStateCapella(t, 1)
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(wrappedHeader))
+
+	blockActivities := &ethpb.BlockActivities{}
+	require.NoError(t, rpcClient.CallContext(ctx, blockActivities, execution.GetBlockActivitiesMethod, parent))
+	newBlock := func() *ethpb.BeaconBlockCapella {
+		b := util.NewBeaconBlockCapella().Block
+		b.Body.ActivityChanges = blockActivities.Activities
+		b.Body.TransactionsCount = blockActivities.TxCount
+		b.Body.BaseFee = blockActivities.BaseFee
+		return b
+	}
+
+	s := &Service{}
+	t.Run("valid", func(t *testing.T) {
+		blk, err := consensusblocks.NewBeaconBlock(newBlock())
+		require.NoError(t, err)
+		require.NoError(t, s.verifyBlockActivities(ctx, st, blk))
+	})
+	t.Run("invalid activity changes", func(t *testing.T) {
+		b := newBlock()
+		b.Body.ActivityChanges = []*ethpb.ActivityChange{{ContractAddress: make([]byte, 20), DeltaActivity: 1}}
+		blk, err := consensusblocks.NewBeaconBlock(b)
+		require.NoError(t, err)
+		require.ErrorContains(t, "invalid activity changes root", s.verifyBlockActivities(ctx, st, blk))
+	})
+	t.Run("invalid transactions count", func(t *testing.T) {
+		b := newBlock()
+		b.Body.TransactionsCount++
+		blk, err := consensusblocks.NewBeaconBlock(b)
+		require.NoError(t, err)
+		require.ErrorContains(t, "invalid transactions count", s.verifyBlockActivities(ctx, st, blk))
+	})
+	t.Run("invalid base fee", func(t *testing.T) {
+		b := newBlock()
+		b.Body.BaseFee++
+		blk, err := consensusblocks.NewBeaconBlock(b)
+		require.NoError(t, err)
+		require.ErrorContains(t, "invalid base fee", s.verifyBlockActivities(ctx, st, blk))
+	})
+}
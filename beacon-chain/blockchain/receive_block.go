@@ -81,6 +81,30 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 	if err != nil {
 		return err
 	}
+	// Keep the raw activities of the ending epoch, they are reset by the epoch transition.
+	var preActivities []uint64
+	// Keep the effective activities of the ending epoch as well, to report the top movers of the transition
+	// to the consumers of shared activity events.
+	var preEffectiveActivities []uint64
+	if slots.ToEpoch(blockCopy.Block().Slot()) > currentEpoch {
+		if features.Get().SaveActivityHistory {
+			preActivities = preState.Activities()
+		}
+		if s.hasSharedActivitySubscribers() {
+			preEffectiveActivities, err = effectiveActivities(preState)
+			if err != nil {
+				return errors.Wrap(err, "could not get effective activities")
+			}
+		}
+	}
+	// Keep the rejected contract bindings of the pre-state, to report the ones rejected by the deposits of the block.
+	var preBindings []*ethpb.RejectedContractBinding
+	if preState.Version() >= version.Electra && len(blockCopy.Block().Body().Deposits()) > 0 {
+		preBindings, err = preState.RejectedContractBindings()
+		if err != nil {
+			return errors.Wrap(err, "could not get rejected contract bindings")
+		}
+	}
 	eg, _ := errgroup.WithContext(ctx)
 	var postState state.BeaconState
 
@@ -128,6 +152,11 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		tracing.AnnotateError(span, err)
 		return err
 	}
+	if len(blockCopy.Block().Body().Deposits()) > 0 {
+		if err := reportRejectedContractBindings(blockRoot, preBindings, postState); err != nil {
+			log.WithError(err).Error("could not report rejected contract bindings")
+		}
+	}
 	if coreTime.CurrentEpoch(postState) > currentEpoch {
 		headSt, err := s.HeadState(ctx)
 		if err != nil {
@@ -136,6 +165,17 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		if err := reportEpochMetrics(ctx, postState, headSt); err != nil {
 			log.WithError(err).Error("could not report epoch metrics")
 		}
+		// The history and the summary are built from a copy of the post state and saved in the background,
+		// to keep the full validator pass and the DB writes out of the forkchoice lock.
+		go s.saveEpochTransitions(s.ctx, []*epochTransition{{
+			epoch:      currentEpoch,
+			blockRoot:  blockRoot,
+			activities: preActivities,
+			postState:  postState.Copy(),
+		}})
+		if preEffectiveActivities != nil {
+			s.sendSharedActivityEvent(blockRoot, preEffectiveActivities, postState, !isValidPayload)
+		}
 	}
 	if err := s.updateJustificationOnBlock(ctx, preState, postState, currStoreJustifiedEpoch); err != nil {
 		return errors.Wrap(err, "could not update justified checkpoint")
@@ -166,6 +206,21 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		log.WithError(err).Error("Could not prune canonical objects from pool ")
 	}
 
+	// Light client updates are only derived from the canonical head.
+	if features.Get().EnableLightClient {
+		headRoot, err := s.HeadRoot(ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not get head root")
+		} else if bytes.Equal(headRoot, blockRoot[:]) {
+			lcState := postState.Copy()
+			go func() {
+				if err := s.processLightClientUpdates(s.ctx, blockCopy, lcState); err != nil {
+					log.WithError(err).Error("Could not process light client updates")
+				}
+			}()
+		}
+	}
+
 	// Have we been finalizing? Should we start saving hot states to db?
 	if err := s.checkSaveHotStateDB(ctx); err != nil {
 		return err
@@ -300,6 +355,11 @@ func (s *Service) prunePostBlockOperationPools(ctx context.Context, blk interfac
 		return errors.Wrap(err, "could not process BLSToExecutionChanges")
 	}
 
+	// Mark block contract transfers as seen so we don't include same ones in future blocks.
+	if err := s.markIncludedBlockContractTransfers(blk.Block()); err != nil {
+		return errors.Wrap(err, "could not process ContractTransfers")
+	}
+
 	// Mark slashings as seen so we don't include same ones in future blocks.
 	for _, as := range blk.Block().Body().AttesterSlashings() {
 		s.cfg.SlashingPool.MarkIncludedAttesterSlashing(as)
@@ -325,6 +385,20 @@ func (s *Service) markIncludedBlockBLSToExecChanges(headBlock interfaces.ReadOnl
 	return nil
 }
 
+func (s *Service) markIncludedBlockContractTransfers(headBlock interfaces.ReadOnlyBeaconBlock) error {
+	if headBlock.Version() < version.Electra {
+		return nil
+	}
+	transfers, err := headBlock.Body().ContractTransfers()
+	if err != nil {
+		return errors.Wrap(err, "could not get ContractTransfers")
+	}
+	for _, transfer := range transfers {
+		s.cfg.ContractTransferPool.MarkIncluded(transfer)
+	}
+	return nil
+}
+
 // This checks whether it's time to start saving hot state to DB.
 // It's time when there's `epochsSinceFinalitySaveHotStateDB` epochs of non-finality.
 // Requires a read lock on forkchoice
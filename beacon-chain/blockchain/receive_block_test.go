@@ -358,6 +358,37 @@ func TestHandleBlockBLSToExecutionChanges(t *testing.T) {
 	})
 }
 
+func TestHandleBlockContractTransfers(t *testing.T) {
+	service, tr := minimalTestService(t)
+	pool := tr.transferPool
+
+	t.Run("pre Electra block", func(t *testing.T) {
+		blk, err := blocks.NewBeaconBlock(&ethpb.BeaconBlockDeneb{Body: &ethpb.BeaconBlockBodyDeneb{}})
+		require.NoError(t, err)
+		require.NoError(t, service.markIncludedBlockContractTransfers(blk))
+	})
+
+	t.Run("Post Electra some transfers", func(t *testing.T) {
+		idx := primitives.ValidatorIndex(123)
+		transfer := &ethpb.SignedContractTransfer{
+			Message: &ethpb.ContractTransfer{
+				ValidatorIndex: idx,
+				RecipientIndex: idx + 1,
+			},
+		}
+		body := &ethpb.BeaconBlockBodyElectra{
+			ContractTransfers: []*ethpb.SignedContractTransfer{transfer},
+		}
+		blk, err := blocks.NewBeaconBlock(&ethpb.BeaconBlockElectra{Body: body})
+		require.NoError(t, err)
+
+		pool.InsertContractTransfer(transfer)
+		require.Equal(t, true, pool.ValidatorExists(idx))
+		require.NoError(t, service.markIncludedBlockContractTransfers(blk))
+		require.Equal(t, false, pool.ValidatorExists(idx))
+	})
+}
+
 func TestReceiveBlock_Capella(t *testing.T) {
 	// TODO: Find out how can we setup block building for Capella epoch.
 	cfg := params.BeaconConfig()
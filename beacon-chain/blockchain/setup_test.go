@@ -8,11 +8,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
@@ -63,16 +65,17 @@ func (mb *mockBroadcaster) BroadcastBLSChanges(_ context.Context, _ []*ethpb.Sig
 var _ p2p.Broadcaster = (*mockBroadcaster)(nil)
 
 type testServiceRequirements struct {
-	ctx     context.Context
-	db      db.Database
-	fcs     forkchoice.ForkChoicer
-	sg      *stategen.State
-	notif   statefeed.Notifier
-	cs      *startup.ClockSynchronizer
-	attPool attestations.Pool
-	attSrv  *attestations.Service
-	blsPool *blstoexec.Pool
-	dc      *depositcache.DepositCache
+	ctx          context.Context
+	db           db.Database
+	fcs          forkchoice.ForkChoicer
+	sg           *stategen.State
+	notif        statefeed.Notifier
+	cs           *startup.ClockSynchronizer
+	attPool      attestations.Pool
+	attSrv       *attestations.Service
+	blsPool      *blstoexec.Pool
+	transferPool *contracttransfers.Pool
+	dc           *depositcache.DepositCache
 }
 
 func minimalTestService(t *testing.T, opts ...Option) (*Service, *testServiceRequirements) {
@@ -87,19 +90,21 @@ func minimalTestService(t *testing.T, opts ...Option) (*Service, *testServiceReq
 	attSrv, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
 	require.NoError(t, err)
 	blsPool := blstoexec.NewPool()
+	transferPool := contracttransfers.NewPool()
 	dc, err := depositcache.New()
 	require.NoError(t, err)
 	req := &testServiceRequirements{
-		ctx:     ctx,
-		db:      beaconDB,
-		fcs:     fcs,
-		sg:      sg,
-		notif:   notif,
-		cs:      cs,
-		attPool: attPool,
-		attSrv:  attSrv,
-		blsPool: blsPool,
-		dc:      dc,
+		ctx:          ctx,
+		db:           beaconDB,
+		fcs:          fcs,
+		sg:           sg,
+		notif:        notif,
+		cs:           cs,
+		attPool:      attPool,
+		attSrv:       attSrv,
+		blsPool:      blsPool,
+		transferPool: transferPool,
+		dc:           dc,
 	}
 	defOpts := []Option{WithDatabase(req.db),
 		WithStateNotifier(req.notif),
@@ -109,7 +114,9 @@ func minimalTestService(t *testing.T, opts ...Option) (*Service, *testServiceReq
 		WithAttestationPool(req.attPool),
 		WithAttestationService(req.attSrv),
 		WithBLSToExecPool(req.blsPool),
+		WithContractTransferPool(req.transferPool),
 		WithDepositCache(dc),
+		WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
 	}
 	// append the variadic opts so they override the defaults by being processed afterwards
 	opts = append(defOpts, opts...)
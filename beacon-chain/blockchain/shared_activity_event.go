@@ -0,0 +1,136 @@
+package blockchain
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+)
+
+// maxEffectiveActivityMovers is the number of validators reported in a shared activity event.
+const maxEffectiveActivityMovers = 16
+
+// effectiveActivities returns the effective activity of every validator in the state.
+func effectiveActivities(st state.ReadOnlyBeaconState) ([]uint64, error) {
+	activities := make([]uint64, 0, st.NumValidators())
+	if err := st.ReadFromEveryValidator(func(_ int, val state.ReadOnlyValidator) error {
+		activities = append(activities, val.EffectiveActivity())
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// sharedActivityEvent builds the shared activity event of the epoch transition which led to
+// postState. The validators whose effective activity changed the most compared to preActivities
+// are reported as top movers.
+func sharedActivityEvent(
+	blockRoot [32]byte,
+	preActivities []uint64,
+	postState state.ReadOnlyBeaconState,
+	optimistic bool,
+) (*ethpbv1.EventSharedActivity, error) {
+	sharedActivity := postState.SharedActivity()
+	if sharedActivity == nil {
+		return nil, errors.New("nil shared activity in state")
+	}
+
+	movers := make([]*ethpbv1.EffectiveActivityMover, 0)
+	if err := postState.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+		var previous uint64
+		if idx < len(preActivities) {
+			previous = preActivities[idx]
+		}
+		if previous == val.EffectiveActivity() {
+			return nil
+		}
+		movers = append(movers, &ethpbv1.EffectiveActivityMover{
+			ValidatorIndex:            primitives.ValidatorIndex(idx),
+			PreviousEffectiveActivity: previous,
+			EffectiveActivity:         val.EffectiveActivity(),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(movers, func(i, j int) bool {
+		return effectiveActivityChange(movers[i]) > effectiveActivityChange(movers[j])
+	})
+	if len(movers) > maxEffectiveActivityMovers {
+		movers = movers[:maxEffectiveActivityMovers]
+	}
+
+	return &ethpbv1.EventSharedActivity{
+		Epoch: coreTime.CurrentEpoch(postState),
+		Block: bytesutil.SafeCopyBytes(blockRoot[:]),
+		SharedActivity: &ethpbv1.SharedActivity{
+			TransactionsGasPerPeriod: sharedActivity.TransactionsGasPerPeriod,
+			TransactionsGasPerEpoch:  sharedActivity.TransactionsGasPerEpoch,
+			BaseFeePerPeriod:         sharedActivity.BaseFeePerPeriod,
+			BaseFeePerEpoch:          sharedActivity.BaseFeePerEpoch,
+		},
+		TopMovers:           movers,
+		ExecutionOptimistic: optimistic,
+	}, nil
+}
+
+func effectiveActivityChange(m *ethpbv1.EffectiveActivityMover) uint64 {
+	if m.EffectiveActivity > m.PreviousEffectiveActivity {
+		return m.EffectiveActivity - m.PreviousEffectiveActivity
+	}
+	return m.PreviousEffectiveActivity - m.EffectiveActivity
+}
+
+// SharedActivitySubscriber registers the consumers of shared activity events.
+type SharedActivitySubscriber interface {
+	SubscribeSharedActivity() (unsubscribe func())
+}
+
+// SubscribeSharedActivity registers a consumer of shared activity events and returns the function
+// removing the registration. The effective activities needed to build the events are only collected
+// while there is at least one consumer.
+func (s *Service) SubscribeSharedActivity() func() {
+	s.sharedActivitySubs.Add(1)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.sharedActivitySubs.Add(-1)
+		})
+	}
+}
+
+// hasSharedActivitySubscribers returns true if shared activity events have any consumers.
+func (s *Service) hasSharedActivitySubscribers() bool {
+	return s.sharedActivitySubs.Load() > 0
+}
+
+// sendSharedActivityEvent notifies the state feed about the shared activity updated by an epoch transition.
+func (s *Service) sendSharedActivityEvent(
+	blockRoot [32]byte,
+	preActivities []uint64,
+	postState state.ReadOnlyBeaconState,
+	optimistic bool,
+) {
+	event, err := sharedActivityEvent(blockRoot, preActivities, postState, optimistic)
+	if err != nil {
+		log.WithError(err).Error("Could not build shared activity event")
+		return
+	}
+	s.notifySharedActivity(event)
+}
+
+// notifySharedActivity sends the shared activity event to the state feed.
+func (s *Service) notifySharedActivity(event *ethpbv1.EventSharedActivity) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.SharedActivityUpdated,
+		Data: event,
+	})
+}
@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestSharedActivityEvent(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, maxEffectiveActivityMovers+4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+	require.NoError(t, st.SetSharedActivity(&ethpb.SharedActivity{
+		TransactionsGasPerPeriod: 4,
+		TransactionsGasPerEpoch:  3,
+		BaseFeePerPeriod:         2,
+		BaseFeePerEpoch:          1,
+	}))
+	vals := st.Validators()
+	preActivities := make([]uint64, len(vals))
+	for i, v := range vals {
+		preActivities[i] = 100
+		v.EffectiveActivity = 100 + uint64(i)
+	}
+	// Validator 0 keeps its effective activity, validator 1 loses the most.
+	vals[0].EffectiveActivity = 100
+	vals[1].EffectiveActivity = 0
+	require.NoError(t, st.SetValidators(vals))
+
+	pre, err := effectiveActivities(st)
+	require.NoError(t, err)
+	assert.Equal(t, len(vals), len(pre))
+	assert.Equal(t, uint64(0), pre[1])
+
+	event, err := sharedActivityEvent([32]byte{'a'}, preActivities, st, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), uint64(event.Epoch))
+	assert.Equal(t, true, event.ExecutionOptimistic)
+	assert.DeepEqual(t, &ethpbv1.SharedActivity{
+		TransactionsGasPerPeriod: 4,
+		TransactionsGasPerEpoch:  3,
+		BaseFeePerPeriod:         2,
+		BaseFeePerEpoch:          1,
+	}, event.SharedActivity)
+	require.Equal(t, maxEffectiveActivityMovers, len(event.TopMovers))
+	assert.DeepEqual(t, &ethpbv1.EffectiveActivityMover{
+		ValidatorIndex:            1,
+		PreviousEffectiveActivity: 100,
+		EffectiveActivity:         0,
+	}, event.TopMovers[0])
+	assert.Equal(t, uint64(len(vals)-1), uint64(event.TopMovers[1].ValidatorIndex))
+	for _, m := range event.TopMovers {
+		assert.NotEqual(t, uint64(0), uint64(m.ValidatorIndex), "unchanged validators must not be reported")
+	}
+}
+
+func TestSubscribeSharedActivity(t *testing.T) {
+	s := &Service{}
+	assert.Equal(t, false, s.hasSharedActivitySubscribers())
+
+	unsubscribe1 := s.SubscribeSharedActivity()
+	unsubscribe2 := s.SubscribeSharedActivity()
+	assert.Equal(t, true, s.hasSharedActivitySubscribers())
+	unsubscribe1()
+	// Removing the same registration twice has no effect.
+	unsubscribe1()
+	assert.Equal(t, true, s.hasSharedActivitySubscribers())
+	unsubscribe2()
+	assert.Equal(t, false, s.hasSharedActivitySubscribers())
+}
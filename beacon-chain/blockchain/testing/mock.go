@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
@@ -72,6 +73,8 @@ type ChainService struct {
 	OptimisticRoots             map[[32]byte]bool
 	BlockSlot                   primitives.Slot
 	SyncingRoot                 [32]byte
+	LightClientFinality         *ethpbv2.LightClientFinalityUpdate
+	LightClientOptimistic       *ethpbv2.LightClientOptimisticUpdate
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -615,3 +618,13 @@ func (c *ChainService) BlockBeingSynced(root [32]byte) bool {
 func (*ChainService) ReceiveBlob(_ context.Context, _ *ethpb.BlobSidecar) error {
 	return nil
 }
+
+// LightClientFinalityUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdate {
+	return s.LightClientFinality
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate {
+	return s.LightClientOptimistic
+}
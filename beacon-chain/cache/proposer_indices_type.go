@@ -10,8 +10,24 @@ import (
 // a ProposerIndices struct.
 var ErrNotProposerIndices = errors.New("object is not a proposer indices struct")
 
+// ErrNotProposerPowers will be returned when a cache object is not a pointer to
+// a ProposerPowers struct.
+var ErrNotProposerPowers = errors.New("object is not a proposer powers struct")
+
 // ProposerIndices defines the cached struct for proposer indices.
 type ProposerIndices struct {
 	BlockRoot       [32]byte
 	ProposerIndices []primitives.ValidatorIndex
 }
+
+// ProposerPowers defines the cached struct for the effective power of the active validators
+// in an epoch, which is used to sample the proposers of every slot of the epoch.
+type ProposerPowers struct {
+	BlockRoot [32]byte
+	// Powers holds the effective power of each active validator, indexed by validator index.
+	Powers []uint64
+	// Cumulative holds the running total of the powers, in the order of the active indices.
+	Cumulative []uint64
+	// Total is the cumulative power of the active validators in the epoch.
+	Total uint64
+}
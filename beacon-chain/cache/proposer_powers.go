@@ -0,0 +1,100 @@
+//go:build !fuzz
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// maxProposerPowersCacheSize defines the max number of proposer power tables on per block root basis can cache.
+	// It matches the proposer indices cache, which is keyed by the same roots.
+	maxProposerPowersCacheSize = uint64(8)
+
+	// ProposerPowersCacheMiss tracks the number of proposerPowers requests that aren't present in the cache.
+	ProposerPowersCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_powers_cache_miss",
+		Help: "The number of proposer powers requests that aren't present in the cache.",
+	})
+	// ProposerPowersCacheHit tracks the number of proposerPowers requests that are in the cache.
+	ProposerPowersCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_powers_cache_hit",
+		Help: "The number of proposer powers requests that are present in the cache.",
+	})
+)
+
+// ProposerPowersCache is a struct with 1 queue for looking up proposer power tables by root.
+type ProposerPowersCache struct {
+	proposerPowersCache *cache.FIFO
+	lock                sync.RWMutex
+}
+
+// proposerPowersKeyFn takes the block root as the key to retrieve proposer powers in a given epoch.
+func proposerPowersKeyFn(obj interface{}) (string, error) {
+	info, ok := obj.(*ProposerPowers)
+	if !ok {
+		return "", ErrNotProposerPowers
+	}
+
+	return key(info.BlockRoot), nil
+}
+
+// NewProposerPowersCache creates a new proposer powers cache for storing/accessing the proposer power table of an epoch.
+func NewProposerPowersCache() *ProposerPowersCache {
+	c := &ProposerPowersCache{}
+	c.Clear()
+	return c
+}
+
+// Clear resets the ProposerPowersCache to its initial state
+func (c *ProposerPowersCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.proposerPowersCache = cache.NewFIFO(proposerPowersKeyFn)
+}
+
+// AddProposerPowers adds ProposerPowers object to the cache.
+// This method also trims the least recently list if the cache size has ready the max cache size limit.
+func (c *ProposerPowersCache) AddProposerPowers(p *ProposerPowers) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.proposerPowersCache.AddIfNotPresent(p); err != nil {
+		return err
+	}
+	trim(c.proposerPowersCache, maxProposerPowersCacheSize)
+	return nil
+}
+
+// ProposerPowers returns the proposer power table of a block root seed.
+func (c *ProposerPowersCache) ProposerPowers(r [32]byte) (*ProposerPowers, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	obj, exists, err := c.proposerPowersCache.GetByKey(key(r))
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		ProposerPowersCacheHit.Inc()
+	} else {
+		ProposerPowersCacheMiss.Inc()
+		return nil, nil
+	}
+
+	item, ok := obj.(*ProposerPowers)
+	if !ok {
+		return nil, ErrNotProposerPowers
+	}
+
+	return item, nil
+}
+
+// Len returns the number of keys in the underlying cache.
+func (c *ProposerPowersCache) Len() int {
+	return len(c.proposerPowersCache.ListKeys())
+}
@@ -0,0 +1,32 @@
+//go:build fuzz
+
+// This file is used in fuzzer builds to bypass proposer powers caches.
+package cache
+
+// FakeProposerPowersCache is a struct with 1 queue for looking up proposer power tables by root.
+type FakeProposerPowersCache struct {
+}
+
+// NewProposerPowersCache creates a new proposer powers cache for storing/accessing the proposer power table of an epoch.
+func NewProposerPowersCache() *FakeProposerPowersCache {
+	return &FakeProposerPowersCache{}
+}
+
+// AddProposerPowers adds ProposerPowers object to the cache.
+// This method also trims the least recently list if the cache size has ready the max cache size limit.
+func (c *FakeProposerPowersCache) AddProposerPowers(p *ProposerPowers) error {
+	return nil
+}
+
+// ProposerPowers returns the proposer power table of a block root seed.
+func (c *FakeProposerPowersCache) ProposerPowers(r [32]byte) (*ProposerPowers, error) {
+	return nil, nil
+}
+
+func (c *FakeProposerPowersCache) Len() int {
+	return 0
+}
+
+// Clear is a stub.
+func (c *FakeProposerPowersCache) Clear() {
+}
@@ -0,0 +1,53 @@
+//go:build !fuzz
+
+package cache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestProposerPowersKeyFn_OK(t *testing.T) {
+	item := &ProposerPowers{
+		BlockRoot: [32]byte{'A'},
+		Powers:    []uint64{1, 2, 3},
+		Total:     6,
+	}
+
+	k, err := proposerPowersKeyFn(item)
+	require.NoError(t, err)
+	assert.Equal(t, key(item.BlockRoot), k)
+}
+
+func TestProposerPowersKeyFn_InvalidObj(t *testing.T) {
+	_, err := proposerPowersKeyFn("bad")
+	assert.Equal(t, ErrNotProposerPowers, err)
+}
+
+func TestProposerPowersCache_AddProposerPowers(t *testing.T) {
+	cache := NewProposerPowersCache()
+	bRoot := [32]byte{'A'}
+	received, err := cache.ProposerPowers(bRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, received == nil)
+
+	item := &ProposerPowers{BlockRoot: bRoot, Powers: []uint64{1, 2, 3}, Total: 6}
+	require.NoError(t, cache.AddProposerPowers(item))
+	received, err = cache.ProposerPowers(bRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, item, received)
+}
+
+func TestProposerPowersCache_CanRotate(t *testing.T) {
+	cache := NewProposerPowersCache()
+	for i := 0; i < int(maxProposerPowersCacheSize)+1; i++ {
+		s := []byte(strconv.Itoa(i))
+		item := &ProposerPowers{BlockRoot: bytesutil.ToBytes32(s)}
+		require.NoError(t, cache.AddProposerPowers(item))
+	}
+	assert.Equal(t, int(maxProposerPowersCacheSize), cache.Len())
+}
@@ -254,7 +254,10 @@ func RewardProposer(ctx context.Context, beaconState state.BeaconState, proposer
 		return nil
 	}
 
-	proposerReward := calculateProposerReward(baseProposerReward, proposerRewardNumerator, proposerRewardDenominator)
+	proposerReward, err := calculateProposerReward(time.CurrentEpoch(beaconState), baseProposerReward, proposerRewardNumerator, proposerRewardDenominator)
+	if err != nil {
+		return err
+	}
 
 	i, err := helpers.BeaconProposerIndex(ctx, beaconState)
 	if err != nil {
@@ -264,9 +267,15 @@ func RewardProposer(ctx context.Context, beaconState state.BeaconState, proposer
 	return helpers.IncreaseBalance(beaconState, i, proposerReward)
 }
 
-func calculateProposerReward(baseReward uint64, numerator, denominator uint64) uint64 {
+func calculateProposerReward(epoch primitives.Epoch, baseReward uint64, numerator, denominator uint64) (uint64, error) {
 	cfg := params.BeaconConfig()
-	baseReward = baseReward * (cfg.WeightDenominator - cfg.SyncRewardWeight) / cfg.WeightDenominator
+	baseReward, err := helpers.ActivityMulDiv(epoch, "attestation proposer reward", baseReward, cfg.WeightDenominator-cfg.SyncRewardWeight, cfg.WeightDenominator)
+	if err != nil {
+		return 0, err
+	}
+	if epoch >= cfg.ElectraForkEpoch {
+		return helpers.ActivityMulDiv(epoch, "attestation proposer reward", baseReward, numerator, denominator)
+	}
 
 	var (
 		bigBaseReward  = new(big.Int).SetUint64(baseReward)
@@ -276,7 +285,7 @@ func calculateProposerReward(baseReward uint64, numerator, denominator uint64) u
 
 	bigBaseReward.Mul(bigBaseReward, bigNumerator)
 	bigBaseReward.Div(bigBaseReward, bigDenominator)
-	return bigBaseReward.Uint64()
+	return bigBaseReward.Uint64(), nil
 }
 
 // AttestationParticipationFlagIndices retrieves a map of attestation scoring based on Altair's participation flag indices.
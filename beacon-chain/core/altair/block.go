@@ -6,9 +6,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	p2pType "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -91,7 +93,7 @@ func processSyncAggregate(ctx context.Context, s state.BeaconState, sync *ethpb.
 	if err != nil {
 		return nil, nil, 0, err
 	}
-	proposerReward, participantReward, err := SyncRewards(activeBalance, baseProposerReward)
+	proposerReward, participantReward, err := SyncRewards(activeBalance, baseProposerReward, time.CurrentEpoch(s))
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -156,8 +158,9 @@ func VerifySyncCommitteeSig(s state.BeaconState, syncKeys []bls.PublicKey, syncS
 	return nil
 }
 
-// SyncRewards returns the proposer reward and the sync participant reward given the total active balance in state.
-func SyncRewards(activeBalance, baseProposerReward uint64) (proposerReward, participantReward uint64, err error) {
+// SyncRewards returns the proposer reward and the sync participant reward given the total active balance in state
+// and the base proposer reward of the given epoch.
+func SyncRewards(activeBalance, baseProposerReward uint64, epoch primitives.Epoch) (proposerReward, participantReward uint64, err error) {
 	cfg := params.BeaconConfig()
 	totalActiveIncrements := activeBalance / cfg.EffectiveBalanceIncrement
 	baseRewardPerInc, err := BaseRewardPerIncrement(activeBalance)
@@ -167,6 +170,10 @@ func SyncRewards(activeBalance, baseProposerReward uint64) (proposerReward, part
 	totalBaseRewards := baseRewardPerInc * totalActiveIncrements
 	maxParticipantRewards := totalBaseRewards * cfg.SyncRewardWeight / cfg.WeightDenominator / uint64(cfg.SlotsPerEpoch)
 	participantReward = maxParticipantRewards / cfg.SyncCommitteeSize
-	proposerReward = baseProposerReward * cfg.SyncRewardWeight / cfg.WeightDenominator / cfg.SyncCommitteeSize
+	proposerReward, err = helpers.ActivityMulDiv(epoch, "sync proposer reward", baseProposerReward, cfg.SyncRewardWeight, cfg.WeightDenominator)
+	if err != nil {
+		return 0, 0, err
+	}
+	proposerReward /= cfg.SyncCommitteeSize
 	return
 }
@@ -530,7 +530,7 @@ func Test_SyncRewards(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			proposerReward, participantReward, err := altair.SyncRewards(tt.activeBalance, 0)
+			proposerReward, participantReward, err := altair.SyncRewards(tt.activeBalance, 0, 0)
 			if (err != nil) && (tt.errString != "") {
 				require.ErrorContains(t, tt.errString, err)
 				return
@@ -1,3 +1,7 @@
 package altair
 
 var ProcessSyncAggregateEported = processSyncAggregate
+
+var CalculateProposerReward = calculateProposerReward
+
+var BaseProposerRewardOfEpoch = baseProposerReward
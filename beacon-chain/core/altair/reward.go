@@ -6,10 +6,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/math"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
 // BaseReward takes state and validator index and calculate
@@ -66,33 +68,100 @@ func BaseRewardPerIncrement(activeBalance uint64) (uint64, error) {
 
 // BaseProposerReward of the beacon state.
 func BaseProposerReward(s state.ReadOnlyBeaconState, totalPower, totalEffectivePower uint64) (uint64, error) {
+	breakdown, err := BaseProposerRewardBreakdown(s, totalPower, totalEffectivePower)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.BaseProposerReward, nil
+}
+
+// ProposerRewardBreakdown describes how the base proposer reward of the beacon state
+// is composed from validators' effective activity and shared transactions gas.
+type ProposerRewardBreakdown struct {
+	// BaseProposerReward is the value returned by BaseProposerReward.
+	BaseProposerReward     uint64
+	TotalEffectiveActivity uint64
+	TotalPower             uint64
+	TotalEffectivePower    uint64
+	SharedActivity         *ethpb.SharedActivity
+}
+
+// BaseProposerRewardBreakdown computes the base proposer reward of the beacon state
+// together with the activity, powers and shared activity it is derived from.
+func BaseProposerRewardBreakdown(s state.ReadOnlyBeaconState, totalPower, totalEffectivePower uint64) (*ProposerRewardBreakdown, error) {
 	activity, err := helpers.TotalEffectiveActivity(s)
 	if err != nil {
-		return 0, errors.Wrap(err, "could not calculate total effective activity")
+		return nil, errors.Wrap(err, "could not calculate total effective activity")
 	}
 
 	sharedActivity := s.SharedActivity()
 	if sharedActivity == nil {
-		return 0, errors.New("nil shared activity in state")
+		return nil, errors.New("nil shared activity in state")
 	}
 
-	period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
-	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
-	denominator := period * period * slotsPerEpoch * slotsPerEpoch
+	periodSlots, err := math.Mul64(uint64(params.BeaconConfig().EpochsPerActivityPeriod), uint64(params.BeaconConfig().SlotsPerEpoch))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute slots per activity period")
+	}
+	denominator, err := math.Mul64(periodSlots, periodSlots)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute reward denominator")
+	}
+	if denominator == 0 {
+		return nil, errors.New("zero activity period")
+	}
+	epoch := time.CurrentEpoch(s)
 	transactionsGas := sharedActivity.TransactionsGasPerPeriod
 	baseFee := sharedActivity.BaseFeePerPeriod
-	reward := baseFee * (activity + transactionsGas) / denominator
+	rewardActivity, err := helpers.ActivityAdd(epoch, "reward activity", activity, transactionsGas)
+	if err != nil {
+		return nil, err
+	}
+	reward, err := helpers.ActivityMulDiv(epoch, "proposer reward", baseFee, rewardActivity, denominator)
+	if err != nil {
+		return nil, err
+	}
+	total, err := baseProposerReward(epoch, reward, totalEffectivePower, totalPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProposerRewardBreakdown{
+		BaseProposerReward:     total,
+		TotalEffectiveActivity: activity,
+		TotalPower:             totalPower,
+		TotalEffectivePower:    totalEffectivePower,
+		SharedActivity:         sharedActivity,
+	}, nil
+}
 
-	return baseProposerReward(reward, totalEffectivePower, totalPower), nil
+// EarnedActivityReward returns the activity part of a reward the proposer earned from the base proposer reward.
+// The attestations and sync aggregate rewards of the proposer are both scaled from the base proposer reward,
+// which is proportional to the sum of the total effective activity and the shared transactions gas, so the
+// earned reward is split in the same proportion.
+func (b *ProposerRewardBreakdown) EarnedActivityReward(earned uint64) (uint64, error) {
+	if b.TotalEffectiveActivity == 0 {
+		return 0, nil
+	}
+	total, err := math.Add64(b.TotalEffectiveActivity, b.SharedActivity.TransactionsGasPerPeriod)
+	if err != nil {
+		return 0, err
+	}
+	return math.MulDiv64(earned, b.TotalEffectiveActivity, total)
 }
 
-func baseProposerReward(reward, totalEffectivePower, totalPower uint64) uint64 {
+func baseProposerReward(epoch primitives.Epoch, reward, totalEffectivePower, totalPower uint64) (uint64, error) {
 	if totalPower == 0 {
-		return reward
+		return reward, nil
+	}
+	if epoch >= params.BeaconConfig().ElectraForkEpoch {
+		return helpers.ActivityMulDiv(epoch, "base proposer reward", reward, totalEffectivePower, totalPower)
 	}
 
-	if stdmath.MaxUint64/totalEffectivePower > reward {
-		return reward * totalEffectivePower / totalPower
+	// A zero total effective power used to panic with a division by zero, it now yields a zero reward. Such a
+	// state can only be reached when no validator has any effective power, there was no chain to keep in sync.
+	if totalEffectivePower == 0 || stdmath.MaxUint64/totalEffectivePower > reward {
+		return reward * totalEffectivePower / totalPower, nil
 	}
 
 	var (
@@ -105,5 +174,5 @@ func baseProposerReward(reward, totalEffectivePower, totalPower uint64) uint64 {
 	ret.Mul(rewardBig, totalEffectivePowerBig)
 	ret.Div(ret, totalPowerBig)
 
-	return ret.Uint64()
+	return ret.Uint64(), nil
 }
@@ -1,8 +1,11 @@
 package altair_test
 
 import (
+	"context"
+	"errors"
 	mathC "github.com/prysmaticlabs/prysm/v4/math"
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
@@ -10,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
 )
@@ -196,3 +200,172 @@ func Test_BaseRewardPerIncrement(t *testing.T) {
 		})
 	}
 }
+
+func Test_BaseProposerRewardBreakdown(t *testing.T) {
+	helpers.ClearCache()
+	st, _ := util.DeterministicGenesisStateAltair(t, 4)
+	vals := st.Validators()
+	for i, v := range vals {
+		v.EffectiveActivity = uint64(i+1) * 1e9
+	}
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetSharedActivity(&ethpb.SharedActivity{
+		TransactionsGasPerPeriod: 15000000 * 1575 * 32,
+		BaseFeePerPeriod:         23 * 1575 * 32,
+	}))
+
+	totalPower, totalEffectivePower, err := helpers.Powers(context.Background(), st)
+	require.NoError(t, err)
+	want, err := altair.BaseProposerReward(st, totalPower, totalEffectivePower)
+	require.NoError(t, err)
+
+	breakdown, err := altair.BaseProposerRewardBreakdown(st, totalPower, totalEffectivePower)
+	require.NoError(t, err)
+	require.Equal(t, want, breakdown.BaseProposerReward)
+	require.Equal(t, uint64(10e9), breakdown.TotalEffectiveActivity)
+	require.Equal(t, totalPower, breakdown.TotalPower)
+	require.Equal(t, totalEffectivePower, breakdown.TotalEffectivePower)
+	require.Equal(t, uint64(15000000*1575*32), breakdown.SharedActivity.TransactionsGasPerPeriod)
+}
+
+func TestProposerRewardBreakdown_EarnedActivityReward(t *testing.T) {
+	breakdown := &altair.ProposerRewardBreakdown{
+		BaseProposerReward:     1000,
+		TotalEffectiveActivity: 3e9,
+		SharedActivity:         &ethpb.SharedActivity{TransactionsGasPerPeriod: 1e9},
+	}
+	got, err := breakdown.EarnedActivityReward(401)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), got)
+
+	breakdown.TotalEffectiveActivity = 0
+	got, err = breakdown.EarnedActivityReward(401)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), got)
+}
+
+// legacyBaseProposerReward is baseProposerReward before the activity arithmetic depended on the fork.
+func legacyBaseProposerReward(reward, totalEffectivePower, totalPower uint64) uint64 {
+	if totalPower == 0 {
+		return reward
+	}
+	if math.MaxUint64/totalEffectivePower > reward {
+		return reward * totalEffectivePower / totalPower
+	}
+	ret := new(big.Int).Mul(new(big.Int).SetUint64(reward), new(big.Int).SetUint64(totalEffectivePower))
+	return ret.Div(ret, new(big.Int).SetUint64(totalPower)).Uint64()
+}
+
+// legacyCalculateProposerReward is calculateProposerReward before the activity arithmetic depended on the fork.
+func legacyCalculateProposerReward(baseReward, numerator, denominator uint64) uint64 {
+	cfg := params.BeaconConfig()
+	baseReward = baseReward * (cfg.WeightDenominator - cfg.SyncRewardWeight) / cfg.WeightDenominator
+	ret := new(big.Int).Mul(new(big.Int).SetUint64(baseReward), new(big.Int).SetUint64(numerator))
+	return ret.Div(ret, new(big.Int).SetUint64(denominator)).Uint64()
+}
+
+// legacySyncProposerReward is the sync proposer reward of SyncRewards before the activity arithmetic depended on the fork.
+func legacySyncProposerReward(baseProposerReward uint64) uint64 {
+	cfg := params.BeaconConfig()
+	return baseProposerReward * cfg.SyncRewardWeight / cfg.WeightDenominator / cfg.SyncCommitteeSize
+}
+
+func TestProposerRewards_ActivityArithmetic(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+	const (
+		preElectra = primitives.Epoch(9)
+		electra    = primitives.Epoch(10)
+	)
+	var overflowErr *helpers.ActivityOverflowError
+
+	t.Run("base proposer reward", func(t *testing.T) {
+		tests := []struct {
+			name                             string
+			reward, totalEffective, totalPow uint64
+			electraWant                      uint64
+			electraOverflows                 bool
+		}{
+			{name: "small", reward: 1000, totalEffective: 500, totalPow: 1000, electraWant: 500},
+			{name: "zero total power", reward: 1000, totalEffective: 500, electraWant: 1000},
+			{name: "wide product", reward: math.MaxUint64 / 2, totalEffective: 4, totalPow: 8, electraWant: math.MaxUint64 / 4},
+			{name: "result overflows", reward: math.MaxUint64, totalEffective: 2, totalPow: 1, electraOverflows: true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := altair.BaseProposerRewardOfEpoch(preElectra, tt.reward, tt.totalEffective, tt.totalPow)
+				require.NoError(t, err)
+				require.Equal(t, legacyBaseProposerReward(tt.reward, tt.totalEffective, tt.totalPow), got)
+
+				got, err = altair.BaseProposerRewardOfEpoch(electra, tt.reward, tt.totalEffective, tt.totalPow)
+				if tt.electraOverflows {
+					require.Equal(t, true, errors.As(err, &overflowErr))
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tt.electraWant, got)
+			})
+		}
+	})
+
+	t.Run("base proposer reward without effective power", func(t *testing.T) {
+		// Deliberate change of the legacy arithmetic: a zero total effective power panicked with a division
+		// by zero and now yields a zero reward on both sides of the fork.
+		func() {
+			defer func() {
+				require.NotNil(t, recover(), "legacy arithmetic did not panic")
+			}()
+			legacyBaseProposerReward(1000, 0, 1000)
+		}()
+		for _, epoch := range []primitives.Epoch{preElectra, electra} {
+			got, err := altair.BaseProposerRewardOfEpoch(epoch, 1000, 0, 1000)
+			require.NoError(t, err)
+			require.Equal(t, uint64(0), got)
+		}
+	})
+
+	t.Run("attestation proposer reward", func(t *testing.T) {
+		tests := []struct {
+			name                   string
+			baseReward, num, denom uint64
+			electraWant            uint64
+			electraOverflows       bool
+		}{
+			{name: "small", baseReward: 64000, num: 26, denom: 56, electraWant: 28652},
+			{name: "base reward wraps before Electra", baseReward: math.MaxUint64, num: 1, denom: 7, electraWant: 2541133112194683130},
+			{name: "result overflows", baseReward: math.MaxUint64, num: 2, denom: 1, electraOverflows: true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := altair.CalculateProposerReward(preElectra, tt.baseReward, tt.num, tt.denom)
+				require.NoError(t, err)
+				require.Equal(t, legacyCalculateProposerReward(tt.baseReward, tt.num, tt.denom), got)
+
+				got, err = altair.CalculateProposerReward(electra, tt.baseReward, tt.num, tt.denom)
+				if tt.electraOverflows {
+					require.Equal(t, true, errors.As(err, &overflowErr))
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tt.electraWant, got)
+			})
+		}
+	})
+
+	t.Run("sync proposer reward", func(t *testing.T) {
+		activeBalance := params.BeaconConfig().MaxEffectiveBalance * 64
+		for _, base := range []uint64{0, 1 << 20, math.MaxUint64 / 8, math.MaxUint64} {
+			got, _, err := altair.SyncRewards(activeBalance, base, preElectra)
+			require.NoError(t, err)
+			require.Equal(t, legacySyncProposerReward(base), got)
+
+			got, _, err = altair.SyncRewards(activeBalance, base, electra)
+			require.NoError(t, err)
+			want, err := mathC.MulDiv64(base, params.BeaconConfig().SyncRewardWeight, params.BeaconConfig().WeightDenominator)
+			require.NoError(t, err)
+			require.Equal(t, want/params.BeaconConfig().SyncCommitteeSize, got)
+		}
+	})
+}
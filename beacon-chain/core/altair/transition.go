@@ -97,6 +97,10 @@ func ProcessEpoch(ctx context.Context, state state.BeaconState) (state.BeaconSta
 	if err != nil {
 		return nil, err
 	}
+	state, err = e.ProcessPendingActivityCredits(state)
+	if err != nil {
+		return nil, err
+	}
 	state, err = e.ProcessSlashingsReset(state)
 	if err != nil {
 		return nil, err
@@ -8,8 +8,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -36,7 +39,10 @@ func ProcessActivityChanges(
 
 // ProcessActivityChange perform activity updates if
 // contract exists in beacon state contract map and
-// its owner is active validator.
+// its owner is active validator. All contracts of a
+// validator credit the same activity. Starting from
+// Electra, the activity of an owner pending activation
+// is queued and credited once the owner activates.
 func ProcessActivityChange(
 	ctx context.Context,
 	beaconState state.BeaconState,
@@ -57,6 +63,11 @@ func ProcessActivityChange(
 	}
 
 	if !helpers.IsActiveValidatorUsingTrie(owner, epoch) {
+		if beaconState.Version() >= version.Electra && isPendingActivation(owner, epoch) {
+			if err := queuePendingActivityCredit(beaconState, ownerIdx, activityChange.DeltaActivity); err != nil {
+				return nil, err
+			}
+		}
 		return beaconState, nil
 	}
 
@@ -65,7 +76,10 @@ func ProcessActivityChange(
 		return nil, err
 	}
 
-	activity += activityChange.DeltaActivity
+	activity, err = helpers.ActivityAdd(epoch, "epoch activity", activity, activityChange.DeltaActivity)
+	if err != nil {
+		return nil, err
+	}
 	if err := beaconState.UpdateActivityAtIndex(ownerIdx, activity); err != nil {
 		return nil, err
 	}
@@ -73,6 +87,36 @@ func ProcessActivityChange(
 	return beaconState, nil
 }
 
+// isPendingActivation returns true if the validator has not been activated yet and
+// has not exited before its activation.
+func isPendingActivation(val state.ReadOnlyValidator, epoch primitives.Epoch) bool {
+	return val.ActivationEpoch() > epoch && val.ExitEpoch() == params.BeaconConfig().FarFutureEpoch
+}
+
+// queuePendingActivityCredit queues the activity to be credited to the validator once it activates.
+// The activity is dropped if the list of pending activity credits is full.
+func queuePendingActivityCredit(beaconState state.BeaconState, idx primitives.ValidatorIndex, activity uint64) error {
+	if activity == 0 {
+		return nil
+	}
+	n, err := beaconState.PendingActivityCreditsLength()
+	if err != nil {
+		return err
+	}
+	if uint64(n) >= params.BeaconConfig().PendingActivityCreditsLimit {
+		pendingActivityCreditsDroppedCount.Inc()
+		log.WithFields(logrus.Fields{
+			"index":    idx,
+			"activity": activity,
+		}).Debug("Dropping activity of validator pending activation, pending activity credits list is full")
+		return nil
+	}
+	return beaconState.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{
+		ValidatorIndex: idx,
+		Activity:       activity,
+	})
+}
+
 // ProcessTransactionsCount perform transactions gas per epoch updates.
 func ProcessTransactionsCount(
 	ctx context.Context,
@@ -82,13 +126,20 @@ func ProcessTransactionsCount(
 	ctx, span := trace.StartSpan(ctx, "core.ProcessTransactionsCount")
 	defer span.End()
 
-	transactionsGas := transactionsCount * params.BeaconConfig().BaseTransactionCost
+	epoch := time.CurrentEpoch(beaconState)
+	transactionsGas, err := helpers.ActivityMul(epoch, "transactions gas", transactionsCount, params.BeaconConfig().BaseTransactionCost)
+	if err != nil {
+		return nil, err
+	}
 	sharedActivity := beaconState.SharedActivity()
 	if sharedActivity == nil {
 		return nil, errors.New("nil shared activity in state")
 	}
 
-	sharedActivity.TransactionsGasPerEpoch += transactionsGas
+	sharedActivity.TransactionsGasPerEpoch, err = helpers.ActivityAdd(epoch, "transactions gas per epoch", sharedActivity.TransactionsGasPerEpoch, transactionsGas)
+	if err != nil {
+		return nil, err
+	}
 	if err := beaconState.SetSharedActivity(sharedActivity); err != nil {
 		return nil, err
 	}
@@ -108,7 +159,11 @@ func ProcessBaseFee(
 	if sharedActivity == nil {
 		return nil, errors.New("nil shared activity in state")
 	}
-	sharedActivity.BaseFeePerEpoch += baseFee
+	var err error
+	sharedActivity.BaseFeePerEpoch, err = helpers.ActivityAdd(time.CurrentEpoch(beaconState), "base fee per epoch", sharedActivity.BaseFeePerEpoch, baseFee)
+	if err != nil {
+		return nil, err
+	}
 	if err := beaconState.SetSharedActivity(sharedActivity); err != nil {
 		return nil, err
 	}
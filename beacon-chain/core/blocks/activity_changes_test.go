@@ -9,6 +9,7 @@ import (
 	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
@@ -218,6 +219,124 @@ func TestProcessActivityChanges(t *testing.T) {
 	}
 }
 
+func TestProcessActivityChanges_AdditionalContract(t *testing.T) {
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Validators: []*ethpb.Validator{
+			{
+				PublicKey:       []byte{1},
+				Contract:        bytesutil.PadTo([]byte{1, 1, 1}, 20),
+				ActivationEpoch: 0,
+				ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			},
+		},
+		Activities: []uint64{0},
+		AdditionalContracts: []*ethpb.ValidatorContract{
+			{ValidatorIndex: 0, Contract: bytesutil.PadTo([]byte{2, 2, 2}, 20)},
+		},
+	})
+	require.NoError(t, err)
+
+	changes := []*ethpb.ActivityChange{
+		{
+			ContractAddress: []byte{1, 1, 1},
+			DeltaActivity:   42,
+		},
+		{
+			ContractAddress: []byte{2, 2, 2},
+			DeltaActivity:   4200,
+		},
+	}
+	st, err = blocks.ProcessActivityChanges(context.Background(), st, changes)
+	require.NoError(t, err)
+	activity, err := st.ActivityAtIndex(0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4242), activity)
+}
+
+func TestProcessActivityChanges_PendingActivation(t *testing.T) {
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Validators: []*ethpb.Validator{
+			{
+				PublicKey:       []byte{1},
+				Contract:        bytesutil.PadTo([]byte{1, 1, 1}, 20),
+				ActivationEpoch: 42,
+				ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			},
+			{
+				PublicKey:       []byte{2},
+				Contract:        bytesutil.PadTo([]byte{2, 2, 2}, 20),
+				ActivationEpoch: 42,
+				ExitEpoch:       0,
+			},
+		},
+		Activities: []uint64{0, 0},
+	})
+	require.NoError(t, err)
+
+	changes := []*ethpb.ActivityChange{
+		{
+			ContractAddress: []byte{1, 1, 1},
+			DeltaActivity:   42,
+		},
+		{
+			ContractAddress: []byte{2, 2, 2},
+			DeltaActivity:   4242,
+		},
+		{
+			ContractAddress: []byte{1, 1, 1},
+			DeltaActivity:   4200,
+		},
+	}
+	st, err = blocks.ProcessActivityChanges(context.Background(), st, changes)
+	require.NoError(t, err)
+	activity, err := st.ActivityAtIndex(0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), activity)
+	credits, err := st.PendingActivityCredits()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(credits))
+	assert.Equal(t, primitives.ValidatorIndex(0), credits[0].ValidatorIndex)
+	assert.Equal(t, uint64(42), credits[0].Activity)
+	assert.Equal(t, primitives.ValidatorIndex(0), credits[1].ValidatorIndex)
+	assert.Equal(t, uint64(4200), credits[1].Activity)
+}
+
+func TestProcessActivityChanges_PendingActivation_ListFull(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.PendingActivityCreditsLimit = 1
+	params.OverrideBeaconConfig(cfg)
+
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Validators: []*ethpb.Validator{
+			{
+				PublicKey:       []byte{1},
+				Contract:        bytesutil.PadTo([]byte{1, 1, 1}, 20),
+				ActivationEpoch: 42,
+				ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			},
+		},
+		Activities: []uint64{0},
+		PendingActivityCredits: []*ethpb.PendingActivityCredit{
+			{ValidatorIndex: 7, Activity: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	changes := []*ethpb.ActivityChange{
+		{
+			ContractAddress: []byte{1, 1, 1},
+			DeltaActivity:   42,
+		},
+	}
+	st, err = blocks.ProcessActivityChanges(context.Background(), st, changes)
+	require.NoError(t, err)
+	credits, err := st.PendingActivityCredits()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(credits))
+	assert.Equal(t, primitives.ValidatorIndex(7), credits[0].ValidatorIndex)
+}
+
 func TestProcessTransactionsCount(t *testing.T) {
 	st, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Fork: &ethpb.Fork{
@@ -0,0 +1,202 @@
+package blocks_test
+
+import (
+	"context"
+	"errors"
+	stdmath "math"
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+var overflowContract = []byte{0xfa, 1}
+
+func overflowState(t *testing.T, activity, effectiveActivity uint64, shared *ethpb.SharedActivity) state.BeaconState {
+	st, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators: []*ethpb.Validator{{
+			PublicKey:         []byte{1},
+			Contract:          overflowContract,
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+			EffectiveActivity: effectiveActivity,
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+		}},
+		Balances:   []uint64{params.BeaconConfig().MaxEffectiveBalance},
+		Activities: []uint64{activity},
+		Fork: &ethpb.Fork{
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		},
+		SharedActivity: shared,
+	})
+	require.NoError(t, err)
+	return st
+}
+
+// requireOverflow checks that an operation rejected its input with an ActivityOverflowError exactly when
+// the input overflows.
+func requireOverflow(t *testing.T, overflows bool, st state.BeaconState, err error) {
+	if !overflows {
+		require.NoError(t, err)
+		return
+	}
+	var overflowErr *helpers.ActivityOverflowError
+	if !errors.As(err, &overflowErr) {
+		t.Fatalf("Expected an activity overflow error, got %v", err)
+	}
+	require.Equal(t, true, st == nil, "State returned on overflow")
+}
+
+// useCheckedActivityMath schedules Electra at genesis, so the fuzzed arithmetic rejects overflows.
+func useCheckedActivityMath(f *testing.F) {
+	params.SetupTestConfigCleanup(f)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+}
+
+func addOverflows(a, b uint64) bool {
+	return a > stdmath.MaxUint64-b
+}
+
+func FuzzProcessActivityChanges_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(0), uint64(21_000))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1))
+	f.Add(uint64(stdmath.MaxUint64-21_000), uint64(21_000))
+	f.Fuzz(func(t *testing.T, activity, delta uint64) {
+		st := overflowState(t, activity, 0, &ethpb.SharedActivity{})
+		post, err := blocks.ProcessActivityChanges(context.Background(), st, []*ethpb.ActivityChange{
+			{ContractAddress: overflowContract, DeltaActivity: delta},
+		})
+		requireOverflow(t, addOverflows(activity, delta), post, err)
+		if err == nil {
+			got, err := post.ActivityAtIndex(0)
+			require.NoError(t, err)
+			require.Equal(t, activity+delta, got)
+		}
+	})
+}
+
+func FuzzProcessTransactionsCount_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(0), uint64(100))
+	f.Add(uint64(0), uint64(stdmath.MaxUint64/21_000+1))
+	f.Add(uint64(stdmath.MaxUint64-21_000), uint64(1))
+	f.Fuzz(func(t *testing.T, gasPerEpoch, count uint64) {
+		st := overflowState(t, 0, 0, &ethpb.SharedActivity{TransactionsGasPerEpoch: gasPerEpoch})
+		post, err := blocks.ProcessTransactionsCount(context.Background(), st, count)
+		gas := new(big.Int).Mul(new(big.Int).SetUint64(count), new(big.Int).SetUint64(params.BeaconConfig().BaseTransactionCost))
+		gas.Add(gas, new(big.Int).SetUint64(gasPerEpoch))
+		requireOverflow(t, !gas.IsUint64(), post, err)
+		if err == nil {
+			require.Equal(t, gas.Uint64(), post.SharedActivity().TransactionsGasPerEpoch)
+		}
+	})
+}
+
+func FuzzProcessBaseFee_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(0), uint64(7_000_000_000))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1))
+	f.Fuzz(func(t *testing.T, baseFeePerEpoch, baseFee uint64) {
+		st := overflowState(t, 0, 0, &ethpb.SharedActivity{BaseFeePerEpoch: baseFeePerEpoch})
+		post, err := blocks.ProcessBaseFee(context.Background(), st, baseFee)
+		requireOverflow(t, addOverflows(baseFeePerEpoch, baseFee), post, err)
+		if err == nil {
+			require.Equal(t, baseFeePerEpoch+baseFee, post.SharedActivity().BaseFeePerEpoch)
+		}
+	})
+}
+
+// movingAverage is the reference ((average + delta) * period - average) / period.
+func movingAverage(average, delta, period uint64) *big.Int {
+	v := new(big.Int).Add(new(big.Int).SetUint64(average), new(big.Int).SetUint64(delta))
+	v.Mul(v, new(big.Int).SetUint64(period))
+	v.Sub(v, new(big.Int).SetUint64(average))
+	return v.Div(v, new(big.Int).SetUint64(period))
+}
+
+func FuzzProcessEffectiveActivityUpdates_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(1_000_000), uint64(21_000))
+	f.Add(uint64(stdmath.MaxUint64), uint64(0))
+	f.Add(uint64(stdmath.MaxUint64/2), uint64(stdmath.MaxUint64/2+2))
+	f.Fuzz(func(t *testing.T, effectiveActivity, activity uint64) {
+		st := overflowState(t, activity, effectiveActivity, &ethpb.SharedActivity{})
+		post, err := epoch.ProcessEffectiveActivityUpdates(context.Background(), st)
+		requireOverflow(t, addOverflows(effectiveActivity, activity), post, err)
+		if err == nil {
+			want := movingAverage(effectiveActivity, activity, uint64(params.BeaconConfig().EpochsPerActivityPeriod))
+			v, err := post.ValidatorAtIndexReadOnly(0)
+			require.NoError(t, err)
+			require.Equal(t, want.Uint64(), v.EffectiveActivity())
+		}
+	})
+}
+
+func FuzzProcessSharedActivityUpdates_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(21_000*1_000), uint64(21_000*10), uint64(7), uint64(7_000_000_000))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(stdmath.MaxUint64), uint64(1))
+	f.Fuzz(func(t *testing.T, gasPerPeriod, gasPerEpoch, baseFeePerPeriod, baseFeePerEpoch uint64) {
+		st := overflowState(t, 0, 0, &ethpb.SharedActivity{
+			TransactionsGasPerPeriod: gasPerPeriod,
+			TransactionsGasPerEpoch:  gasPerEpoch,
+			BaseFeePerPeriod:         baseFeePerPeriod,
+			BaseFeePerEpoch:          baseFeePerEpoch,
+		})
+		baseFeeGwei := baseFeePerEpoch / params.BeaconConfig().WeiPerGwei
+		if baseFeeGwei == 0 {
+			baseFeeGwei = 1
+		}
+		post, err := epoch.ProcessSharedActivityUpdates(st)
+		requireOverflow(t, addOverflows(gasPerPeriod, gasPerEpoch) || addOverflows(baseFeePerPeriod, baseFeeGwei), post, err)
+		if err == nil {
+			period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
+			require.Equal(t, movingAverage(gasPerPeriod, gasPerEpoch, period).Uint64(), post.SharedActivity().TransactionsGasPerPeriod)
+			require.Equal(t, movingAverage(baseFeePerPeriod, baseFeeGwei, period).Uint64(), post.SharedActivity().BaseFeePerPeriod)
+		}
+	})
+}
+
+func FuzzBaseProposerReward_Overflow(f *testing.F) {
+	useCheckedActivityMath(f)
+	f.Add(uint64(1_000_000), uint64(21_000*1_000), uint64(7))
+	f.Add(uint64(stdmath.MaxUint64), uint64(1), uint64(1))
+	f.Add(uint64(stdmath.MaxUint64/2), uint64(stdmath.MaxUint64/2), uint64(stdmath.MaxUint64))
+	f.Fuzz(func(t *testing.T, effectiveActivity, gasPerPeriod, baseFeePerPeriod uint64) {
+		st := overflowState(t, 0, effectiveActivity, &ethpb.SharedActivity{
+			TransactionsGasPerPeriod: gasPerPeriod,
+			BaseFeePerPeriod:         baseFeePerPeriod,
+		})
+		cfg := params.BeaconConfig()
+		periodSlots := uint64(cfg.EpochsPerActivityPeriod) * uint64(cfg.SlotsPerEpoch)
+		want := new(big.Int).Add(new(big.Int).SetUint64(effectiveActivity), new(big.Int).SetUint64(gasPerPeriod))
+		want.Mul(want, new(big.Int).SetUint64(baseFeePerPeriod))
+		want.Div(want, new(big.Int).SetUint64(periodSlots*periodSlots))
+		overflows := addOverflows(effectiveActivity, gasPerPeriod) || !want.IsUint64()
+
+		// The single validator has the whole power, so the reward is not scaled down.
+		reward, err := altair.BaseProposerReward(st, 1, 1)
+		if overflows {
+			var overflowErr *helpers.ActivityOverflowError
+			if !errors.As(err, &overflowErr) {
+				t.Fatalf("Expected an activity overflow error, got %v", err)
+			}
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, want.Uint64(), reward)
+	})
+}
@@ -0,0 +1,262 @@
+package blocks
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+// ProcessContractTransfers processes a list of contract transfers and validates them. However,
+// the method doesn't immediately verify the signatures in the transfers and prefers to extract
+// a signature set from them at the end of the transition and then verify them via the
+// signature set.
+func ProcessContractTransfers(
+	st state.BeaconState,
+	signed interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error) {
+	if signed.Version() < version.Electra {
+		return st, nil
+	}
+	transfers, err := signed.Block().Body().ContractTransfers()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get ContractTransfers")
+	}
+	// Return early if no transfers
+	if len(transfers) == 0 {
+		return st, nil
+	}
+	for _, transfer := range transfers {
+		st, err = processContractTransfer(st, transfer)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process ContractTransfer")
+		}
+	}
+	return st, nil
+}
+
+// processContractTransfer validates a SignedContractTransfer message and moves the
+// contract to the recipient validator. The activity accumulated by the source validator
+// moves together with the contract only when it is the last contract of the source.
+// When the recipient is the validator itself, the contract is unbound instead.
+// The contract transfer nonce of the source validator is incremented, so the message
+// can not be replayed.
+func processContractTransfer(st state.BeaconState, signed *ethpb.SignedContractTransfer) (state.BeaconState, error) {
+	// Checks that the message passes the validation conditions.
+	contracts, err := ValidateContractTransfer(st, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	message := signed.Message
+	if err := st.IncrementContractTransferNonce(message.ValidatorIndex); err != nil {
+		return nil, err
+	}
+	contract := bytesutil.ToBytes20(message.Contract)
+	if err := helpers.UnlinkContract(st, message.ValidatorIndex, contract); err != nil {
+		return nil, err
+	}
+	if message.RecipientIndex == message.ValidatorIndex {
+		return st, nil
+	}
+	// The contract is unlinked from the source validator first, so the contract map points to the recipient afterwards.
+	if err := helpers.LinkContract(st, message.RecipientIndex, contract); err != nil {
+		return nil, err
+	}
+	if len(contracts) > 1 {
+		return st, nil
+	}
+
+	val, err := st.ValidatorAtIndex(message.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := st.ValidatorAtIndex(message.RecipientIndex)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := st.ActivityAtIndex(message.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	recipientActivity, err := st.ActivityAtIndex(message.RecipientIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := time.CurrentEpoch(st)
+	recipient.EffectiveActivity, err = helpers.ActivityAdd(epoch, "transferred effective activity", recipient.EffectiveActivity, val.EffectiveActivity)
+	if err != nil {
+		return nil, err
+	}
+	recipientActivity, err = helpers.ActivityAdd(epoch, "transferred activity", recipientActivity, activity)
+	if err != nil {
+		return nil, err
+	}
+	val.EffectiveActivity = 0
+	if err := st.UpdateValidatorAtIndex(message.ValidatorIndex, val); err != nil {
+		return nil, err
+	}
+	if err := st.UpdateValidatorAtIndex(message.RecipientIndex, recipient); err != nil {
+		return nil, err
+	}
+	if err := st.UpdateActivityAtIndex(message.ValidatorIndex, 0); err != nil {
+		return nil, err
+	}
+	if err := st.UpdateActivityAtIndex(message.RecipientIndex, recipientActivity); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// ValidateContractTransfer validates the contract transfer message against the state and returns the
+// contracts currently linked to the source validator.
+func ValidateContractTransfer(st state.ReadOnlyBeaconState, signed *ethpb.SignedContractTransfer) ([][fieldparams.ContractAddressLength]byte, error) {
+	if signed == nil {
+		return nil, errNilSignedContractTransfer
+	}
+	message := signed.Message
+	if message == nil {
+		return nil, errNilContractTransfer
+	}
+	if time.CurrentEpoch(st) > message.ValidUntilEpoch {
+		return nil, ErrContractTransferExpired
+	}
+	// Contract transfer nonces are tracked starting from Electra, transfers submitted before the fork use the zero nonce.
+	nonce := uint64(0)
+	if st.Version() >= version.Electra {
+		var err error
+		nonce, err = st.ContractTransferNonceAtIndex(message.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if message.Nonce != nonce {
+		return nil, ErrContractTransferNonce
+	}
+
+	contracts, err := st.ContractsAtIndex(message.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	contract := bytesutil.ToBytes20(message.Contract)
+	if contract == params.BeaconConfig().ZeroContract {
+		return nil, ErrZeroContractTransfer
+	}
+	owned := false
+	for _, c := range contracts {
+		if c == contract {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		return nil, errContractNotOwned
+	}
+	if message.RecipientIndex == message.ValidatorIndex {
+		return contracts, nil
+	}
+
+	recipient, err := st.ValidatorAtIndexReadOnly(message.RecipientIndex)
+	if err != nil {
+		return nil, err
+	}
+	recipientContracts, err := st.ContractsAtIndex(message.RecipientIndex)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(recipientContracts)) >= helpers.MaxContracts(st) {
+		return nil, errRecipientContractLimit
+	}
+	if recipient.Slashed() || recipient.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+		return nil, errRecipientExiting
+	}
+	return contracts, nil
+}
+
+// ContractTransfersSignatureBatch extracts the relevant signatures from the provided contract transfer
+// messages and transforms them into a signature batch object. Besides the signature of the source
+// validator, the batch holds the signature of the recipient validator accepting the contract. The
+// recipient signature is not checked when the contract is unbound.
+func ContractTransfersSignatureBatch(
+	st state.ReadOnlyBeaconState,
+	transfers []*ethpb.SignedContractTransfer,
+) (*bls.SignatureBatch, error) {
+	// Return early if no transfers
+	if len(transfers) == 0 {
+		return bls.NewSet(), nil
+	}
+	batch := &bls.SignatureBatch{
+		Signatures:   make([][]byte, 0, 2*len(transfers)),
+		PublicKeys:   make([]bls.PublicKey, 0, 2*len(transfers)),
+		Messages:     make([][32]byte, 0, 2*len(transfers)),
+		Descriptions: make([]string, 0, 2*len(transfers)),
+	}
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainContractTransfer, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute signing domain")
+	}
+	add := func(idx primitives.ValidatorIndex, sig []byte, htr [32]byte) error {
+		pubkey := st.PubkeyAtIndex(idx)
+		publicKey, err := bls.PublicKeyFromBytes(pubkey[:])
+		if err != nil {
+			return errors.Wrap(err, "could not convert bytes to public key")
+		}
+		batch.Signatures = append(batch.Signatures, sig)
+		batch.PublicKeys = append(batch.PublicKeys, publicKey)
+		batch.Messages = append(batch.Messages, htr)
+		batch.Descriptions = append(batch.Descriptions, signing.ContractTransferSignature)
+		return nil
+	}
+	for _, transfer := range transfers {
+		htr, err := signing.SigningData(transfer.Message.HashTreeRoot, domain)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute ContractTransfer signing data")
+		}
+		if err := add(transfer.Message.ValidatorIndex, transfer.Signature, htr); err != nil {
+			return nil, err
+		}
+		if transfer.Message.RecipientIndex == transfer.Message.ValidatorIndex {
+			continue
+		}
+		if err := add(transfer.Message.RecipientIndex, transfer.RecipientSignature, htr); err != nil {
+			return nil, err
+		}
+	}
+	return batch, nil
+}
+
+// VerifyContractTransferSignature checks the signatures of the source and the recipient validators
+// in the SignedContractTransfer message. The signing domain is computed with the genesis fork version,
+// so the message stays valid across forks.
+func VerifyContractTransferSignature(
+	st state.ReadOnlyBeaconState,
+	transfer *ethpb.SignedContractTransfer,
+) error {
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainContractTransfer, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing domain")
+	}
+	pubkey := st.PubkeyAtIndex(transfer.Message.ValidatorIndex)
+	if err := signing.VerifySigningRoot(transfer.Message, pubkey[:], transfer.Signature, domain); err != nil {
+		return err
+	}
+	if transfer.Message.RecipientIndex == transfer.Message.ValidatorIndex {
+		return nil
+	}
+	recipientPubkey := st.PubkeyAtIndex(transfer.Message.RecipientIndex)
+	if err := signing.VerifySigningRoot(transfer.Message, recipientPubkey[:], transfer.RecipientSignature, domain); err != nil {
+		return errors.Wrap(err, "could not verify recipient signature")
+	}
+	return nil
+}
@@ -0,0 +1,375 @@
+package blocks_test
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+var transferredContract = bytesutil.PadTo([]byte{0xaa, 0xbb}, 20)
+
+func contractTransferState(t *testing.T) (state.BeaconState, []bls.SecretKey) {
+	st, keys := util.DeterministicGenesisStateElectra(t, 4)
+	vals := st.Validators()
+	for _, v := range vals {
+		v.Contract = params.BeaconConfig().ZeroContract[:]
+	}
+	vals[0].Contract = transferredContract
+	vals[0].EffectiveActivity = 1000
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.UpdateActivityAtIndex(0, 50))
+	require.NoError(t, st.UpdateActivityAtIndex(1, 5))
+	return st, keys
+}
+
+func contractTransferSignature(t *testing.T, st state.ReadOnlyBeaconState, key bls.SecretKey, message *ethpb.ContractTransfer) []byte {
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainContractTransfer, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(message, domain)
+	require.NoError(t, err)
+	return key.Sign(root[:]).Marshal()
+}
+
+// signContractTransfer signs the message with the keys of the source and the recipient validators.
+func signContractTransfer(t *testing.T, st state.ReadOnlyBeaconState, keys []bls.SecretKey, message *ethpb.ContractTransfer) *ethpb.SignedContractTransfer {
+	signed := &ethpb.SignedContractTransfer{
+		Message:            message,
+		Signature:          contractTransferSignature(t, st, keys[message.ValidatorIndex], message),
+		RecipientSignature: make([]byte, 96),
+	}
+	if uint64(message.RecipientIndex) < uint64(len(keys)) {
+		signed.RecipientSignature = contractTransferSignature(t, st, keys[message.RecipientIndex], message)
+	}
+	return signed
+}
+
+func TestProcessContractTransfer(t *testing.T) {
+	t.Run("transfer", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+
+		st, err := blocks.ProcessContractTransfer(st, signed)
+		require.NoError(t, err)
+
+		from, err := st.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		to, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		require.DeepEqual(t, params.BeaconConfig().ZeroContract[:], from.Contract)
+		require.Equal(t, uint64(0), from.EffectiveActivity)
+		require.DeepEqual(t, transferredContract, to.Contract)
+		require.Equal(t, uint64(1000), to.EffectiveActivity)
+
+		activity, err := st.ActivityAtIndex(0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), activity)
+		activity, err = st.ActivityAtIndex(1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(55), activity)
+
+		owner, ok := st.ValidatorIndexByContract(bytesutil.ToBytes20(transferredContract))
+		require.Equal(t, true, ok)
+		require.Equal(t, uint64(1), uint64(owner))
+	})
+	t.Run("unbind", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 0,
+			Contract:       transferredContract,
+		})
+
+		st, err := blocks.ProcessContractTransfer(st, signed)
+		require.NoError(t, err)
+
+		val, err := st.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		require.DeepEqual(t, params.BeaconConfig().ZeroContract[:], val.Contract)
+		_, ok := st.ValidatorIndexByContract(bytesutil.ToBytes20(transferredContract))
+		require.Equal(t, false, ok)
+	})
+	t.Run("contract not owned", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 1,
+			RecipientIndex: 2,
+			Contract:       transferredContract,
+		})
+		_, err := blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "contract is not linked to the validator", err)
+	})
+	t.Run("zero contract", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 1,
+			RecipientIndex: 2,
+			Contract:       params.BeaconConfig().ZeroContract[:],
+		})
+		_, err := blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "zero contract can not be transferred", err)
+	})
+	t.Run("recipient has contract", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		recipientContract := bytesutil.PadTo([]byte{0x01}, 20)
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.Contract = recipientContract
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+		st, err = blocks.ProcessContractTransfer(st, signed)
+		require.NoError(t, err)
+
+		contracts, err := st.ContractsAtIndex(1)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][20]byte{bytesutil.ToBytes20(recipientContract), bytesutil.ToBytes20(transferredContract)}, contracts)
+		owner, ok := st.ValidatorIndexByContract(bytesutil.ToBytes20(transferredContract))
+		require.Equal(t, true, ok)
+		require.Equal(t, uint64(1), uint64(owner))
+	})
+	t.Run("recipient contract limit", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.Contract = bytesutil.PadTo([]byte{0x01}, 20)
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+		for i := uint64(1); i < params.BeaconConfig().MaxContractsPerValidator; i++ {
+			require.NoError(t, st.AppendAdditionalContract(1, bytesutil.ToBytes20([]byte{0x01, byte(i)})))
+		}
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+		_, err = blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "recipient validator can not own more contracts", err)
+	})
+	t.Run("one of several contracts", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		otherContract := bytesutil.ToBytes20([]byte{0x02})
+		require.NoError(t, st.AppendAdditionalContract(0, otherContract))
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       otherContract[:],
+		})
+		st, err := blocks.ProcessContractTransfer(st, signed)
+		require.NoError(t, err)
+
+		// Activity stays with the source validator, which still owns a contract.
+		from, err := st.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		to, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		require.DeepEqual(t, transferredContract, from.Contract)
+		require.Equal(t, uint64(1000), from.EffectiveActivity)
+		require.DeepEqual(t, otherContract[:], to.Contract)
+		require.Equal(t, uint64(0), to.EffectiveActivity)
+		activity, err := st.ActivityAtIndex(0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(50), activity)
+		activity, err = st.ActivityAtIndex(1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), activity)
+
+		additional, err := st.AdditionalContracts()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(additional))
+		owner, ok := st.ValidatorIndexByContract(otherContract)
+		require.Equal(t, true, ok)
+		require.Equal(t, uint64(1), uint64(owner))
+	})
+	t.Run("recipient exiting", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.ExitEpoch = 10
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+		_, err = blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "recipient validator is slashed or exiting", err)
+	})
+	t.Run("activity overflow", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ElectraForkEpoch = 0
+		params.OverrideBeaconConfig(cfg)
+
+		st, keys := contractTransferState(t)
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.EffectiveActivity = stdmath.MaxUint64
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+		post, err := blocks.ProcessContractTransfer(st, signed)
+		requireOverflow(t, true, post, err)
+	})
+	t.Run("expired", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex:  0,
+			RecipientIndex:  1,
+			Contract:        transferredContract,
+			ValidUntilEpoch: 2,
+		})
+		_, err := blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "contract transfer is expired", err)
+	})
+	t.Run("wrong nonce", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+			Nonce:          1,
+		})
+		_, err := blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "contract transfer nonce does not match the validator nonce", err)
+	})
+	t.Run("replay after the contract returns", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		})
+		st, err := blocks.ProcessContractTransfer(st, signed)
+		require.NoError(t, err)
+		back := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 1,
+			RecipientIndex: 0,
+			Contract:       transferredContract,
+		})
+		st, err = blocks.ProcessContractTransfer(st, back)
+		require.NoError(t, err)
+
+		_, err = blocks.ProcessContractTransfer(st, signed)
+		require.ErrorContains(t, "contract transfer nonce does not match the validator nonce", err)
+	})
+	t.Run("non-existent recipient", func(t *testing.T) {
+		st, keys := contractTransferState(t)
+		signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 100,
+			Contract:       transferredContract,
+		})
+		_, err := blocks.ValidateContractTransfer(st, signed)
+		require.ErrorContains(t, "out of bounds", err)
+	})
+}
+
+func TestProcessContractTransfers(t *testing.T) {
+	st, keys := contractTransferState(t)
+	signed := signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+		ValidatorIndex: 0,
+		RecipientIndex: 2,
+		Contract:       transferredContract,
+	})
+
+	blk := util.NewBeaconBlockElectra()
+	blk.Block.Body.ContractTransfers = []*ethpb.SignedContractTransfer{signed}
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	st, err = blocks.ProcessContractTransfers(st, wsb)
+	require.NoError(t, err)
+	val, err := st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	require.DeepEqual(t, transferredContract, val.Contract)
+
+	nonce, err := st.ContractTransferNonceAtIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
+
+	// The same transfer can not be applied twice.
+	_, err = blocks.ProcessContractTransfers(st, wsb)
+	require.ErrorContains(t, "contract transfer nonce does not match the validator nonce", err)
+}
+
+func TestContractTransfersSignatureBatch(t *testing.T) {
+	st, keys := contractTransferState(t)
+	transfers := []*ethpb.SignedContractTransfer{
+		signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 0,
+			RecipientIndex: 1,
+			Contract:       transferredContract,
+		}),
+		signContractTransfer(t, st, keys, &ethpb.ContractTransfer{
+			ValidatorIndex: 2,
+			RecipientIndex: 2,
+			Contract:       bytesutil.PadTo([]byte{0x02}, 20),
+		}),
+	}
+	batch, err := blocks.ContractTransfersSignatureBatch(st, transfers)
+	require.NoError(t, err)
+	verify, err := batch.Verify()
+	require.NoError(t, err)
+	require.Equal(t, true, verify)
+	require.NoError(t, blocks.VerifyContractTransferSignature(st, transfers[0]))
+
+	// Signed by another validator.
+	wrongSigner := &ethpb.SignedContractTransfer{
+		Message:            transfers[0].Message,
+		Signature:          contractTransferSignature(t, st, keys[1], transfers[0].Message),
+		RecipientSignature: transfers[0].RecipientSignature,
+	}
+	batch, err = blocks.ContractTransfersSignatureBatch(st, []*ethpb.SignedContractTransfer{wrongSigner})
+	require.NoError(t, err)
+	verify, err = batch.Verify()
+	require.NoError(t, err)
+	require.Equal(t, false, verify)
+	require.NotNil(t, blocks.VerifyContractTransferSignature(st, wrongSigner))
+
+	// Not accepted by the recipient.
+	notAccepted := &ethpb.SignedContractTransfer{
+		Message:            transfers[0].Message,
+		Signature:          transfers[0].Signature,
+		RecipientSignature: transfers[0].Signature,
+	}
+	batch, err = blocks.ContractTransfersSignatureBatch(st, []*ethpb.SignedContractTransfer{notAccepted})
+	require.NoError(t, err)
+	verify, err = batch.Verify()
+	require.NoError(t, err)
+	require.Equal(t, false, verify)
+	require.ErrorContains(t, "could not verify recipient signature", blocks.VerifyContractTransferSignature(st, notAccepted))
+
+	// Signed with the current fork version instead of the genesis one.
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		CurrentVersion:  params.BeaconConfig().ElectraForkVersion,
+		PreviousVersion: params.BeaconConfig().DenebForkVersion,
+	}))
+	sig, err := signing.ComputeDomainAndSign(st, time.CurrentEpoch(st), transfers[0].Message, params.BeaconConfig().DomainContractTransfer, keys[0])
+	require.NoError(t, err)
+	require.NotNil(t, blocks.VerifyContractTransferSignature(st, &ethpb.SignedContractTransfer{
+		Message:   transfers[0].Message,
+		Signature: sig,
+	}))
+}
@@ -10,12 +10,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/container/trie"
 	"github.com/prysmaticlabs/prysm/v4/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/math"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 )
 
 // ProcessPreGenesisDeposits processes a deposit for the beacon state before chainstart.
@@ -199,6 +201,10 @@ func ProcessDeposit(beaconState state.BeaconState, deposit *ethpb.Deposit, verif
 				return nil, newValidator, err
 			}
 			if owner.ExitEpoch() >= epoch {
+				newIdx := primitives.ValidatorIndex(beaconState.NumValidators())
+				if err := rejectContractBinding(beaconState, newIdx, contractOwner, contract, epoch); err != nil {
+					return nil, newValidator, err
+				}
 				contract = params.BeaconConfig().ZeroContract[:]
 			}
 		}
@@ -233,6 +239,12 @@ func ProcessDeposit(beaconState state.BeaconState, deposit *ethpb.Deposit, verif
 				return nil, newValidator, err
 			}
 			if owner.ExitEpoch() >= epoch {
+				// A top-up naming the contract the validator already owns is not a rejection.
+				if contractOwner != index {
+					if err := rejectContractBinding(beaconState, index, contractOwner, contract, epoch); err != nil {
+						return nil, newValidator, err
+					}
+				}
 				contract = params.BeaconConfig().ZeroContract[:]
 			}
 		}
@@ -246,6 +258,37 @@ func ProcessDeposit(beaconState state.BeaconState, deposit *ethpb.Deposit, verif
 	return beaconState, newValidator, nil
 }
 
+// rejectContractBinding records a deposit contract that is already bound to another live validator.
+// Starting from Electra the rejection is recorded in the beacon state, evicting the oldest rejection
+// once the list is full. The rejections are reported once the block is received, as the state
+// transition is replayed for blocks that were already processed.
+func rejectContractBinding(
+	beaconState state.BeaconState,
+	idx, owner primitives.ValidatorIndex,
+	contract []byte,
+	epoch primitives.Epoch,
+) error {
+	if beaconState.Version() < version.Electra {
+		return nil
+	}
+	binding := &ethpb.RejectedContractBinding{
+		ValidatorIndex: idx,
+		Contract:       bytesutil.SafeCopyBytes(contract),
+		OwnerIndex:     owner,
+		Epoch:          epoch,
+	}
+	bindings, err := beaconState.RejectedContractBindings()
+	if err != nil {
+		return err
+	}
+	limit := params.BeaconConfig().RejectedContractBindingsLimit
+	if uint64(len(bindings)) < limit {
+		return beaconState.AppendRejectedContractBinding(binding)
+	}
+	bindings = append(bindings[uint64(len(bindings))-limit+1:], binding)
+	return beaconState.SetRejectedContractBindings(bindings)
+}
+
 func verifyDeposit(beaconState state.ReadOnlyBeaconState, deposit *ethpb.Deposit) error {
 	// Verify Merkle proof of deposit and deposit trie root.
 	if deposit == nil || deposit.Data == nil {
@@ -255,6 +255,48 @@ func TestProcessDeposit_TwoValidatorsWithSameContract(t *testing.T) {
 	assert.DeepEqual(t, params.BeaconConfig().ZeroContract, contractAtIndex, "Expected validator 1 to have zero-contract")
 }
 
+func TestProcessDeposit_TwoValidatorsWithSameContract_Electra(t *testing.T) {
+	contract := []byte{0x42, 0x42, 0x42}
+	dep, _, err := util.DeterministicDepositsAndKeysWithContract(3, [][]byte{contract, contract, contract})
+	require.NoError(t, err)
+	eth1Data, err := util.DeterministicEth1Data(len(dep))
+	require.NoError(t, err)
+
+	beaconState, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Eth1Data: eth1Data,
+		Fork: &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		beaconState, _, err = blocks.ProcessDeposit(beaconState, dep[i], true)
+		require.NoError(t, err, "Process deposit failed")
+	}
+
+	bindings, err := beaconState.RejectedContractBindings()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bindings))
+	assert.Equal(t, primitives.ValidatorIndex(1), bindings[0].ValidatorIndex)
+	assert.Equal(t, primitives.ValidatorIndex(0), bindings[0].OwnerIndex)
+	assert.DeepEqual(t, bytesutil.PadTo(contract, 20), bindings[0].Contract)
+
+	t.Run("evicts oldest rejection when full", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.RejectedContractBindingsLimit = 1
+		params.OverrideBeaconConfig(cfg)
+
+		st, _, err := blocks.ProcessDeposit(beaconState.Copy(), dep[2], true)
+		require.NoError(t, err, "Process deposit failed")
+		bindings, err := st.RejectedContractBindings()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(bindings))
+		assert.Equal(t, primitives.ValidatorIndex(2), bindings[0].ValidatorIndex)
+	})
+}
+
 func TestProcessDeposit_AddsNewValidatorDeposit(t *testing.T) {
 	// Similar to TestProcessDeposits_AddsNewValidatorDeposit except that this test directly calls ProcessDeposit
 	dep, _, err := util.DeterministicDepositsAndKeys(1)
@@ -6,3 +6,17 @@ var errNilSignedWithdrawalMessage = errors.New("nil SignedBLSToExecutionChange m
 var errNilWithdrawalMessage = errors.New("nil BLSToExecutionChange message")
 var errInvalidBLSPrefix = errors.New("withdrawal credential prefix is not a BLS prefix")
 var errInvalidWithdrawalCredentials = errors.New("withdrawal credentials do not match")
+var errNilSignedContractTransfer = errors.New("nil SignedContractTransfer message")
+var errNilContractTransfer = errors.New("nil ContractTransfer message")
+var errContractNotOwned = errors.New("contract is not linked to the validator")
+var errRecipientContractLimit = errors.New("recipient validator can not own more contracts")
+var errRecipientExiting = errors.New("recipient validator is slashed or exiting")
+
+// ErrZeroContractTransfer is returned when the contract transfer moves the zero contract, whatever the state is.
+var ErrZeroContractTransfer = errors.New("zero contract can not be transferred")
+
+// ErrContractTransferExpired is returned when the contract transfer is no longer valid at the epoch of the state.
+var ErrContractTransferExpired = errors.New("contract transfer is expired")
+
+// ErrContractTransferNonce is returned when the contract transfer nonce does not match the nonce of the state.
+var ErrContractTransferNonce = errors.New("contract transfer nonce does not match the validator nonce")
@@ -186,6 +186,43 @@ func NewGenesisBlockForState(ctx context.Context, st state.BeaconState) (interfa
 			},
 			Signature: params.BeaconConfig().EmptySignature[:],
 		})
+	case *ethpb.BeaconStateElectra:
+		return blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlockElectra{
+			Block: &ethpb.BeaconBlockElectra{
+				ParentRoot: params.BeaconConfig().ZeroHash[:],
+				StateRoot:  root[:],
+				Body: &ethpb.BeaconBlockBodyElectra{
+					RandaoReveal: make([]byte, 96),
+					Eth1Data: &ethpb.Eth1Data{
+						DepositRoot: make([]byte, 32),
+						BlockHash:   make([]byte, 32),
+					},
+					Graffiti:        make([]byte, 32),
+					ActivityChanges: make([]*ethpb.ActivityChange, 0),
+					SyncAggregate: &ethpb.SyncAggregate{
+						SyncCommitteeBits:      make([]byte, fieldparams.SyncCommitteeLength/8),
+						SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+					},
+					ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+						ParentHash:     make([]byte, 32),
+						FeeRecipient:   make([]byte, 20),
+						StateRoot:      make([]byte, 32),
+						ReceiptsRoot:   make([]byte, 32),
+						ActivitiesRoot: make([]byte, 32),
+						LogsBloom:      make([]byte, 256),
+						PrevRandao:     make([]byte, 32),
+						BaseFeePerGas:  make([]byte, 32),
+						BlockHash:      make([]byte, 32),
+						Transactions:   make([][]byte, 0),
+						Withdrawals:    make([]*enginev1.Withdrawal, 0),
+					},
+					BlsToExecutionChanges: make([]*ethpb.SignedBLSToExecutionChange, 0),
+					BlobKzgCommitments:    make([][]byte, 0),
+					ContractTransfers:     make([]*ethpb.SignedContractTransfer, 0),
+				},
+			},
+			Signature: params.BeaconConfig().EmptySignature[:],
+		})
 	default:
 		return nil, ErrUnrecognizedState
 	}
@@ -0,0 +1,13 @@
+package blocks
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pendingActivityCreditsDroppedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pending_activity_credits_dropped_total",
+		Help: "Increased when the activity of a validator pending activation is dropped because the pending activity credits list is full",
+	})
+)
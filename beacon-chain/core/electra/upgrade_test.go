@@ -0,0 +1,122 @@
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/electra"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestUpgradeToElectra(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateDeneb(t, params.BeaconConfig().MaxValidatorsPerCommittee)
+	preForkState := st.Copy()
+	mSt, err := electra.UpgradeToElectra(st)
+	require.NoError(t, err)
+
+	require.Equal(t, version.Electra, mSt.Version())
+	require.Equal(t, preForkState.GenesisTime(), mSt.GenesisTime())
+	require.DeepSSZEqual(t, preForkState.GenesisValidatorsRoot(), mSt.GenesisValidatorsRoot())
+	require.Equal(t, preForkState.Slot(), mSt.Slot())
+	require.DeepSSZEqual(t, preForkState.LatestBlockHeader(), mSt.LatestBlockHeader())
+	require.DeepSSZEqual(t, preForkState.BlockRoots(), mSt.BlockRoots())
+	require.DeepSSZEqual(t, preForkState.StateRoots(), mSt.StateRoots())
+	require.DeepSSZEqual(t, preForkState.Eth1Data(), mSt.Eth1Data())
+	require.DeepSSZEqual(t, preForkState.Eth1DataVotes(), mSt.Eth1DataVotes())
+	require.DeepSSZEqual(t, preForkState.Eth1DepositIndex(), mSt.Eth1DepositIndex())
+	require.DeepSSZEqual(t, preForkState.SharedActivity(), mSt.SharedActivity())
+	require.DeepSSZEqual(t, preForkState.Validators(), mSt.Validators())
+	require.DeepSSZEqual(t, preForkState.Balances(), mSt.Balances())
+	require.DeepSSZEqual(t, preForkState.Activities(), mSt.Activities())
+	require.DeepSSZEqual(t, preForkState.RandaoMixes(), mSt.RandaoMixes())
+	require.DeepSSZEqual(t, preForkState.Slashings(), mSt.Slashings())
+	require.DeepSSZEqual(t, preForkState.JustificationBits(), mSt.JustificationBits())
+	require.DeepSSZEqual(t, preForkState.PreviousJustifiedCheckpoint(), mSt.PreviousJustifiedCheckpoint())
+	require.DeepSSZEqual(t, preForkState.CurrentJustifiedCheckpoint(), mSt.CurrentJustifiedCheckpoint())
+	require.DeepSSZEqual(t, preForkState.FinalizedCheckpoint(), mSt.FinalizedCheckpoint())
+	p, err := mSt.PreviousEpochParticipation()
+	require.NoError(t, err)
+	pp, err := preForkState.PreviousEpochParticipation()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, pp, p)
+	s, err := mSt.InactivityScores()
+	require.NoError(t, err)
+	ps, err := preForkState.InactivityScores()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, ps, s)
+
+	f := mSt.Fork()
+	require.DeepSSZEqual(t, &ethpb.Fork{
+		PreviousVersion: st.Fork().CurrentVersion,
+		CurrentVersion:  params.BeaconConfig().ElectraForkVersion,
+		Epoch:           time.CurrentEpoch(st),
+	}, f)
+	csc, err := mSt.CurrentSyncCommittee()
+	require.NoError(t, err)
+	psc, err := preForkState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, psc, csc)
+
+	header, err := mSt.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	protoHeader, ok := header.Proto().(*enginev1.ExecutionPayloadHeaderDeneb)
+	require.Equal(t, true, ok)
+	prevHeader, err := preForkState.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	require.DeepEqual(t, prevHeader.Proto(), protoHeader)
+
+	wi, err := mSt.NextWithdrawalIndex()
+	require.NoError(t, err)
+	pwi, err := preForkState.NextWithdrawalIndex()
+	require.NoError(t, err)
+	require.Equal(t, pwi, wi)
+	summaries, err := mSt.HistoricalSummaries()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(summaries))
+
+	additional, err := mSt.AdditionalContracts()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(additional))
+	credits, err := mSt.PendingActivityCredits()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(credits))
+	bindings, err := mSt.RejectedContractBindings()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(bindings))
+}
+
+func TestUpgradeToElectra_AdditionalContracts(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateDeneb(t, 4)
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	val, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Contract = contract
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+	_, err = st.AdditionalContracts()
+	require.ErrorContains(t, "not supported", err)
+
+	mSt, err := electra.UpgradeToElectra(st)
+	require.NoError(t, err)
+
+	// The contract linked before the fork is still indexed.
+	idx, ok := mSt.ValidatorIndexByContract(bytesutil.ToBytes20(contract))
+	require.Equal(t, true, ok)
+	require.Equal(t, primitives.ValidatorIndex(1), idx)
+
+	// Additional contracts can be linked after the fork.
+	extra := bytesutil.ToBytes20(bytesutil.PadTo([]byte{0xbb}, 20))
+	require.NoError(t, mSt.AppendAdditionalContract(1, extra))
+	contracts, err := mSt.ContractsAtIndex(1)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][20]byte{bytesutil.ToBytes20(contract), extra}, contracts)
+	idx, ok = mSt.ValidatorIndexByContract(extra)
+	require.Equal(t, true, ok)
+	require.Equal(t, primitives.ValidatorIndex(1), idx)
+}
@@ -349,6 +349,7 @@ func missingSlotProposers(ctx context.Context, st state.BeaconState) (map[primit
 func ProcessEffectiveActivityUpdates(ctx context.Context, state state.BeaconState) (state.BeaconState, error) {
 	activities := state.Activities()
 	period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
+	currentEpoch := time.CurrentEpoch(state)
 	inactiveProposers, err := missingSlotProposers(ctx, state)
 	if err != nil {
 		return nil, err
@@ -360,7 +361,6 @@ func ProcessEffectiveActivityUpdates(ctx context.Context, state state.BeaconStat
 		if idx >= len(activities) {
 			return false, nil, fmt.Errorf("validator index exceeds activities length in state %d >= %d", idx, len(activities))
 		}
-		var effectiveActivity uint64
 		activity := activities[idx]
 		shift := inactiveProposers[primitives.ValidatorIndex(idx)]
 		if shift > 0 {
@@ -371,7 +371,10 @@ func ProcessEffectiveActivityUpdates(ctx context.Context, state state.BeaconStat
 		// dA - validator's delta (epoch) activity
 		// P - activity period.
 		// newEA = EA - EA / P + dA = ((EA + dA) * P - EA) / P
-		effectiveActivity = ((val.EffectiveActivity+activity)*period - val.EffectiveActivity) / period
+		effectiveActivity, err := helpers.ActivityMovingAverage(currentEpoch, "effective activity", val.EffectiveActivity, activity, period)
+		if err != nil {
+			return false, nil, errors.Wrapf(err, "could not update effective activity of validator %d", idx)
+		}
 		effectiveActivity >>= shift
 
 		if shift > 0 {
@@ -407,6 +410,68 @@ func ProcessActivitiesReset(state state.BeaconState) (state.BeaconState, error)
 	return state, nil
 }
 
+// ProcessPendingActivityCredits credits the activity queued for validators pending activation
+// to the ones active in the next epoch. It runs after the activities reset, so the credit counts
+// as activity of the validator's first active epoch. Credits of validators that exit without
+// activating are dropped.
+//
+// def process_pending_activity_credits(state: BeaconState) -> None:
+//
+//	next_epoch = Epoch(get_current_epoch(state) + 1)
+//	pending = []
+//	for credit in state.pending_activity_credits:
+//	    validator = state.validators[credit.validator_index]
+//	    if is_active_validator(validator, next_epoch):
+//	        state.activities[credit.validator_index] += credit.activity
+//	    elif validator.exit_epoch > next_epoch:
+//	        pending.append(credit)
+//	state.pending_activity_credits = pending
+func ProcessPendingActivityCredits(state state.BeaconState) (state.BeaconState, error) {
+	if state.Version() < version.Electra {
+		return state, nil
+	}
+	credits, err := state.PendingActivityCredits()
+	if err != nil {
+		return nil, err
+	}
+	if len(credits) == 0 {
+		return state, nil
+	}
+
+	nextEpoch := time.NextEpoch(state)
+	pending := make([]*ethpb.PendingActivityCredit, 0, len(credits))
+	for _, c := range credits {
+		val, err := state.ValidatorAtIndexReadOnly(c.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+		if helpers.IsActiveValidatorUsingTrie(val, nextEpoch) {
+			activity, err := state.ActivityAtIndex(c.ValidatorIndex)
+			if err != nil {
+				return nil, err
+			}
+			activity, err = helpers.ActivityAdd(time.CurrentEpoch(state), "pending activity credit", activity, c.Activity)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not credit pending activity of validator %d", c.ValidatorIndex)
+			}
+			if err := state.UpdateActivityAtIndex(c.ValidatorIndex, activity); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if val.ExitEpoch() > nextEpoch {
+			pending = append(pending, c)
+		}
+	}
+	if len(pending) == len(credits) {
+		return state, nil
+	}
+	if err := state.SetPendingActivityCredits(pending); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
 // ProcessSharedActivityUpdates processes transactions gas per period
 // and base fee per period updates.
 func ProcessSharedActivityUpdates(state state.BeaconState) (state.BeaconState, error) {
@@ -422,9 +487,17 @@ func ProcessSharedActivityUpdates(state state.BeaconState) (state.BeaconState, e
 	baseFeePerPeriod := sharedActivity.BaseFeePerPeriod
 	baseFeePerEpoch := math.Max(1, sharedActivity.BaseFeePerEpoch/params.BeaconConfig().WeiPerGwei)
 
-	sharedActivity.TransactionsGasPerPeriod = ((gasPerPeriod+gasPerEpoch)*period - gasPerPeriod) / period
+	currentEpoch := time.CurrentEpoch(state)
+	var err error
+	sharedActivity.TransactionsGasPerPeriod, err = helpers.ActivityMovingAverage(currentEpoch, "transactions gas per period", gasPerPeriod, gasPerEpoch, period)
+	if err != nil {
+		return nil, err
+	}
 	sharedActivity.TransactionsGasPerEpoch = 0
-	sharedActivity.BaseFeePerPeriod = ((baseFeePerPeriod+baseFeePerEpoch)*period - baseFeePerPeriod) / period
+	sharedActivity.BaseFeePerPeriod, err = helpers.ActivityMovingAverage(currentEpoch, "base fee per period", baseFeePerPeriod, baseFeePerEpoch, period)
+	if err != nil {
+		return nil, err
+	}
 	sharedActivity.BaseFeePerEpoch = 0
 
 	if err := state.SetSharedActivity(sharedActivity); err != nil {
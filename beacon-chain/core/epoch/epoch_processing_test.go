@@ -779,3 +779,31 @@ func TestProcessSharedActivityUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessPendingActivityCredits(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Slot: params.BeaconConfig().SlotsPerEpoch * 9,
+		Validators: []*ethpb.Validator{
+			{ActivationEpoch: 10, ExitEpoch: farFuture},
+			{ActivationEpoch: 20, ExitEpoch: farFuture},
+			{ActivationEpoch: 20, ExitEpoch: 10},
+		},
+		Activities: []uint64{100, 0, 0},
+		PendingActivityCredits: []*ethpb.PendingActivityCredit{
+			{ValidatorIndex: 0, Activity: 42},
+			{ValidatorIndex: 1, Activity: 4200},
+			{ValidatorIndex: 2, Activity: 7},
+		},
+	})
+	require.NoError(t, err)
+
+	st, err = epoch.ProcessPendingActivityCredits(st)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{142, 0, 0}, st.Activities())
+	credits, err := st.PendingActivityCredits()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(credits))
+	assert.Equal(t, primitives.ValidatorIndex(1), credits[0].ValidatorIndex)
+	assert.Equal(t, uint64(4200), credits[0].Activity)
+}
@@ -25,6 +25,9 @@ const (
 
 	// BlobSidecarReceived is sent after a blob sidecar is received from gossip or rpc.
 	BlobSidecarReceived = 6
+
+	// ContractTransferReceived is sent after a contract transfer object has been received from gossip or rpc.
+	ContractTransferReceived = 7
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -60,3 +63,8 @@ type BLSToExecutionChangeReceivedData struct {
 type BlobSidecarReceivedData struct {
 	Blob *ethpb.SignedBlobSidecar
 }
+
+// ContractTransferReceivedData is the data sent with ContractTransferReceived events.
+type ContractTransferReceivedData struct {
+	Transfer *ethpb.SignedContractTransfer
+}
@@ -27,6 +27,8 @@ const (
 	NewHead
 	// MissedSlot is sent when we need to notify users that a slot was missed.
 	MissedSlot
+	// SharedActivityUpdated is sent when the shared activity has been updated by an epoch transition.
+	SharedActivityUpdated
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -0,0 +1,97 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/math"
+)
+
+// ActivityOverflowError is returned when the activity, transactions gas, base fee, power or
+// reward arithmetic of a state transition does not fit into 64 bits. A block or an epoch
+// transition failing with it is invalid.
+type ActivityOverflowError struct {
+	// Operation names the quantity that overflowed, e.g. "epoch activity".
+	Operation string
+	Err       error
+}
+
+func (e *ActivityOverflowError) Error() string {
+	return fmt.Sprintf("%s overflows: %v", e.Operation, e.Err)
+}
+
+func (e *ActivityOverflowError) Unwrap() error {
+	return e.Err
+}
+
+// checkedActivityMath returns true if the activity arithmetic of the given epoch rejects overflows.
+// Before Electra the arithmetic wraps around, which is kept so the chain before the fork is processed
+// the same way.
+func checkedActivityMath(epoch primitives.Epoch) bool {
+	return epoch >= params.BeaconConfig().ElectraForkEpoch
+}
+
+// ActivityAdd returns a + b, where the sum is the given operation of the given epoch.
+func ActivityAdd(epoch primitives.Epoch, operation string, a, b uint64) (uint64, error) {
+	if !checkedActivityMath(epoch) {
+		return a + b, nil
+	}
+	res, err := math.Add64(a, b)
+	if err != nil {
+		return 0, &ActivityOverflowError{Operation: operation, Err: err}
+	}
+	return res, nil
+}
+
+// ActivityMul returns a * b, where the product is the given operation of the given epoch.
+func ActivityMul(epoch primitives.Epoch, operation string, a, b uint64) (uint64, error) {
+	if !checkedActivityMath(epoch) {
+		return a * b, nil
+	}
+	res, err := math.Mul64(a, b)
+	if err != nil {
+		return 0, &ActivityOverflowError{Operation: operation, Err: err}
+	}
+	return res, nil
+}
+
+// ActivityMulDiv returns a * b / c, where the result is the given operation of the given epoch.
+// Starting from Electra the intermediate product does not overflow. c must not be zero.
+func ActivityMulDiv(epoch primitives.Epoch, operation string, a, b, c uint64) (uint64, error) {
+	if c == 0 {
+		return 0, math.ErrDivByZero
+	}
+	if !checkedActivityMath(epoch) {
+		return a * b / c, nil
+	}
+	res, err := math.MulDiv64(a, b, c)
+	if err == math.ErrOverflow {
+		return 0, &ActivityOverflowError{Operation: operation, Err: err}
+	}
+	return res, err
+}
+
+// ActivityMovingAverage moves the per period average of the given operation by the value of an epoch:
+//
+//	((average + delta) * period - average) / period
+//
+// Starting from Electra it is computed as average + delta - ceil(average / period), which is the same
+// value, so only the sum of the average and the delta can overflow.
+func ActivityMovingAverage(epoch primitives.Epoch, operation string, average, delta, period uint64) (uint64, error) {
+	if period == 0 {
+		return 0, math.ErrDivByZero
+	}
+	if !checkedActivityMath(epoch) {
+		return ((average+delta)*period - average) / period, nil
+	}
+	sum, err := ActivityAdd(epoch, operation, average, delta)
+	if err != nil {
+		return 0, err
+	}
+	decay := average / period
+	if average%period != 0 {
+		decay++
+	}
+	return sum - decay, nil
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"errors"
+	stdmath "math"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestActivityMath_Overflow(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+
+	_, err := ActivityAdd(10, "epoch activity", stdmath.MaxUint64, 1)
+	var overflowErr *ActivityOverflowError
+	require.Equal(t, true, errors.As(err, &overflowErr))
+	require.Equal(t, "epoch activity", overflowErr.Operation)
+
+	_, err = ActivityMul(10, "transactions gas", 1<<32, 1<<32)
+	require.Equal(t, true, errors.As(err, &overflowErr))
+
+	_, err = ActivityMulDiv(10, "effective power", stdmath.MaxUint64, 2, 1)
+	require.Equal(t, true, errors.As(err, &overflowErr))
+
+	got, err := ActivityMulDiv(10, "effective power", stdmath.MaxUint64, 32, 32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(stdmath.MaxUint64), got)
+}
+
+func TestActivityMath_WrapsBeforeElectra(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 10
+	params.OverrideBeaconConfig(cfg)
+
+	a, b := uint64(stdmath.MaxUint64), uint64(1)
+	got, err := ActivityAdd(9, "epoch activity", a, b)
+	require.NoError(t, err)
+	require.Equal(t, a+b, got)
+
+	a, b = 1<<32, 1<<32
+	got, err = ActivityMul(9, "transactions gas", a, b)
+	require.NoError(t, err)
+	require.Equal(t, a*b, got)
+
+	a, b = stdmath.MaxUint64, 32
+	got, err = ActivityMulDiv(9, "effective power", a, b, 32)
+	require.NoError(t, err)
+	require.Equal(t, a*b/32, got)
+
+	average, delta, period := uint64(stdmath.MaxUint64/4), uint64(21_000), uint64(8)
+	got, err = ActivityMovingAverage(9, "effective activity", average, delta, period)
+	require.NoError(t, err)
+	require.Equal(t, ((average+delta)*period-average)/period, got)
+}
+
+func TestActivityMovingAverage(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	for _, tt := range []struct {
+		average, delta, period uint64
+	}{
+		{0, 0, 8}, {1, 0, 8}, {7, 3, 8}, {1_000_000, 21_000, 8}, {21_000 * 1_000, 0, 1}, {15, 1, 16}, {17, 2, 16},
+	} {
+		want := ((tt.average+tt.delta)*tt.period - tt.average) / tt.period
+		got, err := ActivityMovingAverage(0, "average", tt.average, tt.delta, tt.period)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	_, err := ActivityMovingAverage(0, "average", stdmath.MaxUint64, 1, 8)
+	var overflowErr *ActivityOverflowError
+	require.Equal(t, true, errors.As(err, &overflowErr))
+}
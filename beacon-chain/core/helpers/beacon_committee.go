@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"runtime"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -21,11 +22,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/math"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
 	committeeCache       = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
+	proposerPowersCache  = cache.NewProposerPowersCache()
 )
 
 // SlotCommitteeCount returns the number of beacon committees of a slot. The
@@ -387,7 +390,7 @@ func UpdateProposerIndicesInCache(ctx context.Context, state state.ReadOnlyBeaco
 	if err != nil {
 		return err
 	}
-	proposerIndices, err := precomputeProposerIndices(state, indices, epoch)
+	proposerIndices, err := precomputeProposerIndices(state, indices, epoch, bytesutil.ToBytes32(r))
 	if err != nil {
 		return err
 	}
@@ -401,6 +404,7 @@ func UpdateProposerIndicesInCache(ctx context.Context, state state.ReadOnlyBeaco
 func ClearCache() {
 	committeeCache.Clear()
 	proposerIndicesCache.Clear()
+	proposerPowersCache.Clear()
 	syncCommitteeCache.Clear()
 	balanceCache.Clear()
 }
@@ -432,6 +436,16 @@ func GetProposerIndices(ctx context.Context, st state.BeaconState) ([]primitives
 			if err := UpdateProposerIndicesInCache(ctx, st, time.CurrentEpoch(st)); err != nil {
 				return nil, errors.Wrap(err, "could not update committee cache")
 			}
+			// Before Electra a cache miss returns no proposers, so missed proposals of the epoch are not
+			// penalized. This is kept for the chain before the fork to be processed the same way.
+			if e < params.BeaconConfig().ElectraForkEpoch {
+				return nil, nil
+			}
+			proposerIndices, err = proposerIndicesCache.ProposerIndices(bytesutil.ToBytes32(r))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not interface with committee cache")
+			}
+			return proposerIndices, nil
 		}
 	}
 	return nil, nil
@@ -480,8 +494,15 @@ func computeCommittee(
 }
 
 // This computes proposer indices of the current epoch and returns a list of proposer indices,
-// the index of the list represents the slot number.
-func precomputeProposerIndices(state state.ReadOnlyBeaconState, activeIndices []primitives.ValidatorIndex, e primitives.Epoch) ([]primitives.ValidatorIndex, error) {
+// the index of the list represents the slot number. The proposer power table is computed once
+// for the epoch and shared by the slots, which are computed concurrently. Before Electra every slot still
+// shuffles and walks the active indices, the result is cached so later lookups of the epoch are cheap.
+func precomputeProposerIndices(
+	state state.ReadOnlyBeaconState,
+	activeIndices []primitives.ValidatorIndex,
+	e primitives.Epoch,
+	root [32]byte,
+) ([]primitives.ValidatorIndex, error) {
 	hashFunc := hash.CustomSHA256Hasher()
 	proposerIndices := make([]primitives.ValidatorIndex, params.BeaconConfig().SlotsPerEpoch)
 
@@ -493,20 +514,29 @@ func precomputeProposerIndices(state state.ReadOnlyBeaconState, activeIndices []
 	if err != nil {
 		return nil, err
 	}
-	// todo unit act
-	// We do not have to use locks here, cause all parameters is immutable
-	// - state state.ReadOnlyBeaconState
-	// - activeIndices are copied inside ComputeProposerIndices
-	// - seedWithSlotHash is copied into ComputeProposerIndices by value
-	// TODO(fastex): calculate proposer indices concurrently
+	powers, err := proposerPowers(state, activeIndices, e, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute proposer powers")
+	}
+
+	// The power table and the active indices are only read, every slot works on its own copy of the indices.
+	var g errgroup.Group
+	g.SetLimit(runtime.GOMAXPROCS(0))
 	for i := uint64(0); i < uint64(params.BeaconConfig().SlotsPerEpoch); i++ {
+		i := i
 		seedWithSlot := append(seed[:], bytesutil.Bytes8(uint64(slot)+i)...)
 		seedWithSlotHash := hashFunc(seedWithSlot)
-		index, err := ComputeProposerIndex(state, activeIndices, seedWithSlotHash)
-		if err != nil {
-			return nil, err
-		}
-		proposerIndices[i] = index
+		g.Go(func() error {
+			index, err := selectProposerIndex(powers, activeIndices, seedWithSlotHash, e)
+			if err != nil {
+				return err
+			}
+			proposerIndices[i] = index
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
 	return proposerIndices, nil
@@ -706,7 +706,7 @@ func TestPrecomputeProposerIndices_Ok(t *testing.T) {
 	indices, err := ActiveValidatorIndices(context.Background(), state, 0)
 	require.NoError(t, err)
 
-	proposerIndices, err := precomputeProposerIndices(state, indices, time.CurrentEpoch(state))
+	proposerIndices, err := precomputeProposerIndices(state, indices, time.CurrentEpoch(state), [32]byte{})
 	require.NoError(t, err)
 
 	var wantedProposerIndices []primitives.ValidatorIndex
@@ -722,6 +722,69 @@ func TestPrecomputeProposerIndices_Ok(t *testing.T) {
 	assert.DeepEqual(t, wantedProposerIndices, proposerIndices, "Did not precompute proposer indices correctly")
 }
 
+func TestGetProposerIndices_CacheMiss(t *testing.T) {
+	newState := func(t *testing.T) state.BeaconState {
+		validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount)
+		for i := 0; i < len(validators); i++ {
+			validators[i] = &ethpb.Validator{
+				EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+				ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			}
+		}
+		stateRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+		for i := 0; i < len(stateRoots); i++ {
+			stateRoots[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i+1)), 32)
+		}
+		st, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
+			Slot:           params.BeaconConfig().SlotsPerEpoch * 2,
+			Validators:     validators,
+			RandaoMixes:    make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+			StateRoots:     stateRoots,
+			SharedActivity: &ethpb.SharedActivity{},
+		})
+		require.NoError(t, err)
+		return st
+	}
+
+	t.Run("no proposers before electra", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ElectraForkEpoch = 3
+		params.OverrideBeaconConfig(cfg)
+		ClearCache()
+		defer ClearCache()
+
+		st := newState(t)
+		indices, err := GetProposerIndices(context.Background(), st)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(indices))
+
+		// The miss filled the cache, the next call returns the proposers.
+		indices, err = GetProposerIndices(context.Background(), st)
+		require.NoError(t, err)
+		assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(indices))
+	})
+	t.Run("proposers from electra", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ElectraForkEpoch = 2
+		params.OverrideBeaconConfig(cfg)
+		ClearCache()
+		defer ClearCache()
+
+		st := newState(t)
+		indices, err := GetProposerIndices(context.Background(), st)
+		require.NoError(t, err)
+		require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(indices))
+
+		active, err := ActiveValidatorIndices(context.Background(), st, time.CurrentEpoch(st))
+		require.NoError(t, err)
+		want, err := precomputeProposerIndices(st, active, time.CurrentEpoch(st), [32]byte{})
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, indices)
+	})
+}
+
 func Test_BaseReward(t *testing.T) {
 	validatorCount := uint64(4096*4 + 3712)
 	validatorCount = uint64(102043) + 1
@@ -0,0 +1,144 @@
+package helpers
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// proposerPowers returns the proposer power table of the active validators in the given epoch. The table is
+// kept in the proposer powers cache under the same root as the proposer indices, a zero root skips the cache.
+func proposerPowers(
+	st state.ReadOnlyBeaconState,
+	activeIndices []primitives.ValidatorIndex,
+	epoch primitives.Epoch,
+	root [32]byte,
+) (*cache.ProposerPowers, error) {
+	if root != [32]byte{} {
+		powers, err := proposerPowersCache.ProposerPowers(root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not interface with proposer powers cache")
+		}
+		if powers != nil {
+			return powers, nil
+		}
+	}
+
+	powers, err := computeProposerPowers(st, activeIndices, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if root != [32]byte{} {
+		powers.BlockRoot = root
+		if err := proposerPowersCache.AddProposerPowers(powers); err != nil {
+			return nil, err
+		}
+	}
+	return powers, nil
+}
+
+// computeProposerPowers computes the effective power of every active validator in the given epoch, their running
+// total and their total. The powers do not depend on the slot, so a single table serves every proposer selection
+// of the epoch.
+func computeProposerPowers(
+	st state.ReadOnlyBeaconState,
+	activeIndices []primitives.ValidatorIndex,
+	epoch primitives.Epoch,
+) (*cache.ProposerPowers, error) {
+	length := uint64(len(activeIndices))
+	if length == 0 {
+		return nil, errors.New("empty active indices list")
+	}
+
+	sharedActivity := st.SharedActivity()
+	if sharedActivity == nil {
+		return nil, errors.New("nil shared activity in state")
+	}
+	transactionsGas := sharedActivity.TransactionsGasPerPeriod / length
+
+	powers := make([]uint64, st.NumValidators())
+	cumulative := make([]uint64, length)
+	var total uint64
+	for i, idx := range activeIndices {
+		v, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not calculate total effective power")
+		}
+		power, err := EffectivePower(v, transactionsGas, epoch)
+		if err != nil {
+			return nil, err
+		}
+		powers[idx] = power
+		if total, err = ActivityAdd(epoch, "total effective power", total, power); err != nil {
+			return nil, err
+		}
+		cumulative[i] = total
+	}
+	return &cache.ProposerPowers{
+		Powers:     powers,
+		Cumulative: cumulative,
+		Total:      total,
+	}, nil
+}
+
+// selectProposerIndex samples the proposer out of the active indices using a precomputed power table.
+//
+// Starting from Electra the proposer is found with a binary search over the running total of the powers,
+// which is built once per epoch, so a slot only costs O(log n). Before Electra the candidates are walked
+// in the order given by the slot seed until their accumulated power reaches the random value drawn from
+// the seed. That walk is linear, but it has to be kept to select the same proposers as before the fork.
+func selectProposerIndex(
+	powers *cache.ProposerPowers,
+	activeIndices []primitives.ValidatorIndex,
+	seed [32]byte,
+	epoch primitives.Epoch,
+) (primitives.ValidatorIndex, error) {
+	if epoch >= params.BeaconConfig().ElectraForkEpoch {
+		return searchProposerIndex(powers, activeIndices, seed)
+	}
+
+	indices := make([]primitives.ValidatorIndex, len(activeIndices))
+	copy(indices, activeIndices)
+
+	unshuffledIndices, err := UnshuffleList(indices, seed)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not unshuffle active indices")
+	}
+
+	random := RandomBytes(seed, powers.Total)
+	var accumPower uint64
+	for _, candidateIndex := range unshuffledIndices {
+		if uint64(candidateIndex) >= uint64(len(powers.Powers)) {
+			return 0, errors.New("active index out of range")
+		}
+		accumPower += powers.Powers[candidateIndex]
+		if accumPower >= random {
+			return candidateIndex, nil
+		}
+	}
+	return 0, errors.New("could not select proposer index")
+}
+
+// searchProposerIndex returns the first active index whose running total of power reaches the random value
+// drawn from the seed. Every active validator is selected with a probability proportional to its power.
+func searchProposerIndex(
+	powers *cache.ProposerPowers,
+	activeIndices []primitives.ValidatorIndex,
+	seed [32]byte,
+) (primitives.ValidatorIndex, error) {
+	if len(powers.Cumulative) != len(activeIndices) {
+		return 0, errors.New("proposer power table does not match active indices")
+	}
+	random := RandomBytes(seed, powers.Total)
+	i := sort.Search(len(powers.Cumulative), func(i int) bool {
+		return powers.Cumulative[i] >= random
+	})
+	if i == len(powers.Cumulative) {
+		return 0, errors.New("could not select proposer index")
+	}
+	return activeIndices[i], nil
+}
@@ -0,0 +1,292 @@
+package helpers
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// computeProposerIndexReference is the proposer selection that recomputes the total power and reads
+// the state for every candidate. It is kept as a reference for the power table based selection.
+// Starting from Electra the candidates are walked in the order of the active indices.
+func computeProposerIndexReference(
+	bState state.ReadOnlyBeaconState,
+	activeIndices []primitives.ValidatorIndex,
+	seed [32]byte,
+) (primitives.ValidatorIndex, error) {
+	length := uint64(len(activeIndices))
+	if length == 0 {
+		return 0, errors.New("empty active indices list")
+	}
+	transactionsGas := bState.SharedActivity().TransactionsGasPerPeriod / length
+	totalEffectivePower, err := TotalEffectivePower(bState, activeIndices, transactionsGas, time.CurrentEpoch(bState))
+	if err != nil {
+		return 0, err
+	}
+	indices := make([]primitives.ValidatorIndex, length)
+	copy(indices, activeIndices)
+	unshuffledIndices := indices
+	if time.CurrentEpoch(bState) < params.BeaconConfig().ElectraForkEpoch {
+		unshuffledIndices, err = UnshuffleList(indices, seed)
+		if err != nil {
+			return 0, err
+		}
+	}
+	random := RandomBytes(seed, totalEffectivePower)
+	var accumPower uint64
+	for i := uint64(0); ; i++ {
+		v, err := bState.ValidatorAtIndexReadOnly(unshuffledIndices[i])
+		if err != nil {
+			return 0, err
+		}
+		power, err := EffectivePower(v, transactionsGas, time.CurrentEpoch(bState))
+		if err != nil {
+			return 0, err
+		}
+		accumPower += power
+		if accumPower >= random {
+			return unshuffledIndices[i], nil
+		}
+	}
+}
+
+func proposerPowersState(t testing.TB, count int) state.BeaconState {
+	r := rand.New(rand.NewSource(int64(count)))
+	validators := make([]*ethpb.Validator, count)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance - uint64(r.Intn(8))*params.BeaconConfig().EffectiveBalanceIncrement,
+			EffectiveActivity: uint64(r.Intn(1_000_000)),
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+		}
+		// Leave a few validators inactive, so active indices are not contiguous.
+		if i%17 == 0 {
+			validators[i].ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+		}
+	}
+	st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		SharedActivity: &ethpb.SharedActivity{
+			TransactionsGasPerPeriod: 15_000_000 * 1575 * 32,
+		},
+	})
+	require.NoError(t, err)
+	return st
+}
+
+func TestComputeProposerIndex_MatchesReferenceSelection(t *testing.T) {
+	ClearCache()
+	st := proposerPowersState(t, 3000)
+	indices, err := ActiveValidatorIndices(context.Background(), st, 0)
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 256; i++ {
+		seed := hash.Hash(bytesutil.Bytes8(i))
+		want, err := computeProposerIndexReference(st, indices, seed)
+		require.NoError(t, err)
+		got, err := ComputeProposerIndex(st, indices, seed)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestSelectProposerIndex_RandomPowers(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		electraFork primitives.Epoch
+	}{
+		{name: "before electra", electraFork: params.BeaconConfig().FarFutureEpoch},
+		{name: "electra", electraFork: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.ElectraForkEpoch = tt.electraFork
+			params.OverrideBeaconConfig(cfg)
+			defer ClearCache()
+
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 32; i++ {
+				validators := make([]*ethpb.Validator, 1+r.Intn(2000))
+				for j := range validators {
+					validators[j] = &ethpb.Validator{
+						EffectiveBalance: uint64(r.Intn(33)) * params.BeaconConfig().EffectiveBalanceIncrement,
+						ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+					}
+					// Mix validators without any activity and validators with no power at all.
+					if r.Intn(3) > 0 {
+						validators[j].EffectiveActivity = uint64(r.Int63n(1 << 40))
+					}
+					if r.Intn(10) == 0 {
+						validators[j].ActivationEpoch = params.BeaconConfig().FarFutureEpoch
+					}
+				}
+				validators[0].ActivationEpoch = 0
+				// Active indices are cached by seed, which is the same for every state here.
+				ClearCache()
+				st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{
+					Validators:  validators,
+					RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+					SharedActivity: &ethpb.SharedActivity{
+						TransactionsGasPerPeriod: uint64(r.Int63n(1 << 50)),
+					},
+				})
+				require.NoError(t, err)
+				indices, err := ActiveValidatorIndices(context.Background(), st, 0)
+				require.NoError(t, err)
+
+				powers, err := computeProposerPowers(st, indices, 0)
+				require.NoError(t, err)
+				for s := uint64(0); s < 8; s++ {
+					seed := hash.Hash(append(bytesutil.Bytes8(uint64(i)), bytesutil.Bytes8(s)...))
+					want, err := computeProposerIndexReference(st, indices, seed)
+					require.NoError(t, err)
+					got, err := selectProposerIndex(powers, indices, seed, 0)
+					require.NoError(t, err)
+					require.Equal(t, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPrecomputeProposerIndices_MatchesSlotSelection(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+	st := proposerPowersState(t, 3000)
+	indices, err := ActiveValidatorIndices(context.Background(), st, 0)
+	require.NoError(t, err)
+
+	seed, err := Seed(st, 0, params.BeaconConfig().DomainBeaconProposer)
+	require.NoError(t, err)
+	proposers, err := precomputeProposerIndices(st, indices, 0, [32]byte{'r'})
+	require.NoError(t, err)
+	for s, proposer := range proposers {
+		want, err := computeProposerIndexReference(st, indices, hash.Hash(append(seed[:], bytesutil.Bytes8(uint64(s))...)))
+		require.NoError(t, err)
+		assert.Equal(t, want, proposer)
+	}
+	// The power table of the epoch is cached for the next selections.
+	assert.Equal(t, 1, proposerPowersCache.Len())
+}
+
+func TestComputeProposerIndex_Errors(t *testing.T) {
+	st := proposerPowersState(t, 16)
+	_, err := ComputeProposerIndex(st, []primitives.ValidatorIndex{}, [32]byte{})
+	assert.ErrorContains(t, "empty active indices list", err)
+	_, err = ComputeProposerIndex(st, []primitives.ValidatorIndex{1, 100}, [32]byte{})
+	assert.ErrorContains(t, "could not calculate total effective power", err)
+}
+
+func TestProposerPowers_Cache(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+	st := proposerPowersState(t, 64)
+	indices, err := ActiveValidatorIndices(context.Background(), st, 0)
+	require.NoError(t, err)
+
+	// A zero root is never cached.
+	_, err = proposerPowers(st, indices, 0, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, proposerPowersCache.Len())
+
+	root := [32]byte{'a'}
+	powers, err := proposerPowers(st, indices, 0, root)
+	require.NoError(t, err)
+	assert.Equal(t, 1, proposerPowersCache.Len())
+	cached, err := proposerPowers(st, indices, 0, root)
+	require.NoError(t, err)
+	assert.Equal(t, powers, cached)
+
+	total, err := TotalEffectivePower(st, indices, st.SharedActivity().TransactionsGasPerPeriod/uint64(len(indices)), 0)
+	require.NoError(t, err)
+	assert.Equal(t, total, powers.Total)
+}
+
+func benchmarkProposerSelection(b *testing.B, count int) (state.BeaconState, []primitives.ValidatorIndex, [32]byte) {
+	st := proposerPowersState(b, count)
+	indices, err := ActiveValidatorIndices(context.Background(), st, 0)
+	require.NoError(b, err)
+	seed, err := Seed(st, 0, params.BeaconConfig().DomainBeaconProposer)
+	require.NoError(b, err)
+	return st, indices, seed
+}
+
+func BenchmarkComputeProposerIndex_Reference500000(b *testing.B) {
+	st, indices, seed := benchmarkProposerSelection(b, 500_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := computeProposerIndexReference(st, indices, seed)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkComputeProposerIndex_PowerTable500000(b *testing.B) {
+	st, indices, seed := benchmarkProposerSelection(b, 500_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := ComputeProposerIndex(st, indices, seed)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkPrecomputeProposerIndices_Reference500000(b *testing.B) {
+	st, indices, seed := benchmarkProposerSelection(b, 500_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for s := uint64(0); s < uint64(params.BeaconConfig().SlotsPerEpoch); s++ {
+			_, err := computeProposerIndexReference(st, indices, hash.Hash(append(seed[:], bytesutil.Bytes8(s)...)))
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkPrecomputeProposerIndices_PowerTable500000(b *testing.B) {
+	st, indices, _ := benchmarkProposerSelection(b, 500_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := precomputeProposerIndices(st, indices, 0, [32]byte{})
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkPrecomputeProposerIndices_Electra500000(b *testing.B) {
+	params.SetupTestConfigCleanup(b)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	st, indices, _ := benchmarkProposerSelection(b, 500_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := precomputeProposerIndices(st, indices, 0, [32]byte{})
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkSelectProposerIndex_Electra500000(b *testing.B) {
+	params.SetupTestConfigCleanup(b)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	st, indices, seed := benchmarkProposerSelection(b, 500_000)
+	powers, err := computeProposerPowers(st, indices, 0)
+	require.NoError(b, err)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := selectProposerIndex(powers, indices, seed, 0)
+		require.NoError(b, err)
+	}
+}
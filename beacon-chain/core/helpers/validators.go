@@ -10,11 +10,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -242,21 +244,67 @@ func ValidatorActivationChurnLimitDeneb(activeValidatorCount uint64) uint64 {
 	return limit
 }
 
-// UpdateContract sets contract for validator with the given 'index'.
+// UpdateContract links the contract to the validator with the given 'index'. The contract is kept in the
+// validator record when the validator has none yet. Starting from Electra it is linked as an additional
+// contract otherwise, unless the validator already owns the maximum number of contracts.
 func UpdateContract(s state.BeaconState, idx primitives.ValidatorIndex, contract []byte) error {
-	valAtIdx, err := s.ValidatorAtIndex(idx)
+	contracts, err := s.ContractsAtIndex(idx)
 	if err != nil {
 		return err
 	}
+	key := bytesutil.ToBytes20(contract)
+	if key == params.BeaconConfig().ZeroContract {
+		return nil
+	}
 
-	// Do not update contract, if validator already has contract.
-	if bytesutil.ToBytes20(valAtIdx.Contract) != params.BeaconConfig().ZeroContract {
-		// TODO(fastex): shoud we return error here?
+	// Do not update contract, if validator can not own more contracts. A linked contract
+	// can only be moved or unbound with a signed contract transfer.
+	if uint64(len(contracts)) >= MaxContracts(s) {
 		return nil
 	}
+	for _, c := range contracts {
+		if c == key {
+			return nil
+		}
+	}
+	return LinkContract(s, idx, key)
+}
+
+// LinkContract links the contract to the validator with the given 'index'. The caller is
+// responsible for checking that the validator can own one more contract.
+func LinkContract(s state.BeaconState, idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error {
+	valAtIdx, err := s.ValidatorAtIndex(idx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes20(valAtIdx.Contract) == params.BeaconConfig().ZeroContract {
+		valAtIdx.Contract = bytesutil.SafeCopyBytes(contract[:])
+		return s.UpdateValidatorAtIndex(idx, valAtIdx)
+	}
+	return s.AppendAdditionalContract(idx, contract)
+}
 
-	valAtIdx.Contract = contract
-	return s.UpdateValidatorAtIndex(idx, valAtIdx)
+// UnlinkContract removes the contract from the validator with the given 'index',
+// whether it is kept in the validator record or linked as an additional contract.
+func UnlinkContract(s state.BeaconState, idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error {
+	valAtIdx, err := s.ValidatorAtIndex(idx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes20(valAtIdx.Contract) == contract {
+		valAtIdx.Contract = params.BeaconConfig().ZeroContract[:]
+		return s.UpdateValidatorAtIndex(idx, valAtIdx)
+	}
+	return s.RemoveAdditionalContract(idx, contract)
+}
+
+// MaxContracts returns the maximum number of contracts a validator can own in the given state.
+// Before Electra only the contract kept in the validator record is supported.
+func MaxContracts(s state.ReadOnlyBeaconState) uint64 {
+	if s.Version() < version.Electra {
+		return 1
+	}
+	return params.BeaconConfig().MaxContractsPerValidator
 }
 
 // TotalEffectiveActivity returns the total amount of gas used by validators contracts during period.
@@ -264,10 +312,12 @@ func TotalEffectiveActivity(s state.ReadOnlyBeaconState) (uint64, error) {
 	e := time.CurrentEpoch(s)
 	var totalEffectiveActivity uint64
 	if err := s.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
-		if IsActiveValidatorUsingTrie(val, e) {
-			totalEffectiveActivity += val.EffectiveActivity()
+		if !IsActiveValidatorUsingTrie(val, e) {
+			return nil
 		}
-		return nil
+		var err error
+		totalEffectiveActivity, err = ActivityAdd(e, "total effective activity", totalEffectiveActivity, val.EffectiveActivity())
+		return err
 	}); err != nil {
 		return 0, err
 	}
@@ -314,6 +364,14 @@ func BeaconProposerIndex(ctx context.Context, state state.ReadOnlyBeaconState) (
 			if err := UpdateProposerIndicesInCache(ctx, state, time.CurrentEpoch(state)); err != nil {
 				return 0, errors.Wrap(err, "could not update committee cache")
 			}
+			// The proposer indices of the epoch have just been cached under the same root.
+			proposerIndices, err = proposerIndicesCache.ProposerIndices(bytesutil.ToBytes32(r))
+			if err != nil {
+				return 0, errors.Wrap(err, "could not interface with committee cache")
+			}
+			if len(proposerIndices) == int(params.BeaconConfig().SlotsPerEpoch) {
+				return proposerIndices[state.Slot()%params.BeaconConfig().SlotsPerEpoch], nil
+			}
 		}
 	}
 
@@ -387,7 +445,6 @@ func ComputeProposerIndexEth(bState state.ReadOnlyValidators, activeIndices []pr
 
 // Powers returns both total power and total effective power of all active validators.
 func Powers(ctx context.Context, bState state.ReadOnlyBeaconState) (uint64, uint64, error) {
-	maxEffectiveBalance := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().EffectiveBalanceIncrement
 	var totalPower, totalEffectivePower uint64
 	e := time.CurrentEpoch(bState)
 	activeIndices, err := ActiveValidatorIndices(ctx, bState, e)
@@ -411,34 +468,44 @@ func Powers(ctx context.Context, bState state.ReadOnlyBeaconState) (uint64, uint
 		if err != nil {
 			return 0, 0, err
 		}
-		effectiveBalance := v.EffectiveBalance() / params.BeaconConfig().EffectiveBalanceIncrement
-		effectiveActivity := v.EffectiveActivity()
-		power := effectiveActivity + transactionsGas
-		effectivePower := power * effectiveBalance / maxEffectiveBalance
-		totalPower += power
-		totalEffectivePower += effectivePower
+		power, err := ActivityAdd(e, "power", v.EffectiveActivity(), transactionsGas)
+		if err != nil {
+			return 0, 0, err
+		}
+		effectivePower, err := EffectivePower(v, transactionsGas, e)
+		if err != nil {
+			return 0, 0, err
+		}
+		if totalPower, err = ActivityAdd(e, "total power", totalPower, power); err != nil {
+			return 0, 0, err
+		}
+		if totalEffectivePower, err = ActivityAdd(e, "total effective power", totalEffectivePower, effectivePower); err != nil {
+			return 0, 0, err
+		}
 	}
 
 	return totalPower, totalEffectivePower, nil
 }
 
-// EffectivePower of the validator in current epoch.
-func EffectivePower(val state.ReadOnlyValidator, transactionsGas uint64) uint64 {
+// EffectivePower of the validator in the given epoch.
+func EffectivePower(val state.ReadOnlyValidator, transactionsGas uint64, epoch primitives.Epoch) (uint64, error) {
 	maxEffectiveBalance := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().EffectiveBalanceIncrement
 	effectiveBalance := val.EffectiveBalance() / params.BeaconConfig().EffectiveBalanceIncrement
 	effectiveActivity := val.EffectiveActivity()
 
-	power := effectiveActivity + transactionsGas
-	effectivePower := power * effectiveBalance / maxEffectiveBalance
-
-	return effectivePower
+	power, err := ActivityAdd(epoch, "power", effectiveActivity, transactionsGas)
+	if err != nil {
+		return 0, err
+	}
+	return ActivityMulDiv(epoch, "effective power", power, effectiveBalance, maxEffectiveBalance)
 }
 
-// TotalEffectivePower returns the sum of active validators powers.
+// TotalEffectivePower returns the sum of active validators powers in the given epoch.
 func TotalEffectivePower(
 	bState state.ReadOnlyValidators,
 	activeIndices []primitives.ValidatorIndex,
 	transactionsGas uint64,
+	epoch primitives.Epoch,
 ) (uint64, error) {
 	var totalEffectivePower uint64
 	for _, idx := range activeIndices {
@@ -446,7 +513,13 @@ func TotalEffectivePower(
 		if err != nil {
 			return 0, err
 		}
-		totalEffectivePower += EffectivePower(v, transactionsGas)
+		effectivePower, err := EffectivePower(v, transactionsGas, epoch)
+		if err != nil {
+			return 0, err
+		}
+		if totalEffectivePower, err = ActivityAdd(epoch, "total effective power", totalEffectivePower, effectivePower); err != nil {
+			return 0, err
+		}
 	}
 
 	return totalEffectivePower, nil
@@ -473,53 +546,19 @@ func RandomBytes(seed [32]byte, totalEffectivePower uint64) uint64 {
 	}
 }
 
+// ComputeProposerIndex samples the proposer out of the active indices weighted by effective power.
+// Selecting the proposers of a whole epoch should share a single power table instead, see precomputeProposerIndices.
 func ComputeProposerIndex(
 	bState state.ReadOnlyBeaconState,
 	activeIndices []primitives.ValidatorIndex,
 	seed [32]byte,
 ) (primitives.ValidatorIndex, error) {
-	length := uint64(len(activeIndices))
-	if length == 0 {
-		return 0, errors.New("empty active indices list")
-	}
-
-	sharedActivity := bState.SharedActivity()
-	if sharedActivity == nil {
-		return 0, errors.New("nil shared activity in state")
-	}
-
-	transactionsGas := sharedActivity.TransactionsGasPerPeriod / length
-
-	totalEffectivePower, err := TotalEffectivePower(bState, activeIndices, transactionsGas)
-	if err != nil {
-		return 0, errors.Wrap(err, "could not calculate total effective power")
-	}
-
-	indices := make([]primitives.ValidatorIndex, length)
-	copy(indices, activeIndices)
-
-	unshuffledIndices, err := UnshuffleList(indices, seed)
+	epoch := time.CurrentEpoch(bState)
+	powers, err := computeProposerPowers(bState, activeIndices, epoch)
 	if err != nil {
-		return 0, errors.Wrap(err, "could not unshuffle active indices")
-	}
-
-	random := RandomBytes(seed, totalEffectivePower)
-	var accumPower uint64
-	for i := uint64(0); ; i++ {
-		candidateIndex := unshuffledIndices[i]
-		if uint64(candidateIndex) >= uint64(bState.NumValidators()) {
-			return 0, errors.New("active index out of range")
-		}
-		v, err := bState.ValidatorAtIndexReadOnly(candidateIndex)
-		if err != nil {
-			return 0, err
-		}
-
-		accumPower += EffectivePower(v, transactionsGas)
-		if accumPower >= random {
-			return candidateIndex, nil
-		}
+		return 0, err
 	}
+	return selectProposerIndex(powers, activeIndices, seed, epoch)
 }
 
 // IsEligibleForActivationQueue checks if the validator is eligible to
@@ -1062,7 +1062,7 @@ func TestTotalEffectivePower(t *testing.T) {
 			indices, err := ActiveValidatorIndices(context.Background(), s, 1)
 			require.NoError(t, err)
 			length := uint64(len(indices))
-			effectivePower, err := TotalEffectivePower(s, indices, tt.txGas/length)
+			effectivePower, err := TotalEffectivePower(s, indices, tt.txGas/length, 1)
 			require.NoError(t, err)
 			assert.Equal(t, tt.effectivePower, effectivePower)
 		})
@@ -1154,3 +1154,78 @@ func TestUpdateContract(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateContract_Electra(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxContractsPerValidator = 2
+	params.OverrideBeaconConfig(cfg)
+
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Validators: []*ethpb.Validator{
+			{Contract: bytesutil.PadTo([]byte("contract_0"), 20)},
+			{Contract: make([]byte, 20)},
+		},
+		SharedActivity: &ethpb.SharedActivity{},
+	})
+	require.NoError(t, err)
+
+	contract0 := bytesutil.ToBytes20([]byte("contract_0"))
+	contract1 := bytesutil.ToBytes20([]byte("contract_1"))
+	contract2 := bytesutil.ToBytes20([]byte("contract_2"))
+
+	// Already linked contract is not duplicated.
+	require.NoError(t, UpdateContract(st, 0, contract0[:]))
+	// Second contract is linked as an additional one.
+	require.NoError(t, UpdateContract(st, 0, contract1[:]))
+	// Third contract exceeds the limit and is ignored.
+	require.NoError(t, UpdateContract(st, 0, contract2[:]))
+
+	contracts, err := st.ContractsAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][20]byte{contract0, contract1}, contracts)
+	idx, ok := st.ValidatorIndexByContract(contract1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.ValidatorIndex(0), idx)
+	_, ok = st.ValidatorIndexByContract(contract2)
+	assert.Equal(t, false, ok)
+}
+
+func TestLinkUnlinkContract(t *testing.T) {
+	st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+		Validators: []*ethpb.Validator{
+			{Contract: make([]byte, 20)},
+		},
+		SharedActivity: &ethpb.SharedActivity{},
+	})
+	require.NoError(t, err)
+
+	contract0 := bytesutil.ToBytes20([]byte("contract_0"))
+	contract1 := bytesutil.ToBytes20([]byte("contract_1"))
+	require.NoError(t, LinkContract(st, 0, contract0))
+	require.NoError(t, LinkContract(st, 0, contract1))
+	val, err := st.ValidatorAtIndexReadOnly(0)
+	require.NoError(t, err)
+	assert.Equal(t, contract0, val.Contract())
+
+	require.NoError(t, UnlinkContract(st, 0, contract0))
+	contracts, err := st.ContractsAtIndex(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][20]byte{contract1}, contracts)
+
+	require.NoError(t, UnlinkContract(st, 0, contract1))
+	contracts, err = st.ContractsAtIndex(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(contracts))
+
+	assert.ErrorContains(t, "is not linked to validator", UnlinkContract(st, 0, contract1))
+}
+
+func TestMaxContracts(t *testing.T) {
+	st, err := state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), MaxContracts(st))
+	st, err = state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{})
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxContractsPerValidator, MaxContracts(st))
+}
@@ -53,6 +53,8 @@ const (
 	ContributionSignature = "sync committee contribution signature"
 	// SyncAggregateSignature represents sync committee aggregator's signature
 	SyncAggregateSignature = "sync committee aggregator signature"
+	// ContractTransferSignature represents signature to ContractTransfer
+	ContractTransferSignature = "contract transfer signature"
 )
 
 // ComputeDomainAndSign computes the domain and signing root and sign it using the passed in private key.
@@ -90,6 +90,15 @@ func CanUpgradeToDeneb(slot primitives.Slot) bool {
 	return epochStart && DenebEpoch
 }
 
+// CanUpgradeToElectra returns true if the input `slot` can upgrade to Electra.
+// Spec code:
+// If state.slot % SLOTS_PER_EPOCH == 0 and compute_epoch_at_slot(state.slot) == ELECTRA_FORK_EPOCH
+func CanUpgradeToElectra(slot primitives.Slot) bool {
+	epochStart := slots.IsEpochStart(slot)
+	electraEpoch := slots.ToEpoch(slot) == params.BeaconConfig().ElectraForkEpoch
+	return epochStart && electraEpoch
+}
+
 // CanProcessEpoch checks the eligibility to process epoch.
 // The epoch can be processed at the end of the last slot of every epoch.
 //
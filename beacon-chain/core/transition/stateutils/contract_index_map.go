@@ -12,9 +12,14 @@ import (
 )
 
 // ContractIndexMap builds a lookup map for quickly determining the index of
-// a validator by their deployed contract address.
-func ContractIndexMap(validators []*ethpb.Validator, epoch primitives.Epoch) map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex {
-	m := make(map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex, len(validators))
+// a validator by their deployed contract address. Additional contracts, tracked
+// in the beacon state starting from Electra, are indexed as well.
+func ContractIndexMap(
+	validators []*ethpb.Validator,
+	additional []*ethpb.ValidatorContract,
+	epoch primitives.Epoch,
+) map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex {
+	m := make(map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex, len(validators)+len(additional))
 	if validators == nil {
 		return m
 	}
@@ -27,5 +32,15 @@ func ContractIndexMap(validators []*ethpb.Validator, epoch primitives.Epoch) map
 			m[key] = primitives.ValidatorIndex(idx)
 		}
 	}
+	for _, c := range additional {
+		if c == nil || uint64(c.ValidatorIndex) >= uint64(len(validators)) {
+			continue
+		}
+		record := validators[c.ValidatorIndex]
+		if record == nil || record.ExitEpoch < epoch {
+			continue
+		}
+		m[bytesutil.ToBytes20(c.Contract)] = c.ValidatorIndex
+	}
 	return m
 }
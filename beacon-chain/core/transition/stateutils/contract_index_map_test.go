@@ -49,7 +49,7 @@ func TestContractIndexMap_OK(t *testing.T) {
 		},
 	}
 
-	m := stateutils.ContractIndexMap(state.Validators(), 1)
+	m := stateutils.ContractIndexMap(state.Validators(), nil, 1)
 	for _, tt := range tests {
 		result, ok := m[tt.key]
 		assert.Equal(t, tt.val, result)
@@ -105,7 +105,58 @@ func TestContractIndexMap_ExitedValidator(t *testing.T) {
 		},
 	}
 
-	m := stateutils.ContractIndexMap(state.Validators(), 1)
+	m := stateutils.ContractIndexMap(state.Validators(), nil, 1)
+	for _, tt := range tests {
+		result, ok := m[tt.key]
+		assert.Equal(t, tt.val, result)
+		assert.Equal(t, tt.ok, ok)
+	}
+}
+
+func TestContractIndexMap_AdditionalContracts(t *testing.T) {
+	validators := []*ethpb.Validator{
+		{
+			Contract:  []byte("contract_zero"),
+			ExitEpoch: 10,
+		},
+		{
+			Contract:  []byte("contract_one"),
+			ExitEpoch: 0,
+		},
+	}
+	additional := []*ethpb.ValidatorContract{
+		{ValidatorIndex: 0, Contract: bytesutil.PadTo([]byte("contract_extra"), 20)},
+		// Owner exited.
+		{ValidatorIndex: 1, Contract: bytesutil.PadTo([]byte("contract_exited"), 20)},
+		// Owner does not exist.
+		{ValidatorIndex: 5, Contract: bytesutil.PadTo([]byte("contract_unknown"), 20)},
+	}
+
+	tests := []struct {
+		key [fieldparams.ContractAddressLength]byte
+		val primitives.ValidatorIndex
+		ok  bool
+	}{
+		{
+			key: bytesutil.ToBytes20([]byte("contract_zero")),
+			val: 0,
+			ok:  true,
+		}, {
+			key: bytesutil.ToBytes20([]byte("contract_extra")),
+			val: 0,
+			ok:  true,
+		}, {
+			key: bytesutil.ToBytes20([]byte("contract_exited")),
+			val: 0,
+			ok:  false,
+		}, {
+			key: bytesutil.ToBytes20([]byte("contract_unknown")),
+			val: 0,
+			ok:  false,
+		},
+	}
+
+	m := stateutils.ContractIndexMap(validators, additional, 1)
 	for _, tt := range tests {
 		result, ok := m[tt.key]
 		assert.Equal(t, tt.val, result)
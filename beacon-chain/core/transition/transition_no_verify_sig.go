@@ -212,6 +212,17 @@ func ProcessBlockNoVerifyAnySig(
 		}
 		set.Join(cSet)
 	}
+	if blk.Version() >= version.Electra {
+		transfers, err := signed.Block().Body().ContractTransfers()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get ContractTransfers")
+		}
+		tSet, err := b.ContractTransfersSignatureBatch(st, transfers)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get ContractTransfers signatures")
+		}
+		set.Join(tSet)
+	}
 	return set, st, nil
 }
 
@@ -263,7 +274,7 @@ func ProcessOperationsNoVerifyAttsSigs(
 		if err != nil {
 			return nil, err
 		}
-	case version.Altair, version.Bellatrix, version.Capella, version.Deneb:
+	case version.Altair, version.Bellatrix, version.Capella, version.Deneb, version.Electra:
 		state, err = altairOperations(ctx, state, signedBeaconBlock)
 		if err != nil {
 			return nil, err
@@ -421,7 +432,11 @@ func altairOperations(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process base fee")
 	}
-	return b.ProcessBLSToExecutionChanges(st, signedBeaconBlock)
+	st, err = b.ProcessBLSToExecutionChanges(st, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process bls to execution changes")
+	}
+	return b.ProcessContractTransfers(st, signedBeaconBlock)
 }
 
 // This calls phase 0 block operations.
@@ -0,0 +1,286 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+var (
+	errEmptySidecar       = errors.New("nil or empty blob sidecar")
+	errInvalidBlockRoot   = errors.New("blob sidecar block root is not 32 bytes")
+	errIndexOutOfBounds   = errors.New("blob index >= MaxBlobsPerBlock")
+	errRetentionTooShort  = errors.New("blob retention is shorter than MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS")
+	errSidecarSizeInvalid = errors.New("blob sidecar file has an invalid size")
+)
+
+// BlobStorageDirName is the name of the directory holding the blob storage in the data directory.
+const BlobStorageDirName = "blobs"
+
+const (
+	sszExt  = "ssz"
+	partExt = "part"
+)
+
+// sidecarSize is the size of an ssz encoded blob sidecar. Every field of a sidecar has a fixed size,
+// so every sidecar file on disk has exactly this size.
+var sidecarSize = (&ethpb.BlobSidecar{}).SizeSSZ()
+
+// BlobStorageOption is a functional option for configuring a BlobStorage.
+type BlobStorageOption func(*BlobStorage) error
+
+// WithBlobRetentionEpochs is an option that changes the number of epochs blobs will be persisted.
+// The value cannot be lower than the spec MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS.
+func WithBlobRetentionEpochs(e primitives.Epoch) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		if e < params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest {
+			return errors.Wrapf(errRetentionTooShort, "%d < %d", e, params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest)
+		}
+		b.retentionEpochs = e
+		return nil
+	}
+}
+
+// BlobStorage is the concrete implementation of the filesystem backend for saving and retrieving BlobSidecars.
+// Each sidecar is stored in its own file, at <base>/<first byte of root>/<root>/<index>.ssz. Sidecars are written
+// to a temporary file first and renamed into place, so a file with the final name always holds a complete sidecar.
+type BlobStorage struct {
+	base            string
+	retentionEpochs primitives.Epoch
+
+	// lock guards the slot index.
+	lock  sync.Mutex
+	slots map[[32]byte]primitives.Slot
+}
+
+// NewBlobStorage creates a new instance of the BlobStorage object rooted at the given directory. The directory is
+// scanned before the storage is returned: incomplete writes and files which do not hold the sidecar their path
+// refers to are removed.
+func NewBlobStorage(base string, opts ...BlobStorageOption) (*BlobStorage, error) {
+	base = path.Clean(base)
+	if err := file.MkdirAll(base); err != nil {
+		return nil, errors.Wrapf(err, "failed to create blob storage at %s", base)
+	}
+	b := &BlobStorage{
+		base:            base,
+		retentionEpochs: params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest,
+		slots:           make(map[[32]byte]primitives.Slot),
+	}
+	for _, o := range opts {
+		if err := o(b); err != nil {
+			return nil, errors.Wrap(err, "failed to create blob storage")
+		}
+	}
+	if err := b.scan(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan blob storage at %s", base)
+	}
+	return b, nil
+}
+
+// Save saves the blob sidecar to the filesystem. Saving a sidecar which is already stored is a no-op.
+// Sidecars which fell out of the retention window are removed by the BlobPruner.
+func (bs *BlobStorage) Save(sidecar *ethpb.BlobSidecar) error {
+	if sidecar == nil {
+		return errEmptySidecar
+	}
+	if len(sidecar.BlockRoot) != fieldparams.RootLength {
+		return errors.Wrapf(errInvalidBlockRoot, "%d bytes", len(sidecar.BlockRoot))
+	}
+	if sidecar.Index >= fieldparams.MaxBlobsPerBlock {
+		return errors.Wrapf(errIndexOutOfBounds, "%d >= %d", sidecar.Index, fieldparams.MaxBlobsPerBlock)
+	}
+	root := bytesutil.ToBytes32(sidecar.BlockRoot)
+	fname := bs.sidecarFileName(root, sidecar.Index)
+	if file.FileExists(fname) {
+		return nil
+	}
+	encoded, err := sidecar.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode blob sidecar")
+	}
+	if err := os.MkdirAll(bs.rootDir(root), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return errors.Wrapf(err, "failed to create blob directory for root %#x", root)
+	}
+	if err := writeAtomically(fname, encoded); err != nil {
+		return errors.Wrapf(err, "failed to save blob sidecar %d for root %#x", sidecar.Index, root)
+	}
+	blobsWrittenCounter.Inc()
+
+	bs.lock.Lock()
+	bs.slots[root] = sidecar.Slot
+	bs.lock.Unlock()
+	return nil
+}
+
+// Get retrieves a single BlobSidecar by its root and index. The returned error wraps os.ErrNotExist
+// if the sidecar is not stored, which is always the case for an index >= MaxBlobsPerBlock.
+func (bs *BlobStorage) Get(root [32]byte, idx uint64) (*ethpb.BlobSidecar, error) {
+	if idx >= fieldparams.MaxBlobsPerBlock {
+		return nil, errors.Wrapf(os.ErrNotExist, "blob index %d >= %d", idx, fieldparams.MaxBlobsPerBlock)
+	}
+	encoded, err := os.ReadFile(bs.sidecarFileName(root, idx))
+	if err != nil {
+		return nil, err
+	}
+	sc := &ethpb.BlobSidecar{}
+	if err := sc.UnmarshalSSZ(encoded); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode blob sidecar %d for root %#x", idx, root)
+	}
+	return sc, nil
+}
+
+// Indices returns the indices of the sidecars stored for the given root, in ascending order.
+func (bs *BlobStorage) Indices(root [32]byte) ([]uint64, error) {
+	entries, err := os.ReadDir(bs.rootDir(root))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to list blobs for root %#x", root)
+	}
+	indices := make([]uint64, 0, len(entries))
+	for _, e := range entries {
+		idx, ok := sidecarIndex(e.Name())
+		if !ok {
+			continue
+		}
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices, nil
+}
+
+// Sidecars retrieves the sidecars stored for the given root, ordered by index. If indices are given, only
+// those sidecars are returned and it is an error for any of them to be missing. The returned error wraps
+// os.ErrNotExist if no sidecar is stored for the root or a requested index is missing.
+func (bs *BlobStorage) Sidecars(root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error) {
+	if len(indices) == 0 {
+		var err error
+		indices, err = bs.Indices(root)
+		if err != nil {
+			return nil, err
+		}
+		if len(indices) == 0 {
+			return nil, errors.Wrapf(os.ErrNotExist, "no blob sidecars for root %#x", root)
+		}
+	}
+	sidecars := make([]*ethpb.BlobSidecar, len(indices))
+	for i, idx := range indices {
+		sc, err := bs.Get(root, idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "blob sidecar %d for root %#x", idx, root)
+		}
+		sidecars[i] = sc
+	}
+	return sidecars, nil
+}
+
+// Remove removes all sidecars stored for the given root.
+func (bs *BlobStorage) Remove(root [32]byte) error {
+	bs.lock.Lock()
+	delete(bs.slots, root)
+	bs.lock.Unlock()
+	if err := os.RemoveAll(bs.rootDir(root)); err != nil {
+		return errors.Wrapf(err, "failed to remove blobs for root %#x", root)
+	}
+	return nil
+}
+
+func (bs *BlobStorage) rootDir(root [32]byte) string {
+	return filepath.Join(bs.base, shardDir(root), rootString(root))
+}
+
+func (bs *BlobStorage) sidecarFileName(root [32]byte, idx uint64) string {
+	return filepath.Join(bs.rootDir(root), fmt.Sprintf("%d.%s", idx, sszExt))
+}
+
+// shardDir spreads the root directories over 256 parent directories, so that no single directory
+// ends up with an entry for every block in the retention window.
+func shardDir(root [32]byte) string {
+	return fmt.Sprintf("%02x", root[0])
+}
+
+func rootString(root [32]byte) string {
+	return hexutil.Encode(root[:])
+}
+
+// sidecarIndex returns the index of the sidecar a file with the given name holds.
+func sidecarIndex(name string) (uint64, bool) {
+	base, ext, ok := strings.Cut(name, ".")
+	if !ok || ext != sszExt {
+		return 0, false
+	}
+	idx, err := strconv.ParseUint(base, 10, 64)
+	if err != nil || idx >= fieldparams.MaxBlobsPerBlock {
+		return 0, false
+	}
+	return idx, true
+}
+
+// writeAtomically writes the data to a temporary file next to the destination, syncs it and renames it
+// into place, so that readers never observe a partially written file. Concurrent writers use distinct
+// temporary files. The directory is synced after the rename so that the new entry survives a crash.
+func writeAtomically(fname string, data []byte) (err error) {
+	dir := filepath.Dir(fname)
+	f, err := os.CreateTemp(dir, filepath.Base(fname)+".*."+partExt)
+	if err != nil {
+		return err
+	}
+	partial := f.Name()
+	defer func() {
+		if err != nil {
+			if rmErr := os.Remove(partial); rmErr != nil && !os.IsNotExist(rmErr) {
+				log.WithError(rmErr).WithField("file", partial).Warn("Failed to remove partial blob file")
+			}
+		}
+	}()
+	n, err := f.Write(data)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if n != len(data) {
+		_ = f.Close()
+		return fmt.Errorf("wrote %d bytes of %d", n, len(data))
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(partial, fname); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes the entries of the directory to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		_ = d.Close()
+		return err
+	}
+	return d.Close()
+}
@@ -0,0 +1,272 @@
+package filesystem
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func generateBlobSidecars(t *testing.T, root [32]byte, slot primitives.Slot, n uint64) []*ethpb.BlobSidecar {
+	scs := make([]*ethpb.BlobSidecar, n)
+	for i := uint64(0); i < n; i++ {
+		blob := make([]byte, fieldparams.BlobLength)
+		_, err := rand.Read(blob)
+		require.NoError(t, err)
+		scs[i] = &ethpb.BlobSidecar{
+			BlockRoot:       root[:],
+			Index:           i,
+			Slot:            slot,
+			BlockParentRoot: bytesutil.PadTo([]byte{'b'}, 32),
+			ProposerIndex:   101,
+			Blob:            blob,
+			KzgCommitment:   bytesutil.PadTo([]byte{'c'}, 48),
+			KzgProof:        bytesutil.PadTo([]byte{'d'}, 48),
+		}
+	}
+	return scs
+}
+
+func TestBlobStorage_SaveAndGet(t *testing.T) {
+	root := [32]byte{'a'}
+	scs := generateBlobSidecars(t, root, 100, fieldparams.MaxBlobsPerBlock)
+
+	t.Run("not found", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		_, err := bs.Get(root, 0)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		_, err = bs.Sidecars(root)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		indices, err := bs.Indices(root)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(indices))
+	})
+	t.Run("save and get", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		for _, sc := range scs {
+			require.NoError(t, bs.Save(sc))
+		}
+		for _, sc := range scs {
+			got, err := bs.Get(root, sc.Index)
+			require.NoError(t, err)
+			require.DeepSSZEqual(t, sc, got)
+		}
+		got, err := bs.Sidecars(root)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, scs, got)
+	})
+	t.Run("duplicate save is a no-op", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(scs[0]))
+		require.NoError(t, bs.Save(scs[0]))
+		indices, err := bs.Indices(root)
+		require.NoError(t, err)
+		require.DeepEqual(t, []uint64{0}, indices)
+	})
+	t.Run("sidecars by indices", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(scs[1]))
+		require.NoError(t, bs.Save(scs[3]))
+		indices, err := bs.Indices(root)
+		require.NoError(t, err)
+		require.DeepEqual(t, []uint64{1, 3}, indices)
+		got, err := bs.Sidecars(root, 3)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, []*ethpb.BlobSidecar{scs[3]}, got)
+		_, err = bs.Sidecars(root, 1, 2)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("invalid sidecars", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.ErrorIs(t, bs.Save(nil), errEmptySidecar)
+		require.ErrorIs(t, bs.Save(&ethpb.BlobSidecar{BlockRoot: []byte{'a'}}), errInvalidBlockRoot)
+		require.ErrorIs(t, bs.Save(&ethpb.BlobSidecar{BlockRoot: root[:], Index: fieldparams.MaxBlobsPerBlock}), errIndexOutOfBounds)
+		_, err := bs.Get(root, fieldparams.MaxBlobsPerBlock)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("remove", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		for _, sc := range scs {
+			require.NoError(t, bs.Save(sc))
+		}
+		require.NoError(t, bs.Remove(root))
+		_, err := bs.Sidecars(root)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.NoError(t, bs.Remove(root))
+	})
+}
+
+func TestBlobStorage_Layout(t *testing.T) {
+	bs := NewEphemeralBlobStorage(t)
+	root := [32]byte{0xab, 0xcd}
+	require.NoError(t, bs.Save(generateBlobSidecars(t, root, 1, 1)[0]))
+	fname := filepath.Join(bs.base, "ab", "0xabcd000000000000000000000000000000000000000000000000000000000000", "0.ssz")
+	info, err := os.Stat(fname)
+	require.NoError(t, err)
+	require.Equal(t, int64(sidecarSize), info.Size())
+	entries, err := os.ReadDir(filepath.Dir(fname))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries), "partial file left behind")
+}
+
+func TestBlobStorage_ConcurrentSave(t *testing.T) {
+	bs := NewEphemeralBlobStorage(t)
+	root := [32]byte{0xab, 0xcd}
+	sc := generateBlobSidecars(t, root, 1, 1)[0]
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = bs.Save(sc)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	got, err := bs.Get(root, 0)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, sc, got)
+	entries, err := os.ReadDir(bs.rootDir(root))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries), "partial file left behind")
+}
+
+func TestNewBlobStorage_Scan(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "blobs")
+	bs, err := NewBlobStorage(dir)
+	require.NoError(t, err)
+	good := [32]byte{'g'}
+	scs := generateBlobSidecars(t, good, 10, 3)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+	goodDir := bs.rootDir(good)
+
+	// An interrupted write.
+	require.NoError(t, os.WriteFile(filepath.Join(goodDir, "3.ssz.part"), []byte{1, 2, 3}, 0600))
+	// A truncated file.
+	enc, err := scs[2].MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bs.sidecarFileName(good, 2), enc[:len(enc)-1], 0600))
+	// A sidecar stored under the wrong index.
+	enc, err = scs[0].MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bs.sidecarFileName(good, 4), enc, 0600))
+	// A sidecar stored under the wrong root, leaving an empty directory behind.
+	bad := [32]byte{'b'}
+	require.NoError(t, os.MkdirAll(bs.rootDir(bad), 0700))
+	require.NoError(t, os.WriteFile(bs.sidecarFileName(bad, 0), enc, 0600))
+
+	bs, err = NewBlobStorage(dir)
+	require.NoError(t, err)
+	indices, err := bs.Indices(good)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{0, 1}, indices)
+	entries, err := os.ReadDir(goodDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	_, err = os.Stat(bs.rootDir(bad))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.Equal(t, primitives.Slot(10), bs.slots[good])
+	require.Equal(t, 1, len(bs.slots))
+}
+
+func TestBlobStorage_Prune(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	bs := NewEphemeralBlobStorage(t)
+	oldRoot, keptRoot := [32]byte{'o'}, [32]byte{'k'}
+	oldSlot := primitives.Slot(1)
+	keptSlot := slotsPerEpoch
+	for _, sc := range generateBlobSidecars(t, oldRoot, oldSlot, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+	for _, sc := range generateBlobSidecars(t, keptRoot, keptSlot, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	// Pruning exactly retention epochs after the first epoch keeps everything.
+	current := primitives.Slot(uint64(retention) * uint64(slotsPerEpoch))
+	require.NoError(t, bs.Prune(current))
+	_, err := bs.Sidecars(oldRoot)
+	require.NoError(t, err)
+
+	// Saving a sidecar never prunes.
+	current += slotsPerEpoch
+	require.NoError(t, bs.Save(generateBlobSidecars(t, [32]byte{'d'}, current, 1)[0]))
+	_, err = bs.Sidecars(oldRoot)
+	require.NoError(t, err)
+
+	// One epoch later, the sidecars of the first epoch fall out of the retention window.
+	require.NoError(t, bs.Prune(current))
+	_, err = bs.Sidecars(oldRoot)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Stat(bs.rootDir(oldRoot))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	got, err := bs.Sidecars(keptRoot)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+
+	// Pruning also applies to the sidecars indexed by the startup scan.
+	bs, err = NewBlobStorage(bs.base)
+	require.NoError(t, err)
+	require.NoError(t, bs.Prune(current+slotsPerEpoch))
+	_, err = bs.Sidecars(keptRoot)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWithBlobRetentionEpochs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	_, err := NewBlobStorage(filepath.Join(t.TempDir(), "blobs"), WithBlobRetentionEpochs(minEpochs-1))
+	require.ErrorIs(t, err, errRetentionTooShort)
+	bs, err := NewBlobStorage(filepath.Join(t.TempDir(), "blobs"), WithBlobRetentionEpochs(minEpochs+1))
+	require.NoError(t, err)
+	require.Equal(t, minEpochs+1, bs.retentionEpochs)
+}
+
+func TestBlobPruner_PrunesInBackground(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	bs := NewEphemeralBlobStorage(t)
+	oldRoot := [32]byte{'o'}
+	for _, sc := range generateBlobSidecars(t, oldRoot, 1, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	cs := startup.NewClockSynchronizer()
+	p := NewBlobPruner(context.Background(), bs, cs)
+	p.Start()
+	require.NoError(t, p.Status())
+	genesis := time.Now().Add(-time.Duration(uint64(retention+2)*secondsPerEpoch) * time.Second)
+	require.NoError(t, cs.SetClock(startup.NewClock(genesis, [32]byte{})))
+
+	for i := 0; ; i++ {
+		if _, err := bs.Sidecars(oldRoot); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		require.Equal(t, true, i < 100, "blob sidecars were not pruned in the background")
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.NoError(t, p.Stop())
+	require.ErrorContains(t, "not running", p.Status())
+}
@@ -0,0 +1,17 @@
+package filesystem
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// NewEphemeralBlobStorage should only be used for tests.
+// The instance of BlobStorage returned is backed by a temporary directory which is removed
+// when the test finishes.
+func NewEphemeralBlobStorage(t testing.TB) *BlobStorage {
+	bs, err := NewBlobStorage(filepath.Join(t.TempDir(), "blobs"))
+	require.NoError(t, err)
+	return bs
+}
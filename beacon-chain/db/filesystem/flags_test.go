@@ -1,4 +1,4 @@
-package kv
+package filesystem
 
 import (
 	"flag"
@@ -13,14 +13,14 @@ import (
 )
 
 func TestConfigureBlobRetentionEpoch(t *testing.T) {
-	maxEpochsToPersistBlobs = params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
 	params.SetupTestConfigCleanup(t)
 	app := cli.App{}
 	set := flag.NewFlagSet("test", 0)
 
 	// Test case: Spec default.
-	require.NoError(t, ConfigureBlobRetentionEpoch(cli.NewContext(&app, set, nil)))
-	require.Equal(t, params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest, maxEpochsToPersistBlobs)
+	e, err := ConfigureBlobRetentionEpoch(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest, e)
 
 	set.Uint64(flags.BlobRetentionEpoch.Name, 0, "")
 	minEpochsForSidecarRequest := uint64(params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest)
@@ -28,12 +28,13 @@ func TestConfigureBlobRetentionEpoch(t *testing.T) {
 	cliCtx := cli.NewContext(&app, set, nil)
 
 	// Test case: Input epoch is greater than or equal to spec value.
-	require.NoError(t, ConfigureBlobRetentionEpoch(cliCtx))
-	require.Equal(t, primitives.Epoch(2*minEpochsForSidecarRequest), maxEpochsToPersistBlobs)
+	e, err = ConfigureBlobRetentionEpoch(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(2*minEpochsForSidecarRequest), e)
 
 	// Test case: Input epoch is less than spec value.
 	require.NoError(t, set.Set(flags.BlobRetentionEpoch.Name, strconv.FormatUint(minEpochsForSidecarRequest-1, 10)))
 	cliCtx = cli.NewContext(&app, set, nil)
-	err := ConfigureBlobRetentionEpoch(cliCtx)
+	_, err = ConfigureBlobRetentionEpoch(cliCtx)
 	require.ErrorContains(t, "extend-blob-retention-epoch smaller than spec default", err)
 }
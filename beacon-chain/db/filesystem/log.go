@@ -0,0 +1,5 @@
+package filesystem
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "filesystem")
@@ -0,0 +1,21 @@
+package filesystem
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blobsWrittenCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobs_written_total",
+		Help: "Number of blob sidecars written to the filesystem.",
+	})
+	blobsPrunedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobs_pruned_total",
+		Help: "Number of blob sidecars removed from the filesystem after leaving the retention window.",
+	})
+	blobsDiscardedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobs_discarded_total",
+		Help: "Number of incomplete or corrupted blob sidecar files removed by the startup scan.",
+	})
+)
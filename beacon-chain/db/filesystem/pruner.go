@@ -0,0 +1,301 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// The first fields of an ssz encoded sidecar are its block root, index and slot, so the
+// identity of the sidecar a file holds can be checked without reading the blob.
+const (
+	sidecarRootOffset  = 0
+	sidecarIndexOffset = 32
+	sidecarSlotOffset  = 40
+	sidecarHeaderSize  = 48
+)
+
+// BlobPruner is a service which prunes the blob storage in the background, once at startup and then at
+// the start of every epoch, so that saving a sidecar never waits on the removal of expired ones.
+type BlobPruner struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	bs          *BlobStorage
+	clockWaiter startup.ClockWaiter
+
+	// lock guards isRunning.
+	lock      sync.RWMutex
+	isRunning bool
+}
+
+// NewBlobPruner sets up a service pruning the given blob storage once the genesis time is known.
+func NewBlobPruner(ctx context.Context, bs *BlobStorage, cw startup.ClockWaiter) *BlobPruner {
+	ctx, cancel := context.WithCancel(ctx)
+	return &BlobPruner{
+		ctx:         ctx,
+		cancel:      cancel,
+		bs:          bs,
+		clockWaiter: cw,
+	}
+}
+
+// Start the pruning routine.
+func (p *BlobPruner) Start() {
+	p.lock.Lock()
+	p.isRunning = true
+	p.lock.Unlock()
+	go p.run()
+}
+
+// Stop the pruning routine.
+func (p *BlobPruner) Stop() error {
+	defer p.cancel()
+	p.lock.Lock()
+	p.isRunning = false
+	p.lock.Unlock()
+	return nil
+}
+
+// Status of the service.
+func (p *BlobPruner) Status() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if p.isRunning {
+		return nil
+	}
+	return errors.New("not running")
+}
+
+func (p *BlobPruner) run() {
+	clock, err := p.clockWaiter.WaitForClock(p.ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to wait for the clock, blob sidecars will not be pruned")
+		return
+	}
+	p.prune(clock.CurrentSlot())
+
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	epochTicker := slots.NewSlotTicker(clock.GenesisTime(), secondsPerEpoch)
+	defer epochTicker.Done()
+	for {
+		select {
+		case epoch := <-epochTicker.C():
+			// The ticker counts epochs, as it ticks once every epoch.
+			start, err := slots.EpochStart(primitives.Epoch(epoch))
+			if err != nil {
+				log.WithError(err).Error("Failed to compute the start slot of the epoch")
+				continue
+			}
+			p.prune(start)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *BlobPruner) prune(current primitives.Slot) {
+	if err := p.bs.Prune(current); err != nil {
+		log.WithError(err).Error("Failed to prune blob sidecars")
+	}
+}
+
+// Prune removes the sidecars of blocks which are older than the retention window at the given slot.
+// Blocks from the epoch which is exactly retentionEpochs before the epoch of the slot are kept, as
+// they can still be requested by peers.
+func (bs *BlobStorage) Prune(current primitives.Slot) error {
+	epoch := slots.ToEpoch(current)
+	if epoch <= bs.retentionEpochs {
+		return nil
+	}
+	cutoff, err := slots.EpochStart(epoch - bs.retentionEpochs)
+	if err != nil {
+		return errors.Wrap(err, "could not compute pruning cutoff")
+	}
+
+	bs.lock.Lock()
+	var expired [][32]byte
+	for root, slot := range bs.slots {
+		if slot < cutoff {
+			expired = append(expired, root)
+		}
+	}
+	bs.lock.Unlock()
+
+	var pruned int
+	for _, root := range expired {
+		indices, err := bs.Indices(root)
+		if err != nil {
+			return err
+		}
+		if err := bs.Remove(root); err != nil {
+			return err
+		}
+		pruned += len(indices)
+	}
+	if pruned > 0 {
+		blobsPrunedCounter.Add(float64(pruned))
+		log.WithFields(logrus.Fields{
+			"blocks":   len(expired),
+			"sidecars": pruned,
+			"cutoff":   cutoff,
+		}).Debug("Pruned blob sidecars")
+	}
+	return nil
+}
+
+// scan walks the storage directory, removing the files left behind by interrupted writes and the
+// files which do not hold the sidecar their path refers to, and indexes the slot of every stored root.
+func (bs *BlobStorage) scan() error {
+	shards, err := os.ReadDir(bs.base)
+	if err != nil {
+		return err
+	}
+	var blocks, sidecars, discarded int
+	for _, shard := range shards {
+		if !shard.IsDir() {
+			continue
+		}
+		shardPath := filepath.Join(bs.base, shard.Name())
+		roots, err := os.ReadDir(shardPath)
+		if err != nil {
+			return err
+		}
+		for _, r := range roots {
+			rootPath := filepath.Join(shardPath, r.Name())
+			root, ok := parseRootDir(shard.Name(), r)
+			if !ok {
+				log.WithField("path", rootPath).Warn("Ignoring unexpected entry in blob storage")
+				continue
+			}
+			n, d, err := bs.scanRoot(root, rootPath)
+			if err != nil {
+				return err
+			}
+			sidecars += n
+			discarded += d
+			if n == 0 {
+				if err := os.Remove(rootPath); err != nil {
+					return errors.Wrapf(err, "failed to remove empty blob directory %s", rootPath)
+				}
+				continue
+			}
+			blocks++
+		}
+	}
+	blobsDiscardedCounter.Add(float64(discarded))
+	fields := logrus.Fields{
+		"path":     bs.base,
+		"blocks":   blocks,
+		"sidecars": sidecars,
+	}
+	if discarded > 0 {
+		fields["discarded"] = discarded
+		log.WithFields(fields).Warn("Removed incomplete or corrupted blob sidecar files")
+		return nil
+	}
+	log.WithFields(fields).Info("Opened blob storage")
+	return nil
+}
+
+// scanRoot checks the files in the directory of a single root, returning the number of valid sidecars
+// and the number of files which were removed.
+func (bs *BlobStorage) scanRoot(root [32]byte, dir string) (int, int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	var valid, discarded int
+	for _, e := range entries {
+		fname := filepath.Join(dir, e.Name())
+		if e.IsDir() {
+			log.WithField("path", fname).Warn("Ignoring unexpected entry in blob storage")
+			continue
+		}
+		if strings.HasSuffix(e.Name(), "."+partExt) {
+			if err := os.Remove(fname); err != nil {
+				return 0, 0, errors.Wrapf(err, "failed to remove partial blob file %s", fname)
+			}
+			discarded++
+			continue
+		}
+		idx, ok := sidecarIndex(e.Name())
+		if !ok {
+			log.WithField("path", fname).Warn("Ignoring unexpected entry in blob storage")
+			continue
+		}
+		slot, err := checkSidecarFile(fname, root, idx)
+		if err != nil {
+			log.WithError(err).WithField("path", fname).Warn("Removing invalid blob sidecar file")
+			if err := os.Remove(fname); err != nil {
+				return 0, 0, errors.Wrapf(err, "failed to remove invalid blob file %s", fname)
+			}
+			discarded++
+			continue
+		}
+		bs.slots[root] = slot
+		valid++
+	}
+	return valid, discarded, nil
+}
+
+// checkSidecarFile checks that the file has the size of a sidecar and that the sidecar it holds has the
+// given root and index, returning the slot of the sidecar.
+func checkSidecarFile(fname string, root [32]byte, idx uint64) (primitives.Slot, error) {
+	f, err := os.Open(fname) // #nosec G304
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).WithField("path", fname).Warn("Failed to close blob file")
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() != int64(sidecarSize) {
+		return 0, errors.Wrapf(errSidecarSizeInvalid, "%d != %d", info.Size(), sidecarSize)
+	}
+	header := make([]byte, sidecarHeaderSize)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(header[sidecarRootOffset:sidecarIndexOffset], root[:]) {
+		return 0, errors.Errorf("sidecar block root %#x does not match path", header[sidecarRootOffset:sidecarIndexOffset])
+	}
+	if i := binary.LittleEndian.Uint64(header[sidecarIndexOffset:sidecarSlotOffset]); i != idx {
+		return 0, errors.Errorf("sidecar index %d does not match path", i)
+	}
+	return primitives.Slot(binary.LittleEndian.Uint64(header[sidecarSlotOffset:sidecarHeaderSize])), nil
+}
+
+// parseRootDir returns the root a directory in the given shard holds the sidecars of.
+func parseRootDir(shard string, e os.DirEntry) ([32]byte, bool) {
+	if !e.IsDir() {
+		return [32]byte{}, false
+	}
+	b, err := hexutil.Decode(e.Name())
+	if err != nil || len(b) != 32 {
+		return [32]byte{}, false
+	}
+	root := bytesutil.ToBytes32(b)
+	if shardDir(root) != shard {
+		return [32]byte{}, false
+	}
+	return root, true
+}
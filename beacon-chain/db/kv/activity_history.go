@@ -0,0 +1,157 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveActivityHistory saves validators' per-epoch activity history records to the DB.
+// Records are keyed by validator index, epoch and block root, so the records of the same epoch
+// taken on different forks are all kept and an existing record of the same block gets overwritten.
+// Every record is also indexed by epoch and block root, which pruning walks.
+func (s *Store) SaveActivityHistory(ctx context.Context, records []*ethpb.ActivityHistoryRecord) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveActivityHistory")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(activityHistoryBucket)
+		indicesBkt := tx.Bucket(activityHistoryEpochIndicesBucket)
+		for _, r := range records {
+			if r == nil {
+				return errors.New("nil activity history record")
+			}
+			if len(r.BlockRoot) != fieldparams.RootLength {
+				return errors.Errorf("activity history record block root has length %d", len(r.BlockRoot))
+			}
+			enc, err := encode(ctx, r)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(append(activityHistoryKey(r.ValidatorIndex, r.Epoch), r.BlockRoot...), enc); err != nil {
+				return err
+			}
+			if err := indicesBkt.Put(activityHistoryEpochIndex(r.Epoch, r.BlockRoot, r.ValidatorIndex), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ActivityHistory returns the activity history records of the validator with the given index
+// for epochs in the inclusive range [fromEpoch, toEpoch], ordered by epoch. An epoch processed on
+// several forks has a record for each of them.
+func (s *Store) ActivityHistory(
+	ctx context.Context,
+	idx primitives.ValidatorIndex,
+	fromEpoch, toEpoch primitives.Epoch,
+) ([]*ethpb.ActivityHistoryRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ActivityHistory")
+	defer span.End()
+
+	if fromEpoch > toEpoch {
+		return nil, errors.Errorf("from epoch %d is greater than to epoch %d", fromEpoch, toEpoch)
+	}
+	records := make([]*ethpb.ActivityHistoryRecord, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(activityHistoryBucket).Cursor()
+		prefix := bytesutil.Uint64ToBytesBigEndian(uint64(idx))
+		end := activityHistoryKey(idx, toEpoch)
+		for k, v := c.Seek(activityHistoryKey(idx, fromEpoch)); k != nil && bytes.HasPrefix(k, prefix) && bytes.Compare(k[:16], end) <= 0; k, v = c.Next() {
+			r := &ethpb.ActivityHistoryRecord{}
+			if err := decode(ctx, v, r); err != nil {
+				return err
+			}
+			records = append(records, r)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// PruneActivityHistory deletes the activity history records that can no longer be served. Records of epochs
+// before retainFromEpoch are deleted regardless of their fork. Records of epochs in the range
+// [fromEpoch, finalizedEpoch) are deleted when they were taken on a block of an epoch before the finalized
+// epoch which is not in the finalized block roots index, as such a block has been orphaned. Records whose
+// block cannot be found are kept. Both ranges are read from the epoch index, so pruning only visits the
+// records of the pruned epochs.
+func (s *Store) PruneActivityHistory(ctx context.Context, fromEpoch, finalizedEpoch, retainFromEpoch primitives.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneActivityHistory")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(activityHistoryBucket)
+		indicesBkt := tx.Bucket(activityHistoryEpochIndicesBucket)
+		finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		orphaned := make(map[[32]byte]bool)
+		isOrphaned := func(root []byte) bool {
+			r := bytesutil.ToBytes32(root)
+			if o, ok := orphaned[r]; ok {
+				return o
+			}
+			o := false
+			if finalizedBkt.Get(root) == nil && !bytes.Equal(root, genesisRoot) {
+				slot, err := s.slotByBlockRoot(ctx, tx, root)
+				o = err == nil && slots.ToEpoch(slot) < finalizedEpoch
+			}
+			orphaned[r] = o
+			return o
+		}
+
+		var toDelete [][]byte
+		c := indicesBkt.Cursor()
+		retainFrom := bytesutil.Uint64ToBytesBigEndian(uint64(retainFromEpoch))
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], retainFrom) < 0; k, _ = c.Next() {
+			toDelete = append(toDelete, bytesutil.SafeCopyBytes(k))
+		}
+		start := fromEpoch
+		if start < retainFromEpoch {
+			start = retainFromEpoch
+		}
+		end := bytesutil.Uint64ToBytesBigEndian(uint64(finalizedEpoch))
+		for k, _ := c.Seek(bytesutil.Uint64ToBytesBigEndian(uint64(start))); k != nil && bytes.Compare(k[:8], end) < 0; k, _ = c.Next() {
+			if isOrphaned(k[8:40]) {
+				toDelete = append(toDelete, bytesutil.SafeCopyBytes(k))
+			}
+		}
+		for _, k := range toDelete {
+			idx := primitives.ValidatorIndex(bytesutil.BytesToUint64BigEndian(k[40:]))
+			epoch := primitives.Epoch(bytesutil.BytesToUint64BigEndian(k[:8]))
+			if err := bkt.Delete(append(activityHistoryKey(idx, epoch), k[8:40]...)); err != nil {
+				return err
+			}
+			if err := indicesBkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// activityHistoryKey is the validator index followed by the epoch, both big endian encoded,
+// so that records of a validator are stored contiguously and sorted by epoch. Records are stored
+// under this prefix followed by the block root.
+func activityHistoryKey(idx primitives.ValidatorIndex, epoch primitives.Epoch) []byte {
+	key := make([]byte, 0, 16)
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+	return append(key, bytesutil.Uint64ToBytesBigEndian(uint64(epoch))...)
+}
+
+// activityHistoryEpochIndex is the epoch, the block root and the validator index of an activity history
+// record, so that the records of an epoch are stored contiguously and grouped by the block they were taken on.
+func activityHistoryEpochIndex(epoch primitives.Epoch, blockRoot []byte, idx primitives.ValidatorIndex) []byte {
+	key := make([]byte, 0, 48)
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(epoch))...)
+	key = append(key, blockRoot...)
+	return append(key, bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+}
@@ -0,0 +1,139 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore_ActivityHistory_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	contract := bytesutil.PadTo([]byte{'A'}, 20)
+	var records []*ethpb.ActivityHistoryRecord
+	for _, idx := range []primitives.ValidatorIndex{1, 2, 256} {
+		for e := primitives.Epoch(0); e < 5; e++ {
+			records = append(records, &ethpb.ActivityHistoryRecord{
+				ValidatorIndex:    idx,
+				Epoch:             e,
+				Activity:          uint64(idx) * uint64(e),
+				EffectiveActivity: uint64(idx) + uint64(e),
+				Contract:          contract,
+				BlockRoot:         bytesutil.PadTo([]byte{byte(e)}, 32),
+			})
+		}
+	}
+	require.NoError(t, db.SaveActivityHistory(ctx, records))
+
+	got, err := db.ActivityHistory(ctx, 2, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	for i, r := range got {
+		want := records[5+1+i]
+		assert.Equal(t, true, proto.Equal(want, r), "Wanted %v, received %v", want, r)
+	}
+
+	got, err = db.ActivityHistory(ctx, 256, 3, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, primitives.Epoch(3), got[0].Epoch)
+	assert.Equal(t, primitives.Epoch(4), got[1].Epoch)
+
+	got, err = db.ActivityHistory(ctx, 3, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	_, err = db.ActivityHistory(ctx, 1, 3, 2)
+	require.ErrorContains(t, "from epoch 3 is greater than to epoch 2", err)
+}
+
+func TestStore_SaveActivityHistory_Forks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	rootA := bytesutil.PadTo([]byte{'a'}, 32)
+	rootB := bytesutil.PadTo([]byte{'b'}, 32)
+
+	require.NoError(t, db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{{ValidatorIndex: 1, Epoch: 1, Activity: 10, BlockRoot: rootA}}))
+	require.NoError(t, db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{{ValidatorIndex: 1, Epoch: 1, Activity: 20, BlockRoot: rootA}}))
+	require.NoError(t, db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{{ValidatorIndex: 1, Epoch: 1, Activity: 30, BlockRoot: rootB}}))
+	require.NoError(t, db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{{ValidatorIndex: 1, Epoch: 2, Activity: 40, BlockRoot: rootA}}))
+
+	got, err := db.ActivityHistory(ctx, 1, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, uint64(20), got[0].Activity)
+	assert.Equal(t, uint64(30), got[1].Activity)
+
+	require.ErrorContains(t, "nil activity history record", db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{nil}))
+	require.ErrorContains(t, "block root has length 0", db.SaveActivityHistory(ctx, []*ethpb.ActivityHistoryRecord{{ValidatorIndex: 1, Epoch: 3}}))
+}
+
+func TestStore_PruneActivityHistory(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*4, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	orphaned := makeBlocks(t, slotsPerEpoch, 1, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, orphaned))
+	unfinalizedFork := makeBlocks(t, slotsPerEpoch*3, 1, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, unfinalizedFork))
+
+	cpRoot := sszRootOrDie(t, blks[slotsPerEpoch*2-1])
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, bytesutil.ToBytes32(cpRoot)))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: cpRoot}))
+
+	canonicalRoot := sszRootOrDie(t, blks[slotsPerEpoch-1])
+	unknownRoot := bytesutil.PadTo([]byte{'u'}, 32)
+	var records []*ethpb.ActivityHistoryRecord
+	for _, idx := range []primitives.ValidatorIndex{1, 7} {
+		records = append(records,
+			&ethpb.ActivityHistoryRecord{ValidatorIndex: idx, Epoch: 0, BlockRoot: canonicalRoot},
+			&ethpb.ActivityHistoryRecord{ValidatorIndex: idx, Epoch: 0, BlockRoot: sszRootOrDie(t, orphaned[0])},
+			&ethpb.ActivityHistoryRecord{ValidatorIndex: idx, Epoch: 1, BlockRoot: unknownRoot},
+			&ethpb.ActivityHistoryRecord{ValidatorIndex: idx, Epoch: 2, BlockRoot: sszRootOrDie(t, unfinalizedFork[0])},
+		)
+	}
+	require.NoError(t, db.SaveActivityHistory(ctx, records))
+
+	require.NoError(t, db.PruneActivityHistory(ctx, 0, 2, 0))
+	for _, idx := range []primitives.ValidatorIndex{1, 7} {
+		got, err := db.ActivityHistory(ctx, idx, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(got))
+		assert.DeepEqual(t, canonicalRoot, got[0].BlockRoot)
+		assert.DeepEqual(t, unknownRoot, got[1].BlockRoot)
+		assert.DeepEqual(t, sszRootOrDie(t, unfinalizedFork[0]), got[2].BlockRoot)
+	}
+
+	require.NoError(t, db.PruneActivityHistory(ctx, 2, 2, 1))
+	for _, idx := range []primitives.ValidatorIndex{1, 7} {
+		got, err := db.ActivityHistory(ctx, idx, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(got))
+		assert.Equal(t, primitives.Epoch(1), got[0].Epoch)
+		assert.Equal(t, primitives.Epoch(2), got[1].Epoch)
+	}
+
+	// Pruning past every epoch leaves neither records nor their epoch index.
+	require.NoError(t, db.PruneActivityHistory(ctx, 2, 3, 10))
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, 0, tx.Bucket(activityHistoryBucket).Stats().KeyN)
+		assert.Equal(t, 0, tx.Bucket(activityHistoryEpochIndicesBucket).Stats().KeyN)
+		return nil
+	}))
+}
@@ -0,0 +1,74 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveEpochActivitySummaries saves per-epoch activity and power summaries to the DB.
+// Summaries are keyed by epoch and block root, so the summaries of the same epoch taken on
+// different forks are all kept and an existing summary of the same block gets overwritten.
+func (s *Store) SaveEpochActivitySummaries(ctx context.Context, summaries []*ethpb.EpochActivitySummary) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEpochActivitySummaries")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(activitySummaryBucket)
+		for _, summary := range summaries {
+			if summary == nil {
+				return errors.New("nil epoch activity summary")
+			}
+			if len(summary.BlockRoot) != fieldparams.RootLength {
+				return errors.Errorf("epoch activity summary block root has length %d", len(summary.BlockRoot))
+			}
+			enc, err := encode(ctx, summary)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(epochActivitySummaryKey(summary.Epoch, summary.BlockRoot), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// EpochActivitySummaries returns the activity and power summaries of the epochs in the inclusive
+// range [fromEpoch, toEpoch], ordered by epoch. Epochs without a summary are skipped, an epoch
+// processed on several forks has a summary for each of them.
+func (s *Store) EpochActivitySummaries(ctx context.Context, fromEpoch, toEpoch primitives.Epoch) ([]*ethpb.EpochActivitySummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochActivitySummaries")
+	defer span.End()
+
+	if fromEpoch > toEpoch {
+		return nil, errors.Errorf("from epoch %d is greater than to epoch %d", fromEpoch, toEpoch)
+	}
+	summaries := make([]*ethpb.EpochActivitySummary, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(activitySummaryBucket).Cursor()
+		end := bytesutil.Uint64ToBytesBigEndian(uint64(toEpoch))
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(uint64(fromEpoch))); k != nil && bytes.Compare(k[:8], end) <= 0; k, v = c.Next() {
+			summary := &ethpb.EpochActivitySummary{}
+			if err := decode(ctx, v, summary); err != nil {
+				return err
+			}
+			summaries = append(summaries, summary)
+		}
+		return nil
+	})
+	return summaries, err
+}
+
+// epochActivitySummaryKey is the big endian epoch followed by the block root, so the summaries are
+// sorted by epoch.
+func epochActivitySummaryKey(epoch primitives.Epoch, blockRoot []byte) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(epoch)), blockRoot...)
+}
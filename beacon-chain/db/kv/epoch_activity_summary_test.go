@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore_EpochActivitySummaries_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	var summaries []*ethpb.EpochActivitySummary
+	for _, e := range []primitives.Epoch{0, 1, 2, 5, 256} {
+		summaries = append(summaries, &ethpb.EpochActivitySummary{
+			Epoch: e,
+			SharedActivity: &ethpb.SharedActivity{
+				TransactionsGasPerPeriod: uint64(e) * 21_000,
+				BaseFeePerPeriod:         uint64(e) + 7,
+			},
+			TotalPower:             uint64(e) * 100,
+			TotalEffectivePower:    uint64(e) * 90,
+			TotalEffectiveActivity: uint64(e) * 10,
+			BlockRoot:              bytesutil.PadTo([]byte{byte(e)}, 32),
+		})
+	}
+	require.NoError(t, db.SaveEpochActivitySummaries(ctx, summaries))
+
+	got, err := db.EpochActivitySummaries(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	for i, s := range got {
+		want := summaries[1+i]
+		assert.Equal(t, true, proto.Equal(want, s), "Wanted %v, received %v", want, s)
+	}
+
+	got, err = db.EpochActivitySummaries(ctx, 3, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, primitives.Epoch(5), got[0].Epoch)
+	assert.Equal(t, primitives.Epoch(256), got[1].Epoch)
+
+	got, err = db.EpochActivitySummaries(ctx, 3, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	_, err = db.EpochActivitySummaries(ctx, 3, 2)
+	require.ErrorContains(t, "from epoch 3 is greater than to epoch 2", err)
+}
+
+func TestStore_SaveEpochActivitySummaries_Forks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	rootA := bytesutil.PadTo([]byte{'a'}, 32)
+	rootB := bytesutil.PadTo([]byte{'b'}, 32)
+
+	require.NoError(t, db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{{Epoch: 1, TotalPower: 10, BlockRoot: rootA}}))
+	require.NoError(t, db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{{Epoch: 1, TotalPower: 20, BlockRoot: rootA}}))
+	require.NoError(t, db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{{Epoch: 1, TotalPower: 30, BlockRoot: rootB}}))
+	require.NoError(t, db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{{Epoch: 2, TotalPower: 40, BlockRoot: rootA}}))
+
+	got, err := db.EpochActivitySummaries(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, uint64(20), got[0].TotalPower)
+	assert.Equal(t, uint64(30), got[1].TotalPower)
+
+	require.ErrorContains(t, "nil epoch activity summary", db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{nil}))
+	require.ErrorContains(t, "block root has length 0", db.SaveEpochActivitySummaries(ctx, []*ethpb.EpochActivitySummary{{Epoch: 3}}))
+}
@@ -131,6 +131,12 @@ var Buckets = [][]byte{
 	registrationBucket,
 
 	blobsBucket,
+
+	activityHistoryBucket,
+	activityHistoryEpochIndicesBucket,
+	activitySummaryBucket,
+
+	lightClientUpdatesBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -202,10 +208,6 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 		return nil, err
 	}
 
-	if err := checkEpochsForBlobSidecarsRequestBucket(boltDB); err != nil {
-		return nil, errors.Wrap(err, "failed to check epochs for blob sidecars request bucket")
-	}
-
 	return kv, nil
 }
 
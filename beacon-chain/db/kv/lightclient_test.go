@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testLightClientUpdate(period uint64) *ethpbv2.LightClientUpdate {
+	slot := primitives.Slot(period * uint64(params.BeaconConfig().SlotsPerEpoch) * uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, 64),
+			SyncCommitteeSignature: make([]byte, 96),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdate_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	got, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, got == nil)
+
+	update := testLightClientUpdate(1)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, update))
+	got, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(update, got), "Wanted %v, received %v", update, got)
+
+	better := testLightClientUpdate(1)
+	better.SignatureSlot++
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, better))
+	got, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(better, got), "Wanted %v, received %v", better, got)
+
+	require.ErrorContains(t, "nil light client update", db.SaveLightClientUpdate(ctx, 2, nil))
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, period := range []uint64{0, 1, 2, 5, 256} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testLightClientUpdate(period)))
+	}
+
+	got, err := db.LightClientUpdates(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	for _, period := range []uint64{1, 2, 5} {
+		assert.Equal(t, true, proto.Equal(testLightClientUpdate(period), got[period]))
+	}
+
+	got, err = db.LightClientUpdates(ctx, 3, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	_, err = db.LightClientUpdates(ctx, 3, 2)
+	require.ErrorContains(t, "start period 3 is greater than end period 2", err)
+}
@@ -0,0 +1,179 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+const (
+	// maxActivitiesCacheSize defines the max number of execution blocks whose activities are cached.
+	// Proposals only need the activities of recent heads, a few epochs of reorgs fit comfortably.
+	maxActivitiesCacheSize = 128
+	// activitiesFetchAttempts is the number of times eth_getBlockActivities is requested before giving up.
+	activitiesFetchAttempts = 3
+	// activitiesFetchBackoff is the delay before the first retry, it doubles with every further retry.
+	activitiesFetchBackoff = 100 * time.Millisecond
+	// activitiesPrefetchTimeout bounds a background prefetch including its retries.
+	activitiesPrefetchTimeout = 4 * time.Second
+)
+
+var (
+	activitiesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_block_activities_cache_hit",
+		Help: "The number of block activities requests that are present in the cache.",
+	})
+	activitiesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_block_activities_cache_miss",
+		Help: "The number of block activities requests that aren't present in the cache.",
+	})
+	activitiesFetchRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_block_activities_fetch_retries",
+		Help: "The number of retried eth_getBlockActivities requests.",
+	})
+	activitiesFetchFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_block_activities_fetch_failures",
+		Help: "The number of block activities fetches that failed after all retries.",
+	})
+	activitiesFetchLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "execution_block_activities_fetch_latency_milliseconds",
+			Help:    "Captures RPC latency for eth_getBlockActivities in milliseconds",
+			Buckets: []float64{25, 50, 100, 200, 500, 1000, 2000, 4000},
+		},
+	)
+)
+
+// activitiesCache keeps the block activities fetched from the execution client by block hash,
+// so block proposals do not depend on the execution client answering in time.
+type activitiesCache struct {
+	cache *lru.Cache
+}
+
+func newActivitiesCache() *activitiesCache {
+	return &activitiesCache{cache: lruwrpr.New(maxActivitiesCacheSize)}
+}
+
+func (c *activitiesCache) get(blockHash common.Hash) (*ethpb.BlockActivities, bool) {
+	item, ok := c.cache.Get(blockHash)
+	if !ok {
+		activitiesCacheMiss.Inc()
+		return nil, false
+	}
+	activitiesCacheHit.Inc()
+	activities, ok := item.(*ethpb.BlockActivities)
+	return activities, ok
+}
+
+func (c *activitiesCache) add(blockHash common.Hash, activities *ethpb.BlockActivities) {
+	c.cache.Add(blockHash, activities)
+}
+
+// blockActivities returns the block activities from the cache, or fetches and caches them on a miss.
+// The returned object is a copy and can be modified by the caller.
+func (s *Service) blockActivities(ctx context.Context, blockHash common.Hash) (*ethpb.BlockActivities, error) {
+	if s.activitiesCache == nil {
+		return s.fetchBlockActivities(ctx, blockHash)
+	}
+	if activities, ok := s.activitiesCache.get(blockHash); ok {
+		return copyBlockActivities(activities), nil
+	}
+	activities, err := s.fetchBlockActivities(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	s.activitiesCache.add(blockHash, activities)
+	return copyBlockActivities(activities), nil
+}
+
+// fetchBlockActivities calls eth_getBlockActivities, retrying transient failures with an exponential
+// backoff until the attempts are exhausted or the context is done. Other errors are returned at once.
+func (s *Service) fetchBlockActivities(ctx context.Context, blockHash common.Hash) (*ethpb.BlockActivities, error) {
+	backoff := activitiesFetchBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		blockActivities := &ethpb.BlockActivities{}
+		err = s.rpcClient.CallContext(ctx, blockActivities, GetBlockActivitiesMethod, blockHash)
+		activitiesFetchLatency.Observe(float64(time.Since(start).Milliseconds()))
+		if err == nil {
+			return blockActivities, nil
+		}
+		transient := isTransientActivitiesError(err)
+		err = handleRPCError(err)
+		if !transient || attempt == activitiesFetchAttempts {
+			break
+		}
+		activitiesFetchRetries.Inc()
+		select {
+		case <-ctx.Done():
+			activitiesFetchFailures.Inc()
+			return nil, errors.Wrap(err, "context done before block activities could be fetched")
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	activitiesFetchFailures.Inc()
+	return nil, err
+}
+
+// isTransientActivitiesError reports whether a failed eth_getBlockActivities request may succeed when
+// it is sent again: network failures, overloaded servers and server side errors. Malformed requests,
+// unsupported methods and failed authentication fail the same way on every attempt.
+func isTransientActivitiesError(err error) bool {
+	var rpcErr gethRPC.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case -32000, -32603:
+			return true
+		default:
+			return false
+		}
+	}
+	var httpErr gethRPC.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// prefetchBlockActivities fetches the activities of a new head payload in the background,
+// so they are already cached when the next block is proposed on top of it.
+func (s *Service) prefetchBlockActivities(blockHash common.Hash) {
+	if s.activitiesCache == nil || s.ctx == nil || blockHash == (common.Hash{}) {
+		return
+	}
+	if _, ok := s.activitiesCache.cache.Peek(blockHash); ok {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(s.ctx, activitiesPrefetchTimeout)
+		defer cancel()
+		if _, err := s.blockActivities(ctx, blockHash); err != nil {
+			log.WithError(err).WithField(
+				"blockHash", fmt.Sprintf("%#x", bytesutil.Trunc(blockHash[:])),
+			).Debug("Could not prefetch block activities")
+		}
+	}()
+}
+
+func copyBlockActivities(activities *ethpb.BlockActivities) *ethpb.BlockActivities {
+	return &ethpb.BlockActivities{
+		BaseFee:    activities.BaseFee,
+		TxCount:    activities.TxCount,
+		Activities: ethpb.CopyActivityChanges(activities.Activities),
+	}
+}
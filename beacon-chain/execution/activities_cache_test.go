@@ -0,0 +1,180 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+// newActivitiesServer returns a JSON-RPC server which fails the first `failures` requests
+// and serves the given activities afterwards. The number of received requests is counted in `calls`.
+func newActivitiesServer(t *testing.T, activities *ethpb.BlockActivities, failures int32, calls *int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+		}
+		if atomic.AddInt32(calls, 1) <= failures {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "activities not available"}
+		} else {
+			resp["result"] = activities
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newActivitiesService(t *testing.T, srv *httptest.Server) *Service {
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	return &Service{
+		ctx:             context.Background(),
+		rpcClient:       rpcClient,
+		activitiesCache: newActivitiesCache(),
+	}
+}
+
+func testBlockActivities() *ethpb.BlockActivities {
+	return &ethpb.BlockActivities{
+		BaseFee: 21000000,
+		TxCount: 100,
+		Activities: []*ethpb.ActivityChange{
+			{
+				ContractAddress: common.HexToAddress("0xcf8e0d4e9587369b2301d0790347320302cc0943").Bytes(),
+				DeltaActivity:   1,
+			},
+		},
+	}
+}
+
+func TestBlockActivities_CacheHit(t *testing.T) {
+	var calls int32
+	srv := newActivitiesServer(t, testBlockActivities(), 0, &calls)
+	s := newActivitiesService(t, srv)
+	hash := common.BytesToHash([]byte{'a'})
+
+	first, err := s.GetBlockActivitiesByHash(context.Background(), hash)
+	require.NoError(t, err)
+	first.Activities[0].DeltaActivity = 100
+	second, err := s.GetBlockActivitiesByHash(context.Background(), hash)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, uint64(100), second.TxCount)
+	assert.Equal(t, uint64(1), second.Activities[0].DeltaActivity, "cached activities must not be modified by callers")
+}
+
+func TestBlockActivities_RetriesFailedRequests(t *testing.T) {
+	var calls int32
+	srv := newActivitiesServer(t, testBlockActivities(), activitiesFetchAttempts-1, &calls)
+	s := newActivitiesService(t, srv)
+
+	got, err := s.GetBlockActivitiesByHash(context.Background(), common.BytesToHash([]byte{'a'}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(activitiesFetchAttempts), atomic.LoadInt32(&calls))
+	assert.Equal(t, uint64(21000000), got.BaseFee)
+}
+
+func TestBlockActivities_FailsAfterAllAttempts(t *testing.T) {
+	var calls int32
+	srv := newActivitiesServer(t, testBlockActivities(), activitiesFetchAttempts, &calls)
+	s := newActivitiesService(t, srv)
+	hash := common.BytesToHash([]byte{'a'})
+
+	_, err := s.GetBlockActivitiesByHash(context.Background(), hash)
+	require.ErrorContains(t, "activities not available", err)
+	assert.Equal(t, int32(activitiesFetchAttempts), atomic.LoadInt32(&calls))
+	_, ok := s.activitiesCache.cache.Peek(hash)
+	assert.Equal(t, false, ok, "failed fetches must not be cached")
+}
+
+func TestBlockActivities_ContextCanceled(t *testing.T) {
+	var calls int32
+	srv := newActivitiesServer(t, testBlockActivities(), activitiesFetchAttempts, &calls)
+	s := newActivitiesService(t, srv)
+
+	ctx, cancel := context.WithTimeout(context.Background(), activitiesFetchBackoff/2)
+	defer cancel()
+	_, err := s.GetBlockActivitiesByHash(ctx, common.BytesToHash([]byte{'a'}))
+	require.ErrorContains(t, "context done before block activities could be fetched", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestBlockActivities_DoesNotRetryPermanentErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		atomic.AddInt32(&calls, 1)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"error":   map[string]interface{}{"code": -32601, "message": "method not found"},
+		}))
+	}))
+	t.Cleanup(srv.Close)
+	s := newActivitiesService(t, srv)
+
+	_, err := s.GetBlockActivitiesByHash(context.Background(), common.BytesToHash([]byte{'a'}))
+	require.ErrorIs(t, err, ErrMethodNotFound)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestIsTransientActivitiesError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: true},
+		{name: "service unavailable", err: rpc.HTTPError{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "too many requests", err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "unauthorized", err: rpc.HTTPError{StatusCode: http.StatusUnauthorized}, want: false},
+		{name: "decoding error", err: errors.New("json: cannot unmarshal string"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransientActivitiesError(tt.err))
+		})
+	}
+}
+
+func TestPrefetchBlockActivities(t *testing.T) {
+	var calls int32
+	srv := newActivitiesServer(t, testBlockActivities(), 0, &calls)
+	s := newActivitiesService(t, srv)
+	hash := common.BytesToHash([]byte{'a'})
+
+	s.prefetchBlockActivities(common.Hash{})
+	s.prefetchBlockActivities(hash)
+	for deadline := time.Now().Add(5 * time.Second); !s.activitiesCache.cache.Contains(hash); {
+		require.Equal(t, true, time.Now().Before(deadline), "activities were not prefetched")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Already cached activities are neither prefetched nor fetched again.
+	s.prefetchBlockActivities(hash)
+	_, err := s.GetBlockActivitiesByHash(context.Background(), hash)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -243,6 +243,7 @@ func (s *Service) ForkchoiceUpdated(
 	case pb.PayloadStatus_INVALID:
 		return nil, resp.LatestValidHash, ErrInvalidPayloadStatus
 	case pb.PayloadStatus_VALID:
+		s.prefetchBlockActivities(common.BytesToHash(state.HeadBlockHash))
 		return result.PayloadId, resp.LatestValidHash, nil
 	default:
 		return nil, nil, ErrUnknownPayloadStatus
@@ -456,19 +457,13 @@ func (s *Service) GetTerminalBlockHash(ctx context.Context, transitionTime uint6
 }
 
 // GetBlockActivitiesByHash fetches block activities from execution engine block with given hash by
-// calling eth_getBlockActivities via JSON-RPC.
+// calling eth_getBlockActivities via JSON-RPC. The activities of recent head blocks are prefetched
+// on forkchoice updates, so they are usually served from the cache.
 func (s *Service) GetBlockActivitiesByHash(ctx context.Context, blockHash common.Hash) (*ethpb.BlockActivities, error) {
-	blockActivities := &ethpb.BlockActivities{}
-	if err := s.rpcClient.CallContext(
-		ctx,
-		blockActivities,
-		GetBlockActivitiesMethod,
-		blockHash,
-	); err != nil {
-		return nil, handleRPCError(err)
-	}
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetBlockActivitiesByHash")
+	defer span.End()
 
-	return blockActivities, nil
+	return s.blockActivities(ctx, blockHash)
 }
 
 // LatestExecutionBlock fetches the latest execution engine block by calling
@@ -850,7 +845,7 @@ func fullPayloadFromExecutionBlock(
 			Transactions:      txs,
 			Withdrawals:       block.Withdrawals,
 		}, 0) // We can't get the block value and don't care about the block value for this instance
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		act, err := header.ActivitiesRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to extract ActivitiesRoot attribute from execution payload header")
@@ -947,7 +942,7 @@ func fullPayloadFromPayloadBody(
 			Transactions:      body.Transactions,
 			Withdrawals:       body.Withdrawals,
 		}, 0) // We can't get the block value and don't care about the block value for this instance
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		act, err := header.ActivitiesRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to extract ActivitiesRoot attribute from execution payload header")
@@ -1106,7 +1101,7 @@ func buildEmptyExecutionPayload(v int) (proto.Message, error) {
 			ExtraData:      make([]byte, 0),
 			Withdrawals:    make([]*pb.Withdrawal, 0),
 		}, nil
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		return &pb.ExecutionPayloadDeneb{
 			ParentHash:     make([]byte, fieldparams.RootLength),
 			FeeRecipient:   make([]byte, fieldparams.FeeRecipientLength),
@@ -0,0 +1,151 @@
+package execution
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	mocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	payloadattribute "github.com/prysmaticlabs/prysm/v4/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func setupExecutionServer(t *testing.T, opts ...mocks.ExecutionServerOption) (*mocks.ExecutionServer, *Service) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = cfg.FarFutureEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	el, err := mocks.NewExecutionServer(opts...)
+	require.NoError(t, err)
+	srv := httptest.NewServer(el)
+	t.Cleanup(func() {
+		srv.Close()
+		el.Stop()
+	})
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	return el, &Service{rpcClient: rpcClient}
+}
+
+// producePayload builds a payload on top of parent through the engine API and imports it.
+func producePayload(t *testing.T, service *Service, parent common.Hash, timestamp uint64) interfaces.ExecutionData {
+	ctx := context.Background()
+	attr, err := payloadattribute.New(&pb.PayloadAttributesV2{
+		Timestamp:             timestamp,
+		PrevRandao:            bytesutil.PadTo(bytesutil.Uint64ToBytesBigEndian(timestamp), 32),
+		SuggestedFeeRecipient: make([]byte, 20),
+		Withdrawals:           []*pb.Withdrawal{},
+	})
+	require.NoError(t, err)
+	fcs := &pb.ForkchoiceState{HeadBlockHash: parent.Bytes(), SafeBlockHash: parent.Bytes(), FinalizedBlockHash: parent.Bytes()}
+	id, _, err := service.ForkchoiceUpdated(ctx, fcs, attr)
+	require.NoError(t, err)
+	require.NotNil(t, id)
+	payload, _, _, err := service.GetPayload(ctx, *id, 1)
+	require.NoError(t, err)
+	_, err = service.NewPayload(ctx, payload, nil, nil)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestExecutionServer_BlockActivitiesMatchPayload(t *testing.T) {
+	el, service := setupExecutionServer(t)
+	ctx := context.Background()
+
+	parent := el.GenesisHash()
+	totalActivities := 0
+	for i := uint64(1); i <= 16; i++ {
+		payload := producePayload(t, service, parent, i*12)
+		parent = common.BytesToHash(payload.BlockHash())
+
+		blockActivities, err := service.GetBlockActivitiesByHash(ctx, parent)
+		require.NoError(t, err)
+		txCount, err := payload.TransactionsCount()
+		require.NoError(t, err)
+		assert.Equal(t, txCount, blockActivities.TxCount)
+		txs, err := payload.Transactions()
+		require.NoError(t, err)
+		assert.Equal(t, len(txs), int(blockActivities.TxCount))
+		assert.Equal(t, bytesutil.LittleEndianBytesToBigInt(payload.BaseFeePerGas()).Uint64(), blockActivities.BaseFee)
+
+		activities := make(gethtypes.Activities, len(blockActivities.Activities))
+		for j, a := range blockActivities.Activities {
+			activities[j] = &gethtypes.Activity{Address: common.BytesToAddress(a.ContractAddress), DeltaActivity: a.DeltaActivity}
+		}
+		activitiesRoot, err := payload.ActivitiesRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, gethtypes.DeriveSha(activities, trie.NewStackTrie(nil)).Bytes(), activitiesRoot)
+		totalActivities += len(activities)
+	}
+	require.NotEqual(t, 0, totalActivities, "no synthetic activity was produced")
+
+	_, _, err := service.ForkchoiceUpdated(ctx, &pb.ForkchoiceState{HeadBlockHash: parent.Bytes()}, payloadattribute.EmptyWithVersion(version.Capella))
+	require.NoError(t, err)
+	assert.Equal(t, parent, el.HeadHash())
+}
+
+func TestExecutionServer_Deterministic(t *testing.T) {
+	el, service := setupExecutionServer(t)
+	other, err := mocks.NewExecutionServer()
+	require.NoError(t, err)
+	require.Equal(t, el.GenesisHash(), other.GenesisHash())
+
+	first := producePayload(t, service, el.GenesisHash(), 12)
+	again := producePayload(t, service, el.GenesisHash(), 12)
+	assert.DeepEqual(t, first.BlockHash(), again.BlockHash())
+}
+
+func TestExecutionServer_GenesisHeader(t *testing.T) {
+	withdrawalsHash := gethtypes.EmptyWithdrawalsHash
+	genesis := &gethtypes.Header{
+		UncleHash:       gethtypes.EmptyUncleHash,
+		Root:            common.HexToHash("0x01"),
+		TxHash:          gethtypes.EmptyTxsHash,
+		ReceiptHash:     gethtypes.EmptyReceiptsHash,
+		Difficulty:      common.Big1,
+		Number:          common.Big0,
+		GasLimit:        30_000_000,
+		Time:            100,
+		Extra:           []byte("e2e"),
+		BaseFee:         big.NewInt(1_000_000_000),
+		WithdrawalsHash: &withdrawalsHash,
+	}
+	el, service := setupExecutionServer(t, mocks.WithGenesisHeader(genesis))
+	require.Equal(t, genesis.Hash(), el.GenesisHash())
+
+	payload := producePayload(t, service, el.GenesisHash(), 112)
+	assert.DeepEqual(t, genesis.Hash().Bytes(), payload.ParentHash())
+}
+
+func TestExecutionServer_RejectsInconsistentPayload(t *testing.T) {
+	el, service := setupExecutionServer(t)
+	ctx := context.Background()
+
+	payload := producePayload(t, service, el.GenesisHash(), 12)
+	p, ok := payload.Proto().(*pb.ExecutionPayloadCapella)
+	require.Equal(t, true, ok)
+
+	tampered, ok := proto.Clone(p).(*pb.ExecutionPayloadCapella)
+	require.Equal(t, true, ok)
+	tampered.ActivitiesRoot = bytesutil.PadTo([]byte{1}, 32)
+	wrapped, err := blocks.WrappedExecutionPayloadCapella(tampered, 0)
+	require.NoError(t, err)
+	_, err = service.NewPayload(ctx, wrapped, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidBlockHashPayloadStatus)
+}
@@ -36,6 +36,7 @@ type EngineClient struct {
 	ErrForkchoiceUpdated        error
 	ErrNewPayload               error
 	ErrGetPayload               error
+	ErrGetBlockActivities       error
 	ExecutionPayloadByBlockHash map[[32]byte]*pb.ExecutionPayload
 	BlockByHashMap              map[[32]byte]*pb.ExecutionBlock
 	NumReconstructedPayloads    uint64
@@ -192,6 +193,9 @@ func (e *EngineClient) GetTerminalBlockHash(ctx context.Context, transitionTime
 
 // GetBlockActivitiesByHash --
 func (e *EngineClient) GetBlockActivitiesByHash(ctx context.Context, blockHash common.Hash) (*ethpb.BlockActivities, error) {
+	if e.ErrGetBlockActivities != nil {
+		return nil, e.ErrGetBlockActivities
+	}
 	return &ethpb.BlockActivities{
 		BaseFee: 123,
 		TxCount: 123,
@@ -0,0 +1,867 @@
+package testing
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+const (
+	// executionServerGasLimit is the gas limit of every block produced by the ExecutionServer.
+	executionServerGasLimit = 30_000_000
+	// defaultMaxTransactions is the default upper bound of synthetic transactions per block.
+	defaultMaxTransactions = 8
+	// defaultContractCount is the number of synthetic contracts used when none are configured.
+	defaultContractCount = 4
+	// maxContractGas bounds the gas a synthetic transaction spends inside a contract.
+	maxContractGas = 1 << 16
+)
+
+// JSON-RPC error codes of the engine API, as expected by the execution service.
+const (
+	unknownPayloadCode           = -38001
+	invalidForkchoiceStateCode   = -38002
+	invalidPayloadAttributesCode = -38003
+)
+
+// executionServerKey signs the synthetic transactions of the ExecutionServer.
+var executionServerKey = mustKey("45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
+
+// ExecutionServer is an in-process stand-in for an execution client. It serves the
+// engine API from Capella on together with eth_getBlockActivities over JSON-RPC. Unit
+// tests serve it through httptest.NewServer, and the end-to-end tests run it in place
+// of the engine API proxies in front of geth.
+//
+// Blocks are filled with deterministic synthetic transactions calling a fixed set of
+// contracts. Every transaction credits the contract it calls with the gas it spends
+// above the intrinsic gas, so the activities root, transactions count and base fee of
+// a payload always match what eth_getBlockActivities returns for it.
+type ExecutionServer struct {
+	lock            sync.RWMutex
+	server          *rpc.Server
+	chainID         *big.Int
+	signer          gethtypes.Signer
+	contracts       []common.Address
+	maxTransactions uint64
+	genesisTime     uint64
+	genesisHeader   *gethtypes.Header
+	blocks          map[common.Hash]*executionServerBlock
+	canonical       map[uint64]common.Hash
+	payloads        map[pb.PayloadIDBytes]*executionServerBlock
+	genesis         common.Hash
+	head            common.Hash
+	safe            common.Hash
+	finalized       common.Hash
+	nextPayloadID   uint64
+}
+
+// ExecutionServerOption configures an ExecutionServer.
+type ExecutionServerOption func(*ExecutionServer)
+
+// WithChainID sets the chain ID returned by eth_chainId and used to sign transactions.
+// It defaults to the deposit chain ID of the beacon config.
+func WithChainID(id uint64) ExecutionServerOption {
+	return func(s *ExecutionServer) {
+		s.chainID = new(big.Int).SetUint64(id)
+	}
+}
+
+// WithContracts sets the contracts called by the synthetic transactions.
+func WithContracts(contracts []common.Address) ExecutionServerOption {
+	return func(s *ExecutionServer) {
+		s.contracts = contracts
+	}
+}
+
+// WithMaxTransactions sets the upper bound of synthetic transactions per block.
+func WithMaxTransactions(max uint64) ExecutionServerOption {
+	return func(s *ExecutionServer) {
+		s.maxTransactions = max
+	}
+}
+
+// WithGenesisTime sets the timestamp of the execution genesis block.
+func WithGenesisTime(t uint64) ExecutionServerOption {
+	return func(s *ExecutionServer) {
+		s.genesisTime = t
+	}
+}
+
+// WithGenesisHeader sets the execution genesis block, so that the server extends the chain
+// of a beacon genesis state built from that block. It takes precedence over WithGenesisTime.
+func WithGenesisHeader(h *gethtypes.Header) ExecutionServerOption {
+	return func(s *ExecutionServer) {
+		s.genesisHeader = h
+	}
+}
+
+// executionServerBlock is an execution block known to the ExecutionServer.
+type executionServerBlock struct {
+	version     int
+	header      *gethtypes.Header
+	txs         gethtypes.Transactions
+	activities  gethtypes.Activities
+	withdrawals []*pb.Withdrawal
+	// nonce is the nonce of the transaction sender after this block.
+	nonce uint64
+}
+
+// NewExecutionServer creates an ExecutionServer with a genesis block as its head.
+func NewExecutionServer(opts ...ExecutionServerOption) (*ExecutionServer, error) {
+	s := &ExecutionServer{
+		server:          rpc.NewServer(),
+		chainID:         new(big.Int).SetUint64(params.BeaconConfig().DepositChainID),
+		maxTransactions: defaultMaxTransactions,
+		blocks:          make(map[common.Hash]*executionServerBlock),
+		canonical:       make(map[uint64]common.Hash),
+		payloads:        make(map[pb.PayloadIDBytes]*executionServerBlock),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if len(s.contracts) == 0 {
+		s.contracts = make([]common.Address, defaultContractCount)
+		for i := range s.contracts {
+			s.contracts[i] = common.BytesToAddress(crypto.Keccak256([]byte(fmt.Sprintf("contract-%d", i))))
+		}
+	}
+	s.signer = gethtypes.LatestSignerForChainID(s.chainID)
+
+	genesis := &executionServerBlock{
+		version: version.Capella,
+		header: &gethtypes.Header{
+			UncleHash:       gethtypes.EmptyUncleHash,
+			Root:            crypto.Keccak256Hash([]byte("genesis")),
+			TxHash:          gethtypes.EmptyTxsHash,
+			ReceiptHash:     gethtypes.EmptyReceiptsHash,
+			Difficulty:      common.Big0,
+			Number:          common.Big0,
+			GasLimit:        executionServerGasLimit,
+			Time:            s.genesisTime,
+			Extra:           []byte{},
+			BaseFee:         big.NewInt(gethparams.InitialBaseFee),
+			WithdrawalsHash: &gethtypes.EmptyWithdrawalsHash,
+			ActivitiesHash:  &gethtypes.EmptyActivitiesHash,
+			TxCount:         new(uint64),
+		},
+	}
+	if s.genesisHeader != nil {
+		genesis.header = gethtypes.CopyHeader(s.genesisHeader)
+	}
+	hash := genesis.header.Hash()
+	s.blocks[hash] = genesis
+	s.canonical[0] = hash
+	s.genesis, s.head, s.safe, s.finalized = hash, hash, hash, hash
+
+	if err := s.server.RegisterName("engine", &executionServerEngineAPI{s}); err != nil {
+		return nil, err
+	}
+	if err := s.server.RegisterName("eth", &executionServerEthAPI{s}); err != nil {
+		return nil, err
+	}
+	if err := s.server.RegisterName("net", &executionServerNetAPI{s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ServeHTTP serves JSON-RPC requests.
+func (s *ExecutionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.server.ServeHTTP(w, r)
+}
+
+// Stop stops serving JSON-RPC requests.
+func (s *ExecutionServer) Stop() {
+	s.server.Stop()
+}
+
+// GenesisHash returns the hash of the execution genesis block.
+func (s *ExecutionServer) GenesisHash() common.Hash {
+	return s.genesis
+}
+
+// HeadHash returns the hash of the current head block.
+func (s *ExecutionServer) HeadHash() common.Hash {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.head
+}
+
+// buildBlock builds a child of parent with deterministic synthetic transactions.
+func (s *ExecutionServer) buildBlock(
+	parent *executionServerBlock,
+	v int,
+	timestamp uint64,
+	prevRandao common.Hash,
+	feeRecipient common.Address,
+	withdrawals []*pb.Withdrawal,
+	parentBeaconRoot *common.Hash,
+) (*executionServerBlock, error) {
+	parentHash := parent.header.Hash()
+	number := new(big.Int).Add(parent.header.Number, common.Big1)
+	baseFee := calcBaseFee(parent.header)
+	seed := crypto.Keccak256Hash(parentHash.Bytes(), bytesutil.Uint64ToBytesBigEndian(timestamp), prevRandao.Bytes())
+
+	txCount := uint64(0)
+	if s.maxTransactions > 0 {
+		txCount = binary.BigEndian.Uint64(seed[:8]) % (s.maxTransactions + 1)
+	}
+	txs := make(gethtypes.Transactions, txCount)
+	for i := range txs {
+		contract := s.contracts[(uint64(seed[8])+uint64(i))%uint64(len(s.contracts))]
+		txSeed := crypto.Keccak256(seed.Bytes(), bytesutil.Uint64ToBytesBigEndian(uint64(i)))
+		contractGas := 1 + uint64(binary.BigEndian.Uint16(txSeed[:2]))%maxContractGas
+		tx, err := gethtypes.SignNewTx(executionServerKey, s.signer, &gethtypes.DynamicFeeTx{
+			ChainID:   s.chainID,
+			Nonce:     parent.nonce + uint64(i),
+			GasTipCap: big.NewInt(gethparams.GWei),
+			GasFeeCap: new(big.Int).Add(new(big.Int).Mul(baseFee, common.Big2), big.NewInt(gethparams.GWei)),
+			Gas:       gethparams.TxGas + contractGas,
+			To:        &contract,
+			Data:      txSeed,
+		})
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = tx
+	}
+	activities, gasUsed := txActivities(txs)
+	activitiesRoot := gethtypes.DeriveSha(activities, trie.NewStackTrie(nil))
+	txHash := gethtypes.DeriveSha(txs, trie.NewStackTrie(nil))
+	withdrawalsRoot := gethtypes.DeriveSha(toGethWithdrawals(withdrawals), trie.NewStackTrie(nil))
+	receiptHash := gethtypes.EmptyReceiptsHash
+	if len(txs) > 0 {
+		receiptHash = crypto.Keccak256Hash(txHash.Bytes(), activitiesRoot.Bytes())
+	}
+
+	header := &gethtypes.Header{
+		ParentHash:      parentHash,
+		UncleHash:       gethtypes.EmptyUncleHash,
+		Coinbase:        feeRecipient,
+		Root:            crypto.Keccak256Hash(parent.header.Root.Bytes(), txHash.Bytes(), withdrawalsRoot.Bytes()),
+		TxHash:          txHash,
+		ReceiptHash:     receiptHash,
+		Difficulty:      common.Big0,
+		Number:          number,
+		GasLimit:        executionServerGasLimit,
+		GasUsed:         gasUsed,
+		Time:            timestamp,
+		Extra:           []byte{},
+		MixDigest:       prevRandao,
+		BaseFee:         baseFee,
+		WithdrawalsHash: &withdrawalsRoot,
+		ActivitiesHash:  &activitiesRoot,
+		TxCount:         &txCount,
+	}
+	if v >= version.Deneb {
+		header.BlobGasUsed = new(uint64)
+		header.ExcessBlobGas = new(uint64)
+		header.ParentBeaconRoot = parentBeaconRoot
+	}
+	return &executionServerBlock{
+		version:     v,
+		header:      header,
+		txs:         txs,
+		activities:  activities,
+		withdrawals: withdrawals,
+		nonce:       parent.nonce + txCount,
+	}, nil
+}
+
+// importPayload validates a payload received through engine_newPayload against its parent.
+func (s *ExecutionServer) importPayload(p *pb.ExecutionPayloadDenebJSON, v int, parentBeaconRoot *common.Hash) *pb.PayloadStatus {
+	blk, err := blockFromPayload(p, v, parentBeaconRoot)
+	if err != nil {
+		return &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID, ValidationError: err.Error()}
+	}
+	hash := blk.header.Hash()
+	if hash != *p.BlockHash {
+		return &pb.PayloadStatus{
+			Status:          pb.PayloadStatus_INVALID_BLOCK_HASH,
+			ValidationError: fmt.Sprintf("block hash mismatch: have %#x, computed %#x", *p.BlockHash, hash),
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.blocks[hash]; ok {
+		return &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: hash.Bytes()}
+	}
+	parent, ok := s.blocks[blk.header.ParentHash]
+	if !ok {
+		return &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING}
+	}
+	if err := verifyBlock(parent.header, blk); err != nil {
+		return &pb.PayloadStatus{
+			Status:          pb.PayloadStatus_INVALID,
+			LatestValidHash: blk.header.ParentHash.Bytes(),
+			ValidationError: err.Error(),
+		}
+	}
+	blk.nonce = parent.nonce + uint64(len(blk.txs))
+	s.blocks[hash] = blk
+	return &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: hash.Bytes()}
+}
+
+// forkchoiceUpdated updates the head of the chain and starts building a payload on top of it
+// if attributes are provided.
+func (s *ExecutionServer) forkchoiceUpdated(
+	state *pb.ForkchoiceState,
+	v int,
+	timestamp uint64,
+	prevRandao, feeRecipient []byte,
+	withdrawals []*pb.Withdrawal,
+	parentBeaconRoot *common.Hash,
+	hasAttributes bool,
+) (*executionServerForkchoiceResponse, error) {
+	if state == nil {
+		return nil, &executionServerError{code: invalidForkchoiceStateCode, msg: "missing forkchoice state"}
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	headHash := common.BytesToHash(state.HeadBlockHash)
+	head, ok := s.blocks[headHash]
+	if !ok {
+		return &executionServerForkchoiceResponse{Status: &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING}}, nil
+	}
+	s.setHead(headHash)
+	if safe := common.BytesToHash(state.SafeBlockHash); safe != (common.Hash{}) {
+		s.safe = safe
+	}
+	if finalized := common.BytesToHash(state.FinalizedBlockHash); finalized != (common.Hash{}) {
+		s.finalized = finalized
+	}
+	resp := &executionServerForkchoiceResponse{
+		Status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: headHash.Bytes()},
+	}
+	if !hasAttributes {
+		return resp, nil
+	}
+	if timestamp <= head.header.Time {
+		return nil, &executionServerError{code: invalidPayloadAttributesCode, msg: "payload timestamp must be greater than the head timestamp"}
+	}
+	blk, err := s.buildBlock(
+		head, v, timestamp, common.BytesToHash(prevRandao), common.BytesToAddress(feeRecipient), withdrawals, parentBeaconRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var id pb.PayloadIDBytes
+	s.nextPayloadID++
+	binary.BigEndian.PutUint64(id[:], s.nextPayloadID)
+	s.payloads[id] = blk
+	resp.PayloadId = &id
+	return resp, nil
+}
+
+// setHead marks the chain ending at hash as canonical.
+func (s *ExecutionServer) setHead(hash common.Hash) {
+	s.head = hash
+	for blk, ok := s.blocks[hash]; ok; blk, ok = s.blocks[blk.header.ParentHash] {
+		number := blk.header.Number.Uint64()
+		h := blk.header.Hash()
+		if s.canonical[number] == h {
+			break
+		}
+		s.canonical[number] = h
+	}
+	head := s.blocks[hash]
+	for n := head.header.Number.Uint64() + 1; ; n++ {
+		if _, ok := s.canonical[n]; !ok {
+			break
+		}
+		delete(s.canonical, n)
+	}
+}
+
+func (s *ExecutionServer) payload(id pb.PayloadIDBytes, v int) (*executionServerBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	blk, ok := s.payloads[id]
+	if !ok || blk.version != v {
+		return nil, &executionServerError{code: unknownPayloadCode, msg: "unknown payload"}
+	}
+	return blk, nil
+}
+
+func (s *ExecutionServer) blockByNumber(n rpc.BlockNumber) *executionServerBlock {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	switch n {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return s.blocks[s.head]
+	case rpc.SafeBlockNumber:
+		return s.blocks[s.safe]
+	case rpc.FinalizedBlockNumber:
+		return s.blocks[s.finalized]
+	}
+	hash, ok := s.canonical[uint64(n.Int64())]
+	if !ok {
+		return nil
+	}
+	return s.blocks[hash]
+}
+
+func (s *ExecutionServer) blockByHash(hash common.Hash) *executionServerBlock {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.blocks[hash]
+}
+
+// executionServerEngineAPI serves the engine namespace.
+type executionServerEngineAPI struct {
+	s *ExecutionServer
+}
+
+// ExchangeCapabilities --
+func (api *executionServerEngineAPI) ExchangeCapabilities(_ []string) []string {
+	return []string{
+		"engine_newPayloadV2",
+		"engine_newPayloadV3",
+		"engine_forkchoiceUpdatedV2",
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV2",
+		"engine_getPayloadV3",
+		"engine_exchangeTransitionConfigurationV1",
+	}
+}
+
+// ExchangeTransitionConfigurationV1 --
+func (api *executionServerEngineAPI) ExchangeTransitionConfigurationV1(cfg *pb.TransitionConfiguration) *pb.TransitionConfiguration {
+	return cfg
+}
+
+// NewPayloadV2 --
+func (api *executionServerEngineAPI) NewPayloadV2(p *pb.ExecutionPayloadCapellaJSON) (*pb.PayloadStatus, error) {
+	if p == nil {
+		return nil, errors.New("missing execution payload")
+	}
+	return api.s.importPayload(&pb.ExecutionPayloadDenebJSON{
+		ParentHash:        p.ParentHash,
+		FeeRecipient:      p.FeeRecipient,
+		StateRoot:         p.StateRoot,
+		ReceiptsRoot:      p.ReceiptsRoot,
+		ActivitiesRoot:    p.ActivitiesRoot,
+		TransactionsCount: p.TransactionsCount,
+		LogsBloom:         p.LogsBloom,
+		PrevRandao:        p.PrevRandao,
+		BlockNumber:       p.BlockNumber,
+		GasLimit:          p.GasLimit,
+		GasUsed:           p.GasUsed,
+		Timestamp:         p.Timestamp,
+		ExtraData:         p.ExtraData,
+		BaseFeePerGas:     p.BaseFeePerGas,
+		BlockHash:         p.BlockHash,
+		Transactions:      p.Transactions,
+		Withdrawals:       p.Withdrawals,
+	}, version.Capella, nil), nil
+}
+
+// NewPayloadV3 --
+func (api *executionServerEngineAPI) NewPayloadV3(p *pb.ExecutionPayloadDenebJSON, _ []common.Hash, parentBeaconRoot *common.Hash) (*pb.PayloadStatus, error) {
+	if p == nil {
+		return nil, errors.New("missing execution payload")
+	}
+	return api.s.importPayload(p, version.Deneb, parentBeaconRoot), nil
+}
+
+// ForkchoiceUpdatedV2 --
+func (api *executionServerEngineAPI) ForkchoiceUpdatedV2(state *pb.ForkchoiceState, attrs *pb.PayloadAttributesV2) (*executionServerForkchoiceResponse, error) {
+	if attrs == nil {
+		return api.s.forkchoiceUpdated(state, version.Capella, 0, nil, nil, nil, nil, false)
+	}
+	return api.s.forkchoiceUpdated(
+		state, version.Capella, attrs.Timestamp, attrs.PrevRandao, attrs.SuggestedFeeRecipient, attrs.Withdrawals, nil, true,
+	)
+}
+
+// ForkchoiceUpdatedV3 --
+func (api *executionServerEngineAPI) ForkchoiceUpdatedV3(state *pb.ForkchoiceState, attrs *pb.PayloadAttributesV3) (*executionServerForkchoiceResponse, error) {
+	if attrs == nil {
+		return api.s.forkchoiceUpdated(state, version.Deneb, 0, nil, nil, nil, nil, false)
+	}
+	parentBeaconRoot := common.BytesToHash(attrs.ParentBeaconBlockRoot)
+	return api.s.forkchoiceUpdated(
+		state, version.Deneb, attrs.Timestamp, attrs.PrevRandao, attrs.SuggestedFeeRecipient, attrs.Withdrawals, &parentBeaconRoot, true,
+	)
+}
+
+// GetPayloadV2 --
+func (api *executionServerEngineAPI) GetPayloadV2(id pb.PayloadIDBytes) (*executionServerPayloadV2Response, error) {
+	blk, err := api.s.payload(id, version.Capella)
+	if err != nil {
+		return nil, err
+	}
+	p := blk.payloadDeneb()
+	return &executionServerPayloadV2Response{
+		ExecutionPayload: &pb.ExecutionPayloadCapella{
+			ParentHash:        p.ParentHash,
+			FeeRecipient:      p.FeeRecipient,
+			StateRoot:         p.StateRoot,
+			ReceiptsRoot:      p.ReceiptsRoot,
+			LogsBloom:         p.LogsBloom,
+			PrevRandao:        p.PrevRandao,
+			BlockNumber:       p.BlockNumber,
+			GasLimit:          p.GasLimit,
+			GasUsed:           p.GasUsed,
+			Timestamp:         p.Timestamp,
+			ExtraData:         p.ExtraData,
+			BaseFeePerGas:     p.BaseFeePerGas,
+			BlockHash:         p.BlockHash,
+			Transactions:      p.Transactions,
+			Withdrawals:       p.Withdrawals,
+			ActivitiesRoot:    p.ActivitiesRoot,
+			TransactionsCount: p.TransactionsCount,
+		},
+		BlockValue: hexutil.EncodeBig(blk.value()),
+	}, nil
+}
+
+// GetPayloadV3 --
+func (api *executionServerEngineAPI) GetPayloadV3(id pb.PayloadIDBytes) (*executionServerPayloadV3Response, error) {
+	blk, err := api.s.payload(id, version.Deneb)
+	if err != nil {
+		return nil, err
+	}
+	return &executionServerPayloadV3Response{
+		ExecutionPayload: blk.payloadDeneb(),
+		BlockValue:       hexutil.EncodeBig(blk.value()),
+		BlobsBundle: &pb.BlobBundleJSON{
+			Commitments: []hexutil.Bytes{},
+			Proofs:      []hexutil.Bytes{},
+			Blobs:       []hexutil.Bytes{},
+		},
+	}, nil
+}
+
+// executionServerEthAPI serves the eth namespace.
+type executionServerEthAPI struct {
+	s *ExecutionServer
+}
+
+// ChainId --
+func (api *executionServerEthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.s.chainID)
+}
+
+// BlockNumber --
+func (api *executionServerEthAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.s.blockByNumber(rpc.LatestBlockNumber).header.Number.Uint64())
+}
+
+// Syncing --
+func (api *executionServerEthAPI) Syncing() bool {
+	return false
+}
+
+// GetBlockByHash --
+func (api *executionServerEthAPI) GetBlockByHash(hash common.Hash, fullTx bool) *pb.ExecutionBlock {
+	return api.s.blockByHash(hash).executionBlock(fullTx)
+}
+
+// GetBlockByNumber --
+func (api *executionServerEthAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) *pb.ExecutionBlock {
+	return api.s.blockByNumber(number).executionBlock(fullTx)
+}
+
+// GetBlockActivities mirrors eth_getBlockActivities of the execution client.
+func (api *executionServerEthAPI) GetBlockActivities(blockNrOrHash rpc.BlockNumberOrHash) *executionServerActivitiesResponse {
+	var blk *executionServerBlock
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		blk = api.s.blockByHash(hash)
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		blk = api.s.blockByNumber(number)
+	}
+	if blk == nil {
+		return nil
+	}
+	return &executionServerActivitiesResponse{
+		BaseFee:    blk.header.BaseFee.Uint64(),
+		TxCount:    uint64(len(blk.txs)),
+		Activities: blk.activities,
+	}
+}
+
+// executionServerNetAPI serves the net namespace.
+type executionServerNetAPI struct {
+	s *ExecutionServer
+}
+
+// Version --
+func (api *executionServerNetAPI) Version() string {
+	return api.s.chainID.String()
+}
+
+type executionServerForkchoiceResponse struct {
+	Status    *pb.PayloadStatus  `json:"payloadStatus"`
+	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
+}
+
+type executionServerPayloadV2Response struct {
+	ExecutionPayload *pb.ExecutionPayloadCapella `json:"executionPayload"`
+	BlockValue       string                      `json:"blockValue"`
+}
+
+type executionServerPayloadV3Response struct {
+	ExecutionPayload      *pb.ExecutionPayloadDeneb `json:"executionPayload"`
+	BlockValue            string                    `json:"blockValue"`
+	BlobsBundle           *pb.BlobBundleJSON        `json:"blobsBundle"`
+	ShouldOverrideBuilder bool                      `json:"shouldOverrideBuilder"`
+}
+
+type executionServerActivitiesResponse struct {
+	BaseFee    uint64               `json:"baseFee"`
+	TxCount    uint64               `json:"txCount"`
+	Activities gethtypes.Activities `json:"activities"`
+}
+
+// executionServerError is a JSON-RPC error with an engine API error code.
+type executionServerError struct {
+	code int
+	msg  string
+}
+
+func (e *executionServerError) Error() string {
+	return e.msg
+}
+
+// ErrorCode --
+func (e *executionServerError) ErrorCode() int {
+	return e.code
+}
+
+func (b *executionServerBlock) payloadDeneb() *pb.ExecutionPayloadDeneb {
+	txs := make([][]byte, len(b.txs))
+	for i, tx := range b.txs {
+		// Marshaling a transaction we decoded or signed ourselves can not fail.
+		txs[i], _ = tx.MarshalBinary()
+	}
+	h := b.header
+	p := &pb.ExecutionPayloadDeneb{
+		ParentHash:        h.ParentHash.Bytes(),
+		FeeRecipient:      h.Coinbase.Bytes(),
+		StateRoot:         h.Root.Bytes(),
+		ReceiptsRoot:      h.ReceiptHash.Bytes(),
+		LogsBloom:         h.Bloom.Bytes(),
+		PrevRandao:        h.MixDigest.Bytes(),
+		BlockNumber:       h.Number.Uint64(),
+		GasLimit:          h.GasLimit,
+		GasUsed:           h.GasUsed,
+		Timestamp:         h.Time,
+		ExtraData:         h.Extra,
+		BaseFeePerGas:     bytesutil.PadTo(bytesutil.ReverseByteOrder(h.BaseFee.Bytes()), 32),
+		BlockHash:         h.Hash().Bytes(),
+		Transactions:      txs,
+		Withdrawals:       b.withdrawals,
+		ActivitiesRoot:    h.ActivitiesHash.Bytes(),
+		TransactionsCount: *h.TxCount,
+	}
+	if p.Withdrawals == nil {
+		p.Withdrawals = []*pb.Withdrawal{}
+	}
+	return p
+}
+
+// value returns the priority fees paid by the block transactions in wei.
+func (b *executionServerBlock) value() *big.Int {
+	value := new(big.Int)
+	for _, tx := range b.txs {
+		tip := tx.EffectiveGasTipValue(b.header.BaseFee)
+		value.Add(value, new(big.Int).Mul(tip, new(big.Int).SetUint64(tx.Gas())))
+	}
+	return value
+}
+
+func (b *executionServerBlock) executionBlock(fullTx bool) *pb.ExecutionBlock {
+	if b == nil {
+		return nil
+	}
+	txs := []*gethtypes.Transaction{}
+	if fullTx {
+		txs = b.txs
+	}
+	return &pb.ExecutionBlock{
+		Version:         version.Capella,
+		Header:          *b.header,
+		Hash:            b.header.Hash(),
+		Transactions:    txs,
+		TotalDifficulty: "0x0",
+		Withdrawals:     b.withdrawals,
+	}
+}
+
+// blockFromPayload rebuilds the execution block from a JSON payload. The activities are
+// derived from the transactions, while the header keeps the claimed activities root and
+// transactions count, so the block hash can be checked independently of them.
+func blockFromPayload(p *pb.ExecutionPayloadDenebJSON, v int, parentBeaconRoot *common.Hash) (*executionServerBlock, error) {
+	switch {
+	case p.ParentHash == nil, p.FeeRecipient == nil, p.StateRoot == nil, p.ReceiptsRoot == nil,
+		p.ActivitiesRoot == nil, p.TransactionsCount == nil, p.LogsBloom == nil, p.PrevRandao == nil,
+		p.BlockNumber == nil, p.GasLimit == nil, p.GasUsed == nil, p.Timestamp == nil, p.BlockHash == nil:
+		return nil, errors.New("missing required execution payload field")
+	}
+	baseFee, err := hexutil.DecodeBig(p.BaseFeePerGas)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base fee")
+	}
+	txs := make(gethtypes.Transactions, len(p.Transactions))
+	for i, enc := range p.Transactions {
+		tx := &gethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(enc); err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction %d", i)
+		}
+		txs[i] = tx
+	}
+	activities, _ := txActivities(txs)
+	withdrawalsRoot := gethtypes.DeriveSha(toGethWithdrawals(p.Withdrawals), trie.NewStackTrie(nil))
+	txCount := uint64(*p.TransactionsCount)
+	header := &gethtypes.Header{
+		ParentHash:      *p.ParentHash,
+		UncleHash:       gethtypes.EmptyUncleHash,
+		Coinbase:        *p.FeeRecipient,
+		Root:            *p.StateRoot,
+		TxHash:          gethtypes.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptHash:     *p.ReceiptsRoot,
+		Bloom:           gethtypes.BytesToBloom(*p.LogsBloom),
+		Difficulty:      common.Big0,
+		Number:          new(big.Int).SetUint64(uint64(*p.BlockNumber)),
+		GasLimit:        uint64(*p.GasLimit),
+		GasUsed:         uint64(*p.GasUsed),
+		Time:            uint64(*p.Timestamp),
+		Extra:           p.ExtraData,
+		MixDigest:       *p.PrevRandao,
+		BaseFee:         baseFee,
+		WithdrawalsHash: &withdrawalsRoot,
+		ActivitiesHash:  p.ActivitiesRoot,
+		TxCount:         &txCount,
+	}
+	if v >= version.Deneb {
+		if p.BlobGasUsed == nil || p.ExcessBlobGas == nil {
+			return nil, errors.New("missing required execution payload field")
+		}
+		blobGasUsed, excessBlobGas := uint64(*p.BlobGasUsed), uint64(*p.ExcessBlobGas)
+		header.BlobGasUsed = &blobGasUsed
+		header.ExcessBlobGas = &excessBlobGas
+		header.ParentBeaconRoot = parentBeaconRoot
+	}
+	return &executionServerBlock{
+		version:     v,
+		header:      header,
+		txs:         txs,
+		activities:  activities,
+		withdrawals: p.Withdrawals,
+	}, nil
+}
+
+// verifyBlock checks the parts of the header which the ExecutionServer can recompute.
+func verifyBlock(parent *gethtypes.Header, blk *executionServerBlock) error {
+	h := blk.header
+	if h.Number.Uint64() != parent.Number.Uint64()+1 {
+		return fmt.Errorf("invalid block number: have %d, want %d", h.Number, parent.Number.Uint64()+1)
+	}
+	if h.Time <= parent.Time {
+		return errors.New("timestamp must be greater than the parent timestamp")
+	}
+	if want := calcBaseFee(parent); h.BaseFee.Cmp(want) != 0 {
+		return fmt.Errorf("invalid base fee: have %d, want %d", h.BaseFee, want)
+	}
+	activitiesRoot, gasUsed := gethtypes.DeriveSha(blk.activities, trie.NewStackTrie(nil)), uint64(0)
+	for _, tx := range blk.txs {
+		gasUsed += tx.Gas()
+	}
+	if *h.ActivitiesHash != activitiesRoot {
+		return fmt.Errorf("invalid activities root: have %#x, want %#x", *h.ActivitiesHash, activitiesRoot)
+	}
+	if *h.TxCount != uint64(len(blk.txs)) {
+		return fmt.Errorf("invalid transactions count: have %d, want %d", *h.TxCount, len(blk.txs))
+	}
+	if h.GasUsed != gasUsed {
+		return fmt.Errorf("invalid gas used: have %d, want %d", h.GasUsed, gasUsed)
+	}
+	return nil
+}
+
+// txActivities credits the called contract of every transaction with the gas spent above
+// the intrinsic gas. It also returns the gas used by the transactions.
+func txActivities(txs gethtypes.Transactions) (gethtypes.Activities, uint64) {
+	activities := gethtypes.Activities{}
+	gasUsed := uint64(0)
+	for _, tx := range txs {
+		gasUsed += tx.Gas()
+		if tx.To() == nil || tx.Gas() <= gethparams.TxGas {
+			continue
+		}
+		activities = append(activities, &gethtypes.Activity{
+			Address:       *tx.To(),
+			DeltaActivity: tx.Gas() - gethparams.TxGas,
+		})
+	}
+	return activities, gasUsed
+}
+
+// calcBaseFee applies the EIP-1559 base fee update rule.
+func calcBaseFee(parent *gethtypes.Header) *big.Int {
+	target := parent.GasLimit / gethparams.DefaultElasticityMultiplier
+	baseFee := new(big.Int).Set(parent.BaseFee)
+	if parent.GasUsed == target {
+		return baseFee
+	}
+	delta := new(big.Int).SetUint64(parent.GasUsed)
+	if parent.GasUsed > target {
+		delta.Sub(delta, new(big.Int).SetUint64(target))
+	} else {
+		delta.Sub(new(big.Int).SetUint64(target), delta)
+	}
+	delta.Mul(delta, parent.BaseFee)
+	delta.Div(delta, new(big.Int).SetUint64(target))
+	delta.Div(delta, new(big.Int).SetUint64(gethparams.DefaultBaseFeeChangeDenominator))
+	if parent.GasUsed > target {
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		return baseFee.Add(baseFee, delta)
+	}
+	baseFee.Sub(baseFee, delta)
+	if baseFee.Sign() < 0 {
+		baseFee.SetUint64(0)
+	}
+	return baseFee
+}
+
+func toGethWithdrawals(withdrawals []*pb.Withdrawal) gethtypes.Withdrawals {
+	ws := make(gethtypes.Withdrawals, len(withdrawals))
+	for i, w := range withdrawals {
+		ws[i] = &gethtypes.Withdrawal{
+			Index:     w.Index,
+			Validator: uint64(w.ValidatorIndex),
+			Address:   common.BytesToAddress(w.Address),
+			Amount:    w.Amount,
+		}
+	}
+	return ws
+}
+
+func mustKey(hexKey string) *ecdsa.PrivateKey {
+	key, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
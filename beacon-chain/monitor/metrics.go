@@ -90,4 +90,71 @@ var (
 			"validator_index",
 		},
 	)
+	// activityGauge used to track the raw activity accumulated by the validator contracts in the last epoch
+	activityGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "activity",
+			Help:      "Raw activity accumulated by the validator contracts in the last epoch",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// effectiveActivityGauge used to track the effective activity of the validator
+	effectiveActivityGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "effective_activity",
+			Help:      "Effective activity of the validator",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// contractGauge used to expose the contract addresses linked to the validator
+	contractGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "contract",
+			Help:      "Contract address linked to the validator, the value is always 1",
+		},
+		[]string{
+			"validator_index",
+			"contract",
+		},
+	)
+	// effectivePowerGauge used to track the effective power of the validator
+	effectivePowerGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "effective_power",
+			Help:      "Effective power of the validator",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// effectivePowerShareGauge used to track the share of the validator in the total effective power
+	effectivePowerShareGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "effective_power_share",
+			Help:      "Share of the validator in the total effective power of active validators",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// activityProposerRewardCounter used to track the activity part of the proposer reward
+	activityProposerRewardCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "activity_proposer_reward_gwei_total",
+			Help:      "Activity part of the reward earned for proposing the included blocks, in Gwei",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 )
@@ -0,0 +1,242 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// activityCollapseDivisor defines when the activity of a tracked validator is considered collapsed:
+// the activity of the last epoch is less than the activity of the epoch before divided by this value.
+const activityCollapseDivisor = 2
+
+// initializeActivities records the activities accumulated by the tracked validators in the given epoch.
+// It assumes the caller holds the service Lock.
+func (s *Service) initializeActivities(st state.BeaconState, epoch primitives.Epoch) {
+	s.activityEpoch = epoch
+	if st.Version() < version.Altair {
+		return
+	}
+	for idx := range s.TrackedValidators {
+		activity, err := st.ActivityAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not fetch starting activity")
+			continue
+		}
+		latestPerf := s.latestPerformance[idx]
+		latestPerf.activity = activity
+		s.latestPerformance[idx] = latestPerf
+	}
+}
+
+// processActivities keeps track of the activities accumulated by the tracked validators in the current
+// epoch and reports the activity and power of every tracked validator once an epoch is completed.
+func (s *Service) processActivities(ctx context.Context, st state.BeaconState, currEpoch primitives.Epoch) {
+	if st.Version() < version.Altair {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+
+	if currEpoch > s.activityEpoch {
+		s.reportActivities(ctx, st)
+		s.activityEpoch = currEpoch
+	}
+	for idx := range s.TrackedValidators {
+		activity, err := st.ActivityAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get activity")
+			continue
+		}
+		latestPerf := s.latestPerformance[idx]
+		latestPerf.activity = activity
+		s.latestPerformance[idx] = latestPerf
+	}
+}
+
+// reportActivities logs and exports the activity, contracts and power of the tracked validators
+// for the epoch that has just been completed. It assumes the caller holds the service Lock.
+func (s *Service) reportActivities(ctx context.Context, st state.BeaconState) {
+	epoch := coreTime.CurrentEpoch(st)
+	activeCount, err := helpers.ActiveValidatorCount(ctx, st, epoch)
+	if err != nil {
+		log.WithError(err).Error("Could not get active validator count")
+		return
+	}
+	if activeCount == 0 {
+		log.Error("Could not report activities without active validators")
+		return
+	}
+	sharedActivity := st.SharedActivity()
+	if sharedActivity == nil {
+		log.Error("Could not report activities without shared activity in state")
+		return
+	}
+	transactionsGas := sharedActivity.TransactionsGasPerPeriod / activeCount
+	_, totalEffectivePower, err := helpers.Powers(ctx, st)
+	if err != nil {
+		log.WithError(err).Error("Could not compute validator powers")
+		return
+	}
+
+	for idx := range s.TrackedValidators {
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get validator")
+			continue
+		}
+		latestPerf := s.latestPerformance[idx]
+		previousActivity := latestPerf.epochActivity
+		latestPerf.epochActivity = latestPerf.activity
+		latestPerf.effectiveActivity = val.EffectiveActivity()
+
+		bound, err := st.ContractsAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get contracts")
+			continue
+		}
+		contracts := make([]string, len(bound))
+		for i, c := range bound {
+			contracts[i] = fmt.Sprintf("%#x", c)
+		}
+		for _, old := range latestPerf.contracts {
+			if !slices.Contains(contracts, old) {
+				contractGauge.DeleteLabelValues(fmt.Sprintf("%d", idx), old)
+			}
+		}
+		latestPerf.contracts = contracts
+		s.latestPerformance[idx] = latestPerf
+
+		effectivePower, err := helpers.EffectivePower(val, transactionsGas, epoch)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not compute effective power")
+			continue
+		}
+		powerShare := float64(0)
+		if totalEffectivePower > 0 {
+			powerShare = float64(effectivePower) / float64(totalEffectivePower)
+		}
+
+		// update metrics
+		activityGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.epochActivity))
+		effectiveActivityGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.effectiveActivity))
+		effectivePowerGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(effectivePower))
+		effectivePowerShareGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(powerShare)
+		for _, contract := range contracts {
+			contractGauge.WithLabelValues(fmt.Sprintf("%d", idx), contract).Set(1)
+		}
+
+		fields := logrus.Fields{
+			"ValidatorIndex":      idx,
+			"Epoch":               s.activityEpoch,
+			"Contracts":           strings.Join(contracts, ","),
+			"Activity":            latestPerf.epochActivity,
+			"EffectiveActivity":   latestPerf.effectiveActivity,
+			"EffectivePower":      effectivePower,
+			"EffectivePowerShare": fmt.Sprintf("%.5f", powerShare),
+		}
+		log.WithFields(fields).Info("Activity summary")
+
+		if latestPerf.epochActivity < previousActivity/activityCollapseDivisor {
+			log.WithFields(logrus.Fields{
+				"ValidatorIndex":   idx,
+				"Epoch":            s.activityEpoch,
+				"Contracts":        strings.Join(contracts, ","),
+				"Activity":         latestPerf.epochActivity,
+				"PreviousActivity": previousActivity,
+			}).Warn("Activity of tracked validator collapsed")
+		}
+	}
+}
+
+// processActivityProposerReward reports the activity part of the reward earned by a tracked validator
+// for proposing the block. It assumes the caller holds the service Lock.
+func (s *Service) processActivityProposerReward(blk interfaces.ReadOnlyBeaconBlock) {
+	if blk.Version() < version.Altair {
+		return
+	}
+	earned, breakdown, err := s.proposerEarnedReward(s.ctx, blk)
+	if err != nil {
+		log.WithError(err).WithField("Slot", blk.Slot()).Debug("Could not compute proposer reward")
+		return
+	}
+	activityReward, err := breakdown.EarnedActivityReward(earned)
+	if err != nil {
+		log.WithError(err).Error("Could not compute activity proposer reward")
+		return
+	}
+	activityProposerRewardCounter.WithLabelValues(fmt.Sprintf("%d", blk.ProposerIndex())).Add(float64(activityReward))
+	log.WithFields(logrus.Fields{
+		"ProposerIndex":      blk.ProposerIndex(),
+		"Slot":               blk.Slot(),
+		"BaseProposerReward": breakdown.BaseProposerReward,
+		"EarnedReward":       earned,
+		"ActivityReward":     activityReward,
+	}).Info("Activity proposer reward earned")
+}
+
+// proposerEarnedReward returns the attestations and sync aggregate rewards of the block proposer, which are
+// scaled from the base proposer reward, together with the breakdown of the base proposer reward. The block
+// is replayed on a copy of its parent state, so the parent state has to be in the state cache.
+func (s *Service) proposerEarnedReward(
+	ctx context.Context,
+	blk interfaces.ReadOnlyBeaconBlock,
+) (uint64, *altair.ProposerRewardBreakdown, error) {
+	parentRoot := blk.ParentRoot()
+	parent := s.config.StateGen.StateByRootIfCachedNoCopy(parentRoot)
+	if parent == nil {
+		return 0, nil, errors.New("parent state not found in cache")
+	}
+	st, err := transition.ProcessSlots(ctx, parent.Copy(), blk.Slot())
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not process slots")
+	}
+	initBalance, err := st.BalanceAtIndex(blk.ProposerIndex())
+	if err != nil {
+		return 0, nil, err
+	}
+	totalPower, totalEffectivePower, err := helpers.Powers(ctx, st)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not compute validator powers")
+	}
+	breakdown, err := altair.BaseProposerRewardBreakdown(st, totalPower, totalEffectivePower)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not compute base proposer reward")
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, att := range blk.Body().Attestations() {
+		st, err = altair.ProcessAttestationNoVerifySignature(ctx, st, att, totalBalance)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "could not process attestation")
+		}
+	}
+	attBalance, err := st.BalanceAtIndex(blk.ProposerIndex())
+	if err != nil {
+		return 0, nil, err
+	}
+	sa, err := blk.Body().SyncAggregate()
+	if err != nil {
+		return 0, nil, err
+	}
+	_, syncReward, err := altair.ProcessSyncAggregate(ctx, st, sa)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not process sync aggregate")
+	}
+	return attBalance - initBalance + syncReward, breakdown, nil
+}
@@ -0,0 +1,154 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessActivities(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	ctx := context.Background()
+	state, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, state.SetSharedActivity(&ethpb.SharedActivity{TransactionsGasPerPeriod: 256000}))
+	val, err := state.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Contract = bytesutil.PadTo([]byte("contract-1"), 20)
+	require.NoError(t, state.UpdateValidatorAtIndex(1, val))
+
+	require.NoError(t, state.UpdateActivityAtIndex(1, 1000))
+	require.NoError(t, state.UpdateActivityAtIndex(2, 500))
+	s.processActivities(ctx, state, 0)
+	require.LogsDoNotContain(t, hook, "Activity summary")
+	require.Equal(t, uint64(1000), s.latestPerformance[1].activity)
+	require.Equal(t, uint64(500), s.latestPerformance[2].activity)
+
+	// The first block of the next epoch reports the activities accumulated until then.
+	require.NoError(t, state.UpdateActivityAtIndex(1, 0))
+	require.NoError(t, state.UpdateActivityAtIndex(2, 0))
+	s.processActivities(ctx, state, 1)
+	wanted := fmt.Sprintf("\"Activity summary\" Activity=1000 Contracts=%#x EffectiveActivity=0 EffectivePower=1000 EffectivePowerShare=0.00391 Epoch=0 ValidatorIndex=1", val.Contract)
+	require.LogsContain(t, hook, wanted)
+	require.LogsDoNotContain(t, hook, "Activity of tracked validator collapsed")
+	require.Equal(t, uint64(1000), s.latestPerformance[1].epochActivity)
+	require.Equal(t, uint64(0), s.latestPerformance[1].activity)
+
+	hook.Reset()
+	require.NoError(t, state.UpdateActivityAtIndex(2, 300))
+	s.processActivities(ctx, state, 1)
+	require.LogsDoNotContain(t, hook, "Activity summary")
+	s.processActivities(ctx, state, 2)
+	require.LogsContain(t, hook, "\"Activity summary\" Activity=300")
+	require.LogsContain(t, hook, "\"Activity of tracked validator collapsed\" Activity=0")
+	require.LogsContain(t, hook, "Epoch=1 PreviousActivity=1000 ValidatorIndex=1")
+	require.LogsDoNotContain(t, hook, "PreviousActivity=500 ValidatorIndex=2")
+}
+
+func TestProcessActivities_Phase0(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	state, _ := util.DeterministicGenesisState(t, 256)
+
+	s.processActivities(context.Background(), state, 1)
+	require.LogsDoNotContain(t, hook, "Activity summary")
+	require.LogsDoNotContain(t, hook, "Could not")
+}
+
+func TestProcessActivities_NoSharedActivity(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	state, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, state.SetSharedActivity(nil))
+
+	s.processActivities(context.Background(), state, 1)
+	require.LogsContain(t, hook, "Could not report activities without shared activity in state")
+	require.LogsDoNotContain(t, hook, "Activity summary")
+}
+
+func TestProcessActivityProposerReward(t *testing.T) {
+	tests := []struct {
+		name              string
+		electraForkEpoch  primitives.Epoch
+		transactionsGas   uint64
+		baseFee           uint64
+		effectiveActivity uint64
+	}{
+		{
+			// Before Electra the reward arithmetic wraps, so the fixture stays within 64 bits.
+			name:              "before Electra",
+			electraForkEpoch:  params.BeaconConfig().ElectraForkEpoch,
+			transactionsGas:   1 << 20,
+			baseFee:           1 << 30,
+			effectiveActivity: 1 << 20,
+		},
+		{
+			// The base fee times the reward activity needs more than 64 bits, which only the
+			// checked arithmetic from Electra onward computes.
+			name:              "from Electra",
+			electraForkEpoch:  0,
+			transactionsGas:   1 << 40,
+			baseFee:           1 << 30,
+			effectiveActivity: 1 << 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.ElectraForkEpoch = tt.electraForkEpoch
+			params.OverrideBeaconConfig(cfg)
+
+			hook := logTest.NewGlobal()
+			ctx := context.Background()
+			genesis, keys := util.DeterministicGenesisStateAltair(t, 64)
+			require.NoError(t, genesis.SetSharedActivity(&ethpb.SharedActivity{
+				TransactionsGasPerPeriod: tt.transactionsGas,
+				BaseFeePerPeriod:         tt.baseFee,
+			}))
+			vals := genesis.Validators()
+			for _, v := range vals {
+				v.EffectiveActivity = tt.effectiveActivity
+			}
+			require.NoError(t, genesis.SetValidators(vals))
+			c, err := altair.NextSyncCommittee(ctx, genesis)
+			require.NoError(t, err)
+			require.NoError(t, genesis.SetCurrentSyncCommittee(c))
+
+			genConfig := util.DefaultBlockGenConfig()
+			genConfig.FullSyncAggregate = true
+			b, err := util.GenerateFullBlockAltair(genesis, keys, genConfig, 1)
+			require.NoError(t, err)
+			wsb, err := blocks.NewSignedBeaconBlock(b)
+			require.NoError(t, err)
+
+			s := setupService(t)
+			// Without the parent state in the cache the reward is not reported.
+			s.processActivityProposerReward(wsb.Block())
+			require.LogsDoNotContain(t, hook, "Activity proposer reward earned")
+
+			require.NoError(t, s.config.StateGen.SaveState(ctx, bytesutil.ToBytes32(b.Block.ParentRoot), genesis))
+			s.processActivityProposerReward(wsb.Block())
+			require.LogsContain(t, hook, "Activity proposer reward earned")
+			require.LogsDoNotContain(t, hook, "Could not")
+
+			entry := hook.LastEntry()
+			earned, ok := entry.Data["EarnedReward"].(uint64)
+			require.Equal(t, true, ok)
+			require.NotEqual(t, uint64(0), earned)
+			// The total effective activity is 64 times the transactions gas, so is the activity part of the reward.
+			require.Equal(t, earned*64/65, entry.Data["ActivityReward"])
+			require.Equal(t, b.Block.ProposerIndex, entry.Data["ProposerIndex"])
+		})
+	}
+}
@@ -54,6 +54,7 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 		s.updateSyncCommitteeTrackedVals(st)
 	}
 
+	s.processActivities(ctx, st, currEpoch)
 	s.processSyncAggregate(st, blk)
 	s.processProposedBlock(st, root, blk)
 	s.processAttestations(ctx, st, blk)
@@ -98,6 +99,8 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 			"NewBalance":    balance,
 			"BalanceChange": balanceChg,
 		}).Info("Proposed beacon block was included")
+
+		s.processActivityProposerReward(blk)
 	}
 }
 
@@ -31,6 +31,12 @@ type ValidatorLatestPerformance struct {
 	timelyHead    bool
 	balance       uint64
 	balanceChange int64
+	// activity is the raw activity accumulated so far in the epoch being monitored.
+	activity uint64
+	// epochActivity is the raw activity accumulated during the last completed epoch.
+	epochActivity     uint64
+	effectiveActivity uint64
+	contracts         []string
 }
 
 // ValidatorAggregatedPerformance keeps track of the accumulated performance of
@@ -70,7 +76,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch and activityEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -78,6 +84,7 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+	activityEpoch               primitives.Epoch
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -169,6 +176,7 @@ func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch
 			balance: balance,
 		}
 	}
+	s.initializeActivities(state, epoch)
 }
 
 // Status retrieves the status of the service.
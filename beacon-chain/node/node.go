@@ -16,15 +16,18 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	apigateway "github.com/prysmaticlabs/prysm/v4/api/gateway"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/anomaly"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v4/beacon-chain/deterministic-genesis"
@@ -36,6 +39,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits"
@@ -55,10 +59,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime"
 	"github.com/prysmaticlabs/prysm/v4/runtime/debug"
 	"github.com/prysmaticlabs/prysm/v4/runtime/prereqs"
@@ -95,6 +101,7 @@ type BeaconNode struct {
 	slashingsPool           slashings.PoolManager
 	syncCommitteePool       synccommittee.Pool
 	blsToExecPool           blstoexec.PoolManager
+	contractTransferPool    contracttransfers.PoolManager
 	depositCache            cache.DepositCache
 	proposerIdsCache        *cache.ProposerPayloadIDsCache
 	stateFeed               *event.Feed
@@ -111,6 +118,9 @@ type BeaconNode struct {
 	forkChoicer             forkchoice.ForkChoicer
 	clockWaiter             startup.ClockWaiter
 	initialSyncComplete     chan struct{}
+	blobStorage             *filesystem.BlobStorage
+	blobRetentionEpochs     primitives.Epoch
+	backfillStatus          *backfill.Status
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -153,7 +163,8 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err := configureExecutionSetting(cliCtx); err != nil {
 		return nil, err
 	}
-	if err := kv.ConfigureBlobRetentionEpoch(cliCtx); err != nil {
+	blobRetentionEpochs, err := filesystem.ConfigureBlobRetentionEpoch(cliCtx)
+	if err != nil {
 		return nil, err
 	}
 	configureFastSSZHashingAlgorithm()
@@ -178,10 +189,12 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slashingsPool:           slashings.NewPool(),
 		syncCommitteePool:       synccommittee.NewPool(),
 		blsToExecPool:           blstoexec.NewPool(),
+		contractTransferPool:    contracttransfers.NewPool(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		blobRetentionEpochs:     blobRetentionEpochs,
 	}
 
 	beacon.initialSyncComplete = make(chan struct{})
@@ -204,18 +217,23 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Starting Blob Storage")
+	if err := beacon.startBlobStorage(cliCtx); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, err
 	}
 
-	bfs := backfill.NewStatus(beacon.db)
-	if err := bfs.Reload(ctx); err != nil {
+	beacon.backfillStatus = backfill.NewStatus(beacon.db)
+	if err := beacon.backfillStatus.Reload(ctx); err != nil {
 		return nil, errors.Wrap(err, "backfill status initialization error")
 	}
 
 	log.Debugln("Starting State Gen")
-	if err := beacon.startStateGen(ctx, bfs, beacon.forkChoicer); err != nil {
+	if err := beacon.startStateGen(ctx, beacon.backfillStatus, beacon.forkChoicer); err != nil {
 		if errors.Is(err, stategen.ErrNoGenesisBlock) {
 			log.Errorf("No genesis block/state is found. Prysm only provides a mainnet genesis "+
 				"state bundled in the application. You must provide the --%s or --%s flag to load "+
@@ -259,11 +277,26 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(cliCtx); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Blob Pruner Service")
+	if err := beacon.registerBlobPrunerService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
 	}
 
+	log.Debugln("Registering Activity Anomaly Detection Service")
+	if err := beacon.registerActivityAnomalyService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering builder service")
 	if err := beacon.registerBuilderService(cliCtx); err != nil {
 		return nil, err
@@ -399,6 +432,9 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
+		if err := os.RemoveAll(filepath.Join(baseDir, filesystem.BlobStorageDirName)); err != nil {
+			return errors.Wrap(err, "could not clear blob storage")
+		}
 		d, err = db.NewDB(b.ctx, dbPath)
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -464,6 +500,27 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// startBlobStorage opens the blob storage in the data directory and moves the blob sidecars
+// stored in the database by earlier versions into it.
+func (b *BeaconNode) startBlobStorage(cliCtx *cli.Context) error {
+	blobPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), filesystem.BlobStorageDirName)
+	log.WithField("blob-path", blobPath).Info("Checking blob storage")
+
+	bs, err := filesystem.NewBlobStorage(blobPath, filesystem.WithBlobRetentionEpochs(b.blobRetentionEpochs))
+	if err != nil {
+		return err
+	}
+	moved, err := b.db.MoveBlobSidecars(b.ctx, bs.Save)
+	if err != nil {
+		return errors.Wrap(err, "could not move blob sidecars from the database to the blob storage")
+	}
+	if moved > 0 {
+		log.WithField("count", moved).Info("Moved blob sidecars from the database to the blob storage")
+	}
+	b.blobStorage = bs
+	return nil
+}
+
 func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -622,6 +679,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		b.serviceFlagOpts.blockchainFlagOpts,
 		blockchain.WithForkChoiceStore(fc),
 		blockchain.WithDatabase(b.db),
+		blockchain.WithBlobStorage(b.blobStorage),
 		blockchain.WithDepositCache(b.depositCache),
 		blockchain.WithChainStartFetcher(web3Service),
 		blockchain.WithExecutionEngineCaller(web3Service),
@@ -629,6 +687,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithExitPool(b.exitPool),
 		blockchain.WithSlashingPool(b.slashingsPool),
 		blockchain.WithBLSToExecPool(b.blsToExecPool),
+		blockchain.WithContractTransferPool(b.contractTransferPool),
 		blockchain.WithP2PBroadcaster(b.fetchP2P()),
 		blockchain.WithStateNotifier(b),
 		blockchain.WithAttestationService(attService),
@@ -698,6 +757,7 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 	rs := regularsync.NewService(
 		b.ctx,
 		regularsync.WithDatabase(b.db),
+		regularsync.WithBlobStorage(b.blobStorage),
 		regularsync.WithP2P(b.fetchP2P()),
 		regularsync.WithChainService(chainService),
 		regularsync.WithInitialSync(initSync),
@@ -709,6 +769,7 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 		regularsync.WithSlashingPool(b.slashingsPool),
 		regularsync.WithSyncCommsPool(b.syncCommitteePool),
 		regularsync.WithBlsToExecPool(b.blsToExecPool),
+		regularsync.WithContractTransferPool(b.contractTransferPool),
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
@@ -727,6 +788,7 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:                  b.db,
+		BlobStorage:         b.blobStorage,
 		Chain:               chainService,
 		P2P:                 b.fetchP2P(),
 		StateNotifier:       b,
@@ -737,6 +799,32 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(cliCtx *cli.Context) error {
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bf := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		Status:      b.backfillStatus,
+		SyncChecker: initSync,
+		ClockWaiter: b.clockWaiter,
+		BlocksByRange: func(ctx context.Context, clock *startup.Clock, p p2p.SenderEncoder, pid peer.ID,
+			req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+			return regularsync.SendBeaconBlocksByRangeRequest(ctx, clock, p, pid, req, nil)
+		},
+		BatchSize:   cliCtx.Uint64(flags.BackfillBatchSize.Name),
+		WorkerCount: cliCtx.Int(flags.BackfillWorkerCount.Name),
+	})
+	return b.services.RegisterService(bf)
+}
+
+func (b *BeaconNode) registerBlobPrunerService() error {
+	return b.services.RegisterService(filesystem.NewBlobPruner(b.ctx, b.blobStorage, b.clockWaiter))
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -768,6 +856,18 @@ func (b *BeaconNode) registerSlasherService() error {
 	return b.services.RegisterService(slasherSrv)
 }
 
+func (b *BeaconNode) registerActivityAnomalyService() error {
+	if !features.Get().EnableActivityAnomalies {
+		return nil
+	}
+	svc := anomaly.NewService(b.ctx, &anomaly.Config{
+		StateNotifier: b,
+		StateGen:      b.stateGen,
+		BeaconDB:      b.db,
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -791,6 +891,16 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		}
 	}
 
+	// The fetcher is left nil when the detection is disabled, so that the REST API can report it.
+	var anomalyFetcher anomaly.FindingsFetcher
+	if features.Get().EnableActivityAnomalies {
+		var anomalyService *anomaly.Service
+		if err := b.services.FetchService(&anomalyService); err != nil {
+			return err
+		}
+		anomalyFetcher = anomalyService
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	var depositFetcher cache.DepositFetcher
 	var chainStartFetcher execution.ChainStartFetcher
@@ -828,11 +938,14 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		CertFlag:                      cert,
 		KeyFlag:                       key,
 		BeaconDB:                      b.db,
+		BlobStorage:                   b.blobStorage,
+		BackfillStatus:                b.backfillStatus,
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
 		MetadataProvider:              p2pService,
 		ChainInfoFetcher:              chainService,
+		SharedActivitySubscriber:      chainService,
 		HeadFetcher:                   chainService,
 		CanonicalFetcher:              chainService,
 		ForkFetcher:                   chainService,
@@ -843,10 +956,13 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		GenesisTimeFetcher:            chainService,
 		GenesisFetcher:                chainService,
 		OptimisticModeFetcher:         chainService,
+		LightClientFetcher:            chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
 		BLSChangesPool:                b.blsToExecPool,
+		ContractTransferPool:          b.contractTransferPool,
+		ActivityAnomalyFetcher:        anomalyFetcher,
 		SlashingChecker:               slasherService,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
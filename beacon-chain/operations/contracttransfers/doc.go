@@ -0,0 +1,5 @@
+// Package contracttransfers defines an in-memory pool of received contract transfer objects.
+// Like the BLS-to-execution-change pool, it uses a doubly-linked list for ordering pending
+// objects and a map keyed by the sending validator index for looking up list nodes.
+// Only one pending transfer per validator is kept, since a validator can link a single contract.
+package contracttransfers
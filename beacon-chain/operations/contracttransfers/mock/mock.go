@@ -0,0 +1,37 @@
+package mock
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// PoolMock is a fake implementation of PoolManager.
+type PoolMock struct {
+	Transfers []*eth.SignedContractTransfer
+}
+
+// PendingContractTransfers --
+func (m *PoolMock) PendingContractTransfers() ([]*eth.SignedContractTransfer, error) {
+	return m.Transfers, nil
+}
+
+// ContractTransfersForInclusion --
+func (m *PoolMock) ContractTransfersForInclusion(_ state.ReadOnlyBeaconState) ([]*eth.SignedContractTransfer, error) {
+	return m.Transfers, nil
+}
+
+// InsertContractTransfer --
+func (m *PoolMock) InsertContractTransfer(transfer *eth.SignedContractTransfer) {
+	m.Transfers = append(m.Transfers, transfer)
+}
+
+// MarkIncluded --
+func (*PoolMock) MarkIncluded(_ *eth.SignedContractTransfer) {
+	panic("implement me")
+}
+
+// ValidatorExists --
+func (*PoolMock) ValidatorExists(_ primitives.ValidatorIndex) bool {
+	panic("implement me")
+}
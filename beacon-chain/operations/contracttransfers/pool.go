@@ -0,0 +1,171 @@
+package contracttransfers
+
+import (
+	"math"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	doublylinkedlist "github.com/prysmaticlabs/prysm/v4/container/doubly-linked-list"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// We recycle the contract transfers pool to avoid the backing map growing without
+// bound. The cycling operation is expensive because it copies all elements, so
+// we only do it when the map is smaller than this upper bound.
+const contractTransfersPoolThreshold = 2000
+
+var (
+	contractTransfersInPoolTotal = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "contract_transfer_pool_total",
+		Help: "The number of saved contract transfer messages in the operation pool.",
+	})
+)
+
+// PoolManager maintains pending and seen contract transfer objects.
+// This pool is used by proposers to insert contract transfer objects into new blocks.
+type PoolManager interface {
+	PendingContractTransfers() ([]*ethpb.SignedContractTransfer, error)
+	ContractTransfersForInclusion(beaconState state.ReadOnlyBeaconState) ([]*ethpb.SignedContractTransfer, error)
+	InsertContractTransfer(transfer *ethpb.SignedContractTransfer)
+	MarkIncluded(transfer *ethpb.SignedContractTransfer)
+	ValidatorExists(idx primitives.ValidatorIndex) bool
+}
+
+// Pool is a concrete implementation of PoolManager.
+type Pool struct {
+	lock    sync.RWMutex
+	pending doublylinkedlist.List[*ethpb.SignedContractTransfer]
+	m       map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedContractTransfer]
+}
+
+// NewPool returns an initialized pool.
+func NewPool() *Pool {
+	return &Pool{
+		pending: doublylinkedlist.List[*ethpb.SignedContractTransfer]{},
+		m:       make(map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedContractTransfer]),
+	}
+}
+
+// Copies the internal map and returns a new one.
+func (p *Pool) cycleMap() {
+	newMap := make(map[primitives.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedContractTransfer])
+	for k, v := range p.m {
+		newMap[k] = v
+	}
+	p.m = newMap
+}
+
+// PendingContractTransfers returns all objects from the pool.
+func (p *Pool) PendingContractTransfers() ([]*ethpb.SignedContractTransfer, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	result := make([]*ethpb.SignedContractTransfer, p.pending.Len())
+	node := p.pending.First()
+	var err error
+	for i := 0; node != nil; i++ {
+		result[i], err = node.Value()
+		if err != nil {
+			return nil, err
+		}
+		node, err = node.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// ContractTransfersForInclusion returns objects that are ready for inclusion.
+// This method will not return more than the block enforced MaxContractTransfers.
+// Transfers touching a validator already used by a previously selected transfer
+// are skipped, as they could become invalid once the earlier one is processed.
+func (p *Pool) ContractTransfersForInclusion(st state.ReadOnlyBeaconState) ([]*ethpb.SignedContractTransfer, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	length := int(math.Min(float64(params.BeaconConfig().MaxContractTransfers), float64(p.pending.Len())))
+	result := make([]*ethpb.SignedContractTransfer, 0, length)
+	used := make(map[primitives.ValidatorIndex]bool, 2*length)
+	node := p.pending.Last()
+	for node != nil && len(result) < length {
+		transfer, err := node.Value()
+		if err != nil {
+			return nil, err
+		}
+		_, err = blocks.ValidateContractTransfer(st, transfer)
+		if err != nil {
+			logrus.WithError(err).Warning("removing invalid ContractTransfer from pool")
+			// MarkIncluded removes the invalid transfer from the pool
+			p.lock.RUnlock()
+			p.MarkIncluded(transfer)
+			p.lock.RLock()
+		} else if !used[transfer.Message.ValidatorIndex] && !used[transfer.Message.RecipientIndex] {
+			used[transfer.Message.ValidatorIndex] = true
+			used[transfer.Message.RecipientIndex] = true
+			result = append(result, transfer)
+		}
+		node, err = node.Prev()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// InsertContractTransfer inserts an object into the pool.
+func (p *Pool) InsertContractTransfer(transfer *ethpb.SignedContractTransfer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	_, exists := p.m[transfer.Message.ValidatorIndex]
+	if exists {
+		return
+	}
+
+	p.pending.Append(doublylinkedlist.NewNode(transfer))
+	p.m[transfer.Message.ValidatorIndex] = p.pending.Last()
+
+	contractTransfersInPoolTotal.Inc()
+}
+
+// MarkIncluded is used when an object has been included in a beacon block. Every block seen by this
+// node should call this method to include the object. This will remove the object from the pool.
+func (p *Pool) MarkIncluded(transfer *ethpb.SignedContractTransfer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	node := p.m[transfer.Message.ValidatorIndex]
+	if node == nil {
+		return
+	}
+
+	delete(p.m, transfer.Message.ValidatorIndex)
+	p.pending.Remove(node)
+	if p.numPending() == contractTransfersPoolThreshold {
+		p.cycleMap()
+	}
+
+	contractTransfersInPoolTotal.Dec()
+}
+
+// ValidatorExists checks if the contract transfer object exists
+// for that particular validator.
+func (p *Pool) ValidatorExists(idx primitives.ValidatorIndex) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	node := p.m[idx]
+
+	return node != nil
+}
+
+// numPending returns the number of pending contract transfers in the pool
+func (p *Pool) numPending() int {
+	return p.pending.Len()
+}
@@ -0,0 +1,169 @@
+package contracttransfers
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func transfer(from, to primitives.ValidatorIndex) *eth.SignedContractTransfer {
+	return &eth.SignedContractTransfer{
+		Message: &eth.ContractTransfer{
+			ValidatorIndex: from,
+			RecipientIndex: to,
+			Contract:       bytesutil.PadTo(bytesutil.Bytes8(uint64(from)+1), 20),
+		},
+		Signature:          make([]byte, 96),
+		RecipientSignature: make([]byte, 96),
+	}
+}
+
+func TestPendingContractTransfers(t *testing.T) {
+	t.Run("empty pool", func(t *testing.T) {
+		pool := NewPool()
+		transfers, err := pool.PendingContractTransfers()
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(transfers))
+	})
+	t.Run("non-empty pool", func(t *testing.T) {
+		pool := NewPool()
+		pool.InsertContractTransfer(transfer(0, 2))
+		pool.InsertContractTransfer(transfer(1, 3))
+		transfers, err := pool.PendingContractTransfers()
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(transfers))
+	})
+}
+
+func TestContractTransfersForInclusion(t *testing.T) {
+	numValidators := 4 * params.BeaconConfig().MaxContractTransfers
+	st, _ := util.DeterministicGenesisStateDeneb(t, numValidators)
+	vals := st.Validators()
+	half := primitives.ValidatorIndex(numValidators / 2)
+	for i, v := range vals {
+		v.Contract = params.BeaconConfig().ZeroContract[:]
+		if primitives.ValidatorIndex(i) < half {
+			v.Contract = transfer(primitives.ValidatorIndex(i), 0).Message.Contract
+		}
+	}
+	require.NoError(t, st.SetValidators(vals))
+
+	t.Run("empty pool", func(t *testing.T) {
+		pool := NewPool()
+		transfers, err := pool.ContractTransfersForInclusion(st)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(transfers))
+	})
+	t.Run("max transfers", func(t *testing.T) {
+		pool := NewPool()
+		for i := primitives.ValidatorIndex(0); i < half; i++ {
+			pool.InsertContractTransfer(transfer(i, half+i))
+		}
+		transfers, err := pool.ContractTransfersForInclusion(st)
+		require.NoError(t, err)
+		assert.Equal(t, int(params.BeaconConfig().MaxContractTransfers), len(transfers))
+		// Newest objects are returned first.
+		assert.Equal(t, half-1, transfers[0].Message.ValidatorIndex)
+	})
+	t.Run("invalid transfer removed", func(t *testing.T) {
+		pool := NewPool()
+		pool.InsertContractTransfer(transfer(0, half))
+		// Validator at index half does not own a contract.
+		pool.InsertContractTransfer(transfer(half, half+1))
+		transfers, err := pool.ContractTransfersForInclusion(st)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(transfers))
+		assert.Equal(t, primitives.ValidatorIndex(0), transfers[0].Message.ValidatorIndex)
+		assert.Equal(t, false, pool.ValidatorExists(half))
+	})
+	t.Run("conflicting recipient skipped", func(t *testing.T) {
+		pool := NewPool()
+		pool.InsertContractTransfer(transfer(0, half))
+		pool.InsertContractTransfer(transfer(1, half))
+		transfers, err := pool.ContractTransfersForInclusion(st)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(transfers))
+		assert.Equal(t, primitives.ValidatorIndex(1), transfers[0].Message.ValidatorIndex)
+		// The skipped transfer stays in the pool.
+		assert.Equal(t, true, pool.ValidatorExists(0))
+	})
+}
+
+func TestInsertContractTransfer(t *testing.T) {
+	t.Run("empty pool", func(t *testing.T) {
+		pool := NewPool()
+		tr := transfer(0, 1)
+		pool.InsertContractTransfer(tr)
+		require.Equal(t, 1, pool.pending.Len())
+		require.Equal(t, 1, len(pool.m))
+		n, ok := pool.m[0]
+		require.Equal(t, true, ok)
+		v, err := n.Value()
+		require.NoError(t, err)
+		assert.DeepEqual(t, tr, v)
+	})
+	t.Run("validator index already exists", func(t *testing.T) {
+		pool := NewPool()
+		old := transfer(0, 1)
+		pool.InsertContractTransfer(old)
+		pool.InsertContractTransfer(transfer(0, 2))
+		require.Equal(t, 1, pool.pending.Len())
+		require.Equal(t, 1, len(pool.m))
+		n, ok := pool.m[0]
+		require.Equal(t, true, ok)
+		v, err := n.Value()
+		require.NoError(t, err)
+		assert.DeepEqual(t, old, v)
+	})
+}
+
+func TestMarkIncluded(t *testing.T) {
+	t.Run("one element in pool", func(t *testing.T) {
+		pool := NewPool()
+		tr := transfer(0, 1)
+		pool.InsertContractTransfer(tr)
+		pool.MarkIncluded(tr)
+		assert.Equal(t, 0, pool.pending.Len())
+		_, ok := pool.m[0]
+		assert.Equal(t, false, ok)
+	})
+	t.Run("element not in pool", func(t *testing.T) {
+		pool := NewPool()
+		pool.InsertContractTransfer(transfer(0, 1))
+		pool.MarkIncluded(transfer(2, 3))
+		assert.Equal(t, 1, pool.pending.Len())
+		_, ok := pool.m[0]
+		assert.Equal(t, true, ok)
+	})
+	t.Run("middle of pool", func(t *testing.T) {
+		pool := NewPool()
+		first, second, third := transfer(0, 10), transfer(1, 11), transfer(2, 12)
+		pool.InsertContractTransfer(first)
+		pool.InsertContractTransfer(second)
+		pool.InsertContractTransfer(third)
+		pool.MarkIncluded(second)
+		require.Equal(t, 2, pool.pending.Len())
+		_, ok := pool.m[1]
+		assert.Equal(t, false, ok)
+		transfers, err := pool.PendingContractTransfers()
+		require.NoError(t, err)
+		assert.DeepEqual(t, []*eth.SignedContractTransfer{first, third}, transfers)
+	})
+}
+
+func TestValidatorExists(t *testing.T) {
+	pool := NewPool()
+	assert.Equal(t, false, pool.ValidatorExists(0))
+	tr := transfer(0, 1)
+	pool.InsertContractTransfer(tr)
+	assert.Equal(t, true, pool.ValidatorExists(0))
+	assert.Equal(t, false, pool.ValidatorExists(1))
+	pool.MarkIncluded(tr)
+	assert.Equal(t, false, pool.ValidatorExists(0))
+}
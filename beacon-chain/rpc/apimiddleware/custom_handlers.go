@@ -313,6 +313,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				default:
 					return apimiddleware.InternalServerError(errors.New("payload version unsupported"))
 				}
+			case events.ActivityChangesTopic:
+				data = &EventActivityChangesJson{}
+			case events.SharedActivityTopic:
+				data = &EventSharedActivityJson{}
 			case "error":
 				data = &EventErrorJson{}
 			default:
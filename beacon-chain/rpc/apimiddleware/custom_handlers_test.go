@@ -199,6 +199,46 @@ data: {"block":"0x666f6f","state":"0x666f6f","epoch":"1","execution_optimistic":
 	assert.DeepEqual(t, expectedEvent, w.Body.String())
 }
 
+func TestReceiveEvents_ActivityChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		base64Val := "Zm9v"
+		data := &EventActivityChangesJson{
+			Slot:  "1",
+			Block: base64Val,
+			ActivityChanges: []*ActivityChangeJson{
+				{ContractAddress: base64Val, DeltaActivity: "21000"},
+			},
+			TransactionsCount: "3",
+			BaseFee:           "7",
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.ActivityChangesTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+
+	expectedEvent := `event: activity_changes
+data: {"slot":"1","block":"0x666f6f","activity_changes":[{"contract_address":"0x666f6f","delta_activity":"21000"}],"transactions_count":"3","base_fee":"7","execution_optimistic":false}
+
+`
+	assert.DeepEqual(t, expectedEvent, w.Body.String())
+}
+
 func TestReceiveEvents_AggregatedAtt(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *sse.Event)
@@ -73,10 +73,18 @@ type denebPublishBlockRequestJson struct {
 	DenebContents *SignedBeaconBlockContentsDenebJson `json:"deneb_contents"`
 }
 
+type electraPublishBlockRequestJson struct {
+	ElectraContents *SignedBeaconBlockContentsElectraJson `json:"electra_contents"`
+}
+
 type denebPublishBlindedBlockRequestJson struct {
 	DenebContents *SignedBlindedBeaconBlockContentsDenebJson `json:"deneb_contents"`
 }
 
+type electraPublishBlindedBlockRequestJson struct {
+	ElectraContents *SignedBlindedBeaconBlockContentsElectraJson `json:"electra_contents"`
+}
+
 // setInitialPublishBlockPostRequest is triggered before we deserialize the request JSON into a struct.
 // We don't know which version of the block got posted, but we can determine it from the slot.
 // We know that blocks of all versions have a Message field with a Slot field,
@@ -129,8 +137,10 @@ func setInitialPublishBlockPostRequest(endpoint *apimiddleware.Endpoint,
 		endpoint.PostRequest = &SignedBeaconBlockBellatrixJson{}
 	} else if currentEpoch < params.BeaconConfig().DenebForkEpoch {
 		endpoint.PostRequest = &SignedBeaconBlockCapellaJson{}
-	} else {
+	} else if currentEpoch < params.BeaconConfig().ElectraForkEpoch {
 		endpoint.PostRequest = &SignedBeaconBlockContentsDenebJson{}
+	} else {
+		endpoint.PostRequest = &SignedBeaconBlockContentsElectraJson{}
 	}
 	req.Body = io.NopCloser(bytes.NewBuffer(buf))
 	return true, nil
@@ -179,6 +189,13 @@ func preparePublishedBlock(endpoint *apimiddleware.Endpoint, _ http.ResponseWrit
 		}
 		return nil
 	}
+	if block, ok := endpoint.PostRequest.(*SignedBeaconBlockContentsElectraJson); ok {
+		// Prepare post request that can be properly decoded on gRPC side.
+		endpoint.PostRequest = &electraPublishBlockRequestJson{
+			ElectraContents: block,
+		}
+		return nil
+	}
 	return apimiddleware.InternalServerError(errors.New("unsupported block type"))
 }
 
@@ -234,8 +251,10 @@ func setInitialPublishBlindedBlockPostRequest(endpoint *apimiddleware.Endpoint,
 		endpoint.PostRequest = &SignedBlindedBeaconBlockBellatrixJson{}
 	} else if currentEpoch < params.BeaconConfig().DenebForkEpoch {
 		endpoint.PostRequest = &SignedBlindedBeaconBlockCapellaJson{}
-	} else {
+	} else if currentEpoch < params.BeaconConfig().ElectraForkEpoch {
 		endpoint.PostRequest = &SignedBlindedBeaconBlockContentsDenebJson{}
+	} else {
+		endpoint.PostRequest = &SignedBlindedBeaconBlockContentsElectraJson{}
 	}
 	req.Body = io.NopCloser(bytes.NewBuffer(buf))
 	return true, nil
@@ -296,6 +315,17 @@ func preparePublishedBlindedBlock(endpoint *apimiddleware.Endpoint, _ http.Respo
 		endpoint.PostRequest = actualPostReq
 		return nil
 	}
+	if blockContents, ok := endpoint.PostRequest.(*SignedBlindedBeaconBlockContentsElectraJson); ok {
+		// Prepare post request that can be properly decoded on gRPC side.
+		actualPostReq := &electraPublishBlindedBlockRequestJson{
+			ElectraContents: &SignedBlindedBeaconBlockContentsElectraJson{
+				SignedBlindedBlock:        blockContents.SignedBlindedBlock,
+				SignedBlindedBlobSidecars: blockContents.SignedBlindedBlobSidecars,
+			},
+		}
+		endpoint.PostRequest = actualPostReq
+		return nil
+	}
 	return apimiddleware.InternalServerError(errors.New("unsupported block type"))
 }
 
@@ -371,6 +401,13 @@ type denebBlockResponseJson struct {
 	Finalized           bool                        `json:"finalized"`
 }
 
+type electraBlockResponseJson struct {
+	Version             string                        `json:"version"`
+	Data                *SignedBeaconBlockElectraJson `json:"data"`
+	ExecutionOptimistic bool                          `json:"execution_optimistic"`
+	Finalized           bool                          `json:"finalized"`
+}
+
 type bellatrixBlindedBlockResponseJson struct {
 	Version             string                                 `json:"version" enum:"true"`
 	Data                *SignedBlindedBeaconBlockBellatrixJson `json:"data"`
@@ -392,6 +429,13 @@ type denebBlindedBlockResponseJson struct {
 	Finalized           bool                               `json:"finalized"`
 }
 
+type electraBlindedBlockResponseJson struct {
+	Version             string                               `json:"version"`
+	Data                *SignedBlindedBeaconBlockElectraJson `json:"data"`
+	ExecutionOptimistic bool                                 `json:"execution_optimistic"`
+	Finalized           bool                                 `json:"finalized"`
+}
+
 func serializeV2Block(response interface{}) (apimiddleware.RunDefault, []byte, apimiddleware.ErrorJson) {
 	respContainer, ok := response.(*BlockV2ResponseJson)
 	if !ok {
@@ -450,6 +494,16 @@ func serializeV2Block(response interface{}) (apimiddleware.RunDefault, []byte, a
 			ExecutionOptimistic: respContainer.ExecutionOptimistic,
 			Finalized:           respContainer.Finalized,
 		}
+	case strings.EqualFold(respContainer.Version, strings.ToLower(ethpbv2.Version_ELECTRA.String())):
+		actualRespContainer = &electraBlockResponseJson{
+			Version: respContainer.Version,
+			Data: &SignedBeaconBlockElectraJson{
+				Message:   respContainer.Data.ElectraBlock,
+				Signature: respContainer.Data.Signature,
+			},
+			ExecutionOptimistic: respContainer.ExecutionOptimistic,
+			Finalized:           respContainer.Finalized,
+		}
 	default:
 		return false, nil, apimiddleware.InternalServerError(fmt.Errorf("unsupported block version '%s'", respContainer.Version))
 	}
@@ -519,6 +573,16 @@ func serializeBlindedBlock(response interface{}) (apimiddleware.RunDefault, []by
 			ExecutionOptimistic: respContainer.ExecutionOptimistic,
 			Finalized:           respContainer.Finalized,
 		}
+	case strings.EqualFold(respContainer.Version, strings.ToLower(ethpbv2.Version_ELECTRA.String())):
+		actualRespContainer = &electraBlindedBlockResponseJson{
+			Version: respContainer.Version,
+			Data: &SignedBlindedBeaconBlockElectraJson{
+				Message:   respContainer.Data.ElectraBlock,
+				Signature: respContainer.Data.Signature,
+			},
+			ExecutionOptimistic: respContainer.ExecutionOptimistic,
+			Finalized:           respContainer.Finalized,
+		}
 	default:
 		return false, nil, apimiddleware.InternalServerError(fmt.Errorf("unsupported block version '%s'", respContainer.Version))
 	}
@@ -555,6 +619,11 @@ type denebStateResponseJson struct {
 	Data    *BeaconStateDenebJson `json:"data"`
 }
 
+type electraStateResponseJson struct {
+	Version string                  `json:"version" enum:"true"`
+	Data    *BeaconStateElectraJson `json:"data"`
+}
+
 func serializeV2State(response interface{}) (apimiddleware.RunDefault, []byte, apimiddleware.ErrorJson) {
 	respContainer, ok := response.(*BeaconStateV2ResponseJson)
 	if !ok {
@@ -588,6 +657,11 @@ func serializeV2State(response interface{}) (apimiddleware.RunDefault, []byte, a
 			Version: respContainer.Version,
 			Data:    respContainer.Data.DenebState,
 		}
+	case strings.EqualFold(respContainer.Version, strings.ToLower(ethpbv2.Version_ELECTRA.String())):
+		actualRespContainer = &electraStateResponseJson{
+			Version: respContainer.Version,
+			Data:    respContainer.Data.ElectraState,
+		}
 	default:
 		return false, nil, apimiddleware.InternalServerError(fmt.Errorf("unsupported state version '%s'", respContainer.Version))
 	}
@@ -624,6 +698,11 @@ type denebProduceBlockResponseJson struct {
 	Data    *BeaconBlockContentsDenebJson `json:"data"`
 }
 
+type electraProduceBlockResponseJson struct {
+	Version string                          `json:"version" enum:"true"`
+	Data    *BeaconBlockContentsElectraJson `json:"data"`
+}
+
 type bellatrixProduceBlindedBlockResponseJson struct {
 	Version string                           `json:"version" enum:"true"`
 	Data    *BlindedBeaconBlockBellatrixJson `json:"data"`
@@ -639,6 +718,11 @@ type denebProduceBlindedBlockResponseJson struct {
 	Data    *BlindedBeaconBlockContentsDenebJson `json:"data"`
 }
 
+type electraProduceBlindedBlockResponseJson struct {
+	Version string                                 `json:"version" enum:"true"`
+	Data    *BlindedBeaconBlockContentsElectraJson `json:"data"`
+}
+
 func serializeProducedV2Block(response interface{}) (apimiddleware.RunDefault, []byte, apimiddleware.ErrorJson) {
 	respContainer, ok := response.(*ProduceBlockResponseV2Json)
 	if !ok {
@@ -672,6 +756,11 @@ func serializeProducedV2Block(response interface{}) (apimiddleware.RunDefault, [
 			Version: respContainer.Version,
 			Data:    respContainer.Data.DenebContents,
 		}
+	case strings.EqualFold(respContainer.Version, strings.ToLower(ethpbv2.Version_ELECTRA.String())):
+		actualRespContainer = &electraProduceBlockResponseJson{
+			Version: respContainer.Version,
+			Data:    respContainer.Data.ElectraContents,
+		}
 	default:
 		return false, nil, apimiddleware.InternalServerError(fmt.Errorf("unsupported block version '%s'", respContainer.Version))
 	}
@@ -716,6 +805,11 @@ func serializeProducedBlindedBlock(response interface{}) (apimiddleware.RunDefau
 			Version: respContainer.Version,
 			Data:    respContainer.Data.DenebContents,
 		}
+	case strings.EqualFold(respContainer.Version, strings.ToLower(ethpbv2.Version_ELECTRA.String())):
+		actualRespContainer = &electraProduceBlindedBlockResponseJson{
+			Version: respContainer.Version,
+			Data:    respContainer.Data.ElectraContents,
+		}
 	default:
 		return false, nil, apimiddleware.InternalServerError(fmt.Errorf("unsupported block version '%s'", respContainer.Version))
 	}
@@ -173,6 +173,42 @@ func TestSetInitialPublishBlockPostRequest(t *testing.T) {
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
 		assert.Equal(t, reflect.TypeOf(SignedBeaconBlockContentsDenebJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
 	})
+	t.Run("Electra", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig()
+		cfg.CapellaForkEpoch = cfg.BellatrixForkEpoch.Add(2)
+		cfg.DenebForkEpoch = cfg.CapellaForkEpoch.Add(2)
+		cfg.ElectraForkEpoch = cfg.DenebForkEpoch.Add(2)
+		params.OverrideBeaconConfig(cfg)
+		slot, err := slots.EpochStart(params.BeaconConfig().ElectraForkEpoch)
+		require.NoError(t, err)
+		electraS := struct {
+			SignedBlock struct {
+				Message struct {
+					Slot string
+				} `json:"message"`
+			} `json:"signed_block"`
+		}{}
+		electraS.SignedBlock = struct {
+			Message struct {
+				Slot string
+			} `json:"message"`
+		}{
+			Message: struct {
+				Slot string
+			}{Slot: strconv.FormatUint(uint64(slot), 10)},
+		}
+		j, err := json.Marshal(electraS)
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(j)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+		runDefault, errJson := setInitialPublishBlockPostRequest(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		assert.Equal(t, reflect.TypeOf(SignedBeaconBlockContentsElectraJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
+	})
 }
 
 func TestPreparePublishedBlock(t *testing.T) {
@@ -218,6 +254,23 @@ func TestPreparePublishedBlock(t *testing.T) {
 		assert.Equal(t, true, ok)
 	})
 
+	t.Run("Electra", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &SignedBeaconBlockContentsElectraJson{
+				SignedBlock: &SignedBeaconBlockElectraJson{
+					Message: &BeaconBlockElectraJson{
+						Body: &BeaconBlockBodyElectraJson{},
+					},
+				},
+				SignedBlobSidecars: []*SignedBlobSidecarJson{},
+			},
+		}
+		errJson := preparePublishedBlock(endpoint, nil, nil)
+		require.Equal(t, true, errJson == nil)
+		_, ok := endpoint.PostRequest.(*electraPublishBlockRequestJson)
+		assert.Equal(t, true, ok)
+	})
+
 	t.Run("unsupported block type", func(t *testing.T) {
 		errJson := preparePublishedBlock(&apimiddleware.Endpoint{}, nil, nil)
 		assert.Equal(t, true, strings.Contains(errJson.Msg(), "unsupported block type"))
@@ -330,6 +383,39 @@ func TestSetInitialPublishBlindedBlockPostRequest(t *testing.T) {
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
 		assert.Equal(t, reflect.TypeOf(SignedBlindedBeaconBlockContentsDenebJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
 	})
+	t.Run("Electra", func(t *testing.T) {
+		cfg.ElectraForkEpoch = params.BeaconConfig().DenebForkEpoch + 1
+		params.OverrideBeaconConfig(cfg)
+		params.BeaconConfig().InitializeForkSchedule()
+		slot, err := slots.EpochStart(params.BeaconConfig().ElectraForkEpoch)
+		require.NoError(t, err)
+		electraS := struct {
+			SignedBlindedBlock struct {
+				Message struct {
+					Slot string
+				} `json:"message"`
+			} `json:"signed_blinded_block"`
+		}{}
+		electraS.SignedBlindedBlock = struct {
+			Message struct {
+				Slot string
+			} `json:"message"`
+		}{
+			Message: struct {
+				Slot string
+			}{Slot: strconv.FormatUint(uint64(slot), 10)},
+		}
+		j, err := json.Marshal(electraS)
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(j)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+		runDefault, errJson := setInitialPublishBlindedBlockPostRequest(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		assert.Equal(t, reflect.TypeOf(SignedBlindedBeaconBlockContentsElectraJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
+	})
 }
 
 func TestPreparePublishedBlindedBlock(t *testing.T) {
@@ -402,6 +488,20 @@ func TestPreparePublishedBlindedBlock(t *testing.T) {
 		_, ok := endpoint.PostRequest.(*denebPublishBlindedBlockRequestJson)
 		assert.Equal(t, true, ok)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &SignedBlindedBeaconBlockContentsElectraJson{
+				SignedBlindedBlock: &SignedBlindedBeaconBlockElectraJson{
+					Message: &BlindedBeaconBlockElectraJson{},
+				},
+				SignedBlindedBlobSidecars: []*SignedBlindedBlobSidecarJson{},
+			},
+		}
+		errJson := preparePublishedBlindedBlock(endpoint, nil, nil)
+		require.Equal(t, true, errJson == nil)
+		_, ok := endpoint.PostRequest.(*electraPublishBlindedBlockRequestJson)
+		assert.Equal(t, true, ok)
+	})
 	t.Run("unsupported block type", func(t *testing.T) {
 		errJson := preparePublishedBlock(&apimiddleware.Endpoint{}, nil, nil)
 		assert.Equal(t, true, strings.Contains(errJson.Msg(), "unsupported block type"))
@@ -530,6 +630,39 @@ func TestSerializeV2Block(t *testing.T) {
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 	})
 
+	t.Run("Electra", func(t *testing.T) {
+		response := &BlockV2ResponseJson{
+			Version: ethpbv2.Version_ELECTRA.String(),
+			Data: &SignedBeaconBlockContainerV2Json{
+				ElectraBlock: &BeaconBlockElectraJson{
+					Slot:          "1",
+					ProposerIndex: "1",
+					ParentRoot:    "root",
+					StateRoot:     "root",
+					Body:          &BeaconBlockBodyElectraJson{},
+				},
+				Signature: "sig",
+			},
+			ExecutionOptimistic: true,
+		}
+		runDefault, j, errJson := serializeV2Block(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &electraBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		require.NotNil(t, resp.Data.Message)
+		beaconBlock := resp.Data.Message
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "1", beaconBlock.ProposerIndex)
+		assert.Equal(t, "root", beaconBlock.ParentRoot)
+		assert.Equal(t, "root", beaconBlock.StateRoot)
+		assert.NotNil(t, beaconBlock.Body)
+		assert.Equal(t, "sig", resp.Data.Signature)
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+	})
+
 	t.Run("incorrect response type", func(t *testing.T) {
 		response := &types.Empty{}
 		runDefault, j, errJson := serializeV2Block(response)
@@ -716,6 +849,39 @@ func TestSerializeBlindedBlock(t *testing.T) {
 
 	})
 
+	t.Run("Electra", func(t *testing.T) {
+		response := &BlindedBlockResponseJson{
+			Version: ethpbv2.Version_ELECTRA.String(),
+			Data: &SignedBlindedBeaconBlockContainerJson{
+				ElectraBlock: &BlindedBeaconBlockElectraJson{
+					Slot:          "1",
+					ProposerIndex: "1",
+					ParentRoot:    "root",
+					StateRoot:     "root",
+					Body:          &BlindedBeaconBlockBodyElectraJson{},
+				},
+				Signature: "sig",
+			},
+			ExecutionOptimistic: true,
+		}
+		runDefault, j, errJson := serializeBlindedBlock(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &electraBlindedBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		require.NotNil(t, resp.Data.Message)
+		beaconBlock := resp.Data.Message
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "1", beaconBlock.ProposerIndex)
+		assert.Equal(t, "root", beaconBlock.ParentRoot)
+		assert.Equal(t, "root", beaconBlock.StateRoot)
+		assert.NotNil(t, beaconBlock.Body)
+		assert.Equal(t, "sig", resp.Data.Signature)
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+	})
+
 	t.Run("incorrect response type", func(t *testing.T) {
 		response := &types.Empty{}
 		runDefault, j, errJson := serializeBlindedBlock(response)
@@ -798,6 +964,21 @@ func TestSerializeV2State(t *testing.T) {
 		require.NoError(t, json.Unmarshal(j, &capellaStateResponseJson{}))
 	})
 
+	t.Run("Electra", func(t *testing.T) {
+		response := &BeaconStateV2ResponseJson{
+			Version: ethpbv2.Version_ELECTRA.String(),
+			Data: &BeaconStateContainerV2Json{
+				Phase0State:  nil,
+				ElectraState: &BeaconStateElectraJson{},
+			},
+		}
+		runDefault, j, errJson := serializeV2State(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		require.NoError(t, json.Unmarshal(j, &electraStateResponseJson{}))
+	})
+
 	t.Run("incorrect response type", func(t *testing.T) {
 		runDefault, j, errJson := serializeV2State(&types.Empty{})
 		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
@@ -983,6 +1164,56 @@ func TestSerializeProducedV2Block(t *testing.T) {
 		assert.Equal(t, "kzgcommitment", sidecar.KzgCommitment)
 		assert.Equal(t, "kzgproof", sidecar.KzgProof)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		response := &ProduceBlockResponseV2Json{
+			Version: ethpbv2.Version_ELECTRA.String(),
+			Data: &BeaconBlockContainerV2Json{
+				ElectraContents: &BeaconBlockContentsElectraJson{
+					Block: &BeaconBlockElectraJson{
+						Slot:          "1",
+						ProposerIndex: "1",
+						ParentRoot:    "root",
+						StateRoot:     "root",
+						Body:          &BeaconBlockBodyElectraJson{},
+					},
+					BlobSidecars: []*BlobSidecarJson{{
+						BlockRoot:       "root",
+						Index:           "1",
+						Slot:            "1",
+						BlockParentRoot: "root",
+						ProposerIndex:   "1",
+						Blob:            "blob",
+						KzgCommitment:   "kzgcommitment",
+						KzgProof:        "kzgproof",
+					}},
+				},
+			},
+		}
+		runDefault, j, errJson := serializeProducedV2Block(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &electraProduceBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		require.NotNil(t, resp.Data)
+		beaconBlock := resp.Data.Block
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "1", beaconBlock.ProposerIndex)
+		assert.Equal(t, "root", beaconBlock.ParentRoot)
+		assert.Equal(t, "root", beaconBlock.StateRoot)
+		assert.NotNil(t, beaconBlock.Body)
+		require.Equal(t, 1, len(resp.Data.BlobSidecars))
+		sidecar := resp.Data.BlobSidecars[0]
+		assert.Equal(t, "root", sidecar.BlockRoot)
+		assert.Equal(t, "1", sidecar.Index)
+		assert.Equal(t, "1", sidecar.Slot)
+		assert.Equal(t, "root", sidecar.BlockParentRoot)
+		assert.Equal(t, "1", sidecar.ProposerIndex)
+		assert.Equal(t, "blob", sidecar.Blob)
+		assert.Equal(t, "kzgcommitment", sidecar.KzgCommitment)
+		assert.Equal(t, "kzgproof", sidecar.KzgProof)
+	})
 	t.Run("incorrect response type", func(t *testing.T) {
 		response := &types.Empty{}
 		runDefault, j, errJson := serializeProducedV2Block(response)
@@ -1121,6 +1352,37 @@ func TestSerializeProduceBlindedBlock(t *testing.T) {
 		require.NotNil(t, beaconBlock.Body)
 	})
 
+	t.Run("Electra", func(t *testing.T) {
+		response := &ProduceBlindedBlockResponseJson{
+			Version: ethpbv2.Version_ELECTRA.String(),
+			Data: &BlindedBeaconBlockContainerJson{
+				ElectraContents: &BlindedBeaconBlockContentsElectraJson{
+					BlindedBlock: &BlindedBeaconBlockElectraJson{
+						Slot:          "1",
+						ProposerIndex: "1",
+						ParentRoot:    "root",
+						StateRoot:     "root",
+						Body:          &BlindedBeaconBlockBodyElectraJson{},
+					},
+					BlindedBlobSidecars: []*BlindedBlobSidecarJson{},
+				},
+			},
+		}
+		runDefault, j, errJson := serializeProducedBlindedBlock(response)
+		require.Equal(t, nil, errJson)
+		require.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		require.NotNil(t, j)
+		resp := &electraProduceBlindedBlockResponseJson{}
+		require.NoError(t, json.Unmarshal(j, resp))
+		require.NotNil(t, resp.Data)
+		beaconBlock := resp.Data.BlindedBlock
+		assert.Equal(t, "1", beaconBlock.Slot)
+		assert.Equal(t, "1", beaconBlock.ProposerIndex)
+		assert.Equal(t, "root", beaconBlock.ParentRoot)
+		assert.Equal(t, "root", beaconBlock.StateRoot)
+		require.NotNil(t, beaconBlock.Body)
+	})
+
 	t.Run("incorrect response type", func(t *testing.T) {
 		response := &types.Empty{}
 		runDefault, j, errJson := serializeProducedV2Block(response)
@@ -262,6 +262,7 @@ type SignedBeaconBlockContainerV2Json struct {
 	BellatrixBlock *BeaconBlockBellatrixJson `json:"bellatrix_block"`
 	CapellaBlock   *BeaconBlockCapellaJson   `json:"capella_block"`
 	DenebBlock     *BeaconBlockDenebJson     `json:"deneb_block"`
+	ElectraBlock   *BeaconBlockElectraJson   `json:"electra_block"`
 	Signature      string                    `json:"signature" hex:"true"`
 }
 
@@ -271,31 +272,35 @@ type SignedBlindedBeaconBlockContainerJson struct {
 	BellatrixBlock *BlindedBeaconBlockBellatrixJson `json:"bellatrix_block"`
 	CapellaBlock   *BlindedBeaconBlockCapellaJson   `json:"capella_block"`
 	DenebBlock     *BlindedBeaconBlockDenebJson     `json:"deneb_block"`
+	ElectraBlock   *BlindedBeaconBlockElectraJson   `json:"electra_block"`
 	Signature      string                           `json:"signature" hex:"true"`
 }
 
 type SignedBlindedBeaconBlockContentsContainerJson struct {
-	Phase0Block    *SignedBeaconBlockJson                     `json:"phase0_block"`
-	AltairBlock    *SignedBeaconBlockAltairJson               `json:"altair_block"`
-	BellatrixBlock *SignedBlindedBeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *SignedBlindedBeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *SignedBlindedBeaconBlockContentsDenebJson `json:"deneb_contents"`
+	Phase0Block     *SignedBeaconBlockJson                       `json:"phase0_block"`
+	AltairBlock     *SignedBeaconBlockAltairJson                 `json:"altair_block"`
+	BellatrixBlock  *SignedBlindedBeaconBlockBellatrixJson       `json:"bellatrix_block"`
+	CapellaBlock    *SignedBlindedBeaconBlockCapellaJson         `json:"capella_block"`
+	DenebContents   *SignedBlindedBeaconBlockContentsDenebJson   `json:"deneb_contents"`
+	ElectraContents *SignedBlindedBeaconBlockContentsElectraJson `json:"electra_contents"`
 }
 
 type BeaconBlockContainerV2Json struct {
-	Phase0Block    *BeaconBlockJson              `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson        `json:"altair_block"`
-	BellatrixBlock *BeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *BeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *BeaconBlockContentsDenebJson `json:"deneb_contents"`
+	Phase0Block     *BeaconBlockJson                `json:"phase0_block"`
+	AltairBlock     *BeaconBlockAltairJson          `json:"altair_block"`
+	BellatrixBlock  *BeaconBlockBellatrixJson       `json:"bellatrix_block"`
+	CapellaBlock    *BeaconBlockCapellaJson         `json:"capella_block"`
+	DenebContents   *BeaconBlockContentsDenebJson   `json:"deneb_contents"`
+	ElectraContents *BeaconBlockContentsElectraJson `json:"electra_contents"`
 }
 
 type BlindedBeaconBlockContainerJson struct {
-	Phase0Block    *BeaconBlockJson                     `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson               `json:"altair_block"`
-	BellatrixBlock *BlindedBeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *BlindedBeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *BlindedBeaconBlockContentsDenebJson `json:"deneb_contents"`
+	Phase0Block     *BeaconBlockJson                       `json:"phase0_block"`
+	AltairBlock     *BeaconBlockAltairJson                 `json:"altair_block"`
+	BellatrixBlock  *BlindedBeaconBlockBellatrixJson       `json:"bellatrix_block"`
+	CapellaBlock    *BlindedBeaconBlockCapellaJson         `json:"capella_block"`
+	DenebContents   *BlindedBeaconBlockContentsDenebJson   `json:"deneb_contents"`
+	ElectraContents *BlindedBeaconBlockContentsElectraJson `json:"electra_contents"`
 }
 
 type SignedBeaconBlockAltairJson struct {
@@ -318,6 +323,11 @@ type SignedBeaconBlockContentsDenebJson struct {
 	SignedBlobSidecars []*SignedBlobSidecarJson    `json:"signed_blob_sidecars"`
 }
 
+type SignedBeaconBlockContentsElectraJson struct {
+	SignedBlock        *SignedBeaconBlockElectraJson `json:"signed_block"`
+	SignedBlobSidecars []*SignedBlobSidecarJson      `json:"signed_blob_sidecars"`
+}
+
 type SignedBlobSidecarJson struct {
 	Message   *BlobSidecarJson `json:"message"`
 	Signature string           `json:"signature" hex:"true"`
@@ -350,6 +360,11 @@ type SignedBeaconBlockDenebJson struct {
 	Signature string                `json:"signature" hex:"true"`
 }
 
+type SignedBeaconBlockElectraJson struct {
+	Message   *BeaconBlockElectraJson `json:"message"`
+	Signature string                  `json:"signature" hex:"true"`
+}
+
 type SignedBlindedBeaconBlockBellatrixJson struct {
 	Message   *BlindedBeaconBlockBellatrixJson `json:"message"`
 	Signature string                           `json:"signature" hex:"true"`
@@ -365,11 +380,21 @@ type SignedBlindedBeaconBlockContentsDenebJson struct {
 	SignedBlindedBlobSidecars []*SignedBlindedBlobSidecarJson    `json:"signed_blinded_blob_sidecars"`
 }
 
+type SignedBlindedBeaconBlockContentsElectraJson struct {
+	SignedBlindedBlock        *SignedBlindedBeaconBlockElectraJson `json:"signed_blinded_block"`
+	SignedBlindedBlobSidecars []*SignedBlindedBlobSidecarJson      `json:"signed_blinded_blob_sidecars"`
+}
+
 type SignedBlindedBeaconBlockDenebJson struct {
 	Message   *BlindedBeaconBlockDenebJson `json:"message"`
 	Signature string                       `json:"signature" hex:"true"`
 }
 
+type SignedBlindedBeaconBlockElectraJson struct {
+	Message   *BlindedBeaconBlockElectraJson `json:"message"`
+	Signature string                         `json:"signature" hex:"true"`
+}
+
 type SignedBlindedBlobSidecarJson struct {
 	Message   *BlindedBlobSidecarJson `json:"message"`
 	Signature string                  `json:"signature" hex:"true"`
@@ -404,6 +429,11 @@ type BeaconBlockContentsDenebJson struct {
 	BlobSidecars []*BlobSidecarJson    `json:"blob_sidecars"`
 }
 
+type BeaconBlockContentsElectraJson struct {
+	Block        *BeaconBlockElectraJson `json:"block"`
+	BlobSidecars []*BlobSidecarJson      `json:"blob_sidecars"`
+}
+
 type BeaconBlockDenebJson struct {
 	Slot          string                    `json:"slot"`
 	ProposerIndex string                    `json:"proposer_index"`
@@ -412,6 +442,14 @@ type BeaconBlockDenebJson struct {
 	Body          *BeaconBlockBodyDenebJson `json:"body"`
 }
 
+type BeaconBlockElectraJson struct {
+	Slot          string                      `json:"slot"`
+	ProposerIndex string                      `json:"proposer_index"`
+	ParentRoot    string                      `json:"parent_root" hex:"true"`
+	StateRoot     string                      `json:"state_root" hex:"true"`
+	Body          *BeaconBlockBodyElectraJson `json:"body"`
+}
+
 type BlindedBeaconBlockBellatrixJson struct {
 	Slot          string                               `json:"slot"`
 	ProposerIndex string                               `json:"proposer_index"`
@@ -436,11 +474,24 @@ type BlindedBeaconBlockDenebJson struct {
 	Body          *BlindedBeaconBlockBodyDenebJson `json:"body"`
 }
 
+type BlindedBeaconBlockElectraJson struct {
+	Slot          string                             `json:"slot"`
+	ProposerIndex string                             `json:"proposer_index"`
+	ParentRoot    string                             `json:"parent_root" hex:"true"`
+	StateRoot     string                             `json:"state_root" hex:"true"`
+	Body          *BlindedBeaconBlockBodyElectraJson `json:"body"`
+}
+
 type BlindedBeaconBlockContentsDenebJson struct {
 	BlindedBlock        *BlindedBeaconBlockDenebJson `json:"blinded_block"`
 	BlindedBlobSidecars []*BlindedBlobSidecarJson    `json:"blinded_blob_sidecars"`
 }
 
+type BlindedBeaconBlockContentsElectraJson struct {
+	BlindedBlock        *BlindedBeaconBlockElectraJson `json:"blinded_block"`
+	BlindedBlobSidecars []*BlindedBlobSidecarJson      `json:"blinded_blob_sidecars"`
+}
+
 // todo unit act
 type BeaconBlockBodyAltairJson struct {
 	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
@@ -511,6 +562,26 @@ type BeaconBlockBodyDenebJson struct {
 	BlobKzgCommitments    []string                          `json:"blob_kzg_commitments" hex:"true"`
 }
 
+// todo unit act
+type BeaconBlockBodyElectraJson struct {
+	RandaoReveal          string                            `json:"randao_reveal" hex:"true"`
+	Eth1Data              *Eth1DataJson                     `json:"eth1_data"`
+	Graffiti              string                            `json:"graffiti" hex:"true"`
+	ProposerSlashings     []*ProposerSlashingJson           `json:"proposer_slashings"`
+	AttesterSlashings     []*AttesterSlashingJson           `json:"attester_slashings"`
+	Attestations          []*AttestationJson                `json:"attestations"`
+	Deposits              []*DepositJson                    `json:"deposits"`
+	VoluntaryExits        []*SignedVoluntaryExitJson        `json:"voluntary_exits"`
+	ActivityChanges       []*ActivityChangeJson             `json:"activity_changes"`
+	TransactionsCount     string                            `json:"transactions_count"`
+	BaseFee               string                            `json:"base_fee"`
+	SyncAggregate         *SyncAggregateJson                `json:"sync_aggregate"`
+	ExecutionPayload      *ExecutionPayloadDenebJson        `json:"execution_payload"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChangeJson `json:"bls_to_execution_changes"`
+	BlobKzgCommitments    []string                          `json:"blob_kzg_commitments" hex:"true"`
+	ContractTransfers     []*SignedContractTransferJson     `json:"contract_transfers"`
+}
+
 // todo unit act
 type BlindedBeaconBlockBodyBellatrixJson struct {
 	RandaoReveal           string                      `json:"randao_reveal" hex:"true"`
@@ -565,6 +636,26 @@ type BlindedBeaconBlockBodyDenebJson struct {
 	BlobKzgCommitments     []string                          `json:"blob_kzg_commitments" hex:"true"`
 }
 
+// todo unit act
+type BlindedBeaconBlockBodyElectraJson struct {
+	RandaoReveal           string                            `json:"randao_reveal" hex:"true"`
+	Eth1Data               *Eth1DataJson                     `json:"eth1_data"`
+	Graffiti               string                            `json:"graffiti" hex:"true"`
+	ProposerSlashings      []*ProposerSlashingJson           `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashingJson           `json:"attester_slashings"`
+	Attestations           []*AttestationJson                `json:"attestations"`
+	Deposits               []*DepositJson                    `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExitJson        `json:"voluntary_exits"`
+	ActivityChanges        []*ActivityChangeJson             `json:"activity_changes"`
+	TransactionsCount      string                            `json:"transactions_count"`
+	BaseFee                string                            `json:"base_fee"`
+	SyncAggregate          *SyncAggregateJson                `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDenebJson  `json:"execution_payload_header"`
+	BLSToExecutionChanges  []*SignedBLSToExecutionChangeJson `json:"bls_to_execution_changes"`
+	BlobKzgCommitments     []string                          `json:"blob_kzg_commitments" hex:"true"`
+	ContractTransfers      []*SignedContractTransferJson     `json:"contract_transfers"`
+}
+
 type ExecutionPayloadJson struct {
 	ParentHash    string   `json:"parent_hash" hex:"true"`
 	FeeRecipient  string   `json:"fee_recipient" hex:"true"`
@@ -781,6 +872,20 @@ type ActivityChangeJson struct {
 	DeltaActivity   string `json:"delta_activity"`
 }
 
+type SignedContractTransferJson struct {
+	Message            *ContractTransferJson `json:"message"`
+	Signature          string                `json:"signature" hex:"true"`
+	RecipientSignature string                `json:"recipient_signature" hex:"true"`
+}
+
+type ContractTransferJson struct {
+	ValidatorIndex  string `json:"validator_index"`
+	RecipientIndex  string `json:"recipient_index"`
+	Contract        string `json:"contract" hex:"true"`
+	ValidUntilEpoch string `json:"valid_until_epoch"`
+	Nonce           string `json:"nonce"`
+}
+
 type VoluntaryExitJson struct {
 	Epoch          string `json:"epoch"`
 	ValidatorIndex string `json:"validator_index"`
@@ -974,12 +1079,73 @@ type BeaconStateDenebJson struct {
 	HistoricalSummaries          []*HistoricalSummaryJson         `json:"historical_summaries"`
 }
 
+// todo unit act
+type BeaconStateElectraJson struct {
+	GenesisTime                  string                           `json:"genesis_time"`
+	GenesisValidatorsRoot        string                           `json:"genesis_validators_root" hex:"true"`
+	Slot                         string                           `json:"slot"`
+	Fork                         *ForkJson                        `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeaderJson           `json:"latest_block_header"`
+	BlockRoots                   []string                         `json:"block_roots" hex:"true"`
+	StateRoots                   []string                         `json:"state_roots" hex:"true"`
+	HistoricalRoots              []string                         `json:"historical_roots" hex:"true"`
+	Eth1Data                     *Eth1DataJson                    `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1DataJson                  `json:"eth1_data_votes"`
+	Eth1DepositIndex             string                           `json:"eth1_deposit_index"`
+	SharedActivity               *SharedActivityJson              `json:"shared_activity"`
+	Validators                   []*ValidatorJson                 `json:"validators"`
+	Balances                     []string                         `json:"balances"`
+	Activities                   []string                         `json:"activities"`
+	RandaoMixes                  []string                         `json:"randao_mixes" hex:"true"`
+	Slashings                    []string                         `json:"slashings"`
+	PreviousEpochParticipation   EpochParticipation               `json:"previous_epoch_participation"`
+	CurrentEpochParticipation    EpochParticipation               `json:"current_epoch_participation"`
+	JustificationBits            string                           `json:"justification_bits" hex:"true"`
+	PreviousJustifiedCheckpoint  *CheckpointJson                  `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *CheckpointJson                  `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *CheckpointJson                  `json:"finalized_checkpoint"`
+	InactivityScores             []string                         `json:"inactivity_scores"`
+	CurrentSyncCommittee         *SyncCommitteeJson               `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommitteeJson               `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderDenebJson `json:"latest_execution_payload_header"`
+	NextWithdrawalIndex          string                           `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex string                           `json:"next_withdrawal_validator_index"`
+	HistoricalSummaries          []*HistoricalSummaryJson         `json:"historical_summaries"`
+	AdditionalContracts          []*ValidatorContractJson         `json:"additional_contracts"`
+	PendingActivityCredits       []*PendingActivityCreditJson     `json:"pending_activity_credits"`
+	RejectedContractBindings     []*RejectedContractBindingJson   `json:"rejected_contract_bindings"`
+	ContractTransferNonces       []*ContractTransferNonceJson     `json:"contract_transfer_nonces"`
+}
+
+type ValidatorContractJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Contract       string `json:"contract" hex:"true"`
+}
+
+type PendingActivityCreditJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Activity       string `json:"activity"`
+}
+
+type RejectedContractBindingJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Contract       string `json:"contract" hex:"true"`
+	OwnerIndex     string `json:"owner_index"`
+	Epoch          string `json:"epoch"`
+}
+
+type ContractTransferNonceJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Nonce          string `json:"nonce"`
+}
+
 type BeaconStateContainerV2Json struct {
 	Phase0State    *BeaconStateJson          `json:"phase0_state"`
 	AltairState    *BeaconStateAltairJson    `json:"altair_state"`
 	BellatrixState *BeaconStateBellatrixJson `json:"bellatrix_state"`
 	CapellaState   *BeaconStateCapellaJson   `json:"capella_state"`
 	DenebState     *BeaconStateDenebJson     `json:"deneb_state"`
+	ElectraState   *BeaconStateElectraJson   `json:"electra_state"`
 }
 
 type ForkJson struct {
@@ -1213,6 +1379,29 @@ type EventChainReorgJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventActivityChangesJson struct {
+	Slot                string                `json:"slot"`
+	Block               string                `json:"block" hex:"true"`
+	ActivityChanges     []*ActivityChangeJson `json:"activity_changes"`
+	TransactionsCount   string                `json:"transactions_count"`
+	BaseFee             string                `json:"base_fee"`
+	ExecutionOptimistic bool                  `json:"execution_optimistic"`
+}
+
+type EventSharedActivityJson struct {
+	Epoch               string                        `json:"epoch"`
+	Block               string                        `json:"block" hex:"true"`
+	SharedActivity      *SharedActivityJson           `json:"shared_activity"`
+	TopMovers           []*EffectiveActivityMoverJson `json:"top_movers"`
+	ExecutionOptimistic bool                          `json:"execution_optimistic"`
+}
+
+type EffectiveActivityMoverJson struct {
+	ValidatorIndex            string `json:"validator_index"`
+	PreviousEffectiveActivity string `json:"previous_effective_activity"`
+	EffectiveActivity         string `json:"effective_activity"`
+}
+
 type EventPayloadAttributeStreamV1Json struct {
 	Version string `json:"version"`
 	Data    *EventPayloadAttributeV1Json
@@ -122,6 +122,27 @@ func TestServer_GetBlindedBlock(t *testing.T) {
 		assert.DeepEqual(t, expected, denebBlock.DenebBlock)
 		assert.Equal(t, ethpbv2.Version_DENEB, resp.Version)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b := util.NewBlindedBeaconBlockElectra()
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{}
+		bs := &Server{
+			FinalizationFetcher:   mockChainService,
+			Blocker:               &testutil.MockBlocker{BlockToReturn: blk},
+			OptimisticModeFetcher: mockChainService,
+		}
+
+		expected, err := migration.V1Alpha1BeaconBlockBlindedElectraToV2Blinded(b.Message)
+		require.NoError(t, err)
+		resp, err := bs.GetBlindedBlock(ctx, &ethpbv1.BlockRequest{})
+		require.NoError(t, err)
+		electraBlock, ok := resp.Data.Message.(*ethpbv2.SignedBlindedBeaconBlockContainer_ElectraBlock)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, expected, electraBlock.ElectraBlock)
+		assert.Equal(t, ethpbv2.Version_ELECTRA, resp.Version)
+	})
 	t.Run("execution optimistic", func(t *testing.T) {
 		b := util.NewBlindedBeaconBlockBellatrix()
 		blk, err := blocks.NewSignedBeaconBlock(b)
@@ -281,6 +302,26 @@ func TestServer_GetBlindedBlockSSZ(t *testing.T) {
 		assert.DeepEqual(t, expected, resp.Data)
 		assert.Equal(t, ethpbv2.Version_DENEB, resp.Version)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b := util.NewBlindedBeaconBlockElectra()
+		blk, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{}
+		bs := &Server{
+			FinalizationFetcher:   mockChainService,
+			Blocker:               &testutil.MockBlocker{BlockToReturn: blk},
+			OptimisticModeFetcher: mockChainService,
+		}
+
+		expected, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		resp, err := bs.GetBlindedBlockSSZ(ctx, &ethpbv1.BlockRequest{})
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.DeepEqual(t, expected, resp.Data)
+		assert.Equal(t, ethpbv2.Version_ELECTRA, resp.Version)
+	})
 	t.Run("execution optimistic", func(t *testing.T) {
 		b := util.NewBlindedBeaconBlockBellatrix()
 		blk, err := blocks.NewSignedBeaconBlock(b)
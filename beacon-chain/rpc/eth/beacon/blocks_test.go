@@ -177,6 +177,31 @@ func TestServer_GetBlockV2(t *testing.T) {
 		assert.DeepEqual(t, v1Block, bellatrixBlock.CapellaBlock)
 		assert.Equal(t, ethpbv2.Version_CAPELLA, blk.Version)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b := util.NewBeaconBlockElectra()
+		b.Block.Slot = 123
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		mockBlockFetcher := &testutil.MockBlocker{BlockToReturn: sb}
+		mockChainService := &mock.ChainService{
+			FinalizedRoots: map[[32]byte]bool{},
+		}
+		bs := &Server{
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			Blocker:               mockBlockFetcher,
+		}
+
+		blk, err := bs.GetBlockV2(ctx, &ethpbv2.BlockRequestV2{})
+		require.NoError(t, err)
+
+		v1Block, err := migration.V1Alpha1BeaconBlockElectraToV2(b.Block)
+		require.NoError(t, err)
+		electraBlock, ok := blk.Data.Message.(*ethpbv2.SignedBeaconBlockContainer_ElectraBlock)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, v1Block, electraBlock.ElectraBlock)
+		assert.Equal(t, ethpbv2.Version_ELECTRA, blk.Version)
+	})
 	t.Run("execution optimistic", func(t *testing.T) {
 		b := util.NewBeaconBlockBellatrix()
 		sb, err := blocks.NewSignedBeaconBlock(b)
@@ -344,6 +369,29 @@ func TestServer_GetBlockSSZV2(t *testing.T) {
 		assert.DeepEqual(t, sszBlock, resp.Data)
 		assert.Equal(t, ethpbv2.Version_CAPELLA, resp.Version)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b := util.NewBeaconBlockElectra()
+		b.Block.Slot = 123
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{
+			FinalizedRoots: map[[32]byte]bool{},
+		}
+		bs := &Server{
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			Blocker:               &testutil.MockBlocker{BlockToReturn: sb},
+		}
+
+		resp, err := bs.GetBlockSSZV2(ctx, &ethpbv2.BlockRequestV2{})
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+		sszBlock, err := b.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszBlock, resp.Data)
+		assert.Equal(t, ethpbv2.Version_ELECTRA, resp.Version)
+	})
 	t.Run("execution optimistic", func(t *testing.T) {
 		b := util.NewBeaconBlockBellatrix()
 		sb, err := blocks.NewSignedBeaconBlock(b)
@@ -53,6 +53,8 @@ func TestGetSpec(t *testing.T) {
 	config.CapellaForkEpoch = 103
 	config.DenebForkVersion = []byte("DenebForkVersion")
 	config.DenebForkEpoch = 105
+	config.ElectraForkVersion = []byte("ElectraForkVersion")
+	config.ElectraForkEpoch = 107
 	config.BLSWithdrawalPrefixByte = byte('b')
 	config.ETH1AddressWithdrawalPrefixByte = byte('c')
 	config.ProposerWeight = 8
@@ -142,7 +144,7 @@ func TestGetSpec(t *testing.T) {
 	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	assert.Equal(t, 112, len(resp.Data))
+	assert.Equal(t, 114, len(resp.Data))
 	for k, v := range resp.Data {
 		switch k {
 		case "CONFIG_NAME":
@@ -215,6 +217,10 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "0x"+hex.EncodeToString([]byte("DenebForkVersion")), v)
 		case "DENEB_FORK_EPOCH":
 			assert.Equal(t, "105", v)
+		case "ELECTRA_FORK_VERSION":
+			assert.Equal(t, "0x"+hex.EncodeToString([]byte("ElectraForkVersion")), v)
+		case "ELECTRA_FORK_EPOCH":
+			assert.Equal(t, "107", v)
 		case "MIN_ANCHOR_POW_BLOCK_DIFFICULTY":
 			assert.Equal(t, "1000", v)
 		case "BLS_WITHDRAWAL_PREFIX":
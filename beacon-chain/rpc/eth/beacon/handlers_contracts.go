@@ -0,0 +1,314 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/api/pagination"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition/stateutils"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// boundContract is a contract address together with the index of the validator it is bound to.
+type boundContract struct {
+	address [fieldparams.ContractAddressLength]byte
+	owner   primitives.ValidatorIndex
+}
+
+// GetContracts returns a paginated list of the contracts bound to validators, optionally filtered by the status
+// of their owners. Contracts are ordered by the index of their owner, the primary contract of a validator first.
+func (s *Server) GetContracts(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetContracts")
+	defer span.End()
+
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+
+	pageSize := 0
+	if rawPageSize := r.URL.Query().Get("page_size"); rawPageSize != "" {
+		ps, err := strconv.Atoi(rawPageSize)
+		if err != nil || ps < 0 {
+			http2.HandleError(w, "Invalid page_size "+rawPageSize, http.StatusBadRequest)
+			return
+		}
+		pageSize = ps
+	}
+	if pageSize > cmd.Get().MaxRPCPageSize {
+		http2.HandleError(w, fmt.Sprintf("Requested page size %d can not be greater than max size %d", pageSize, cmd.Get().MaxRPCPageSize), http.StatusBadRequest)
+		return
+	}
+
+	statuses := r.URL.Query()["status"]
+	filteredStatuses := make(map[validator.ValidatorStatus]bool, len(statuses))
+	for _, ss := range statuses {
+		ok, vs := validator.ValidatorStatusFromString(strings.ToLower(ss))
+		if !ok {
+			http2.HandleError(w, "Invalid status "+ss, http.StatusBadRequest)
+			return
+		}
+		filteredStatuses[vs] = true
+	}
+
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		http2.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
+
+	contracts, err := boundContracts(st)
+	if err != nil {
+		http2.HandleError(w, "Could not get contracts: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	epoch := slots.ToEpoch(st.Slot())
+	activities := st.Activities()
+	containers := make([]*ContractContainer, 0, len(contracts))
+	for _, c := range contracts {
+		val, err := st.ValidatorAtIndexReadOnly(c.owner)
+		if err != nil {
+			http2.HandleError(w, fmt.Sprintf("Could not get validator at index %d: %s", c.owner, err.Error()), http.StatusInternalServerError)
+			return
+		}
+		valSubStatus, err := helpers.ValidatorSubStatus(val, epoch)
+		if err != nil {
+			http2.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(filteredStatuses) > 0 {
+			valStatus, err := helpers.ValidatorStatus(val, epoch)
+			if err != nil {
+				http2.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if !filteredStatuses[valStatus] && !filteredStatuses[valSubStatus] {
+				continue
+			}
+		}
+		containers = append(containers, contractContainer(c, val, activities[c.owner], valSubStatus))
+	}
+
+	resp := &GetContractsResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                []*ContractContainer{},
+		TotalSize:           strconv.Itoa(len(containers)),
+	}
+	// Paginating an empty list would result in an error.
+	if len(containers) > 0 {
+		start, end, nextPageToken, err := pagination.StartAndEndPage(r.URL.Query().Get("page_token"), pageSize, len(containers))
+		if err != nil {
+			http2.HandleError(w, "Could not paginate results: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp.Data = containers[start:end]
+		resp.NextPageToken = nextPageToken
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetContract returns the validator a contract is bound to, along with the owner's status and activity.
+func (s *Server) GetContract(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetContract")
+	defer span.End()
+
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	rawAddress := mux.Vars(r)["address"]
+	if rawAddress == "" {
+		http2.HandleError(w, "address is required in URL params", http.StatusBadRequest)
+		return
+	}
+	address, err := hexutil.Decode(rawAddress)
+	if err != nil {
+		http2.HandleError(w, "Invalid address: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(address) != fieldparams.ContractAddressLength {
+		http2.HandleError(w, fmt.Sprintf("Address length is %d instead of %d", len(address), fieldparams.ContractAddressLength), http.StatusBadRequest)
+		return
+	}
+
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	c := &boundContract{address: bytesutil.ToBytes20(address)}
+	owner, ok, err := contractOwner(st, c.address, nil)
+	if err != nil {
+		http2.HandleError(w, "Could not get contract owner: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		http2.HandleError(w, "Contract not found", http.StatusNotFound)
+		return
+	}
+	c.owner = owner
+	val, err := st.ValidatorAtIndexReadOnly(owner)
+	if err != nil {
+		http2.HandleError(w, fmt.Sprintf("Could not get validator at index %d: %s", owner, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	valSubStatus, err := helpers.ValidatorSubStatus(val, slots.ToEpoch(st.Slot()))
+	if err != nil {
+		http2.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	act, err := st.ActivityAtIndex(owner)
+	if err != nil {
+		http2.HandleError(w, "Could not get validator activity: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		http2.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
+
+	resp := &GetContractResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                contractContainer(*c, val, act, valSubStatus),
+	}
+	http2.WriteJson(w, resp)
+}
+
+// boundContracts returns the contracts of the state together with the owner they resolve to,
+// i.e. the same bindings GetContract looks up.
+func boundContracts(st state.ReadOnlyBeaconState) ([]boundContract, error) {
+	additional := make(map[primitives.ValidatorIndex][][fieldparams.ContractAddressLength]byte)
+	if st.Version() >= version.Electra {
+		contracts, err := st.AdditionalContracts()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range contracts {
+			additional[c.ValidatorIndex] = append(additional[c.ValidatorIndex], bytesutil.ToBytes20(c.Contract))
+		}
+	}
+	owners, err := allContractOwners(st)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []boundContract
+	appendIfBound := func(address [fieldparams.ContractAddressLength]byte, idx primitives.ValidatorIndex) error {
+		if address == params.BeaconConfig().ZeroContract {
+			return nil
+		}
+		owner, ok, err := contractOwner(st, address, owners)
+		if err != nil {
+			return err
+		}
+		if ok && owner == idx {
+			result = append(result, boundContract{address: address, owner: idx})
+		}
+		return nil
+	}
+	for i := 0; i < st.NumValidators(); i++ {
+		idx := primitives.ValidatorIndex(i)
+		if primary, ok := st.ContractAtIndex(idx); ok {
+			if err := appendIfBound(primary, idx); err != nil {
+				return nil, err
+			}
+		}
+		for _, c := range additional[idx] {
+			if err := appendIfBound(c, idx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// contractOwner returns the validator the contract is bound to. The contract index of the state leaves out the
+// contracts of exited validators, they are looked up in the given map of all owners instead, which is built
+// from the state when nil.
+func contractOwner(
+	st state.ReadOnlyBeaconState,
+	address [fieldparams.ContractAddressLength]byte,
+	owners map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex,
+) (primitives.ValidatorIndex, bool, error) {
+	if owner, ok := st.ValidatorIndexByContract(address); ok {
+		return owner, true, nil
+	}
+	if owners == nil {
+		var err error
+		owners, err = allContractOwners(st)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	owner, ok := owners[address]
+	return owner, ok, nil
+}
+
+// allContractOwners maps every contract bound to a validator to its owner, whether the owner exited or not.
+func allContractOwners(st state.ReadOnlyBeaconState) (map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex, error) {
+	var additional []*ethpb.ValidatorContract
+	if st.Version() >= version.Electra {
+		var err error
+		additional, err = st.AdditionalContracts()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stateutils.ContractIndexMap(st.Validators(), additional, 0), nil
+}
+
+func contractContainer(
+	c boundContract,
+	val state.ReadOnlyValidator,
+	act uint64,
+	valStatus validator.ValidatorStatus,
+) *ContractContainer {
+	return &ContractContainer{
+		Address:           hexutil.Encode(c.address[:]),
+		OwnerIndex:        strconv.FormatUint(uint64(c.owner), 10),
+		OwnerStatus:       valStatus.String(),
+		Activity:          strconv.FormatUint(act, 10),
+		EffectiveActivity: strconv.FormatUint(val.EffectiveActivity(), 10),
+	}
+}
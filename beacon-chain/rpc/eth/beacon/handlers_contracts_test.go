@@ -0,0 +1,224 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	chainMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func contractsTestServer(st state.BeaconState) *Server {
+	chainService := &chainMock.ChainService{}
+	return &Server{
+		Stater: &testutil.MockStater{
+			BeaconState: st,
+		},
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+	}
+}
+
+func contractsTestState(t *testing.T) (state.BeaconState, [20]byte) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 4)
+	vals := st.Validators()
+	for i, val := range vals {
+		val.Contract = bytesutil.PadTo([]byte{0x10, byte(i)}, 20)
+		val.EffectiveActivity = uint64(i) * 1000
+	}
+	// The last validator has exited long ago, its contract is still reported with its owner.
+	vals[3].ExitEpoch = 1
+	vals[3].WithdrawableEpoch = 1
+	require.NoError(t, st.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetActivities([]uint64{10, 11, 12, 13}))
+	additional := bytesutil.ToBytes20([]byte{0xaa, 0xbb})
+	require.NoError(t, st.AppendAdditionalContract(1, additional))
+	return st, additional
+}
+
+func TestGetContracts(t *testing.T) {
+	st, additional := contractsTestState(t)
+	s := contractsTestServer(st)
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 5, len(resp.Data))
+		assert.Equal(t, "5", resp.TotalSize)
+		assert.Equal(t, "", resp.NextPageToken)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{0x10, 1}, 20)), resp.Data[1].Address)
+		assert.Equal(t, hexutil.Encode(additional[:]), resp.Data[2].Address)
+		assert.Equal(t, "1", resp.Data[2].OwnerIndex)
+		assert.Equal(t, "active_ongoing", resp.Data[2].OwnerStatus)
+		assert.Equal(t, "11", resp.Data[2].Activity)
+		assert.Equal(t, "1000", resp.Data[2].EffectiveActivity)
+		assert.Equal(t, "2", resp.Data[3].OwnerIndex)
+		assert.Equal(t, "3", resp.Data[4].OwnerIndex)
+		assert.Equal(t, "withdrawal_possible", resp.Data[4].OwnerStatus)
+	})
+	t.Run("paginated", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?page_size=3&page_token=1", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "2", resp.Data[0].OwnerIndex)
+		assert.Equal(t, "3", resp.Data[1].OwnerIndex)
+		assert.Equal(t, "5", resp.TotalSize)
+
+		request = httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?page_size=3", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp = &GetContractsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, "1", resp.NextPageToken)
+	})
+	t.Run("filter by status", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?status=withdrawal", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].OwnerIndex)
+		assert.Equal(t, "1", resp.TotalSize)
+
+		request = httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?status=active", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp = &GetContractsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 4, len(resp.Data))
+	})
+	t.Run("invalid status", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?status=foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid status foo", e.Message)
+	})
+	t.Run("page token out of range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts?page_size=3&page_token=2", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContracts(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetContract(t *testing.T) {
+	st, additional := contractsTestState(t)
+	s := contractsTestServer(st)
+
+	t.Run("primary contract", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts/{address}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "address": hexutil.Encode(bytesutil.PadTo([]byte{0x10, 2}, 20))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContract(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Data.OwnerIndex)
+		assert.Equal(t, "active_ongoing", resp.Data.OwnerStatus)
+		assert.Equal(t, "12", resp.Data.Activity)
+		assert.Equal(t, "2000", resp.Data.EffectiveActivity)
+	})
+	t.Run("additional contract", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts/{address}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "address": hexutil.Encode(additional[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContract(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, hexutil.Encode(additional[:]), resp.Data.Address)
+		assert.Equal(t, "1", resp.Data.OwnerIndex)
+	})
+	t.Run("contract of exited validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts/{address}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "address": hexutil.Encode(bytesutil.PadTo([]byte{0x10, 3}, 20))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContract(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetContractResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "3", resp.Data.OwnerIndex)
+		assert.Equal(t, "withdrawal_possible", resp.Data.OwnerStatus)
+	})
+	t.Run("unbound contract", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts/{address}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "address": hexutil.Encode(bytesutil.PadTo([]byte{0x10, 9}, 20))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContract(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Contract not found", e.Message)
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/contracts/{address}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "address": "0x1234"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetContract(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Address length is 2 instead of 20", e.Message)
+	})
+}
@@ -3,7 +3,6 @@ package beacon
 import (
 	"fmt"
 	corehelpers "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,7 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/math"
 	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -75,6 +76,11 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 	epoch := slots.ToEpoch(st.Slot())
 	allBalances := st.Balances()
 	allActivities := st.Activities()
+	additionalContracts, err := additionalContractsByIndex(st)
+	if err != nil {
+		http2.HandleError(w, "Could not get additional contracts: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	statuses := r.URL.Query()["status"]
 	for i, ss := range statuses {
@@ -91,9 +97,9 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			if len(ids) == 0 {
-				containers[i] = valContainerFromReadOnlyVal(val, primitives.ValidatorIndex(i), allBalances[i], allActivities[i], valStatus)
+				containers[i] = valContainerFromReadOnlyVal(val, primitives.ValidatorIndex(i), allBalances[i], allActivities[i], additionalContracts[primitives.ValidatorIndex(i)], valStatus)
 			} else {
-				containers[i] = valContainerFromReadOnlyVal(val, ids[i], allBalances[ids[i]], allActivities[ids[i]], valStatus)
+				containers[i] = valContainerFromReadOnlyVal(val, ids[i], allBalances[ids[i]], allActivities[ids[i]], additionalContracts[ids[i]], valStatus)
 			}
 		}
 		resp := &GetValidatorsResponse{
@@ -129,9 +135,9 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 		if filteredStatuses[valStatus] || filteredStatuses[valSubStatus] {
 			var container *ValidatorContainer
 			if len(ids) == 0 {
-				container = valContainerFromReadOnlyVal(val, primitives.ValidatorIndex(i), allBalances[i], allActivities[i], valSubStatus)
+				container = valContainerFromReadOnlyVal(val, primitives.ValidatorIndex(i), allBalances[i], allActivities[i], additionalContracts[primitives.ValidatorIndex(i)], valSubStatus)
 			} else {
-				container = valContainerFromReadOnlyVal(val, ids[i], allBalances[ids[i]], allActivities[ids[i]], valSubStatus)
+				container = valContainerFromReadOnlyVal(val, ids[i], allBalances[ids[i]], allActivities[ids[i]], additionalContracts[ids[i]], valSubStatus)
 			}
 			valContainers = append(valContainers, container)
 		}
@@ -194,7 +200,12 @@ func (s *Server) GetValidator(w http.ResponseWriter, r *http.Request) {
 		http2.HandleError(w, "Could not get validator activity: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	container := valContainerFromReadOnlyVal(readOnlyVals[0], ids[0], bal, act, valSubStatus)
+	additionalContracts, err := additionalContractsByIndex(st)
+	if err != nil {
+		http2.HandleError(w, "Could not get additional contracts: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	container := valContainerFromReadOnlyVal(readOnlyVals[0], ids[0], bal, act, additionalContracts[ids[0]], valSubStatus)
 
 	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
 	if err != nil {
@@ -407,9 +418,9 @@ func (bs *Server) GetValidatorPowers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	maxEffectiveBalance := params.BeaconConfig().MaxEffectiveBalance / params.BeaconConfig().EffectiveBalanceIncrement
 	var totalEffectivePower uint64
-	activeIndices, err := corehelpers.ActiveValidatorIndices(ctx, st, slots.ToEpoch(st.Slot()))
+	epoch := slots.ToEpoch(st.Slot())
+	activeIndices, err := corehelpers.ActiveValidatorIndices(ctx, st, epoch)
 	if err != nil {
 		http2.HandleError(w, "Could not get active validators indices: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -428,24 +439,45 @@ func (bs *Server) GetValidatorPowers(w http.ResponseWriter, r *http.Request) {
 	}
 	transactionsGas := sharedActivity.TransactionsGasPerPeriod / length
 
-	valPowers := make([]*ValidatorPower, len(activeIndices))
+	// The total effective power covers all active validators, only the requested ones are returned.
+	var requested map[primitives.ValidatorIndex]bool
+	if len(ids) > 0 {
+		requested = make(map[primitives.ValidatorIndex]bool, len(ids))
+		for _, id := range ids {
+			requested[id] = true
+		}
+	}
+	valPowers := make([]*ValidatorPower, 0, len(activeIndices))
 
-	for i, idx := range activeIndices {
+	for _, idx := range activeIndices {
 		v, err := st.ValidatorAtIndexReadOnly(idx)
 		if err != nil {
 			http2.HandleError(w, "Could not get validator at index: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
-		effectiveBalance := v.EffectiveBalance() / params.BeaconConfig().EffectiveBalanceIncrement
-		effectiveActivity := v.EffectiveActivity()
-		power := effectiveActivity + transactionsGas
-		effectivePower := power * effectiveBalance / maxEffectiveBalance
-		valPowers[i] = &ValidatorPower{
+		power, err := math.Add64(v.EffectiveActivity(), transactionsGas)
+		if err != nil {
+			http2.HandleError(w, "Could not compute validator power: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		effectivePower, err := corehelpers.EffectivePower(v, transactionsGas, epoch)
+		if err != nil {
+			http2.HandleError(w, "Could not compute validator effective power: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		totalEffectivePower, err = math.Add64(totalEffectivePower, effectivePower)
+		if err != nil {
+			http2.HandleError(w, "Could not compute total effective power: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if requested != nil && !requested[idx] {
+			continue
+		}
+		valPowers = append(valPowers, &ValidatorPower{
 			Index:          strconv.FormatUint(uint64(idx), 10),
 			Power:          strconv.FormatUint(power, 10),
 			EffectivePower: strconv.FormatUint(effectivePower, 10),
-		}
-		totalEffectivePower += effectivePower
+		})
 	}
 
 	data := &ValidatorPowersContainer{
@@ -543,6 +575,7 @@ func valContainerFromReadOnlyVal(
 	id primitives.ValidatorIndex,
 	bal uint64,
 	act uint64,
+	additionalContracts []string,
 	valStatus validator.ValidatorStatus,
 ) *ValidatorContainer {
 	pubkey := val.PublicKey()
@@ -556,6 +589,7 @@ func valContainerFromReadOnlyVal(
 			Pubkey:                     hexutil.Encode(pubkey[:]),
 			WithdrawalCredentials:      hexutil.Encode(val.WithdrawalCredentials()),
 			Contract:                   hexutil.Encode(contract[:]),
+			AdditionalContracts:        additionalContracts,
 			EffectiveBalance:           strconv.FormatUint(val.EffectiveBalance(), 10),
 			EffectiveActivity:          strconv.FormatUint(val.EffectiveActivity(), 10),
 			Slashed:                    val.Slashed(),
@@ -566,3 +600,19 @@ func valContainerFromReadOnlyVal(
 		},
 	}
 }
+
+// additionalContractsByIndex groups the additional contracts of the state by the index of their owner.
+func additionalContractsByIndex(st state.ReadOnlyBeaconState) (map[primitives.ValidatorIndex][]string, error) {
+	if st.Version() < version.Electra {
+		return nil, nil
+	}
+	contracts, err := st.AdditionalContracts()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[primitives.ValidatorIndex][]string)
+	for _, c := range contracts {
+		result[c.ValidatorIndex] = append(result[c.ValidatorIndex], hexutil.Encode(c.Contract))
+	}
+	return result, nil
+}
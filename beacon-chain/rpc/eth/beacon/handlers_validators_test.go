@@ -18,6 +18,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
@@ -542,6 +543,43 @@ func TestGetValidator(t *testing.T) {
 		assert.Equal(t, "18446744073709551615", resp.Data.Validator.ExitEpoch)
 		assert.Equal(t, "18446744073709551615", resp.Data.Validator.WithdrawableEpoch)
 	})
+	t.Run("additional contracts", func(t *testing.T) {
+		electraSt, _ := util.DeterministicGenesisStateElectra(t, 4)
+		contract := bytesutil.ToBytes20([]byte{0xaa, 0xbb})
+		require.NoError(t, electraSt.AppendAdditionalContract(1, contract))
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			Stater: &testutil.MockStater{
+				BeaconState: electraSt,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validators/{validator_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "validator_id": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetValidatorResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data.Validator)
+		assert.DeepEqual(t, []string{hexutil.Encode(contract[:])}, resp.Data.Validator.AdditionalContracts)
+
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head", "validator_id": "0"})
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp = &GetValidatorResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data.Validator)
+		assert.Equal(t, 0, len(resp.Data.Validator.AdditionalContracts))
+	})
 	t.Run("get by pubkey", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		s := Server{
@@ -953,3 +991,46 @@ func TestGetValidatorBalances(t *testing.T) {
 		assert.Equal(t, true, resp.Finalized)
 	})
 }
+
+func TestGetValidatorPowers(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateCapella(t, 64)
+	chainService := &chainMock.ChainService{}
+	s := Server{
+		Stater: &testutil.MockStater{
+			BeaconState: st,
+		},
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+	}
+
+	getPowers := func(t *testing.T, query string) *GetValidatorPowersResponse {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validator_powers"+query, nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorPowers(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetValidatorPowersResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	all := getPowers(t, "")
+	require.Equal(t, 64, len(all.Data.Powers))
+
+	t.Run("get by index and pubkey", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(primitives.ValidatorIndex(20))
+		resp := getPowers(t, fmt.Sprintf("?id=15&id=%s", hexutil.Encode(pubkey[:])))
+		require.Equal(t, 2, len(resp.Data.Powers))
+		assert.Equal(t, "15", resp.Data.Powers[0].Index)
+		assert.Equal(t, "20", resp.Data.Powers[1].Index)
+		// The total effective power still covers all active validators.
+		assert.Equal(t, all.Data.TotalEffectivePower, resp.Data.TotalEffectivePower)
+	})
+	t.Run("unknown ids", func(t *testing.T) {
+		resp := getPowers(t, "?id=9999")
+		require.Equal(t, 0, len(resp.Data.Powers))
+	})
+}
@@ -129,16 +129,17 @@ type ValidatorContainer struct {
 
 // todo unit act
 type Validator struct {
-	Pubkey                     string `json:"pubkey"`
-	WithdrawalCredentials      string `json:"withdrawal_credentials"`
-	Contract                   string `json:"contract,omitempty" ssz-size:"20"`
-	EffectiveBalance           string `json:"effective_balance"`
-	EffectiveActivity          string `json:"effective_activity"`
-	Slashed                    bool   `json:"slashed"`
-	ActivationEligibilityEpoch string `json:"activation_eligibility_epoch"`
-	ActivationEpoch            string `json:"activation_epoch"`
-	ExitEpoch                  string `json:"exit_epoch"`
-	WithdrawableEpoch          string `json:"withdrawable_epoch"`
+	Pubkey                     string   `json:"pubkey"`
+	WithdrawalCredentials      string   `json:"withdrawal_credentials"`
+	Contract                   string   `json:"contract,omitempty" ssz-size:"20"`
+	AdditionalContracts        []string `json:"additional_contracts,omitempty"`
+	EffectiveBalance           string   `json:"effective_balance"`
+	EffectiveActivity          string   `json:"effective_activity"`
+	Slashed                    bool     `json:"slashed"`
+	ActivationEligibilityEpoch string   `json:"activation_eligibility_epoch"`
+	ActivationEpoch            string   `json:"activation_epoch"`
+	ExitEpoch                  string   `json:"exit_epoch"`
+	WithdrawableEpoch          string   `json:"withdrawable_epoch"`
 }
 
 type ValidatorBalance struct {
@@ -167,3 +168,25 @@ type SharedActivities struct {
 	TransactionsGas uint64 `json:"transactions_gas,omitempty"`
 	BaseFee         uint64 `json:"base_fee,omitempty"`
 }
+
+type GetContractsResponse struct {
+	ExecutionOptimistic bool                 `json:"execution_optimistic"`
+	Finalized           bool                 `json:"finalized"`
+	Data                []*ContractContainer `json:"data"`
+	NextPageToken       string               `json:"next_page_token"`
+	TotalSize           string               `json:"total_size"`
+}
+
+type GetContractResponse struct {
+	ExecutionOptimistic bool               `json:"execution_optimistic"`
+	Finalized           bool               `json:"finalized"`
+	Data                *ContractContainer `json:"data"`
+}
+
+type ContractContainer struct {
+	Address           string `json:"address"`
+	OwnerIndex        string `json:"owner_index"`
+	OwnerStatus       string `json:"owner_status"`
+	Activity          string `json:"activity"`
+	EffectiveActivity string `json:"effective_activity"`
+}
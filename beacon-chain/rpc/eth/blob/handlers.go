@@ -1,6 +1,8 @@
 package blob
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/lookup"
 	field_params "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -80,19 +83,26 @@ func (s *Server) Blobs(w http.ResponseWriter, r *http.Request) {
 				http2.HandleError(w, "blobs are not supported before Deneb fork", http.StatusBadRequest)
 				return
 			}
-			sidecars, err = s.BeaconDB.BlobSidecarsBySlot(r.Context(), primitives.Slot(slot), indices...)
+			canonical, err := s.canonicalRootAtSlot(r.Context(), primitives.Slot(slot))
 			if err != nil {
-				http2.HandleError(w, errors.Wrapf(err, "could not retrieve blobs for slot %d", slot).Error(), http.StatusInternalServerError)
+				http2.HandleError(w, errors.Wrapf(err, "could not retrieve block root for slot %d", slot).Error(), http.StatusInternalServerError)
 				return
 			}
-			http2.WriteJson(w, buildSidecardsResponse(sidecars))
-			return
+			if canonical == nil {
+				http2.HandleError(w, fmt.Sprintf("no canonical block found for slot %d", slot), http.StatusNotFound)
+				return
+			}
+			root = canonical
 		}
 	}
 
 	var err error
-	sidecars, err = s.BeaconDB.BlobSidecarsByRoot(r.Context(), bytesutil.ToBytes32(root), indices...)
+	sidecars, err = s.BlobStorage.Sidecars(bytesutil.ToBytes32(root), indices...)
 	if err != nil {
+		if db.IsNotFound(err) {
+			http2.HandleError(w, errors.Wrapf(err, "blobs not found for root %#x", root).Error(), http.StatusNotFound)
+			return
+		}
 		http2.HandleError(w, errors.Wrapf(err, "could not retrieve blobs for root %#x", root).Error(), http.StatusInternalServerError)
 		return
 	}
@@ -119,6 +129,24 @@ func (s *Server) Blobs(w http.ResponseWriter, r *http.Request) {
 	http2.WriteJson(w, buildSidecardsResponse(sidecars))
 }
 
+// canonicalRootAtSlot returns the root of the canonical block at the given slot, or nil if there is none.
+func (s *Server) canonicalRootAtSlot(ctx context.Context, slot primitives.Slot) ([]byte, error) {
+	_, roots, err := s.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	for _, root := range roots {
+		canonical, err := s.ChainInfoFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine if block root is canonical")
+		}
+		if canonical {
+			return root[:], nil
+		}
+	}
+	return nil, nil
+}
+
 // parseIndices filters out invalid and duplicate blob indices
 func parseIndices(url *url.URL) []uint64 {
 	rawIndices := url.Query()["indices"]
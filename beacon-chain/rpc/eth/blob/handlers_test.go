@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -20,6 +22,7 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
 )
 
 func TestParseIndices(t *testing.T) {
@@ -33,49 +36,27 @@ func TestBlobs(t *testing.T) {
 	params.OverrideBeaconConfig(cfg)
 
 	db := testDB.SetupDB(t)
-	blockroot := bytesutil.PadTo([]byte("blockroot"), 32)
-	require.NoError(t, db.SaveBlobSidecar(context.Background(), []*eth.BlobSidecar{
-		{
+	blk := util.NewBeaconBlockDeneb()
+	blk.Block.Slot = 123
+	r, err := util.SaveBlock(t, context.Background(), db, blk).Block().HashTreeRoot()
+	require.NoError(t, err)
+	blockroot := r[:]
+	bs := filesystem.NewEphemeralBlobStorage(t)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, bs.Save(&eth.BlobSidecar{
 			BlockRoot:       blockroot,
-			Index:           0,
-			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
-			ProposerIndex:   123,
-			Blob:            []byte("blob0"),
-			KzgCommitment:   []byte("kzgcommitment0"),
-			KzgProof:        []byte("kzgproof0"),
-		},
-		{
-			BlockRoot:       blockroot,
-			Index:           1,
-			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
-			ProposerIndex:   123,
-			Blob:            []byte("blob1"),
-			KzgCommitment:   []byte("kzgcommitment1"),
-			KzgProof:        []byte("kzgproof1"),
-		},
-		{
-			BlockRoot:       blockroot,
-			Index:           2,
-			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
-			ProposerIndex:   123,
-			Blob:            []byte("blob2"),
-			KzgCommitment:   []byte("kzgcommitment2"),
-			KzgProof:        []byte("kzgproof2"),
-		},
-		{
-			BlockRoot:       blockroot,
-			Index:           3,
+			Index:           uint64(i),
 			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
+			BlockParentRoot: bytesutil.PadTo([]byte("blockparentroot"), fieldparams.RootLength),
 			ProposerIndex:   123,
-			Blob:            []byte("blob3"),
-			KzgCommitment:   []byte("kzgcommitment3"),
-			KzgProof:        []byte("kzgproof3"),
-		},
-	}))
+			Blob:            bytesutil.PadTo([]byte(fmt.Sprintf("blob%d", i)), fieldparams.BlobLength),
+			KzgCommitment:   bytesutil.PadTo([]byte(fmt.Sprintf("kzgcommitment%d", i)), fieldparams.BLSPubkeyLength),
+			KzgProof:        bytesutil.PadTo([]byte(fmt.Sprintf("kzgproof%d", i)), fieldparams.BLSPubkeyLength),
+		}))
+	}
+	padded := func(s string, n int) string {
+		return hexutil.Encode(bytesutil.PadTo([]byte(s), n))
+	}
 
 	t.Run("genesis", func(t *testing.T) {
 		u := "http://foo.example/genesis"
@@ -100,6 +81,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{Root: blockroot},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -110,44 +92,44 @@ func TestBlobs(t *testing.T) {
 		require.Equal(t, 4, len(resp.Data))
 		sidecar := resp.Data[0]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "0", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, padded("blockparentroot", fieldparams.RootLength), sidecar.BlockParentRoot)
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6230", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7430", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6630", sidecar.KZGProof)
+		assert.Equal(t, padded("blob0", fieldparams.BlobLength), sidecar.Blob)
+		assert.Equal(t, padded("kzgcommitment0", fieldparams.BLSPubkeyLength), sidecar.KZGCommitment)
+		assert.Equal(t, padded("kzgproof0", fieldparams.BLSPubkeyLength), sidecar.KZGProof)
 		sidecar = resp.Data[1]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "1", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, padded("blockparentroot", fieldparams.RootLength), sidecar.BlockParentRoot)
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6231", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7431", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6631", sidecar.KZGProof)
+		assert.Equal(t, padded("blob1", fieldparams.BlobLength), sidecar.Blob)
+		assert.Equal(t, padded("kzgcommitment1", fieldparams.BLSPubkeyLength), sidecar.KZGCommitment)
+		assert.Equal(t, padded("kzgproof1", fieldparams.BLSPubkeyLength), sidecar.KZGProof)
 		sidecar = resp.Data[2]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "2", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, padded("blockparentroot", fieldparams.RootLength), sidecar.BlockParentRoot)
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6232", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7432", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6632", sidecar.KZGProof)
+		assert.Equal(t, padded("blob2", fieldparams.BlobLength), sidecar.Blob)
+		assert.Equal(t, padded("kzgcommitment2", fieldparams.BLSPubkeyLength), sidecar.KZGCommitment)
+		assert.Equal(t, padded("kzgproof2", fieldparams.BLSPubkeyLength), sidecar.KZGProof)
 		sidecar = resp.Data[3]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "3", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, padded("blockparentroot", fieldparams.RootLength), sidecar.BlockParentRoot)
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6233", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7433", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6633", sidecar.KZGProof)
+		assert.Equal(t, padded("blob3", fieldparams.BlobLength), sidecar.Blob)
+		assert.Equal(t, padded("kzgcommitment3", fieldparams.BLSPubkeyLength), sidecar.KZGCommitment)
+		assert.Equal(t, padded("kzgproof3", fieldparams.BLSPubkeyLength), sidecar.KZGProof)
 	})
 	t.Run("finalized", func(t *testing.T) {
 		u := "http://foo.example/finalized"
@@ -157,6 +139,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockroot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -174,6 +157,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{CurrentJustifiedCheckPoint: &eth.Checkpoint{Root: blockroot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -189,7 +173,8 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			BeaconDB:    db,
+			BlobStorage: bs,
 		}
 
 		s.Blobs(writer, request)
@@ -205,7 +190,9 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			ChainInfoFetcher: &mockChain.ChainService{},
+			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -221,7 +208,9 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			ChainInfoFetcher: &mockChain.ChainService{},
+			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -232,14 +221,51 @@ func TestBlobs(t *testing.T) {
 		require.Equal(t, 1, len(resp.Data))
 		sidecar := resp.Data[0]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "2", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, padded("blockparentroot", fieldparams.RootLength), sidecar.BlockParentRoot)
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6232", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7432", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6632", sidecar.KZGProof)
+		assert.Equal(t, padded("blob2", fieldparams.BlobLength), sidecar.Blob)
+		assert.Equal(t, padded("kzgcommitment2", fieldparams.BLSPubkeyLength), sidecar.KZGCommitment)
+		assert.Equal(t, padded("kzgproof2", fieldparams.BLSPubkeyLength), sidecar.KZGProof)
+	})
+	t.Run("slot without canonical block", func(t *testing.T) {
+		u := "http://foo.example/123"
+		request := httptest.NewRequest("GET", u, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s := &Server{
+			ChainInfoFetcher: &mockChain.ChainService{CanonicalRoots: map[[32]byte]bool{}},
+			BeaconDB:         db,
+			BlobStorage:      bs,
+		}
+
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusNotFound, e.Code)
+		assert.Equal(t, "no canonical block found for slot 123", e.Message)
+	})
+	t.Run("root without blobs", func(t *testing.T) {
+		u := "http://foo.example/" + hexutil.Encode(bytesutil.PadTo([]byte("unknown"), 32))
+		request := httptest.NewRequest("GET", u, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s := &Server{
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusNotFound, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "blobs not found for root"))
 	})
 	t.Run("slot before Deneb fork", func(t *testing.T) {
 		u := "http://foo.example/31"
@@ -272,17 +298,16 @@ func TestBlobs(t *testing.T) {
 		assert.Equal(t, true, strings.Contains(e.Message, "could not parse block ID"))
 	})
 	t.Run("ssz", func(t *testing.T) {
-		require.NoError(t, db.SaveBlobSidecar(context.Background(), []*eth.BlobSidecar{
-			{
-				BlockRoot:       blockroot,
-				Index:           0,
-				Slot:            3,
-				BlockParentRoot: make([]byte, fieldparams.RootLength),
-				ProposerIndex:   123,
-				Blob:            make([]byte, fieldparams.BlobLength),
-				KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
-				KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
-			},
+		bs := filesystem.NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(&eth.BlobSidecar{
+			BlockRoot:       blockroot,
+			Index:           0,
+			Slot:            3,
+			BlockParentRoot: make([]byte, fieldparams.RootLength),
+			ProposerIndex:   123,
+			Blob:            make([]byte, fieldparams.BlobLength),
+			KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
+			KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
 		}))
 		u := "http://foo.example/finalized?indices=0"
 		request := httptest.NewRequest("GET", u, nil)
@@ -292,12 +317,14 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockroot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
 
 		assert.Equal(t, http.StatusOK, writer.Code)
 		assert.Equal(t, len(writer.Body.Bytes()), 131260)
-		assert.Equal(t, true, strings.HasPrefix(hexutil.Encode(writer.Body.Bytes()), "0x04000000626c6f636b726f6f7400000000000000000000000000000000000000000000000000000000000000030000000000000000000000000000000000000000000000000000000000000000000000000000007b"))
+		prefix := "0x04000000" + hexutil.Encode(blockroot)[2:] + "0000000000000000" + "0300000000000000" + strings.Repeat("00", fieldparams.RootLength) + "7b"
+		assert.Equal(t, true, strings.HasPrefix(hexutil.Encode(writer.Body.Bytes()), prefix))
 	})
 }
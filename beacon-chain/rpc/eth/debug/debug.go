@@ -116,6 +116,19 @@ func (ds *Server) GetBeaconStateV2(ctx context.Context, req *ethpbv2.BeaconState
 			},
 			ExecutionOptimistic: isOptimistic,
 		}, nil
+	case version.Electra:
+		protoState, err := migration.BeaconStateElectraToProto(beaconSt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert state to proto: %v", err)
+		}
+		return &ethpbv2.BeaconStateResponseV2{
+			Version: ethpbv2.Version_ELECTRA,
+			Data: &ethpbv2.BeaconStateContainer{
+				State: &ethpbv2.BeaconStateContainer_ElectraState{ElectraState: protoState},
+			},
+			ExecutionOptimistic: isOptimistic,
+			Finalized:           isFinalized,
+		}, nil
 	default:
 		return nil, status.Error(codes.Internal, "Unsupported state version")
 	}
@@ -147,6 +160,8 @@ func (ds *Server) GetBeaconStateSSZV2(ctx context.Context, req *ethpbv2.BeaconSt
 		ver = ethpbv2.Version_CAPELLA
 	case version.Deneb:
 		ver = ethpbv2.Version_DENEB
+	case version.Electra:
+		ver = ethpbv2.Version_ELECTRA
 	default:
 		return nil, status.Error(codes.Internal, "Unsupported state version")
 	}
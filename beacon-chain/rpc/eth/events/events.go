@@ -47,6 +47,10 @@ const (
 	PayloadAttributesTopic = "payload_attributes"
 	// BlobSidecarTopic represents a new blob sidecar event topic
 	BlobSidecarTopic = "blob_sidecar"
+	// ActivityChangesTopic represents the activity changes of a new imported block event topic.
+	ActivityChangesTopic = "activity_changes"
+	// SharedActivityTopic represents a shared activity update at an epoch transition event topic.
+	SharedActivityTopic = "shared_activity"
 )
 
 var casesHandled = map[string]bool{
@@ -60,6 +64,8 @@ var casesHandled = map[string]bool{
 	BLSToExecutionChangeTopic:      true,
 	PayloadAttributesTopic:         true,
 	BlobSidecarTopic:               true,
+	ActivityChangesTopic:           true,
+	SharedActivityTopic:            true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -93,6 +99,11 @@ func (s *Server) StreamEvents(
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
 
+	if requestedTopics[SharedActivityTopic] && s.SharedActivitySubscriber != nil {
+		unsubscribe := s.SharedActivitySubscriber.SubscribeSharedActivity()
+		defer unsubscribe()
+	}
+
 	// Handle each event received and context cancelation.
 	for {
 		select {
@@ -237,27 +248,41 @@ func (s *Server) handleStateEvents(
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
 	case statefeed.BlockProcessed:
-		if _, ok := requestedTopics[BlockTopic]; !ok {
-			return nil
-		}
 		blkData, ok := event.Data.(*statefeed.BlockProcessedData)
 		if !ok {
 			return nil
 		}
-		v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
-		if err != nil {
-			return err
+		if _, ok := requestedTopics[BlockTopic]; ok {
+			v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
+			if err != nil {
+				return err
+			}
+			item, err := v1Data.Message.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not hash tree root block")
+			}
+			eventBlock := &ethpb.EventBlock{
+				Slot:                blkData.Slot,
+				Block:               item[:],
+				ExecutionOptimistic: blkData.Optimistic,
+			}
+			if err := streamData(stream, BlockTopic, eventBlock); err != nil {
+				return err
+			}
 		}
-		item, err := v1Data.Message.HashTreeRoot()
-		if err != nil {
-			return errors.Wrap(err, "could not hash tree root block")
+		if _, ok := requestedTopics[ActivityChangesTopic]; ok {
+			return streamActivityChanges(stream, blkData)
 		}
-		eventBlock := &ethpb.EventBlock{
-			Slot:                blkData.Slot,
-			Block:               item[:],
-			ExecutionOptimistic: blkData.Optimistic,
+		return nil
+	case statefeed.SharedActivityUpdated:
+		if _, ok := requestedTopics[SharedActivityTopic]; !ok {
+			return nil
+		}
+		sharedActivity, ok := event.Data.(*ethpb.EventSharedActivity)
+		if !ok {
+			return nil
 		}
-		return streamData(stream, BlockTopic, eventBlock)
+		return streamData(stream, SharedActivityTopic, sharedActivity)
 	default:
 		return nil
 	}
@@ -349,6 +374,30 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 	}
 }
 
+// streamActivityChanges streams the activity changes, transactions count and base fee of an imported block.
+func streamActivityChanges(stream ethpbservice.Events_StreamEventsServer, blkData *statefeed.BlockProcessedData) error {
+	if blkData.SignedBlock == nil || blkData.SignedBlock.IsNil() {
+		return nil
+	}
+	body := blkData.SignedBlock.Block().Body()
+	changes := body.ActivityChanges()
+	v1Changes := make([]*ethpb.ActivityChange, len(changes))
+	for i, c := range changes {
+		v1Changes[i] = &ethpb.ActivityChange{
+			ContractAddress: bytesutil.SafeCopyBytes(c.ContractAddress),
+			DeltaActivity:   c.DeltaActivity,
+		}
+	}
+	return streamData(stream, ActivityChangesTopic, &ethpb.EventActivityChanges{
+		Slot:                blkData.Slot,
+		Block:               bytesutil.SafeCopyBytes(blkData.BlockRoot[:]),
+		ActivityChanges:     v1Changes,
+		TransactionsCount:   body.TransactionsCount(),
+		BaseFee:             body.BaseFee(),
+		ExecutionOptimistic: blkData.Optimistic,
+	})
+}
+
 func streamData(stream ethpbservice.Events_StreamEventsServer, name string, data proto.Message) error {
 	returnData, err := anypb.New(data)
 	if err != nil {
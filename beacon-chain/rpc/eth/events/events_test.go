@@ -633,6 +633,98 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(ActivityChangesTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		contract := bytesutil.PadTo([]byte{0xaa}, 20)
+		blk := util.HydrateSignedBeaconBlockCapella(&eth.SignedBeaconBlockCapella{
+			Block: &eth.BeaconBlockCapella{
+				Slot: 8,
+				Body: &eth.BeaconBlockBodyCapella{
+					ActivityChanges: []*eth.ActivityChange{
+						{ContractAddress: contract, DeltaActivity: 21000},
+					},
+					TransactionsCount: 3,
+					BaseFee:           7,
+				},
+			},
+		})
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		blockRoot := [32]byte{'a'}
+		genericResponse, err := anypb.New(&ethpb.EventActivityChanges{
+			Slot:  8,
+			Block: blockRoot[:],
+			ActivityChanges: []*ethpb.ActivityChange{
+				{ContractAddress: contract, DeltaActivity: 21000},
+			},
+			TransactionsCount:   3,
+			BaseFee:             7,
+			ExecutionOptimistic: true,
+		})
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: ActivityChangesTopic,
+			Data:  genericResponse,
+		}
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ActivityChangesTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{
+					Slot:        8,
+					BlockRoot:   blockRoot,
+					SignedBlock: wsb,
+					Optimistic:  true,
+				},
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(SharedActivityTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSharedActivity := &ethpb.EventSharedActivity{
+			Epoch: 2,
+			Block: make([]byte, 32),
+			SharedActivity: &ethpb.SharedActivity{
+				TransactionsGasPerPeriod: 4,
+				TransactionsGasPerEpoch:  3,
+				BaseFeePerPeriod:         2,
+				BaseFeePerEpoch:          1,
+			},
+			TopMovers: []*ethpb.EffectiveActivityMover{
+				{ValidatorIndex: 1, PreviousEffectiveActivity: 10, EffectiveActivity: 20},
+			},
+		}
+		genericResponse, err := anypb.New(wantedSharedActivity)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: SharedActivityTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{SharedActivityTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.SharedActivityUpdated,
+				Data: wantedSharedActivity,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
@@ -14,9 +14,10 @@ import (
 // Server defines a server implementation of the gRPC events service,
 // providing RPC endpoints to subscribe to events from the beacon node.
 type Server struct {
-	Ctx               context.Context
-	StateNotifier     statefeed.Notifier
-	OperationNotifier opfeed.Notifier
-	HeadFetcher       blockchain.HeadFetcher
-	ChainInfoFetcher  blockchain.ChainInfoFetcher
+	Ctx                      context.Context
+	StateNotifier            statefeed.Notifier
+	OperationNotifier        opfeed.Notifier
+	HeadFetcher              blockchain.HeadFetcher
+	ChainInfoFetcher         blockchain.ChainInfoFetcher
+	SharedActivitySubscriber blockchain.SharedActivitySubscriber
 }
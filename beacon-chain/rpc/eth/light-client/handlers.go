@@ -0,0 +1,283 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// maxRequestLightClientUpdates is the maximum number of light client updates served in a single request,
+// MAX_REQUEST_LIGHT_CLIENT_UPDATES in the spec.
+const maxRequestLightClientUpdates = 128
+
+// lightClientVersion is the version of the served light client data. The headers only carry the beacon block
+// header, as defined by Altair, whatever the fork of their block.
+var lightClientVersion = version.String(version.Altair)
+
+// GetLightClientBootstrap is an HTTP handler for Beacon API getLightClientBootstrap.
+func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientBootstrap")
+	defer span.End()
+
+	root, valid := shared.ValidateHex(w, "block_root", mux.Vars(r)["block_root"], fieldparams.RootLength)
+	if !valid {
+		return
+	}
+	blockRoot := bytesutil.ToBytes32(root)
+	blk, err := s.BeaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrapf(err, "could not retrieve block %#x", root).Error(), http.StatusInternalServerError)
+		return
+	}
+	if blk == nil || blk.IsNil() {
+		http2.HandleError(w, fmt.Sprintf("block %#x not found", root), http.StatusNotFound)
+		return
+	}
+	if !s.BeaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		http2.HandleError(w, fmt.Sprintf("block %#x is not finalized", root), http.StatusNotFound)
+		return
+	}
+	if blk.Version() < version.Altair {
+		http2.HandleError(w, "light client bootstrap is not supported before Altair fork", http.StatusBadRequest)
+		return
+	}
+	isCheckpoint, err := s.isFinalizedCheckpointBlock(ctx, blockRoot, blk)
+	if err != nil {
+		http2.HandleError(w, errors.Wrapf(err, "could not check finalized checkpoint of block %#x", root).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !isCheckpoint {
+		http2.HandleError(w, fmt.Sprintf("block %#x is not a finalized checkpoint root", root), http.StatusNotFound)
+		return
+	}
+	st, err := s.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrapf(err, "could not retrieve state of block %#x", root).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The latest block header of the post-state is the block's header without its state root.
+	header := st.LatestBlockHeader()
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not compute state root").Error(), http.StatusInternalServerError)
+		return
+	}
+	header.StateRoot = stateRoot[:]
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get current sync committee").Error(), http.StatusInternalServerError)
+		return
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get current sync committee proof").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &LightClientBootstrapResponse{
+		Version: lightClientVersion,
+		Data: &LightClientBootstrap{
+			Header: headerFromConsensus(&ethpbv1.BeaconBlockHeader{
+				Slot:          header.Slot,
+				ProposerIndex: header.ProposerIndex,
+				ParentRoot:    header.ParentRoot,
+				StateRoot:     header.StateRoot,
+				BodyRoot:      header.BodyRoot,
+			}),
+			CurrentSyncCommittee: syncCommitteeFromConsensus(&ethpbv2.SyncCommittee{
+				Pubkeys:         committee.Pubkeys,
+				AggregatePubkey: committee.AggregatePubkey,
+			}),
+			CurrentSyncCommitteeBranch: branchFromConsensus(branch),
+		},
+	})
+}
+
+// GetLightClientUpdatesByRange is an HTTP handler for Beacon API getLightClientUpdatesByRange.
+// It returns the best update of every sync committee period from start_period onwards, stopping at
+// the first period for which the node has no update.
+func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientUpdatesByRange")
+	defer span.End()
+
+	query := r.URL.Query()
+	startPeriod, valid := shared.ValidateUint(w, "start_period", query.Get("start_period"))
+	if !valid {
+		return
+	}
+	count, valid := shared.ValidateUint(w, "count", query.Get("count"))
+	if !valid {
+		return
+	}
+	if count == 0 {
+		http2.HandleError(w, "count must be greater than 0", http.StatusBadRequest)
+		return
+	}
+	if count > maxRequestLightClientUpdates {
+		count = maxRequestLightClientUpdates
+	}
+	endPeriod := startPeriod + count - 1
+	if endPeriod < startPeriod {
+		http2.HandleError(w, "start_period and count overflow", http.StatusBadRequest)
+		return
+	}
+
+	updates, err := s.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not retrieve light client updates").Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, 0, len(updates))
+	for period := startPeriod; period <= endPeriod; period++ {
+		update, ok := updates[period]
+		if !ok {
+			break
+		}
+		resp = append(resp, &LightClientUpdateWithVersion{
+			Version: lightClientVersion,
+			Data:    updateFromConsensus(update),
+		})
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetLightClientFinalityUpdate is an HTTP handler for Beacon API getLightClientFinalityUpdate.
+func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientFinalityUpdate")
+	defer span.End()
+
+	update := s.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		http2.HandleError(w, "no light client finality update available", http.StatusNotFound)
+		return
+	}
+	http2.WriteJson(w, &LightClientFinalityUpdateResponse{
+		Version: lightClientVersion,
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  headerFromConsensus(update.AttestedHeader),
+			FinalizedHeader: headerFromConsensus(update.FinalizedHeader),
+			FinalityBranch:  branchFromConsensus(update.FinalityBranch),
+			SyncAggregate:   syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// GetLightClientOptimisticUpdate is an HTTP handler for Beacon API getLightClientOptimisticUpdate.
+func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientOptimisticUpdate")
+	defer span.End()
+
+	update := s.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		http2.HandleError(w, "no light client optimistic update available", http.StatusNotFound)
+		return
+	}
+	http2.WriteJson(w, &LightClientOptimisticUpdateResponse{
+		Version: lightClientVersion,
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: headerFromConsensus(update.AttestedHeader),
+			SyncAggregate:  syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// isFinalizedCheckpointBlock reports whether the given finalized block is the block of a finalized checkpoint,
+// that is the last canonical block at or before the start slot of the checkpoint epoch.
+func (s *Server) isFinalizedCheckpointBlock(ctx context.Context, root [32]byte, blk interfaces.ReadOnlySignedBeaconBlock) (bool, error) {
+	slot := blk.Block().Slot()
+	if slots.IsEpochStart(slot) {
+		return true, nil
+	}
+	child, err := s.BeaconDB.FinalizedChildBlock(ctx, root)
+	if err != nil {
+		return false, err
+	}
+	// The latest finalized checkpoint has no finalized child yet.
+	if child == nil || child.IsNil() {
+		cp, err := s.BeaconDB.FinalizedCheckpoint(ctx)
+		if err != nil {
+			return false, err
+		}
+		return bytesutil.ToBytes32(cp.Root) == root, nil
+	}
+	nextEpochStart, err := slots.EpochStart(slots.ToEpoch(slot) + 1)
+	if err != nil {
+		return false, err
+	}
+	return child.Block().Slot() >= nextEpochStart, nil
+}
+
+func updateFromConsensus(update *ethpbv2.LightClientUpdate) *LightClientUpdate {
+	return &LightClientUpdate{
+		AttestedHeader:          headerFromConsensus(update.AttestedHeader),
+		NextSyncCommittee:       syncCommitteeFromConsensus(update.NextSyncCommittee),
+		NextSyncCommitteeBranch: branchFromConsensus(update.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerFromConsensus(update.FinalizedHeader),
+		FinalityBranch:          branchFromConsensus(update.FinalityBranch),
+		SyncAggregate:           syncAggregateFromConsensus(update.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(update.SignatureSlot), 10),
+	}
+}
+
+func headerFromConsensus(h *ethpbv1.BeaconBlockHeader) *LightClientHeader {
+	if h == nil {
+		return nil
+	}
+	return &LightClientHeader{Beacon: &shared.BeaconBlockHeader{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}}
+}
+
+func syncCommitteeFromConsensus(c *ethpbv2.SyncCommittee) *SyncCommittee {
+	if c == nil {
+		return nil
+	}
+	pubkeys := make([]string, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		pubkeys[i] = hexutil.Encode(pk)
+	}
+	return &SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: hexutil.Encode(c.AggregatePubkey),
+	}
+}
+
+func syncAggregateFromConsensus(a *ethpbv1.SyncAggregate) *shared.SyncAggregate {
+	if a == nil {
+		return nil
+	}
+	return &shared.SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func branchFromConsensus(branch [][]byte) []string {
+	b := make([]string, len(branch))
+	for i, node := range branch {
+		b[i] = hexutil.Encode(node)
+	}
+	return b
+}
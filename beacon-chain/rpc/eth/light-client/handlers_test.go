@@ -0,0 +1,262 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func testUpdate(period uint64) *ethpbv2.LightClientUpdate {
+	slot := primitives.Slot(period * uint64(params.BeaconConfig().SlotsPerEpoch) * uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		},
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, 64),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestGetLightClientBootstrap(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 32)
+	genesis := util.NewBeaconBlockAltair()
+	genesisRoot, err := util.SaveBlock(t, ctx, db, genesis).Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// The block at slot 1 gets finalized along with its child at slot 2, the finalized checkpoint root.
+	var roots [][32]byte
+	parentRoot := genesisRoot
+	for slot := primitives.Slot(1); slot <= 3; slot++ {
+		blk := util.NewBeaconBlockAltair()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parentRoot[:]
+		parentRoot, err = util.SaveBlock(t, ctx, db, blk).Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, parentRoot))
+		roots = append(roots, parentRoot)
+	}
+	root := roots[1]
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+	s := &Server{
+		BeaconDB: db,
+		StateGen: stategen.New(db, doublylinkedtree.New()),
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &LightClientBootstrapResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "altair", resp.Version)
+		assert.Equal(t, "0", resp.Data.Header.Beacon.Slot)
+		stateRoot, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Encode(stateRoot[:]), resp.Data.Header.Beacon.StateRoot)
+		assert.Equal(t, int(params.BeaconConfig().SyncCommitteeSize), len(resp.Data.CurrentSyncCommittee.Pubkeys))
+		assert.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+	})
+	t.Run("not a finalized checkpoint root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(roots[0][:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "not a finalized checkpoint root", e.Message)
+	})
+	t.Run("not finalized", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(roots[2][:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "is not finalized", e.Message)
+	})
+	t.Run("invalid block root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": "0x01"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("block not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, fieldparams.RootLength))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "not found", e.Message)
+	})
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testUpdate(period)))
+	}
+	s := &Server{BeaconDB: db}
+
+	t.Run("stops at the first missing period", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example?start_period=1&count=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "altair", resp[0].Version)
+		assert.Equal(t, strconv.FormatUint(uint64(testUpdate(1).AttestedHeader.Slot), 10), resp[0].Data.AttestedHeader.Beacon.Slot)
+		assert.Equal(t, strconv.FormatUint(uint64(testUpdate(2).SignatureSlot), 10), resp[1].Data.SignatureSlot)
+	})
+	t.Run("no updates", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example?start_period=3&count=1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		assert.Equal(t, 0, len(resp))
+	})
+	t.Run("missing count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example?start_period=1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("zero count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example?start_period=1&count=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetLightClientFinalityUpdate(t *testing.T) {
+	update := testUpdate(1)
+	update.FinalizedHeader = &ethpbv1.BeaconBlockHeader{
+		Slot:       update.AttestedHeader.Slot - 64,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+	update.FinalityBranch = [][]byte{{'a'}, {'b'}}
+	chain := &mockChain.ChainService{}
+	s := &Server{LightClientFetcher: chain}
+
+	request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientFinalityUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	chain.LightClientFinality = &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientFinalityUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &LightClientFinalityUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "altair", resp.Version)
+	assert.Equal(t, strconv.FormatUint(uint64(update.FinalizedHeader.Slot), 10), resp.Data.FinalizedHeader.Beacon.Slot)
+	assert.DeepEqual(t, []string{"0x61", "0x62"}, resp.Data.FinalityBranch)
+	assert.Equal(t, strconv.FormatUint(uint64(update.SignatureSlot), 10), resp.Data.SignatureSlot)
+}
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	update := testUpdate(1)
+	chain := &mockChain.ChainService{}
+	s := &Server{LightClientFetcher: chain}
+
+	request := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientOptimisticUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	chain.LightClientOptimistic = &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetLightClientOptimisticUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &LightClientOptimisticUpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "altair", resp.Version)
+	assert.Equal(t, strconv.FormatUint(uint64(update.AttestedHeader.Slot), 10), resp.Data.AttestedHeader.Beacon.Slot)
+	assert.Equal(t, hexutil.Encode(update.SyncAggregate.SyncCommitteeBits), resp.Data.SyncAggregate.SyncCommitteeBits)
+}
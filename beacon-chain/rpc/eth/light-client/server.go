@@ -0,0 +1,13 @@
+package lightclient
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+)
+
+type Server struct {
+	BeaconDB           db.ReadOnlyDatabase
+	StateGen           stategen.StateManager
+	LightClientFetcher blockchain.LightClientFetcher
+}
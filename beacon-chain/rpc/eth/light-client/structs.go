@@ -0,0 +1,62 @@
+package lightclient
+
+import "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+
+type LightClientBootstrapResponse struct {
+	Version string                `json:"version"`
+	Data    *LightClientBootstrap `json:"data"`
+}
+
+type LightClientBootstrap struct {
+	Header                     *LightClientHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string           `json:"current_sync_committee_branch"`
+}
+
+type LightClientUpdateWithVersion struct {
+	Version string             `json:"version"`
+	Data    *LightClientUpdate `json:"data"`
+}
+
+type LightClientUpdate struct {
+	AttestedHeader          *LightClientHeader    `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee        `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string              `json:"next_sync_committee_branch"`
+	FinalizedHeader         *LightClientHeader    `json:"finalized_header"`
+	FinalityBranch          []string              `json:"finality_branch"`
+	SyncAggregate           *shared.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot           string                `json:"signature_slot"`
+}
+
+type LightClientFinalityUpdateResponse struct {
+	Version string                     `json:"version"`
+	Data    *LightClientFinalityUpdate `json:"data"`
+}
+
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader    `json:"attested_header"`
+	FinalizedHeader *LightClientHeader    `json:"finalized_header"`
+	FinalityBranch  []string              `json:"finality_branch"`
+	SyncAggregate   *shared.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot   string                `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdateResponse struct {
+	Version string                       `json:"version"`
+	Data    *LightClientOptimisticUpdate `json:"data"`
+}
+
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader    `json:"attested_header"`
+	SyncAggregate  *shared.SyncAggregate `json:"sync_aggregate"`
+	SignatureSlot  string                `json:"signature_slot"`
+}
+
+type LightClientHeader struct {
+	Beacon *shared.BeaconBlockHeader `json:"beacon"`
+}
+
+type SyncCommittee struct {
+	Pubkeys         []string `json:"pubkeys"`
+	AggregatePubkey string   `json:"aggregate_pubkey"`
+}
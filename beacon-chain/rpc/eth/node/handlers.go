@@ -30,5 +30,11 @@ func (s *Server) GetSyncStatus(w http.ResponseWriter, r *http.Request) {
 			ElOffline:    !s.ExecutionChainInfoFetcher.ExecutionClientConnected(),
 		},
 	}
+	if s.BackfillStatus != nil && !s.BackfillStatus.Complete() {
+		low := s.BackfillStatus.EndGap()
+		response.Data.IsBackfilling = true
+		response.Data.BackfillSlot = strconv.FormatUint(uint64(low), 10)
+		response.Data.BackfillDistance = strconv.FormatUint(uint64(low-s.BackfillStatus.StartGap()), 10)
+	}
 	http2.WriteJson(w, response)
 }
@@ -2,13 +2,17 @@ package node
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
 	syncmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
@@ -50,3 +54,50 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, true, resp.Data.IsOptimistic)
 	assert.Equal(t, false, resp.Data.ElOffline)
 }
+
+func TestSyncStatus_Backfilling(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	origin := util.NewBeaconBlock()
+	origin.Block.Slot = 64
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, origin)
+	require.NoError(t, beaconDB.(*kv.Store).SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, originRoot))
+	bs := backfill.NewStatus(beaconDB)
+	require.NoError(t, bs.Reload(ctx))
+
+	currentSlot := new(primitives.Slot)
+	*currentSlot = 110
+	state, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, state.SetSlot(100))
+	chainService := &mock.ChainService{Slot: currentSlot, State: state}
+	s := &Server{
+		HeadFetcher:               chainService,
+		GenesisTimeFetcher:        chainService,
+		OptimisticModeFetcher:     chainService,
+		SyncChecker:               &syncmock.Sync{},
+		ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{},
+		BackfillStatus:            bs,
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetSyncStatus(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &SyncStatusResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, false, resp.Data.IsSyncing)
+	assert.Equal(t, true, resp.Data.IsBackfilling)
+	assert.Equal(t, "64", resp.Data.BackfillSlot)
+	assert.Equal(t, "64", resp.Data.BackfillDistance)
+}
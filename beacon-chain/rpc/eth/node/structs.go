@@ -10,4 +10,10 @@ type SyncStatusResponseData struct {
 	IsSyncing    bool   `json:"is_syncing"`
 	IsOptimistic bool   `json:"is_optimistic"`
 	ElOffline    bool   `json:"el_offline"`
+	// The chain history below the checkpoint sync origin is still being backfilled.
+	IsBackfilling bool `json:"is_backfilling"`
+	// Slot of the lowest backfilled block and the number of slots between it and genesis,
+	// set while the chain history is being backfilled.
+	BackfillSlot     string `json:"backfill_slot,omitempty"`
+	BackfillDistance string `json:"backfill_distance,omitempty"`
 }
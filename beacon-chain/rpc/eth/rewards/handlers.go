@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
 	coreblocks "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
@@ -51,6 +52,12 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 		http2.HandleError(w, "Could not get proposer's balance: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	// The activity-based base proposer reward only depends on the epoch's activities and powers,
+	// which are not modified by the operations below, so it can be computed upfront.
+	breakdown, ok := baseProposerRewardBreakdown(w, r, st)
+	if !ok {
+		return
+	}
 	st, err = altair.ProcessAttestationsNoVerifySignature(r.Context(), st, blk)
 	if err != nil {
 		http2.HandleError(w, "Could not get attestation rewards"+err.Error(), http.StatusInternalServerError)
@@ -103,6 +110,12 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 		http2.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	earned := attBalance - initBalance + syncCommitteeReward
+	earnedActivity, err := breakdown.EarnedActivityReward(earned)
+	if err != nil {
+		http2.HandleError(w, "Could not get activity reward: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	response := &BlockRewardsResponse{
 		Data: BlockRewards{
@@ -112,6 +125,7 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 			SyncAggregate:     strconv.FormatUint(syncCommitteeReward, 10),
 			ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-attSlashingsBalance, 10),
 			AttesterSlashings: strconv.FormatUint(attSlashingsBalance-attBalance, 10),
+			Activity:          activityRewardFromBreakdown(breakdown, earned, earnedActivity),
 		},
 		ExecutionOptimistic: optimistic,
 		Finalized:           s.FinalizationFetcher.IsFinalized(r.Context(), blkRoot),
@@ -246,6 +260,42 @@ func (s *Server) SyncCommitteeRewards(w http.ResponseWriter, r *http.Request) {
 	http2.WriteJson(w, response)
 }
 
+// baseProposerRewardBreakdown breaks the base proposer reward of the state down into
+// its activity and shared transactions gas components.
+func baseProposerRewardBreakdown(w http.ResponseWriter, r *http.Request, st state.BeaconState) (*altair.ProposerRewardBreakdown, bool) {
+	totalPower, totalEffectivePower, err := helpers.Powers(r.Context(), st)
+	if err != nil {
+		http2.HandleError(w, "Could not get powers: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	breakdown, err := altair.BaseProposerRewardBreakdown(st, totalPower, totalEffectivePower)
+	if err != nil {
+		http2.HandleError(w, "Could not get base proposer reward: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return breakdown, true
+}
+
+// activityRewardFromBreakdown describes the reward the proposer earned from the base proposer reward, split
+// into the given activity part and the shared transactions gas part.
+func activityRewardFromBreakdown(breakdown *altair.ProposerRewardBreakdown, earned, earnedActivity uint64) *ActivityReward {
+	return &ActivityReward{
+		BaseProposerReward:       strconv.FormatUint(breakdown.BaseProposerReward, 10),
+		Earned:                   strconv.FormatUint(earned, 10),
+		ActivityComponent:        strconv.FormatUint(earnedActivity, 10),
+		TransactionsGasComponent: strconv.FormatUint(earned-earnedActivity, 10),
+		TotalEffectiveActivity:   strconv.FormatUint(breakdown.TotalEffectiveActivity, 10),
+		TotalPower:               strconv.FormatUint(breakdown.TotalPower, 10),
+		TotalEffectivePower:      strconv.FormatUint(breakdown.TotalEffectivePower, 10),
+		SharedActivity: &SharedActivity{
+			TransactionsGasPerPeriod: strconv.FormatUint(breakdown.SharedActivity.TransactionsGasPerPeriod, 10),
+			TransactionsGasPerEpoch:  strconv.FormatUint(breakdown.SharedActivity.TransactionsGasPerEpoch, 10),
+			BaseFeePerPeriod:         strconv.FormatUint(breakdown.SharedActivity.BaseFeePerPeriod, 10),
+			BaseFeePerEpoch:          strconv.FormatUint(breakdown.SharedActivity.BaseFeePerEpoch, 10),
+		},
+	}
+}
+
 func (s *Server) attRewardsState(w http.ResponseWriter, r *http.Request) (state.BeaconState, bool) {
 	segments := strings.Split(r.URL.Path, "/")
 	requestedEpoch, err := strconv.ParseUint(segments[len(segments)-1], 10, 64)
@@ -209,6 +209,17 @@ func TestBlockRewards(t *testing.T) {
 		assert.Equal(t, "48130", resp.Data.SyncAggregate)
 		assert.Equal(t, "16000000000", resp.Data.AttesterSlashings)
 		assert.Equal(t, "16000000000", resp.Data.ProposerSlashings)
+		require.NotNil(t, resp.Data.Activity)
+		// Without effective activity, the whole reward earned from the base proposer reward comes from transactions gas.
+		assert.Equal(t, "86298129", resp.Data.Activity.Earned)
+		assert.Equal(t, "0", resp.Data.Activity.ActivityComponent)
+		assert.Equal(t, "86298129", resp.Data.Activity.TransactionsGasComponent)
+		assert.Equal(t, "0", resp.Data.Activity.TotalEffectiveActivity)
+		assert.Equal(t, "756000000000", resp.Data.Activity.TotalPower)
+		assert.Equal(t, "756000000000", resp.Data.Activity.TotalEffectivePower)
+		require.NotNil(t, resp.Data.Activity.SharedActivity)
+		assert.Equal(t, "756000000000", resp.Data.Activity.SharedActivity.TransactionsGasPerPeriod)
+		assert.Equal(t, "1159200", resp.Data.Activity.SharedActivity.BaseFeePerPeriod)
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 		assert.Equal(t, false, resp.Finalized)
 	})
@@ -7,12 +7,34 @@ type BlockRewardsResponse struct {
 }
 
 type BlockRewards struct {
-	ProposerIndex     string `json:"proposer_index"`
-	Total             string `json:"total"`
-	Attestations      string `json:"attestations"`
-	SyncAggregate     string `json:"sync_aggregate"`
-	ProposerSlashings string `json:"proposer_slashings"`
-	AttesterSlashings string `json:"attester_slashings"`
+	ProposerIndex     string          `json:"proposer_index"`
+	Total             string          `json:"total"`
+	Attestations      string          `json:"attestations"`
+	SyncAggregate     string          `json:"sync_aggregate"`
+	ProposerSlashings string          `json:"proposer_slashings"`
+	AttesterSlashings string          `json:"attester_slashings"`
+	Activity          *ActivityReward `json:"activity"`
+}
+
+// ActivityReward describes the part of the block rewards that comes from the base proposer reward.
+// Earned is the attestations and sync aggregate reward of the proposer, which are both scaled from
+// the base proposer reward, and ActivityComponent and TransactionsGasComponent split it.
+type ActivityReward struct {
+	BaseProposerReward       string          `json:"base_proposer_reward"`
+	Earned                   string          `json:"earned"`
+	ActivityComponent        string          `json:"activity_component"`
+	TransactionsGasComponent string          `json:"transactions_gas_component"`
+	TotalEffectiveActivity   string          `json:"total_effective_activity"`
+	TotalPower               string          `json:"total_power"`
+	TotalEffectivePower      string          `json:"total_effective_power"`
+	SharedActivity           *SharedActivity `json:"shared_activity"`
+}
+
+type SharedActivity struct {
+	TransactionsGasPerPeriod string `json:"transactions_gas_per_period"`
+	TransactionsGasPerEpoch  string `json:"transactions_gas_per_epoch"`
+	BaseFeePerPeriod         string `json:"base_fee_per_period"`
+	BaseFeePerEpoch          string `json:"base_fee_per_epoch"`
 }
 
 type AttestationRewardsResponse struct {
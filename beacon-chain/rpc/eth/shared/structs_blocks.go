@@ -254,6 +254,84 @@ type BlindedBeaconBlockBodyDeneb struct {
 	BlobKzgCommitments     []string                      `json:"blob_kzg_commitments" validate:"required,dive,hexadecimal"`
 }
 
+type SignedBeaconBlockContentsElectra struct {
+	SignedBlock        *SignedBeaconBlockElectra `json:"signed_block" validate:"required"`
+	SignedBlobSidecars []*SignedBlobSidecar      `json:"signed_blob_sidecars"  validate:"dive"`
+}
+
+type BeaconBlockContentsElectra struct {
+	Block        *BeaconBlockElectra `json:"block" validate:"required"`
+	BlobSidecars []*BlobSidecar      `json:"blob_sidecars" validate:"dive"`
+}
+
+type SignedBeaconBlockElectra struct {
+	Message   *BeaconBlockElectra `json:"message" validate:"required"`
+	Signature string              `json:"signature" validate:"required"`
+}
+
+type BeaconBlockElectra struct {
+	Slot          string                  `json:"slot" validate:"required"`
+	ProposerIndex string                  `json:"proposer_index" validate:"required"`
+	ParentRoot    string                  `json:"parent_root" validate:"required"`
+	StateRoot     string                  `json:"state_root" validate:"required"`
+	Body          *BeaconBlockBodyElectra `json:"body" validate:"required"`
+}
+
+type BeaconBlockBodyElectra struct {
+	RandaoReveal          string                        `json:"randao_reveal" validate:"required"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data" validate:"required"`
+	Graffiti              string                        `json:"graffiti" validate:"required"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" validate:"required,dive"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings" validate:"required,dive"`
+	Attestations          []*Attestation                `json:"attestations" validate:"required,dive"`
+	Deposits              []*Deposit                    `json:"deposits" validate:"required,dive"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" validate:"required,dive"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate" validate:"required"`
+	ExecutionPayload      *ExecutionPayloadDeneb        `json:"execution_payload" validate:"required"`
+	BlsToExecutionChanges []*SignedBlsToExecutionChange `json:"bls_to_execution_changes" validate:"required,dive"`
+	BlobKzgCommitments    []string                      `json:"blob_kzg_commitments" validate:"required,dive"`
+	ContractTransfers     []*SignedContractTransfer     `json:"contract_transfers" validate:"required,dive"`
+}
+
+type SignedBlindedBeaconBlockContentsElectra struct {
+	SignedBlindedBlock        *SignedBlindedBeaconBlockElectra `json:"signed_blinded_block" validate:"required"`
+	SignedBlindedBlobSidecars []*SignedBlindedBlobSidecar      `json:"signed_blinded_blob_sidecars" validate:"dive"`
+}
+
+type BlindedBeaconBlockContentsElectra struct {
+	BlindedBlock        *BlindedBeaconBlockElectra `json:"blinded_block" validate:"required"`
+	BlindedBlobSidecars []*BlindedBlobSidecar      `json:"blinded_blob_sidecars" validate:"dive"`
+}
+
+type BlindedBeaconBlockElectra struct {
+	Slot          string                         `json:"slot" validate:"required"`
+	ProposerIndex string                         `json:"proposer_index" validate:"required"`
+	ParentRoot    string                         `json:"parent_root" validate:"required"`
+	StateRoot     string                         `json:"state_root" validate:"required"`
+	Body          *BlindedBeaconBlockBodyElectra `json:"body" validate:"required"`
+}
+
+type SignedBlindedBeaconBlockElectra struct {
+	Message   *BlindedBeaconBlockElectra `json:"message" validate:"required"`
+	Signature string                     `json:"signature" validate:"required"`
+}
+
+type BlindedBeaconBlockBodyElectra struct {
+	RandaoReveal           string                        `json:"randao_reveal" validate:"required"`
+	Eth1Data               *Eth1Data                     `json:"eth1_data" validate:"required"`
+	Graffiti               string                        `json:"graffiti" validate:"required"`
+	ProposerSlashings      []*ProposerSlashing           `json:"proposer_slashings" validate:"required,dive"`
+	AttesterSlashings      []*AttesterSlashing           `json:"attester_slashings" validate:"required,dive"`
+	Attestations           []*Attestation                `json:"attestations" validate:"required,dive"`
+	Deposits               []*Deposit                    `json:"deposits" validate:"required,dive"`
+	VoluntaryExits         []*SignedVoluntaryExit        `json:"voluntary_exits" validate:"required,dive"`
+	SyncAggregate          *SyncAggregate                `json:"sync_aggregate" validate:"required"`
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb  `json:"execution_payload_header" validate:"required"`
+	BlsToExecutionChanges  []*SignedBlsToExecutionChange `json:"bls_to_execution_changes" validate:"required,dive"`
+	BlobKzgCommitments     []string                      `json:"blob_kzg_commitments" validate:"required,dive,hexadecimal"`
+	ContractTransfers      []*SignedContractTransfer     `json:"contract_transfers" validate:"required,dive"`
+}
+
 type SignedBlindedBlobSidecar struct {
 	Message   *BlindedBlobSidecar `json:"message" validate:"required"`
 	Signature string              `json:"signature" validate:"required"`
@@ -461,3 +539,17 @@ type BlsToExecutionChange struct {
 	FromBlsPubkey      string `json:"from_bls_pubkey" validate:"required"`
 	ToExecutionAddress string `json:"to_execution_address" validate:"required"`
 }
+
+type SignedContractTransfer struct {
+	Message            *ContractTransfer `json:"message" validate:"required"`
+	Signature          string            `json:"signature" validate:"required"`
+	RecipientSignature string            `json:"recipient_signature" validate:"required"`
+}
+
+type ContractTransfer struct {
+	ValidatorIndex  string `json:"validator_index" validate:"required"`
+	RecipientIndex  string `json:"recipient_index" validate:"required"`
+	Contract        string `json:"contract" validate:"required"`
+	ValidUntilEpoch string `json:"valid_until_epoch" validate:"required"`
+	Nonce           string `json:"nonce" validate:"required"`
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	bytesutil2 "github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/math"
@@ -1976,6 +1977,355 @@ func (b *BlindedBeaconBlockDeneb) ToConsensus() (*eth.BlindedBeaconBlockDeneb, e
 	}, nil
 }
 
+func (b *SignedBeaconBlockContentsElectra) ToGeneric() (*eth.GenericSignedBeaconBlock, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	var signedBlobSidecars []*eth.SignedBlobSidecar
+	if len(b.SignedBlobSidecars) != 0 {
+		err := VerifyMaxLength(b.SignedBlobSidecars, fieldparams.MaxBlobsPerBlock)
+		if err != nil {
+			return nil, NewDecodeError(err, "SignedBlobSidecars")
+		}
+		signedBlobSidecars = make([]*eth.SignedBlobSidecar, len(b.SignedBlobSidecars))
+		for i := range b.SignedBlobSidecars {
+			signedBlob, err := b.SignedBlobSidecars[i].ToConsensus()
+			if err != nil {
+				return nil, NewDecodeError(err, fmt.Sprintf("SignedBlobSidecars[%d]", i))
+			}
+			signedBlobSidecars[i] = signedBlob
+		}
+	}
+	signedElectraBlock, err := b.SignedBlock.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedBlock")
+	}
+	block := &eth.SignedBeaconBlockAndBlobsElectra{
+		Block: signedElectraBlock,
+		Blobs: signedBlobSidecars,
+	}
+	return &eth.GenericSignedBeaconBlock{Block: &eth.GenericSignedBeaconBlock_Electra{Electra: block}}, nil
+}
+
+func (b *SignedBeaconBlockContentsElectra) ToUnsigned() *BeaconBlockContentsElectra {
+	var blobSidecars []*BlobSidecar
+	if len(b.SignedBlobSidecars) != 0 {
+		blobSidecars = make([]*BlobSidecar, len(b.SignedBlobSidecars))
+		for i, s := range b.SignedBlobSidecars {
+			blobSidecars[i] = s.Message
+		}
+	}
+	return &BeaconBlockContentsElectra{
+		Block:        b.SignedBlock.Message,
+		BlobSidecars: blobSidecars,
+	}
+}
+
+func (b *BeaconBlockContentsElectra) ToGeneric() (*eth.GenericBeaconBlock, error) {
+	block, err := b.ToConsensus()
+	if err != nil {
+		return nil, err
+	}
+	return &eth.GenericBeaconBlock{Block: &eth.GenericBeaconBlock_Electra{Electra: block}}, nil
+}
+
+func (b *BeaconBlockContentsElectra) ToConsensus() (*eth.BeaconBlockAndBlobsElectra, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	var blobSidecars []*eth.BlobSidecar
+	if len(b.BlobSidecars) != 0 {
+		err := VerifyMaxLength(b.BlobSidecars, fieldparams.MaxBlobsPerBlock)
+		if err != nil {
+			return nil, NewDecodeError(err, "BlobSidecars")
+		}
+		blobSidecars = make([]*eth.BlobSidecar, len(b.BlobSidecars))
+		for i := range b.BlobSidecars {
+			blob, err := b.BlobSidecars[i].ToConsensus()
+			if err != nil {
+				return nil, NewDecodeError(err, fmt.Sprintf("BlobSidecars[%d]", i))
+			}
+			blobSidecars[i] = blob
+		}
+	}
+	electraBlock, err := b.Block.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "Block")
+	}
+	return &eth.BeaconBlockAndBlobsElectra{
+		Block: electraBlock,
+		Blobs: blobSidecars,
+	}, nil
+}
+
+func (b *SignedBlindedBeaconBlockContentsElectra) ToGeneric() (*eth.GenericSignedBeaconBlock, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	var signedBlindedBlobSidecars []*eth.SignedBlindedBlobSidecar
+	if len(b.SignedBlindedBlobSidecars) != 0 {
+		err := VerifyMaxLength(b.SignedBlindedBlobSidecars, fieldparams.MaxBlobsPerBlock)
+		if err != nil {
+			return nil, NewDecodeError(err, "SignedBlindedBlobSidecars")
+		}
+		signedBlindedBlobSidecars = make([]*eth.SignedBlindedBlobSidecar, len(b.SignedBlindedBlobSidecars))
+		for i := range b.SignedBlindedBlobSidecars {
+			signedBlob, err := b.SignedBlindedBlobSidecars[i].ToConensus(i)
+			if err != nil {
+				return nil, NewDecodeError(err, fmt.Sprintf("SignedBlindedBlobSidecars[%d]", i))
+			}
+			signedBlindedBlobSidecars[i] = signedBlob
+		}
+	}
+	signedBlindedBlock, err := b.SignedBlindedBlock.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedBlindedBlock")
+	}
+	block := &eth.SignedBlindedBeaconBlockAndBlobsElectra{
+		SignedBlindedBlock:        signedBlindedBlock,
+		SignedBlindedBlobSidecars: signedBlindedBlobSidecars,
+	}
+	return &eth.GenericSignedBeaconBlock{Block: &eth.GenericSignedBeaconBlock_BlindedElectra{BlindedElectra: block}, IsBlinded: true, PayloadValue: 0 /* can't get payload value from blinded block */}, nil
+}
+
+func (b *SignedBlindedBeaconBlockContentsElectra) ToUnsigned() *BlindedBeaconBlockContentsElectra {
+	var blobSidecars []*BlindedBlobSidecar
+	if len(b.SignedBlindedBlobSidecars) != 0 {
+		blobSidecars = make([]*BlindedBlobSidecar, len(b.SignedBlindedBlobSidecars))
+		for i := range b.SignedBlindedBlobSidecars {
+			blobSidecars[i] = b.SignedBlindedBlobSidecars[i].Message
+		}
+	}
+	return &BlindedBeaconBlockContentsElectra{
+		BlindedBlock:        b.SignedBlindedBlock.Message,
+		BlindedBlobSidecars: blobSidecars,
+	}
+}
+
+func (b *BlindedBeaconBlockContentsElectra) ToGeneric() (*eth.GenericBeaconBlock, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	var blindedBlobSidecars []*eth.BlindedBlobSidecar
+	if len(b.BlindedBlobSidecars) != 0 {
+		err := VerifyMaxLength(b.BlindedBlobSidecars, fieldparams.MaxBlobsPerBlock)
+		if err != nil {
+			return nil, NewDecodeError(err, "BlindedBlobSidecars")
+		}
+		blindedBlobSidecars = make([]*eth.BlindedBlobSidecar, len(b.BlindedBlobSidecars))
+		for i := range b.BlindedBlobSidecars {
+			blob, err := b.BlindedBlobSidecars[i].ToConsensus()
+			if err != nil {
+				return nil, NewDecodeError(err, fmt.Sprintf("BlindedBlobSidecars[%d]", i))
+			}
+			blindedBlobSidecars[i] = blob
+		}
+	}
+	blindedBlock, err := b.BlindedBlock.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "BlindedBlock")
+	}
+	block := &eth.BlindedBeaconBlockAndBlobsElectra{
+		Block: blindedBlock,
+		Blobs: blindedBlobSidecars,
+	}
+	return &eth.GenericBeaconBlock{Block: &eth.GenericBeaconBlock_BlindedElectra{BlindedElectra: block}, IsBlinded: true, PayloadValue: 0 /* can't get payload value from blinded block */}, nil
+}
+
+func (b *SignedBeaconBlockElectra) ToConsensus() (*eth.SignedBeaconBlockElectra, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	sig, err := DecodeHexWithLength(b.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Signature")
+	}
+	block, err := b.Message.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "Message")
+	}
+	return &eth.SignedBeaconBlockElectra{
+		Block:     block,
+		Signature: sig,
+	}, nil
+}
+
+// ToConsensus converts the block through its Deneb counterpart, as the Electra body only
+// extends the Deneb body with contract transfers.
+func (b *BeaconBlockElectra) ToConsensus() (*eth.BeaconBlockElectra, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+	if b.Body == nil {
+		return nil, NewDecodeError(errNilValue, "Body")
+	}
+	if b.Body.ContractTransfers == nil {
+		return nil, NewDecodeError(errNilValue, "Body.ContractTransfers")
+	}
+
+	denebBlock, err := (&BeaconBlockDeneb{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BeaconBlockBodyDeneb{
+			RandaoReveal:          b.Body.RandaoReveal,
+			Eth1Data:              b.Body.Eth1Data,
+			Graffiti:              b.Body.Graffiti,
+			ProposerSlashings:     b.Body.ProposerSlashings,
+			AttesterSlashings:     b.Body.AttesterSlashings,
+			Attestations:          b.Body.Attestations,
+			Deposits:              b.Body.Deposits,
+			VoluntaryExits:        b.Body.VoluntaryExits,
+			SyncAggregate:         b.Body.SyncAggregate,
+			ExecutionPayload:      b.Body.ExecutionPayload,
+			BlsToExecutionChanges: b.Body.BlsToExecutionChanges,
+			BlobKzgCommitments:    b.Body.BlobKzgCommitments,
+		},
+	}).ToConsensus()
+	if err != nil {
+		return nil, err
+	}
+	contractTransfers, err := contractTransfersToConsensus(b.Body.ContractTransfers)
+	if err != nil {
+		return nil, err
+	}
+	body := denebBlock.Body
+	return &eth.BeaconBlockElectra{
+		Slot:          denebBlock.Slot,
+		ProposerIndex: denebBlock.ProposerIndex,
+		ParentRoot:    denebBlock.ParentRoot,
+		StateRoot:     denebBlock.StateRoot,
+		Body: &eth.BeaconBlockBodyElectra{
+			RandaoReveal:          body.RandaoReveal,
+			Eth1Data:              body.Eth1Data,
+			Graffiti:              body.Graffiti,
+			ProposerSlashings:     body.ProposerSlashings,
+			AttesterSlashings:     body.AttesterSlashings,
+			Attestations:          body.Attestations,
+			Deposits:              body.Deposits,
+			VoluntaryExits:        body.VoluntaryExits,
+			ActivityChanges:       body.ActivityChanges,
+			TransactionsCount:     body.TransactionsCount,
+			BaseFee:               body.BaseFee,
+			SyncAggregate:         body.SyncAggregate,
+			ExecutionPayload:      body.ExecutionPayload,
+			BlsToExecutionChanges: body.BlsToExecutionChanges,
+			BlobKzgCommitments:    body.BlobKzgCommitments,
+			ContractTransfers:     contractTransfers,
+		},
+	}, nil
+}
+
+func (b *SignedBlindedBeaconBlockElectra) ToConsensus() (*eth.SignedBlindedBeaconBlockElectra, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+
+	sig, err := DecodeHexWithLength(b.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Signature")
+	}
+	blindedBlock, err := b.Message.ToConsensus()
+	if err != nil {
+		return nil, err
+	}
+	return &eth.SignedBlindedBeaconBlockElectra{
+		Message:   blindedBlock,
+		Signature: sig,
+	}, nil
+}
+
+// ToConsensus converts the block through its Deneb counterpart, as the Electra body only
+// extends the Deneb body with contract transfers.
+func (b *BlindedBeaconBlockElectra) ToConsensus() (*eth.BlindedBeaconBlockElectra, error) {
+	if b == nil {
+		return nil, errNilValue
+	}
+	if b.Body == nil {
+		return nil, NewDecodeError(errNilValue, "Body")
+	}
+	if b.Body.ContractTransfers == nil {
+		return nil, NewDecodeError(errNilValue, "Body.ContractTransfers")
+	}
+
+	denebBlock, err := (&BlindedBeaconBlockDeneb{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BlindedBeaconBlockBodyDeneb{
+			RandaoReveal:           b.Body.RandaoReveal,
+			Eth1Data:               b.Body.Eth1Data,
+			Graffiti:               b.Body.Graffiti,
+			ProposerSlashings:      b.Body.ProposerSlashings,
+			AttesterSlashings:      b.Body.AttesterSlashings,
+			Attestations:           b.Body.Attestations,
+			Deposits:               b.Body.Deposits,
+			VoluntaryExits:         b.Body.VoluntaryExits,
+			SyncAggregate:          b.Body.SyncAggregate,
+			ExecutionPayloadHeader: b.Body.ExecutionPayloadHeader,
+			BlsToExecutionChanges:  b.Body.BlsToExecutionChanges,
+			BlobKzgCommitments:     b.Body.BlobKzgCommitments,
+		},
+	}).ToConsensus()
+	if err != nil {
+		return nil, err
+	}
+	contractTransfers, err := contractTransfersToConsensus(b.Body.ContractTransfers)
+	if err != nil {
+		return nil, err
+	}
+	body := denebBlock.Body
+	return &eth.BlindedBeaconBlockElectra{
+		Slot:          denebBlock.Slot,
+		ProposerIndex: denebBlock.ProposerIndex,
+		ParentRoot:    denebBlock.ParentRoot,
+		StateRoot:     denebBlock.StateRoot,
+		Body: &eth.BlindedBeaconBlockBodyElectra{
+			RandaoReveal:           body.RandaoReveal,
+			Eth1Data:               body.Eth1Data,
+			Graffiti:               body.Graffiti,
+			ProposerSlashings:      body.ProposerSlashings,
+			AttesterSlashings:      body.AttesterSlashings,
+			Attestations:           body.Attestations,
+			Deposits:               body.Deposits,
+			VoluntaryExits:         body.VoluntaryExits,
+			ActivityChanges:        body.ActivityChanges,
+			TransactionsCount:      body.TransactionsCount,
+			BaseFee:                body.BaseFee,
+			SyncAggregate:          body.SyncAggregate,
+			ExecutionPayloadHeader: body.ExecutionPayloadHeader,
+			BlsToExecutionChanges:  body.BlsToExecutionChanges,
+			BlobKzgCommitments:     body.BlobKzgCommitments,
+			ContractTransfers:      contractTransfers,
+		},
+	}, nil
+}
+
+func contractTransfersToConsensus(src []*SignedContractTransfer) ([]*eth.SignedContractTransfer, error) {
+	err := VerifyMaxLength(src, int(params.BeaconConfig().MaxContractTransfers))
+	if err != nil {
+		return nil, NewDecodeError(err, "Body.ContractTransfers")
+	}
+	transfers := make([]*eth.SignedContractTransfer, len(src))
+	for i, t := range src {
+		if t == nil {
+			return nil, NewDecodeError(errNilValue, fmt.Sprintf("Body.ContractTransfers[%d]", i))
+		}
+		transfers[i], err = t.ToConsensus()
+		if err != nil {
+			return nil, NewDecodeError(err, fmt.Sprintf("Body.ContractTransfers[%d]", i))
+		}
+	}
+	return transfers, nil
+}
+
 func (s *SignedBlindedBlobSidecar) ToConensus(i int) (*eth.SignedBlindedBlobSidecar, error) {
 	if s == nil {
 		return nil, errNilValue
@@ -2763,6 +3113,212 @@ func SignedBeaconBlockDenebFromConsensus(b *eth.SignedBeaconBlockDeneb) (*Signed
 	}, nil
 }
 
+func BlindedBeaconBlockContentsElectraFromConsensus(b *eth.BlindedBeaconBlockAndBlobsElectra) (*BlindedBeaconBlockContentsElectra, error) {
+	var blindedBlobSidecars []*BlindedBlobSidecar
+	if len(b.Blobs) != 0 {
+		blindedBlobSidecars = make([]*BlindedBlobSidecar, len(b.Blobs))
+		for i, s := range b.Blobs {
+			signedBlob, err := BlindedBlobSidecarFromConsensus(s)
+			if err != nil {
+				return nil, err
+			}
+			blindedBlobSidecars[i] = signedBlob
+		}
+	}
+	blindedBlock, err := BlindedBeaconBlockElectraFromConsensus(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	return &BlindedBeaconBlockContentsElectra{
+		BlindedBlock:        blindedBlock,
+		BlindedBlobSidecars: blindedBlobSidecars,
+	}, nil
+}
+
+func SignedBlindedBeaconBlockContentsElectraFromConsensus(b *eth.SignedBlindedBeaconBlockAndBlobsElectra) (*SignedBlindedBeaconBlockContentsElectra, error) {
+	var blindedBlobSidecars []*SignedBlindedBlobSidecar
+	if len(b.SignedBlindedBlobSidecars) != 0 {
+		blindedBlobSidecars = make([]*SignedBlindedBlobSidecar, len(b.SignedBlindedBlobSidecars))
+		for i, s := range b.SignedBlindedBlobSidecars {
+			signedBlob, err := SignedBlindedBlobSidecarFromConsensus(s)
+			if err != nil {
+				return nil, err
+			}
+			blindedBlobSidecars[i] = signedBlob
+		}
+	}
+	blindedBlock, err := SignedBlindedBeaconBlockElectraFromConsensus(b.SignedBlindedBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlockContentsElectra{
+		SignedBlindedBlock:        blindedBlock,
+		SignedBlindedBlobSidecars: blindedBlobSidecars,
+	}, nil
+}
+
+func BeaconBlockContentsElectraFromConsensus(b *eth.BeaconBlockAndBlobsElectra) (*BeaconBlockContentsElectra, error) {
+	var blobSidecars []*BlobSidecar
+	if len(b.Blobs) != 0 {
+		blobSidecars = make([]*BlobSidecar, len(b.Blobs))
+		for i, s := range b.Blobs {
+			blob, err := BlobSidecarFromConsensus(s)
+			if err != nil {
+				return nil, err
+			}
+			blobSidecars[i] = blob
+		}
+	}
+	block, err := BeaconBlockElectraFromConsensus(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconBlockContentsElectra{
+		Block:        block,
+		BlobSidecars: blobSidecars,
+	}, nil
+}
+
+func SignedBeaconBlockContentsElectraFromConsensus(b *eth.SignedBeaconBlockAndBlobsElectra) (*SignedBeaconBlockContentsElectra, error) {
+	var blobSidecars []*SignedBlobSidecar
+	if len(b.Blobs) != 0 {
+		blobSidecars = make([]*SignedBlobSidecar, len(b.Blobs))
+		for i, s := range b.Blobs {
+			blob, err := SignedBlobSidecarFromConsensus(s)
+			if err != nil {
+				return nil, err
+			}
+			blobSidecars[i] = blob
+		}
+	}
+	block, err := SignedBeaconBlockElectraFromConsensus(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBeaconBlockContentsElectra{
+		SignedBlock:        block,
+		SignedBlobSidecars: blobSidecars,
+	}, nil
+}
+
+func BlindedBeaconBlockElectraFromConsensus(b *eth.BlindedBeaconBlockElectra) (*BlindedBeaconBlockElectra, error) {
+	block, err := BlindedBeaconBlockDenebFromConsensus(&eth.BlindedBeaconBlockDeneb{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &eth.BlindedBeaconBlockBodyDeneb{
+			RandaoReveal:           b.Body.RandaoReveal,
+			Eth1Data:               b.Body.Eth1Data,
+			Graffiti:               b.Body.Graffiti,
+			ProposerSlashings:      b.Body.ProposerSlashings,
+			AttesterSlashings:      b.Body.AttesterSlashings,
+			Attestations:           b.Body.Attestations,
+			Deposits:               b.Body.Deposits,
+			VoluntaryExits:         b.Body.VoluntaryExits,
+			SyncAggregate:          b.Body.SyncAggregate,
+			ExecutionPayloadHeader: b.Body.ExecutionPayloadHeader,
+			BlsToExecutionChanges:  b.Body.BlsToExecutionChanges,
+			BlobKzgCommitments:     b.Body.BlobKzgCommitments,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	body := block.Body
+	return &BlindedBeaconBlockElectra{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		Body: &BlindedBeaconBlockBodyElectra{
+			RandaoReveal:           body.RandaoReveal,
+			Eth1Data:               body.Eth1Data,
+			Graffiti:               body.Graffiti,
+			ProposerSlashings:      body.ProposerSlashings,
+			AttesterSlashings:      body.AttesterSlashings,
+			Attestations:           body.Attestations,
+			Deposits:               body.Deposits,
+			VoluntaryExits:         body.VoluntaryExits,
+			SyncAggregate:          body.SyncAggregate,
+			ExecutionPayloadHeader: body.ExecutionPayloadHeader,
+			BlsToExecutionChanges:  body.BlsToExecutionChanges,
+			BlobKzgCommitments:     body.BlobKzgCommitments,
+			ContractTransfers:      ContractTransfersFromConsensus(b.Body.ContractTransfers), // new in electra
+		},
+	}, nil
+}
+
+func SignedBlindedBeaconBlockElectraFromConsensus(b *eth.SignedBlindedBeaconBlockElectra) (*SignedBlindedBeaconBlockElectra, error) {
+	block, err := BlindedBeaconBlockElectraFromConsensus(b.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlockElectra{
+		Message:   block,
+		Signature: hexutil.Encode(b.Signature),
+	}, nil
+}
+
+func BeaconBlockElectraFromConsensus(b *eth.BeaconBlockElectra) (*BeaconBlockElectra, error) {
+	block, err := BeaconBlockDenebFromConsensus(&eth.BeaconBlockDeneb{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &eth.BeaconBlockBodyDeneb{
+			RandaoReveal:          b.Body.RandaoReveal,
+			Eth1Data:              b.Body.Eth1Data,
+			Graffiti:              b.Body.Graffiti,
+			ProposerSlashings:     b.Body.ProposerSlashings,
+			AttesterSlashings:     b.Body.AttesterSlashings,
+			Attestations:          b.Body.Attestations,
+			Deposits:              b.Body.Deposits,
+			VoluntaryExits:        b.Body.VoluntaryExits,
+			SyncAggregate:         b.Body.SyncAggregate,
+			ExecutionPayload:      b.Body.ExecutionPayload,
+			BlsToExecutionChanges: b.Body.BlsToExecutionChanges,
+			BlobKzgCommitments:    b.Body.BlobKzgCommitments,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	body := block.Body
+	return &BeaconBlockElectra{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		StateRoot:     block.StateRoot,
+		Body: &BeaconBlockBodyElectra{
+			RandaoReveal:          body.RandaoReveal,
+			Eth1Data:              body.Eth1Data,
+			Graffiti:              body.Graffiti,
+			ProposerSlashings:     body.ProposerSlashings,
+			AttesterSlashings:     body.AttesterSlashings,
+			Attestations:          body.Attestations,
+			Deposits:              body.Deposits,
+			VoluntaryExits:        body.VoluntaryExits,
+			SyncAggregate:         body.SyncAggregate,
+			ExecutionPayload:      body.ExecutionPayload,
+			BlsToExecutionChanges: body.BlsToExecutionChanges,
+			BlobKzgCommitments:    body.BlobKzgCommitments,
+			ContractTransfers:     ContractTransfersFromConsensus(b.Body.ContractTransfers), // new in electra
+		},
+	}, nil
+}
+
+func SignedBeaconBlockElectraFromConsensus(b *eth.SignedBeaconBlockElectra) (*SignedBeaconBlockElectra, error) {
+	beaconBlock, err := BeaconBlockElectraFromConsensus(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBeaconBlockElectra{
+		Message:   beaconBlock,
+		Signature: hexutil.Encode(b.Signature),
+	}, nil
+}
+
 func BlindedBlobSidecarFromConsensus(b *eth.BlindedBlobSidecar) (*BlindedBlobSidecar, error) {
 	return &BlindedBlobSidecar{
 		BlockRoot:       hexutil.Encode(b.BlockRoot),
@@ -3270,6 +3826,73 @@ func BlsChangesFromConsensus(src []*eth.SignedBLSToExecutionChange) ([]*SignedBl
 	return changes, nil
 }
 
+func ContractTransfersFromConsensus(src []*eth.SignedContractTransfer) []*SignedContractTransfer {
+	transfers := make([]*SignedContractTransfer, len(src))
+	for i, tr := range src {
+		transfers[i] = SignedContractTransferFromConsensus(tr)
+	}
+	return transfers
+}
+
+func (t *SignedContractTransfer) ToConsensus() (*eth.SignedContractTransfer, error) {
+	if t.Message == nil {
+		return nil, NewDecodeError(errNilValue, "Message")
+	}
+	sig, err := DecodeHexWithLength(t.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Signature")
+	}
+	recipientSig, err := DecodeHexWithLength(t.RecipientSignature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "RecipientSignature")
+	}
+	index, err := strconv.ParseUint(t.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.ValidatorIndex")
+	}
+	recipient, err := strconv.ParseUint(t.Message.RecipientIndex, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.RecipientIndex")
+	}
+	contract, err := DecodeHexWithLength(t.Message.Contract, common.AddressLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.Contract")
+	}
+	validUntil, err := strconv.ParseUint(t.Message.ValidUntilEpoch, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.ValidUntilEpoch")
+	}
+	nonce, err := strconv.ParseUint(t.Message.Nonce, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.Nonce")
+	}
+	return &eth.SignedContractTransfer{
+		Message: &eth.ContractTransfer{
+			ValidatorIndex:  primitives.ValidatorIndex(index),
+			RecipientIndex:  primitives.ValidatorIndex(recipient),
+			Contract:        contract,
+			ValidUntilEpoch: primitives.Epoch(validUntil),
+			Nonce:           nonce,
+		},
+		Signature:          sig,
+		RecipientSignature: recipientSig,
+	}, nil
+}
+
+func SignedContractTransferFromConsensus(t *eth.SignedContractTransfer) *SignedContractTransfer {
+	return &SignedContractTransfer{
+		Message: &ContractTransfer{
+			ValidatorIndex:  fmt.Sprintf("%d", t.Message.ValidatorIndex),
+			RecipientIndex:  fmt.Sprintf("%d", t.Message.RecipientIndex),
+			Contract:        hexutil.Encode(t.Message.Contract),
+			ValidUntilEpoch: fmt.Sprintf("%d", t.Message.ValidUntilEpoch),
+			Nonce:           fmt.Sprintf("%d", t.Message.Nonce),
+		},
+		Signature:          hexutil.Encode(t.Signature),
+		RecipientSignature: hexutil.Encode(t.RecipientSignature),
+	}
+}
+
 func Uint256ToSSZBytes(num string) ([]byte, error) {
 	uint256, ok := new(big.Int).SetString(num, 10)
 	if !ok {
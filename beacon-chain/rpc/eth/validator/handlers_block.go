@@ -135,6 +135,16 @@ func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *h
 		handleProduceDenebV3(ctx, w, isSSZ, denebBlockContents, v1alpha1resp.PayloadValue)
 		return
 	}
+	blindedElectraBlockContents, ok := v1alpha1resp.Block.(*eth.GenericBeaconBlock_BlindedElectra)
+	if ok {
+		handleProduceBlindedElectraV3(ctx, w, isSSZ, blindedElectraBlockContents, v1alpha1resp.PayloadValue)
+		return
+	}
+	electraBlockContents, ok := v1alpha1resp.Block.(*eth.GenericBeaconBlock_Electra)
+	if ok {
+		handleProduceElectraV3(ctx, w, isSSZ, electraBlockContents, v1alpha1resp.PayloadValue)
+		return
+	}
 }
 
 func handleProducePhase0V3(
@@ -424,3 +434,75 @@ func handleProduceDenebV3(
 		Data:                    jsonBytes,
 	})
 }
+
+func handleProduceBlindedElectraV3(
+	ctx context.Context,
+	w http.ResponseWriter,
+	isSSZ bool,
+	blk *eth.GenericBeaconBlock_BlindedElectra,
+	payloadValue uint64,
+) {
+	_, span := trace.StartSpan(ctx, "validator.ProduceBlockV3.internal.handleProduceBlindedElectraV3")
+	defer span.End()
+	if isSSZ {
+		sszResp, err := blk.BlindedElectra.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "blindedElectraBlockContents.ssz")
+		return
+	}
+	blockContents, err := shared.BlindedBeaconBlockContentsElectraFromConsensus(blk.BlindedElectra)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonBytes, err := json.Marshal(blockContents)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &ProduceBlockV3Response{
+		Version:                 version.String(version.Electra),
+		ExecutionPayloadBlinded: true,
+		ExecutionPayloadValue:   fmt.Sprintf("%d", payloadValue),
+		Data:                    jsonBytes,
+	})
+}
+
+func handleProduceElectraV3(
+	ctx context.Context,
+	w http.ResponseWriter,
+	isSSZ bool,
+	blk *eth.GenericBeaconBlock_Electra,
+	payloadValue uint64,
+) {
+	_, span := trace.StartSpan(ctx, "validator.ProduceBlockV3.internal.handleProduceElectraV3")
+	defer span.End()
+	if isSSZ {
+		sszResp, err := blk.Electra.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "electraBlockContents.ssz")
+		return
+	}
+	blockContents, err := shared.BeaconBlockContentsElectraFromConsensus(blk.Electra)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonBytes, err := json.Marshal(blockContents)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &ProduceBlockV3Response{
+		Version:                 version.String(version.Electra),
+		ExecutionPayloadBlinded: false,
+		ExecutionPayloadValue:   fmt.Sprintf("%d", payloadValue), // mev not available at this point
+		Data:                    jsonBytes,
+	})
+}
@@ -129,6 +129,27 @@ func (vs *Server) ProduceBlockV2(ctx context.Context, req *ethpbv1.ProduceBlockR
 			},
 		}, nil
 	}
+	_, ok = v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_BlindedElectra)
+	if ok {
+		return nil, status.Error(codes.Internal, "Prepared Electra beacon block contents are blinded")
+	}
+	electraBlock, ok := v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_Electra)
+	if ok {
+		blockAndBlobs, err := migration.V1Alpha1BeaconBlockElectraAndBlobsToV2(electraBlock.Electra)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare beacon block contents: %v", err)
+		}
+		return &ethpbv2.ProduceBlockResponseV2{
+			Version: ethpbv2.Version_ELECTRA,
+			Data: &ethpbv2.BeaconBlockContainerV2{
+				Block: &ethpbv2.BeaconBlockContainerV2_ElectraContents{
+					ElectraContents: &ethpbv2.BeaconBlockContentsElectra{
+						Block:        blockAndBlobs.Block,
+						BlobSidecars: blockAndBlobs.BlobSidecars,
+					}},
+			},
+		}, nil
+	}
 	return nil, status.Error(codes.InvalidArgument, "Unsupported block type")
 }
 
@@ -256,6 +277,25 @@ func (vs *Server) ProduceBlockV2SSZ(ctx context.Context, req *ethpbv1.ProduceBlo
 			Data:    sszBlock,
 		}, nil
 	}
+	_, ok = v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_BlindedElectra)
+	if ok {
+		return nil, status.Error(codes.Internal, "Prepared Electra beacon blockcontent is blinded")
+	}
+	electraBlockcontent, ok := v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_Electra)
+	if ok {
+		blockContent, err := migration.V1Alpha1BeaconBlockElectraAndBlobsToV2(electraBlockcontent.Electra)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+		}
+		sszBlock, err := blockContent.MarshalSSZ()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not marshal block into SSZ format: %v", err)
+		}
+		return &ethpbv2.SSZContainer{
+			Version: ethpbv2.Version_ELECTRA,
+			Data:    sszBlock,
+		}, nil
+	}
 
 	return nil, status.Error(codes.InvalidArgument, "Unsupported block type")
 }
@@ -379,6 +419,27 @@ func (vs *Server) ProduceBlindedBlock(ctx context.Context, req *ethpbv1.ProduceB
 			},
 		}, nil
 	}
+	_, ok = v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_Electra)
+	if ok {
+		return nil, status.Error(codes.Internal, "Prepared Electra beacon block contents are not blinded")
+	}
+	electraBlock, ok := v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_BlindedElectra)
+	if ok {
+		blockAndBlobs, err := migration.V1Alpha1BlindedBlockAndBlobsElectraToV2Blinded(electraBlock.BlindedElectra)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare beacon block contents: %v", err)
+		}
+		return &ethpbv2.ProduceBlindedBlockResponse{
+			Version: ethpbv2.Version_ELECTRA,
+			Data: &ethpbv2.BlindedBeaconBlockContainer{
+				Block: &ethpbv2.BlindedBeaconBlockContainer_ElectraContents{
+					ElectraContents: &ethpbv2.BlindedBeaconBlockContentsElectra{
+						BlindedBlock:        blockAndBlobs.BlindedBlock,
+						BlindedBlobSidecars: blockAndBlobs.BlindedBlobSidecars,
+					}},
+			},
+		}, nil
+	}
 	return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("block was not a supported blinded block type, validator may not be registered if using a relay. received: %T", v1alpha1resp.Block))
 }
 
@@ -505,5 +566,24 @@ func (vs *Server) ProduceBlindedBlockSSZ(ctx context.Context, req *ethpbv1.Produ
 			Data:    sszBlock,
 		}, nil
 	}
+	_, ok = v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_Electra)
+	if ok {
+		return nil, status.Error(codes.Internal, "Prepared Electra beacon block content is not blinded")
+	}
+	electraBlockcontent, ok := v1alpha1resp.Block.(*ethpbalpha.GenericBeaconBlock_BlindedElectra)
+	if ok {
+		blockContent, err := migration.V1Alpha1BlindedBlockAndBlobsElectraToV2Blinded(electraBlockcontent.BlindedElectra)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not prepare beacon block: %v", err)
+		}
+		sszBlock, err := blockContent.MarshalSSZ()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not marshal block into SSZ format: %v", err)
+		}
+		return &ethpbv2.SSZContainer{
+			Version: ethpbv2.Version_ELECTRA,
+			Data:    sszBlock,
+		}, nil
+	}
 	return nil, status.Error(codes.InvalidArgument, "Unsupported block type")
 }
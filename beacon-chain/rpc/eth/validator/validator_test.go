@@ -162,6 +162,49 @@ func TestProduceBlockV2(t *testing.T) {
 		_, err := server.ProduceBlockV2(ctx, &ethpbv1.ProduceBlockRequest{})
 		assert.ErrorContains(t, "Prepared Deneb beacon block contents are blinded", err)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		blk := &ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_Electra{
+				Electra: &ethpbalpha.BeaconBlockAndBlobsElectra{
+					Block: &ethpbalpha.BeaconBlockElectra{Slot: 123},
+					Blobs: []*ethpbalpha.BlobSidecar{{Slot: 123}},
+				}}}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blk, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		resp, err := server.ProduceBlockV2(ctx, &ethpbv1.ProduceBlockRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, ethpbv2.Version_ELECTRA, resp.Version)
+		containerBlock, ok := resp.Data.Block.(*ethpbv2.BeaconBlockContainerV2_ElectraContents)
+		require.Equal(t, true, ok)
+		assert.Equal(t, primitives.Slot(123), containerBlock.ElectraContents.Block.Slot)
+		require.Equal(t, 1, len(containerBlock.ElectraContents.BlobSidecars))
+		assert.Equal(t, primitives.Slot(123), containerBlock.ElectraContents.BlobSidecars[0].Slot)
+	})
+	t.Run("Electra blinded", func(t *testing.T) {
+		blk := &ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_BlindedElectra{
+				BlindedElectra: &ethpbalpha.BlindedBeaconBlockAndBlobsElectra{
+					Block: &ethpbalpha.BlindedBeaconBlockElectra{Slot: 123},
+					Blobs: []*ethpbalpha.BlindedBlobSidecar{{Slot: 123}},
+				}}}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blk, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		_, err := server.ProduceBlockV2(ctx, &ethpbv1.ProduceBlockRequest{})
+		assert.ErrorContains(t, "Prepared Electra beacon block contents are blinded", err)
+	})
 	t.Run("optimistic", func(t *testing.T) {
 		blk := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_Bellatrix{Bellatrix: &ethpbalpha.BeaconBlockBellatrix{Slot: 123}}}
 		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
@@ -358,6 +401,75 @@ func TestProduceBlockV2SSZ(t *testing.T) {
 		_, err = server.ProduceBlockV2SSZ(ctx, &ethpbv1.ProduceBlockRequest{})
 		assert.ErrorContains(t, "Prepared Deneb beacon blockcontent is blinded", err)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b, err := util.NewBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		b.SignedBlock.Message.Slot = 123
+		blk, err := migration.V2BeaconBlockElectraToV1Alpha1(b.SignedBlock.Message)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlobsToV1Alpha1SignedBlobs(b.SignedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlobSidecar, len(signedBlobs))
+		v2blobs := make([]*ethpbv2.BlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+			v2blobs[i] = b.SignedBlobSidecars[i].Message
+		}
+
+		blkContents := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_Electra{
+			Electra: &ethpbalpha.BeaconBlockAndBlobsElectra{
+				Block: blk,
+				Blobs: blobs,
+			},
+		}}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blkContents, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		resp, err := server.ProduceBlockV2SSZ(ctx, &ethpbv1.ProduceBlockRequest{})
+		require.NoError(t, err)
+		expectedObject := &ethpbv2.BeaconBlockContentsElectra{
+			Block:        b.SignedBlock.Message,
+			BlobSidecars: v2blobs,
+		}
+		expectedData, err := expectedObject.MarshalSSZ()
+		assert.NoError(t, err)
+		assert.DeepEqual(t, expectedData, resp.Data)
+	})
+	t.Run("Electra blinded", func(t *testing.T) {
+		b, err := util.NewBlindedBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		blk, err := migration.BlindedElectraToV1Alpha1SignedBlock(b.SignedBlindedBlock)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(b.SignedBlindedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlindedBlobSidecar, len(signedBlobs))
+		v2blobs := make([]*ethpbv2.BlindedBlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+			v2blobs[i] = b.SignedBlindedBlobSidecars[i].Message
+		}
+		genericBlock := &ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_BlindedElectra{
+				BlindedElectra: &ethpbalpha.BlindedBeaconBlockAndBlobsElectra{
+					Block: blk.Message,
+					Blobs: blobs,
+				},
+			},
+		}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(genericBlock, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		_, err = server.ProduceBlockV2SSZ(ctx, &ethpbv1.ProduceBlockRequest{})
+		assert.ErrorContains(t, "Prepared Electra beacon blockcontent is blinded", err)
+	})
 	t.Run("optimistic", func(t *testing.T) {
 		blk := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_Bellatrix{Bellatrix: &ethpbalpha.BeaconBlockBellatrix{Slot: 123}}}
 		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
@@ -558,6 +670,73 @@ func TestProduceBlindedBlock(t *testing.T) {
 		_, err = server.ProduceBlindedBlock(ctx, &ethpbv1.ProduceBlockRequest{})
 		assert.ErrorContains(t, "Prepared Deneb beacon block contents are not blinded", err)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b, err := util.NewBlindedBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		b.SignedBlindedBlock.Message.Slot = 123
+		blk, err := migration.BlindedElectraToV1Alpha1SignedBlock(b.SignedBlindedBlock)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(b.SignedBlindedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlindedBlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+		}
+		genericBlock := &ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_BlindedElectra{
+				BlindedElectra: &ethpbalpha.BlindedBeaconBlockAndBlobsElectra{
+					Block: blk.Message,
+					Blobs: blobs,
+				},
+			},
+		}
+
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(genericBlock, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		resp, err := server.ProduceBlindedBlock(ctx, &ethpbv1.ProduceBlockRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, ethpbv2.Version_ELECTRA, resp.Version)
+		containerBlock, ok := resp.Data.Block.(*ethpbv2.BlindedBeaconBlockContainer_ElectraContents)
+		require.Equal(t, true, ok)
+		assert.Equal(t, primitives.Slot(123), containerBlock.ElectraContents.BlindedBlock.Slot)
+		assert.Equal(t, fieldparams.MaxBlobsPerBlock, len(containerBlock.ElectraContents.BlindedBlobSidecars))
+	})
+	t.Run("Electra full", func(t *testing.T) {
+		b, err := util.NewBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		b.SignedBlock.Message.Slot = 123
+		blk, err := migration.V2BeaconBlockElectraToV1Alpha1(b.SignedBlock.Message)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlobsToV1Alpha1SignedBlobs(b.SignedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+		}
+		blkContents := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_Electra{
+			Electra: &ethpbalpha.BeaconBlockAndBlobsElectra{
+				Block: blk,
+				Blobs: blobs,
+			},
+		}}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blkContents, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		_, err = server.ProduceBlindedBlock(ctx, &ethpbv1.ProduceBlockRequest{})
+		assert.ErrorContains(t, "Prepared Electra beacon block contents are not blinded", err)
+	})
 	t.Run("optimistic", func(t *testing.T) {
 		blk := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: &ethpbalpha.BlindedBeaconBlockBellatrix{Slot: 123}}}
 		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
@@ -769,6 +948,76 @@ func TestProduceBlindedBlockSSZ(t *testing.T) {
 		_, err = server.ProduceBlindedBlockSSZ(ctx, &ethpbv1.ProduceBlockRequest{})
 		assert.ErrorContains(t, "Prepared Deneb beacon block content is not blinded", err)
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b, err := util.NewBlindedBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		b.SignedBlindedBlock.Message.Slot = 123
+		blk, err := migration.BlindedElectraToV1Alpha1SignedBlock(b.SignedBlindedBlock)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(b.SignedBlindedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlindedBlobSidecar, len(signedBlobs))
+		v2blobs := make([]*ethpbv2.BlindedBlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+			v2blobs[i] = b.SignedBlindedBlobSidecars[i].Message
+		}
+		genericBlock := &ethpbalpha.GenericBeaconBlock{
+			Block: &ethpbalpha.GenericBeaconBlock_BlindedElectra{
+				BlindedElectra: &ethpbalpha.BlindedBeaconBlockAndBlobsElectra{
+					Block: blk.Message,
+					Blobs: blobs,
+				},
+			},
+		}
+
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(genericBlock, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		resp, err := server.ProduceBlindedBlockSSZ(ctx, &ethpbv1.ProduceBlockRequest{})
+		require.NoError(t, err)
+		expectedObject := &ethpbv2.BlindedBeaconBlockContentsElectra{
+			BlindedBlock:        b.SignedBlindedBlock.Message,
+			BlindedBlobSidecars: v2blobs,
+		}
+		expectedData, err := expectedObject.MarshalSSZ()
+		assert.NoError(t, err)
+		assert.DeepEqual(t, expectedData, resp.Data)
+	})
+	t.Run("Electra full", func(t *testing.T) {
+		b, err := util.NewBeaconBlockContentsElectra(fieldparams.MaxBlobsPerBlock)
+		require.NoError(t, err)
+		b.SignedBlock.Message.Slot = 123
+		blk, err := migration.V2BeaconBlockElectraToV1Alpha1(b.SignedBlock.Message)
+		require.NoError(t, err)
+		signedBlobs := migration.SignedBlobsToV1Alpha1SignedBlobs(b.SignedBlobSidecars)
+		blobs := make([]*ethpbalpha.BlobSidecar, len(signedBlobs))
+		for i := range signedBlobs {
+			blobs[i] = signedBlobs[i].Message
+		}
+		blkContents := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_Electra{
+			Electra: &ethpbalpha.BeaconBlockAndBlobsElectra{
+				Block: blk,
+				Blobs: blobs,
+			},
+		}}
+		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(blkContents, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+			OptimisticModeFetcher: &mockChain.ChainService{Optimistic: false},
+		}
+
+		_, err = server.ProduceBlindedBlockSSZ(ctx, &ethpbv1.ProduceBlockRequest{})
+		assert.ErrorContains(t, "Prepared Electra beacon block content is not blinded", err)
+	})
 	t.Run("optimistic", func(t *testing.T) {
 		blk := &ethpbalpha.GenericBeaconBlock{Block: &ethpbalpha.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: &ethpbalpha.BlindedBeaconBlockBellatrix{Slot: 123}}}
 		v1alpha1Server := mock.NewMockBeaconNodeValidatorServer(ctrl)
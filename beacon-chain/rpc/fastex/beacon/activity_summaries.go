@@ -0,0 +1,110 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// maxActivitySummaryEpochs is the maximum number of epochs a single activity summaries request can span.
+const maxActivitySummaryEpochs = 1024
+
+// GetEpochActivitySummaries is a HTTP handler that serves the GET /fastex/v1/beacon/activity_summaries endpoint.
+// It returns the network wide shared activity, total power, total effective power and total effective activity
+// recorded by the beacon node at every epoch transition it processed.
+//
+// The optional from_epoch and to_epoch query parameters bound the returned range (inclusive). to_epoch defaults to
+// the current head epoch and from_epoch to the earliest epoch that keeps the range within 1024 epochs, a wider
+// range is rejected. Only the summaries taken on the canonical chain are returned. Epochs the node did not
+// process, e.g. before a checkpoint sync, are absent.
+//
+// Example usage:
+//
+//	GET /fastex/v1/beacon/activity_summaries?from_epoch=10&to_epoch=10
+//
+// The above request will return a JSON response like:
+//
+//	{
+//		"data": [
+//			{
+//				"epoch": "10",
+//				"transactions_gas_per_period": "4200000",
+//				"transactions_gas_per_epoch": "525000",
+//				"base_fee_per_period": "80000000000",
+//				"base_fee_per_epoch": "10000000000",
+//				"total_power": "2048000000000",
+//				"total_effective_power": "2051000000000",
+//				"total_effective_activity": "3150000"
+//			}
+//		]
+//	}
+func (s *Server) GetEpochActivitySummaries(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetEpochActivitySummaries")
+	defer span.End()
+
+	ok, rawFrom, fromEpoch := shared.UintFromQuery(w, r, "from_epoch")
+	if !ok {
+		return
+	}
+	ok, rawTo, toEpoch := shared.UintFromQuery(w, r, "to_epoch")
+	if !ok {
+		return
+	}
+	if rawTo == "" {
+		headState, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		toEpoch = uint64(slots.ToEpoch(headState.Slot()))
+	}
+	if rawFrom == "" {
+		fromEpoch = 0
+		if toEpoch >= maxActivitySummaryEpochs {
+			fromEpoch = toEpoch - maxActivitySummaryEpochs + 1
+		}
+	}
+	if fromEpoch > toEpoch {
+		http2.HandleError(w, fmt.Sprintf("from_epoch %d is greater than to_epoch %d", fromEpoch, toEpoch), http.StatusBadRequest)
+		return
+	}
+	if toEpoch-fromEpoch >= maxActivitySummaryEpochs {
+		http2.HandleError(w, fmt.Sprintf("Requested range spans more than %d epochs", maxActivitySummaryEpochs), http.StatusBadRequest)
+		return
+	}
+
+	summaries, err := s.BeaconDB.EpochActivitySummaries(ctx, primitives.Epoch(fromEpoch), primitives.Epoch(toEpoch))
+	if err != nil {
+		http2.HandleError(w, "Could not get epoch activity summaries: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*EpochActivitySummary, 0, len(summaries))
+	for _, sum := range summaries {
+		canonical, err := s.CanonicalFetcher.IsCanonical(ctx, bytesutil.ToBytes32(sum.BlockRoot))
+		if err != nil {
+			http2.HandleError(w, "Could not determine if summary block is canonical: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !canonical {
+			continue
+		}
+		data = append(data, &EpochActivitySummary{
+			Epoch:                    strconv.FormatUint(uint64(sum.Epoch), 10),
+			TransactionsGasPerPeriod: strconv.FormatUint(sum.SharedActivity.GetTransactionsGasPerPeriod(), 10),
+			TransactionsGasPerEpoch:  strconv.FormatUint(sum.SharedActivity.GetTransactionsGasPerEpoch(), 10),
+			BaseFeePerPeriod:         strconv.FormatUint(sum.SharedActivity.GetBaseFeePerPeriod(), 10),
+			BaseFeePerEpoch:          strconv.FormatUint(sum.SharedActivity.GetBaseFeePerEpoch(), 10),
+			TotalPower:               strconv.FormatUint(sum.TotalPower, 10),
+			TotalEffectivePower:      strconv.FormatUint(sum.TotalEffectivePower, 10),
+			TotalEffectiveActivity:   strconv.FormatUint(sum.TotalEffectiveActivity, 10),
+		})
+	}
+	http2.WriteJson(w, &EpochActivitySummariesResponse{Data: data})
+}
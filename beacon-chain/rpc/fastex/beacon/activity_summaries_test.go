@@ -0,0 +1,135 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestGetEpochActivitySummaries(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+
+	summaries := make([]*ethpb.EpochActivitySummary, 0, 4)
+	for e := primitives.Epoch(1); e <= 4; e++ {
+		summaries = append(summaries, &ethpb.EpochActivitySummary{
+			Epoch: e,
+			SharedActivity: &ethpb.SharedActivity{
+				TransactionsGasPerPeriod: uint64(e) * 1000,
+				TransactionsGasPerEpoch:  uint64(e) * 100,
+				BaseFeePerPeriod:         uint64(e) * 10,
+				BaseFeePerEpoch:          uint64(e),
+			},
+			TotalPower:             uint64(e) * 2,
+			TotalEffectivePower:    uint64(e) * 3,
+			TotalEffectiveActivity: uint64(e) * 4,
+			BlockRoot:              bytesutil.PadTo([]byte{byte(e)}, 32),
+		})
+	}
+	// A summary of epoch 2 taken on a fork that is not canonical.
+	summaries = append(summaries, &ethpb.EpochActivitySummary{
+		Epoch:          2,
+		SharedActivity: &ethpb.SharedActivity{},
+		TotalPower:     1,
+		BlockRoot:      bytesutil.PadTo([]byte{'f'}, 32),
+	})
+	require.NoError(t, beaconDB.SaveEpochActivitySummaries(ctx, summaries))
+
+	canonicalRoots := make(map[[32]byte]bool)
+	for e := 1; e <= 4; e++ {
+		canonicalRoots[bytesutil.ToBytes32([]byte{byte(e)})] = true
+	}
+	chain := &chainMock.ChainService{State: st, CanonicalRoots: canonicalRoots}
+	s := &Server{
+		HeadFetcher:      chain,
+		CanonicalFetcher: chain,
+		BeaconDB:         beaconDB,
+	}
+
+	t.Run("range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_summaries?from_epoch=2&to_epoch=3", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetEpochActivitySummaries(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &EpochActivitySummariesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &EpochActivitySummary{
+			Epoch:                    "2",
+			TransactionsGasPerPeriod: "2000",
+			TransactionsGasPerEpoch:  "200",
+			BaseFeePerPeriod:         "20",
+			BaseFeePerEpoch:          "2",
+			TotalPower:               "4",
+			TotalEffectivePower:      "6",
+			TotalEffectiveActivity:   "8",
+		}, resp.Data[0])
+		assert.Equal(t, "3", resp.Data[1].Epoch)
+	})
+	t.Run("defaults to head epoch", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_summaries", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetEpochActivitySummaries(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &EpochActivitySummariesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Epoch)
+		assert.Equal(t, "3", resp.Data[2].Epoch)
+	})
+	t.Run("from after to", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_summaries?from_epoch=3&to_epoch=2", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetEpochActivitySummaries(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "from_epoch 3 is greater than to_epoch 2", e.Message)
+	})
+	t.Run("range too wide", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_summaries?from_epoch=0&to_epoch=1024", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetEpochActivitySummaries(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Requested range spans more than 1024 epochs", e.Message)
+	})
+	t.Run("from defaults to the widest range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_summaries?to_epoch=1026", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetEpochActivitySummaries(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &EpochActivitySummariesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].Epoch)
+		assert.Equal(t, "4", resp.Data[1].Epoch)
+	})
+}
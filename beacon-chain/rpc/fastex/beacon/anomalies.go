@@ -0,0 +1,81 @@
+package beacon
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+// ListActivityAnomalies is a HTTP handler that serves the GET /fastex/v1/beacon/activity_anomalies endpoint.
+// It returns the activity anomalies recently found in processed blocks, oldest first. Anomalies are only
+// available when the node runs with --enable-activity-anomaly-detection.
+//
+// The optional contract and detector query parameters filter the anomalies by contract address and by
+// the name of the detector which reported them.
+//
+// Example usage:
+//
+//	GET /fastex/v1/beacon/activity_anomalies?detector=spike
+//
+// The above request will return a JSON response like:
+//
+//	{
+//		"data": [
+//			{
+//				"detector": "spike",
+//				"slot": "1024",
+//				"block_root": "0x...",
+//				"proposer_index": "12",
+//				"contract": "0x00000000000000000000000000000000000000aa",
+//				"delta_activity": "2100000",
+//				"baseline_activity": "21000",
+//				"reason": "delta activity is 100x the average of the last 64 blocks"
+//			}
+//		]
+//	}
+func (s *Server) ListActivityAnomalies(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListActivityAnomalies")
+	defer span.End()
+
+	if s.AnomalyFetcher == nil {
+		http2.HandleError(w, "Activity anomaly detection is not enabled", http.StatusNotFound)
+		return
+	}
+	var contract []byte
+	if rawContract := r.URL.Query().Get("contract"); rawContract != "" {
+		var ok bool
+		contract, ok = shared.ValidateHex(w, "contract", rawContract, fieldparams.ContractAddressLength)
+		if !ok {
+			return
+		}
+	}
+	detector := r.URL.Query().Get("detector")
+
+	findings := s.AnomalyFetcher.RecentFindings()
+	data := make([]*ActivityAnomaly, 0, len(findings))
+	for _, f := range findings {
+		if contract != nil && !bytes.Equal(contract, f.Contract[:]) {
+			continue
+		}
+		if detector != "" && detector != f.Detector {
+			continue
+		}
+		data = append(data, &ActivityAnomaly{
+			Detector:         f.Detector,
+			Slot:             strconv.FormatUint(uint64(f.Slot), 10),
+			BlockRoot:        hexutil.Encode(f.BlockRoot[:]),
+			ProposerIndex:    strconv.FormatUint(uint64(f.ProposerIndex), 10),
+			Contract:         hexutil.Encode(f.Contract[:]),
+			DeltaActivity:    strconv.FormatUint(f.DeltaActivity, 10),
+			BaselineActivity: strconv.FormatUint(f.BaselineActivity, 10),
+			Reason:           f.Reason,
+		})
+	}
+	http2.WriteJson(w, &ListActivityAnomaliesResponse{Data: data})
+}
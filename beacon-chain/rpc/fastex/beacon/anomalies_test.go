@@ -0,0 +1,98 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/anomaly"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type mockFindingsFetcher struct {
+	findings []*anomaly.Finding
+}
+
+func (m *mockFindingsFetcher) RecentFindings() []*anomaly.Finding {
+	return m.findings
+}
+
+func TestListActivityAnomalies(t *testing.T) {
+	fetcher := &mockFindingsFetcher{findings: []*anomaly.Finding{
+		{
+			Detector:         "spike",
+			Slot:             1024,
+			BlockRoot:        [32]byte{'a'},
+			ProposerIndex:    12,
+			Contract:         bytesutil.ToBytes20([]byte{0xaa}),
+			DeltaActivity:    2100000,
+			BaselineActivity: 21000,
+			Reason:           "spike",
+		},
+		{
+			Detector:      "self_dealing",
+			Slot:          1025,
+			ProposerIndex: 13,
+			Contract:      bytesutil.ToBytes20([]byte{0xbb}),
+		},
+	}}
+	s := &Server{AnomalyFetcher: fetcher}
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_anomalies", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListActivityAnomalies(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &ListActivityAnomaliesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &ActivityAnomaly{
+			Detector:         "spike",
+			Slot:             "1024",
+			BlockRoot:        "0x6100000000000000000000000000000000000000000000000000000000000000",
+			ProposerIndex:    "12",
+			Contract:         "0xaa00000000000000000000000000000000000000",
+			DeltaActivity:    "2100000",
+			BaselineActivity: "21000",
+			Reason:           "spike",
+		}, resp.Data[0])
+	})
+	t.Run("filtered", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_anomalies?contract=0xbb00000000000000000000000000000000000000&detector=self_dealing", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListActivityAnomalies(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &ListActivityAnomaliesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "1025", resp.Data[0].Slot)
+	})
+	t.Run("invalid contract", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_anomalies?contract=0xbb", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListActivityAnomalies(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("detection disabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/activity_anomalies", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		(&Server{}).ListActivityAnomalies(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Activity anomaly detection is not enabled", e.Message)
+	})
+}
@@ -0,0 +1,130 @@
+package beacon
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// ListContractTransfers is a HTTP handler that serves the GET /fastex/v1/beacon/pool/contract_transfers endpoint.
+// It retrieves contract transfers known by the node but not necessarily incorporated into any block.
+func (s *Server) ListContractTransfers(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListContractTransfers")
+	defer span.End()
+
+	sourceTransfers, err := s.ContractTransferPool.PendingContractTransfers()
+	if err != nil {
+		http2.HandleError(w, "Could not get contract transfers from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &ListContractTransfersResponse{Data: shared.ContractTransfersFromConsensus(sourceTransfers)})
+}
+
+// SubmitContractTransfers is a HTTP handler that serves the POST /fastex/v1/beacon/pool/contract_transfers endpoint.
+// It submits signed contract transfers to the node's pool and, once the Electra fork is active, broadcasts
+// the ones that pass validation to the network. A transfer whose recipient index equals the validator index
+// unbinds the contract from the validator, otherwise the recipient validator must co-sign the message.
+//
+// Example request body:
+//
+//	[
+//		{
+//			"message": {
+//				"validator_index": "12",
+//				"recipient_index": "14",
+//				"contract": "0x00000000000000000000000000000000000000aa",
+//				"valid_until_epoch": "1200",
+//				"nonce": "0"
+//			},
+//			"signature": "0x...",
+//			"recipient_signature": "0x..."
+//		}
+//	]
+func (s *Server) SubmitContractTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitContractTransfers")
+	defer span.End()
+
+	var req []*shared.SignedContractTransfer
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req) == 0 {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+
+	st, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var failures []*shared.IndexedVerificationFailure
+	for i, item := range req {
+		if item == nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Empty contract transfer",
+			})
+			continue
+		}
+		transfer, err := item.ToConsensus()
+		if err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not convert request contract transfer to consensus contract transfer: " + err.Error(),
+			})
+			continue
+		}
+		if _, err = blocks.ValidateContractTransfer(st, transfer); err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate SignedContractTransfer: " + err.Error(),
+			})
+			continue
+		}
+		if err = blocks.VerifyContractTransferSignature(st, transfer); err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate signature: " + err.Error(),
+			})
+			continue
+		}
+		s.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.ContractTransferReceived,
+			Data: &operation.ContractTransferReceivedData{
+				Transfer: transfer,
+			},
+		})
+		s.ContractTransferPool.InsertContractTransfer(transfer)
+		if st.Version() >= version.Electra {
+			if err = s.Broadcaster.Broadcast(ctx, transfer); err != nil {
+				http2.HandleError(w, "Could not broadcast contract transfer: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		failuresErr := &shared.IndexedVerificationFailureError{
+			Code:     http.StatusBadRequest,
+			Message:  "One or more contract transfers failed validation",
+			Failures: failures,
+		}
+		http2.WriteError(w, failuresErr)
+	}
+}
@@ -0,0 +1,179 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
+	p2pMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestListContractTransfers(t *testing.T) {
+	pool := contracttransfers.NewPool()
+	transfer := &ethpb.SignedContractTransfer{
+		Message: &ethpb.ContractTransfer{
+			ValidatorIndex: 1,
+			RecipientIndex: 2,
+			Contract:       bytesutil.PadTo([]byte{0xaa}, 20),
+		},
+		Signature:          bytesutil.PadTo([]byte{0x01}, 96),
+		RecipientSignature: bytesutil.PadTo([]byte{0x02}, 96),
+	}
+	pool.InsertContractTransfer(transfer)
+	s := &Server{ContractTransferPool: pool}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/beacon/pool/contract_transfers", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ListContractTransfers(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &ListContractTransfersResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, shared.SignedContractTransferFromConsensus(transfer), resp.Data[0])
+}
+
+func TestSubmitContractTransfers(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateElectra(t, 4)
+	vals := st.Validators()
+	for _, v := range vals {
+		v.Contract = params.BeaconConfig().ZeroContract[:]
+	}
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	vals[1].Contract = contract
+	require.NoError(t, st.SetValidators(vals))
+
+	message := &ethpb.ContractTransfer{
+		ValidatorIndex:  1,
+		RecipientIndex:  2,
+		Contract:        contract,
+		ValidUntilEpoch: 10,
+	}
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainContractTransfer, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(message, domain)
+	require.NoError(t, err)
+	valid := shared.SignedContractTransferFromConsensus(&ethpb.SignedContractTransfer{
+		Message:            message,
+		Signature:          keys[1].Sign(root[:]).Marshal(),
+		RecipientSignature: keys[2].Sign(root[:]).Marshal(),
+	})
+	wrongSig := shared.SignedContractTransferFromConsensus(&ethpb.SignedContractTransfer{
+		Message:            message,
+		Signature:          keys[2].Sign(root[:]).Marshal(),
+		RecipientSignature: keys[2].Sign(root[:]).Marshal(),
+	})
+	notAccepted := shared.SignedContractTransferFromConsensus(&ethpb.SignedContractTransfer{
+		Message:            message,
+		Signature:          keys[1].Sign(root[:]).Marshal(),
+		RecipientSignature: keys[1].Sign(root[:]).Marshal(),
+	})
+
+	newServer := func() (*Server, *p2pMock.MockBroadcaster) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		chain := &chainMock.ChainService{State: st}
+		return &Server{
+			HeadFetcher:          chain,
+			ContractTransferPool: contracttransfers.NewPool(),
+			Broadcaster:          broadcaster,
+			OperationNotifier:    chain.OperationNotifier(),
+		}, broadcaster
+	}
+	submit := func(s *Server, body interface{}) *httptest.ResponseRecorder {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/fastex/v1/beacon/pool/contract_transfers", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SubmitContractTransfers(writer, request)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		s, broadcaster := newServer()
+		writer := submit(s, []*shared.SignedContractTransfer{valid})
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, true, s.ContractTransferPool.ValidatorExists(1))
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		s, broadcaster := newServer()
+		writer := submit(s, []*shared.SignedContractTransfer{wrongSig})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &shared.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.StringContains(t, "Could not validate signature", e.Failures[0].Message)
+		assert.Equal(t, false, s.ContractTransferPool.ValidatorExists(1))
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+	t.Run("no recipient signature", func(t *testing.T) {
+		s, broadcaster := newServer()
+		writer := submit(s, []*shared.SignedContractTransfer{notAccepted})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &shared.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.StringContains(t, "could not verify recipient signature", e.Failures[0].Message)
+		assert.Equal(t, false, s.ContractTransferPool.ValidatorExists(1))
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+	t.Run("stale nonce", func(t *testing.T) {
+		s, _ := newServer()
+		stale := *valid
+		stale.Message = &shared.ContractTransfer{
+			ValidatorIndex:  valid.Message.ValidatorIndex,
+			RecipientIndex:  valid.Message.RecipientIndex,
+			Contract:        valid.Message.Contract,
+			ValidUntilEpoch: valid.Message.ValidUntilEpoch,
+			Nonce:           "1",
+		}
+		writer := submit(s, []*shared.SignedContractTransfer{&stale})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &shared.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.StringContains(t, "contract transfer nonce does not match the validator nonce", e.Failures[0].Message)
+	})
+	t.Run("contract not owned", func(t *testing.T) {
+		s, _ := newServer()
+		notOwned := *valid
+		notOwned.Message = &shared.ContractTransfer{
+			ValidatorIndex:  "0",
+			RecipientIndex:  "2",
+			Contract:        valid.Message.Contract,
+			ValidUntilEpoch: valid.Message.ValidUntilEpoch,
+			Nonce:           "0",
+		}
+		writer := submit(s, []*shared.SignedContractTransfer{valid, &notOwned})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &shared.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 1, e.Failures[0].Index)
+		assert.StringContains(t, "contract is not linked to the validator", e.Failures[0].Message)
+		assert.Equal(t, true, s.ContractTransferPool.ValidatorExists(1))
+	})
+	t.Run("no body", func(t *testing.T) {
+		s, _ := newServer()
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/fastex/v1/beacon/pool/contract_transfers", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SubmitContractTransfers(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
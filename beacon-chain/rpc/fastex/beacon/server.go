@@ -0,0 +1,23 @@
+package beacon
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/anomaly"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+)
+
+// Server defines a server implementation for HTTP endpoints, providing
+// access to Fastex specific beacon chain operations.
+type Server struct {
+	HeadFetcher          blockchain.HeadFetcher
+	CanonicalFetcher     blockchain.CanonicalFetcher
+	BeaconDB             db.ReadOnlyDatabase
+	ContractTransferPool contracttransfers.PoolManager
+	Broadcaster          p2p.Broadcaster
+	OperationNotifier    operation.Notifier
+	// AnomalyFetcher is nil when activity anomaly detection is disabled.
+	AnomalyFetcher anomaly.FindingsFetcher
+}
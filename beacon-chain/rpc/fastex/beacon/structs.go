@@ -0,0 +1,37 @@
+package beacon
+
+import "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+
+type ListContractTransfersResponse struct {
+	Data []*shared.SignedContractTransfer `json:"data"`
+}
+
+type ListActivityAnomaliesResponse struct {
+	Data []*ActivityAnomaly `json:"data"`
+}
+
+type ActivityAnomaly struct {
+	Detector         string `json:"detector"`
+	Slot             string `json:"slot"`
+	BlockRoot        string `json:"block_root"`
+	ProposerIndex    string `json:"proposer_index"`
+	Contract         string `json:"contract"`
+	DeltaActivity    string `json:"delta_activity"`
+	BaselineActivity string `json:"baseline_activity"`
+	Reason           string `json:"reason"`
+}
+
+type EpochActivitySummariesResponse struct {
+	Data []*EpochActivitySummary `json:"data"`
+}
+
+type EpochActivitySummary struct {
+	Epoch                    string `json:"epoch"`
+	TransactionsGasPerPeriod string `json:"transactions_gas_per_period"`
+	TransactionsGasPerEpoch  string `json:"transactions_gas_per_epoch"`
+	BaseFeePerPeriod         string `json:"base_fee_per_period"`
+	BaseFeePerEpoch          string `json:"base_fee_per_epoch"`
+	TotalPower               string `json:"total_power"`
+	TotalEffectivePower      string `json:"total_effective_power"`
+	TotalEffectiveActivity   string `json:"total_effective_activity"`
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// GetActivityHistory is a HTTP handler that serves the GET /fastex/v1/validators/{validator_id}/activity_history endpoint.
+// It returns the per-epoch raw activity, effective activity and linked contract of a validator, as recorded
+// by the beacon node at every epoch transition. Records are only available when the node runs with --save-activity-history.
+//
+// The validator ID can be either a validator index or a hex encoded public key. The optional from_epoch and to_epoch
+// query parameters bound the returned range (inclusive), they default to genesis and the current head epoch.
+// Only the records taken on the canonical chain are returned.
+//
+// Example usage:
+//
+//	GET /fastex/v1/validators/12/activity_history?from_epoch=10&to_epoch=11
+//
+// The above request will return a JSON response like:
+//
+//	{
+//		"data": [
+//			{
+//				"index": "12",
+//				"epoch": "10",
+//				"activity": "21000",
+//				"effective_activity": "18000",
+//				"contract": "0x00000000000000000000000000000000000000aa"
+//			}
+//		]
+//	}
+func (s *Server) GetActivityHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetActivityHistory")
+	defer span.End()
+
+	valId := mux.Vars(r)["validator_id"]
+	if valId == "" {
+		http2.HandleError(w, "validator_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	ok, rawFrom, fromEpoch := shared.UintFromQuery(w, r, "from_epoch")
+	if !ok {
+		return
+	}
+	ok, rawTo, toEpoch := shared.UintFromQuery(w, r, "to_epoch")
+	if !ok {
+		return
+	}
+
+	headState, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if rawFrom == "" {
+		fromEpoch = 0
+	}
+	if rawTo == "" {
+		toEpoch = uint64(slots.ToEpoch(headState.Slot()))
+	}
+	if fromEpoch > toEpoch {
+		http2.HandleError(w, fmt.Sprintf("from_epoch %d is greater than to_epoch %d", fromEpoch, toEpoch), http.StatusBadRequest)
+		return
+	}
+
+	idx, ok := validatorIndexFromId(w, headState, valId)
+	if !ok {
+		return
+	}
+
+	records, err := s.BeaconDB.ActivityHistory(ctx, idx, primitives.Epoch(fromEpoch), primitives.Epoch(toEpoch))
+	if err != nil {
+		http2.HandleError(w, "Could not get activity history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*ActivityHistoryRecord, 0, len(records))
+	for _, rec := range records {
+		canonical, err := s.CanonicalFetcher.IsCanonical(ctx, bytesutil.ToBytes32(rec.BlockRoot))
+		if err != nil {
+			http2.HandleError(w, "Could not determine if record block is canonical: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !canonical {
+			continue
+		}
+		data = append(data, &ActivityHistoryRecord{
+			Index:             strconv.FormatUint(uint64(rec.ValidatorIndex), 10),
+			Epoch:             strconv.FormatUint(uint64(rec.Epoch), 10),
+			Activity:          strconv.FormatUint(rec.Activity, 10),
+			EffectiveActivity: strconv.FormatUint(rec.EffectiveActivity, 10),
+			Contract:          hexutil.Encode(rec.Contract),
+		})
+	}
+	http2.WriteJson(w, &ActivityHistoryResponse{Data: data})
+}
+
+// validatorIndexFromId resolves a validator index or hex encoded public key against the given state.
+// Indices are not checked against the validator registry.
+func validatorIndexFromId(w http.ResponseWriter, st state.ReadOnlyBeaconState, valId string) (primitives.ValidatorIndex, bool) {
+	if len(valId) > 2 && valId[:2] == "0x" {
+		pubkey, ok := shared.ValidateHex(w, "validator_id", valId, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return 0, false
+		}
+		index, found := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+		if !found {
+			http2.HandleError(w, "Unknown validator: "+valId, http.StatusNotFound)
+			return 0, false
+		}
+		return index, true
+	}
+	index, err := strconv.ParseUint(valId, 10, 64)
+	if err != nil {
+		http2.HandleError(w, "Invalid validator index: "+err.Error(), http.StatusBadRequest)
+		return 0, false
+	}
+	return primitives.ValidatorIndex(index), true
+}
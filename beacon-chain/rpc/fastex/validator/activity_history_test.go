@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	chainMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestGetActivityHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	records := make([]*ethpb.ActivityHistoryRecord, 0, 4)
+	for e := primitives.Epoch(1); e <= 4; e++ {
+		records = append(records, &ethpb.ActivityHistoryRecord{
+			ValidatorIndex:    1,
+			Epoch:             e,
+			Activity:          uint64(e) * 1000,
+			EffectiveActivity: uint64(e) * 100,
+			Contract:          contract,
+			BlockRoot:         bytesutil.PadTo([]byte{byte(e)}, 32),
+		})
+	}
+	// A record of epoch 2 taken on a fork that is not canonical.
+	records = append(records, &ethpb.ActivityHistoryRecord{
+		ValidatorIndex: 1,
+		Epoch:          2,
+		Activity:       1,
+		Contract:       contract,
+		BlockRoot:      bytesutil.PadTo([]byte{'f'}, 32),
+	})
+	require.NoError(t, beaconDB.SaveActivityHistory(ctx, records))
+
+	canonicalRoots := make(map[[32]byte]bool)
+	for e := 1; e <= 4; e++ {
+		canonicalRoots[bytesutil.ToBytes32([]byte{byte(e)})] = true
+	}
+	chain := &chainMock.ChainService{State: st, CanonicalRoots: canonicalRoots}
+	s := &Server{
+		HeadFetcher:      chain,
+		CanonicalFetcher: chain,
+		BeaconDB:         beaconDB,
+	}
+
+	t.Run("by index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/1/activity_history?from_epoch=2&to_epoch=3", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetActivityHistory(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &ActivityHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Index)
+		assert.Equal(t, "2", resp.Data[0].Epoch)
+		assert.Equal(t, "2000", resp.Data[0].Activity)
+		assert.Equal(t, "200", resp.Data[0].EffectiveActivity)
+		assert.Equal(t, hexutil.Encode(contract), resp.Data[0].Contract)
+		assert.Equal(t, "3", resp.Data[1].Epoch)
+	})
+	t.Run("by pubkey with default range", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(1)
+		valId := hexutil.Encode(pubkey[:])
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/fastex/v1/validators/%s/activity_history", valId), nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": valId})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetActivityHistory(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &ActivityHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Data))
+		assert.Equal(t, "4", resp.Data[3].Epoch)
+	})
+	t.Run("unknown pubkey", func(t *testing.T) {
+		valId := hexutil.Encode(bytesutil.PadTo([]byte{0x01}, 48))
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": valId})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetActivityHistory(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/1/activity_history?from_epoch=3&to_epoch=2", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetActivityHistory(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "from_epoch 3 is greater than to_epoch 2", e.Message)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetActivityHistory(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
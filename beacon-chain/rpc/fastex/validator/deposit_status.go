@@ -0,0 +1,105 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// GetDepositStatus is a HTTP handler that serves the GET /fastex/v1/validators/{validator_id}/deposit_status endpoint.
+// It reports the outcome of the contract binding of a validator's deposits in the head state: the contract the
+// validator is bound to, the deposit contracts that were rejected because another live validator already owns them,
+// and the activity its contracts earned while the validator was pending activation. The activity is credited once
+// the validator activates. Rejections and pending activity are tracked starting from Electra, the rejections being
+// kept until they are evicted by newer ones.
+//
+// The validator ID can be either a validator index or a hex encoded public key.
+//
+// Example usage:
+//
+//	GET /fastex/v1/validators/12/deposit_status
+//
+// The above request will return a JSON response like:
+//
+//	{
+//		"data": {
+//			"index": "12",
+//			"contract": "0x0000000000000000000000000000000000000000",
+//			"pending_activity_credit": "0",
+//			"rejected_contract_bindings": [
+//				{
+//					"contract": "0x00000000000000000000000000000000000000aa",
+//					"owner_index": "3",
+//					"epoch": "1021"
+//				}
+//			]
+//		}
+//	}
+func (s *Server) GetDepositStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetDepositStatus")
+	defer span.End()
+
+	valId := mux.Vars(r)["validator_id"]
+	if valId == "" {
+		http2.HandleError(w, "validator_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	headState, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	idx, ok := validatorIndexFromId(w, headState, valId)
+	if !ok {
+		return
+	}
+	if uint64(idx) >= uint64(headState.NumValidators()) {
+		http2.HandleError(w, fmt.Sprintf("Unknown validator: %d", idx), http.StatusNotFound)
+		return
+	}
+
+	contract, _ := headState.ContractAtIndex(idx)
+	status := &DepositStatus{
+		Index:                    strconv.FormatUint(uint64(idx), 10),
+		Contract:                 hexutil.Encode(contract[:]),
+		PendingActivityCredit:    "0",
+		RejectedContractBindings: []*RejectedContractBinding{},
+	}
+	if headState.Version() >= version.Electra {
+		credits, err := headState.PendingActivityCredits()
+		if err != nil {
+			http2.HandleError(w, "Could not get pending activity credits: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// Every activity change of a pending validator is queued as its own credit.
+		var credit uint64
+		for _, c := range credits {
+			if c.ValidatorIndex == idx {
+				credit += c.Activity
+			}
+		}
+		status.PendingActivityCredit = strconv.FormatUint(credit, 10)
+		bindings, err := headState.RejectedContractBindings()
+		if err != nil {
+			http2.HandleError(w, "Could not get rejected contract bindings: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, b := range bindings {
+			if b.ValidatorIndex != idx {
+				continue
+			}
+			status.RejectedContractBindings = append(status.RejectedContractBindings, &RejectedContractBinding{
+				Contract:   hexutil.Encode(b.Contract),
+				OwnerIndex: strconv.FormatUint(uint64(b.OwnerIndex), 10),
+				Epoch:      strconv.FormatUint(uint64(b.Epoch), 10),
+			})
+		}
+	}
+	http2.WriteJson(w, &DepositStatusResponse{Data: status})
+}
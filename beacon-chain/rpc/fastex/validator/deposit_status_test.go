@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	chainMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestGetDepositStatus(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 4)
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	require.NoError(t, st.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{ValidatorIndex: 2, Activity: 300}))
+	require.NoError(t, st.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{ValidatorIndex: 3, Activity: 100}))
+	require.NoError(t, st.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{ValidatorIndex: 2, Activity: 200}))
+	require.NoError(t, st.AppendRejectedContractBinding(&ethpb.RejectedContractBinding{ValidatorIndex: 2, Contract: contract, OwnerIndex: 1, Epoch: 7}))
+	require.NoError(t, st.AppendRejectedContractBinding(&ethpb.RejectedContractBinding{ValidatorIndex: 3, Contract: contract, OwnerIndex: 1, Epoch: 8}))
+
+	s := &Server{HeadFetcher: &chainMock.ChainService{State: st}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/2/deposit_status", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "2"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositStatus(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &DepositStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Data.Index)
+		assert.Equal(t, "500", resp.Data.PendingActivityCredit)
+		require.Equal(t, 1, len(resp.Data.RejectedContractBindings))
+		assert.Equal(t, hexutil.Encode(contract), resp.Data.RejectedContractBindings[0].Contract)
+		assert.Equal(t, "1", resp.Data.RejectedContractBindings[0].OwnerIndex)
+		assert.Equal(t, "7", resp.Data.RejectedContractBindings[0].Epoch)
+	})
+	t.Run("nothing recorded", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/0/deposit_status", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "0"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositStatus(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &DepositStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.Data.PendingActivityCredit)
+		assert.Equal(t, 0, len(resp.Data.RejectedContractBindings))
+	})
+	t.Run("before electra", func(t *testing.T) {
+		denebState, _ := util.DeterministicGenesisStateDeneb(t, 4)
+		s := &Server{HeadFetcher: &chainMock.ChainService{State: denebState}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/2/deposit_status", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "2"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositStatus(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &DepositStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.Data.PendingActivityCredit)
+		assert.Equal(t, 0, len(resp.Data.RejectedContractBindings))
+	})
+	t.Run("by pubkey", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(2)
+		valId := hexutil.Encode(pubkey[:])
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/"+valId+"/deposit_status", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": valId})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositStatus(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &DepositStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Data.Index)
+		assert.Equal(t, "500", resp.Data.PendingActivityCredit)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/fastex/v1/validators/10/deposit_status", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_id": "10"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositStatus(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Unknown validator", e.Message)
+	})
+}
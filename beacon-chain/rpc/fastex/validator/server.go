@@ -0,0 +1,14 @@
+package validator
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+)
+
+// Server defines a server implementation for HTTP endpoints, providing
+// access to Fastex specific validator data.
+type Server struct {
+	HeadFetcher      blockchain.HeadFetcher
+	CanonicalFetcher blockchain.CanonicalFetcher
+	BeaconDB         db.ReadOnlyDatabase
+}
@@ -0,0 +1,30 @@
+package validator
+
+type ActivityHistoryResponse struct {
+	Data []*ActivityHistoryRecord `json:"data"`
+}
+
+type ActivityHistoryRecord struct {
+	Index             string `json:"index"`
+	Epoch             string `json:"epoch"`
+	Activity          string `json:"activity"`
+	EffectiveActivity string `json:"effective_activity"`
+	Contract          string `json:"contract"`
+}
+
+type DepositStatusResponse struct {
+	Data *DepositStatus `json:"data"`
+}
+
+type DepositStatus struct {
+	Index                    string                     `json:"index"`
+	Contract                 string                     `json:"contract"`
+	PendingActivityCredit    string                     `json:"pending_activity_credit"`
+	RejectedContractBindings []*RejectedContractBinding `json:"rejected_contract_bindings"`
+}
+
+type RejectedContractBinding struct {
+	Contract   string `json:"contract"`
+	OwnerIndex string `json:"owner_index"`
+	Epoch      string `json:"epoch"`
+}
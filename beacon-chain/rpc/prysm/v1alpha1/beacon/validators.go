@@ -667,6 +667,72 @@ func (bs *Server) GetValidatorPerformance(
 	return response, nil
 }
 
+// GetValidatorActivities reports the contract, activity and power of the requested validators at the
+// head of the beacon chain. Validators that are not active have no power.
+func (bs *Server) GetValidatorActivities(
+	ctx context.Context, req *ethpb.ValidatorActivitiesRequest,
+) (*ethpb.ValidatorActivitiesResponse, error) {
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := coreTime.CurrentEpoch(headState)
+	activeCount, err := helpers.ActiveValidatorCount(ctx, headState, currentEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	_, totalEffectivePower, err := helpers.Powers(ctx, headState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute total effective power: %v", err)
+	}
+	sharedActivity := headState.SharedActivity()
+	if sharedActivity == nil {
+		return nil, status.Error(codes.Internal, "Nil shared activity in state")
+	}
+	transactionsGas := sharedActivity.TransactionsGasPerPeriod / activeCount
+
+	activities := make([]*ethpb.ValidatorActivitiesResponse_ValidatorActivity, 0, len(req.PublicKeys))
+	missingValidators := make([][]byte, 0)
+	for _, pubKey := range req.PublicKeys {
+		idx, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			missingValidators = append(missingValidators, pubKey)
+			continue
+		}
+		val, err := headState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		activity, err := headState.ActivityAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get activity: %v", err)
+		}
+		var effectivePower uint64
+		if helpers.IsActiveValidatorUsingTrie(val, currentEpoch) {
+			effectivePower, err = helpers.EffectivePower(val, transactionsGas, currentEpoch)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute effective power: %v", err)
+			}
+		}
+		contract, _ := headState.ContractAtIndex(idx)
+		activities = append(activities, &ethpb.ValidatorActivitiesResponse_ValidatorActivity{
+			PublicKey:         pubKey,
+			Index:             idx,
+			Contract:          contract[:],
+			Activity:          activity,
+			EffectiveActivity: val.EffectiveActivity(),
+			EffectivePower:    effectivePower,
+		})
+	}
+
+	return &ethpb.ValidatorActivitiesResponse{
+		Epoch:               currentEpoch,
+		Activities:          activities,
+		TotalEffectivePower: totalEffectivePower,
+		MissingValidators:   missingValidators,
+	}, nil
+}
+
 // GetIndividualVotes retrieves individual voting status of validators.
 func (bs *Server) GetIndividualVotes(
 	ctx context.Context,
@@ -1408,6 +1408,63 @@ func TestServer_GetValidatorActiveSetChanges(t *testing.T) {
 	}
 }
 
+func TestServer_GetValidatorActivities(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	headState, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{
+				PublicKey:             pubKey(0),
+				WithdrawalCredentials: make([]byte, 32),
+				Contract:              contract,
+				EffectiveBalance:      maxBalance,
+				EffectiveActivity:     300,
+				ExitEpoch:             farFuture,
+			},
+			{
+				PublicKey:             pubKey(1),
+				WithdrawalCredentials: make([]byte, 32),
+				Contract:              make([]byte, 20),
+				EffectiveBalance:      maxBalance,
+				EffectiveActivity:     100,
+				ExitEpoch:             farFuture,
+			},
+			{
+				PublicKey:             pubKey(2),
+				WithdrawalCredentials: make([]byte, 32),
+				Contract:              make([]byte, 20),
+				EffectiveBalance:      maxBalance,
+				EffectiveActivity:     50,
+				ActivationEpoch:       farFuture,
+				ExitEpoch:             farFuture,
+			},
+		},
+		Activities:     []uint64{5, 0, 7},
+		SharedActivity: &ethpb.SharedActivity{TransactionsGasPerPeriod: 200},
+	})
+	require.NoError(t, err)
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+
+	res, err := bs.GetValidatorActivities(context.Background(), &ethpb.ValidatorActivitiesRequest{
+		PublicKeys: [][]byte{pubKey(0), pubKey(2), pubKey(9)},
+	})
+	require.NoError(t, err)
+	want := &ethpb.ValidatorActivitiesResponse{
+		Activities: []*ethpb.ValidatorActivitiesResponse_ValidatorActivity{
+			{PublicKey: pubKey(0), Index: 0, Contract: contract, Activity: 5, EffectiveActivity: 300, EffectivePower: 400},
+			{PublicKey: pubKey(2), Index: 2, Contract: make([]byte, 20), Activity: 7, EffectiveActivity: 50},
+		},
+		TotalEffectivePower: 600,
+		MissingValidators:   [][]byte{pubKey(9)},
+	}
+	assert.DeepEqual(t, want, res)
+}
+
 func TestServer_GetValidatorQueue_PendingActivation(t *testing.T) {
 	headState, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Validators: []*ethpb.Validator{
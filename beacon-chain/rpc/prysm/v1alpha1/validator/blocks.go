@@ -111,6 +111,17 @@ func sendVerifiedBlocks(stream ethpb.BeaconNodeValidator_StreamBlocksAltairServe
 			return nil
 		}
 		b.Block = &ethpb.StreamBlocksResponse_DenebBlock{DenebBlock: phBlk}
+	case version.Electra:
+		pb, err := data.SignedBlock.Proto()
+		if err != nil {
+			return errors.Wrap(err, "could not get protobuf block")
+		}
+		phBlk, ok := pb.(*ethpb.SignedBeaconBlockElectra)
+		if !ok {
+			log.Warn("Mismatch between version and block type, was expecting SignedBeaconBlockElectra")
+			return nil
+		}
+		b.Block = &ethpb.StreamBlocksResponse_ElectraBlock{ElectraBlock: phBlk}
 	}
 
 	if err := stream.Send(b); err != nil {
@@ -162,6 +173,8 @@ func (vs *Server) sendBlocks(stream ethpb.BeaconNodeValidator_StreamBlocksAltair
 		b.Block = &ethpb.StreamBlocksResponse_CapellaBlock{CapellaBlock: p}
 	case *ethpb.SignedBeaconBlockDeneb:
 		b.Block = &ethpb.StreamBlocksResponse_DenebBlock{DenebBlock: p}
+	case *ethpb.SignedBeaconBlockElectra:
+		b.Block = &ethpb.StreamBlocksResponse_ElectraBlock{ElectraBlock: p}
 	default:
 		log.Errorf("Unknown block type %T", p)
 	}
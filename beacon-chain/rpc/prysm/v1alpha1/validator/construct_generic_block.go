@@ -24,6 +24,8 @@ func (vs *Server) constructGenericBeaconBlock(sBlk interfaces.SignedBeaconBlock,
 	payloadValue := sBlk.ValueInGwei()
 
 	switch sBlk.Version() {
+	case version.Electra:
+		return vs.constructElectraBlock(blockProto, isBlinded, payloadValue, blindBlobs, fullBlobs), nil
 	case version.Deneb:
 		return vs.constructDenebBlock(blockProto, isBlinded, payloadValue, blindBlobs, fullBlobs), nil
 	case version.Capella:
@@ -40,6 +42,17 @@ func (vs *Server) constructGenericBeaconBlock(sBlk interfaces.SignedBeaconBlock,
 }
 
 // Helper functions for constructing blocks for each version
+func (vs *Server) constructElectraBlock(blockProto proto.Message, isBlinded bool, payloadValue uint64, blindBlobs []*ethpb.BlindedBlobSidecar, fullBlobs []*ethpb.BlobSidecar) *ethpb.GenericBeaconBlock {
+	if isBlinded {
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedElectra{BlindedElectra: &ethpb.BlindedBeaconBlockAndBlobsElectra{Block: blockProto.(*ethpb.BlindedBeaconBlockElectra), Blobs: blindBlobs}}, IsBlinded: true, PayloadValue: payloadValue}
+	}
+	blockAndBlobs := &ethpb.BeaconBlockAndBlobsElectra{
+		Block: blockProto.(*ethpb.BeaconBlockElectra),
+		Blobs: fullBlobs,
+	}
+	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Electra{Electra: blockAndBlobs}, IsBlinded: false, PayloadValue: payloadValue}
+}
+
 func (vs *Server) constructDenebBlock(blockProto proto.Message, isBlinded bool, payloadValue uint64, blindBlobs []*ethpb.BlindedBlobSidecar, fullBlobs []*ethpb.BlobSidecar) *ethpb.GenericBeaconBlock {
 	if isBlinded {
 		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: &ethpb.BlindedBeaconBlockAndBlobsDeneb{Block: blockProto.(*ethpb.BlindedBeaconBlockDeneb), Blobs: blindBlobs}}, IsBlinded: true, PayloadValue: payloadValue}
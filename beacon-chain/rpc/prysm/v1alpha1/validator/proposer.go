@@ -176,6 +176,9 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 
 		// Set bls to execution change. New in Capella.
 		vs.setBlsToExecData(sBlk, head)
+
+		// Set contract transfers. New in Deneb.
+		vs.setContractTransfers(sBlk, head)
 	}()
 
 	localPayload, blobsBundle, overrideBuilder, err := vs.getLocalPayloadAndBlobs(ctx, sBlk.Block(), head)
@@ -224,7 +227,9 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	}
 
 	var blindSidecars []*ethpb.SignedBlindedBlobSidecar
-	if blk.Version() >= version.Deneb && blk.IsBlinded() {
+	if blk.Version() >= version.Electra && blk.IsBlinded() {
+		blindSidecars = req.GetBlindedElectra().SignedBlindedBlobSidecars
+	} else if blk.Version() >= version.Deneb && blk.IsBlinded() {
 		blindSidecars = req.GetBlindedDeneb().SignedBlindedBlobSidecars
 	}
 
@@ -258,7 +263,6 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 				return nil, errors.Wrap(err, "could not extract blobs")
 			}
 		}
-		sidecars := make([]*ethpb.BlobSidecar, len(scs))
 		for i, sc := range scs {
 			log.WithFields(logrus.Fields{
 				"blockRoot": hex.EncodeToString(sc.Message.BlockRoot),
@@ -267,10 +271,7 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 			if err := vs.P2P.BroadcastBlob(ctx, sc.Message.Index, sc); err != nil {
 				log.WithError(err).Errorf("Could not broadcast blob sidecar index %d / %d", i, len(scs))
 			}
-			sidecars[i] = sc.Message
-		}
-		if len(scs) > 0 {
-			if err := vs.BeaconDB.SaveBlobSidecar(ctx, sidecars); err != nil {
+			if err := vs.BlobStorage.Save(sc.Message); err != nil {
 				return nil, err
 			}
 		}
@@ -288,6 +289,12 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 		return nil, fmt.Errorf("could not process beacon block: %v", err)
 	}
 
+	if blinded {
+		if err := vs.verifyBuilderPayloadActivities(ctx, blk); err != nil {
+			log.WithError(err).Error("Could not verify builder payload activities, consider disconnecting from the relay")
+		}
+	}
+
 	log.WithField("slot", blk.Block().Slot()).Debugf(
 		"Block proposal received via RPC")
 	vs.BlockNotifier.BlockFeed().Send(&feed.Event{
@@ -303,14 +310,19 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 // extraSidecars extracts the sidecars from the request.
 // return error if there are too many sidecars.
 func extraSidecars(req *ethpb.GenericSignedBeaconBlock) ([]*ethpb.SignedBlobSidecar, error) {
-	b, ok := req.GetBlock().(*ethpb.GenericSignedBeaconBlock_Deneb)
-	if !ok {
+	var blobs []*ethpb.SignedBlobSidecar
+	switch b := req.GetBlock().(type) {
+	case *ethpb.GenericSignedBeaconBlock_Deneb:
+		blobs = b.Deneb.Blobs
+	case *ethpb.GenericSignedBeaconBlock_Electra:
+		blobs = b.Electra.Blobs
+	default:
 		return nil, errors.New("Could not cast block to Deneb")
 	}
-	if len(b.Deneb.Blobs) > fieldparams.MaxBlobsPerBlock {
-		return nil, fmt.Errorf("too many blobs in block: %d", len(b.Deneb.Blobs))
+	if len(blobs) > fieldparams.MaxBlobsPerBlock {
+		return nil, fmt.Errorf("too many blobs in block: %d", len(blobs))
 	}
-	return b.Deneb.Blobs, nil
+	return blobs, nil
 }
 
 // PrepareBeaconProposer caches and updates the fee recipient for the given proposer.
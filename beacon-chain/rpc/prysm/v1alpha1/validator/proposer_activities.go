@@ -2,13 +2,23 @@
 package validator
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
 )
 
 // Sets the activity changes, transactions count, base fee and execution height for the block.
@@ -36,7 +46,11 @@ func (vs *Server) setActivities(
 
 	blockActivities, err := vs.ExecutionEngineCaller.GetBlockActivitiesByHash(ctx, blockHash)
 	if err != nil {
-		return errors.Wrap(err, "could not get block activities from execution layer")
+		blockActivities, err = activitiesFromHeader(latestExecutionHeader, err)
+		if err != nil {
+			return err
+		}
+		log.WithField("blockHash", blockHash.Hex()).Warn("Execution layer did not return block activities, using the payload header instead")
 	}
 
 	blk.SetActivityChanges(blockActivities.Activities)
@@ -45,3 +59,160 @@ func (vs *Server) setActivities(
 
 	return nil
 }
+
+// activitiesFromHeader builds the block activities out of the execution payload header when the execution
+// layer could not provide them. This is only possible when the payload has no activity changes, as the
+// header commits to them by root only.
+func activitiesFromHeader(header interfaces.ExecutionData, elErr error) (*ethpb.BlockActivities, error) {
+	activitiesRoot, err := header.ActivitiesRoot()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(activitiesRoot, types.EmptyActivitiesHash.Bytes()) {
+		return nil, errors.Wrap(elErr, "could not get block activities from execution layer")
+	}
+	txCount, err := header.TransactionsCount()
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.BlockActivities{
+		BaseFee:    bytesutil.LittleEndianBytesToBigInt(header.BaseFeePerGas()).Uint64(),
+		TxCount:    txCount,
+		Activities: []*ethpb.ActivityChange{},
+	}, nil
+}
+
+// validateBidActivities checks that a builder bid header commits to an activities root, as the next block has to
+// carry the activity changes committed to by it.
+func validateBidActivities(header interfaces.ExecutionData) error {
+	activitiesRoot, err := header.ActivitiesRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get activities root")
+	}
+	if len(activitiesRoot) != fieldparams.RootLength {
+		return fmt.Errorf("builder returned header with an activities root of length %d", len(activitiesRoot))
+	}
+	return nil
+}
+
+// matchingActivities checks that the activity fields of a builder payload can match what the local execution layer
+// reports for the payload once it is revealed. The activities of the builder payload are only known to the local
+// execution layer after the block is published, so before signing the header is checked to be consistent: a payload
+// with transactions reports a non zero transactions count, and a payload with the same transactions as the local
+// payload has the same activities root and transactions count as it.
+func matchingActivities(local, builder interfaces.ExecutionData) (bool, error) {
+	if err := validateBidActivities(builder); err != nil {
+		return false, err
+	}
+	br, err := builder.ActivitiesRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get builder activities root")
+	}
+	bc, err := builder.TransactionsCount()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get builder transactions count")
+	}
+	btr, err := builder.TransactionsRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get builder transactions root")
+	}
+	if bc == 0 && bytesutil.ToBytes32(btr) != emptyTransactionsRoot {
+		log.WithField("builder", fmt.Sprintf("%#x", btr)).Warn("Proposer: builder transactions count is zero for a payload with transactions, using local block")
+		return false, nil
+	}
+
+	txs, err := local.Transactions()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get local transactions")
+	}
+	ltr, err := ssz.TransactionsRoot(txs)
+	if err != nil {
+		return false, errors.Wrap(err, "could not compute local transactions root")
+	}
+	if !bytes.Equal(btr, ltr[:]) {
+		return true, nil
+	}
+	lr, err := local.ActivitiesRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get local activities root")
+	}
+	lc, err := local.TransactionsCount()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get local transactions count")
+	}
+	if !bytes.Equal(br, lr) || bc != lc {
+		log.WithFields(logrus.Fields{
+			"local":        fmt.Sprintf("%#x", lr),
+			"builder":      fmt.Sprintf("%#x", br),
+			"localCount":   lc,
+			"builderCount": bc,
+		}).Warn("Proposer: activities of the same transactions don't match, using local block")
+		return false, nil
+	}
+	return true, nil
+}
+
+// validatePayloadTransactionsCount checks that the transactions count of an unblinded builder payload matches
+// its transactions, so the activities of the next block can be satisfied by the execution layer.
+func validatePayloadTransactionsCount(payload interfaces.ExecutionData, txs [][]byte) error {
+	txCount, err := payload.TransactionsCount()
+	if err != nil {
+		return errors.Wrap(err, "could not get transactions count")
+	}
+	if txCount != uint64(len(txs)) {
+		return fmt.Errorf("payload transactions count %d does not match its %d transactions", txCount, len(txs))
+	}
+	return nil
+}
+
+// verifyBuilderPayloadActivities fetches the activities of a builder payload from the local EL client once the
+// unblinded block has been imported, and checks them against the activities root the relay committed to. Unlike
+// matchingActivities it runs on the revealed payload, so it only reports a relay that lied about the activities.
+// The fetched activities stay cached for the proposal of the next block.
+func (vs *Server) verifyBuilderPayloadActivities(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if blk.Version() < version.Capella {
+		return nil
+	}
+	payload, err := blk.Block().Body().Execution()
+	if err != nil {
+		return errors.Wrap(err, "could not get execution payload")
+	}
+	activitiesRoot, err := payload.ActivitiesRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get activities root")
+	}
+	txCount, err := payload.TransactionsCount()
+	if err != nil {
+		return errors.Wrap(err, "could not get transactions count")
+	}
+
+	blockHash := common.BytesToHash(payload.BlockHash())
+	blockActivities, err := vs.ExecutionEngineCaller.GetBlockActivitiesByHash(ctx, blockHash)
+	if err != nil {
+		return errors.Wrap(err, "could not get block activities from execution layer")
+	}
+	localRoot := types.DeriveSha(executionActivities(blockActivities.Activities), trie.NewStackTrie(nil))
+	if !bytes.Equal(activitiesRoot, localRoot.Bytes()) {
+		return fmt.Errorf("builder payload activities root %#x does not match the execution layer %#x", activitiesRoot, localRoot)
+	}
+	if txCount != blockActivities.TxCount {
+		return fmt.Errorf("builder payload transactions count %d does not match the execution layer %d", txCount, blockActivities.TxCount)
+	}
+
+	log.WithFields(logrus.Fields{
+		"blockHash":  blockHash.Hex(),
+		"activities": len(blockActivities.Activities),
+	}).Debug("Verified builder payload activities with the execution layer")
+	return nil
+}
+
+func executionActivities(changes []*ethpb.ActivityChange) types.Activities {
+	activities := make([]*types.Activity, len(changes))
+	for i, change := range changes {
+		activities[i] = &types.Activity{
+			Address:       common.BytesToAddress(change.ContractAddress),
+			DeltaActivity: change.DeltaActivity,
+		}
+	}
+	return activities
+}
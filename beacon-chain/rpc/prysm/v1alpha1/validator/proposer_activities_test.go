@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
 	blockchainTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	builderTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
@@ -14,8 +17,10 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
 	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
@@ -78,4 +83,144 @@ func TestServer_setActivities(t *testing.T) {
 		require.Equal(t, uint64(123), transactionCount)
 		require.DeepSSZEqual(t, activityChangesFromEL, activityChanges)
 	})
+	t.Run("Execution layer unavailable, empty activities", func(t *testing.T) {
+		st := capellaTransitionState.Copy()
+		header, err := blocks.WrappedExecutionPayloadHeaderCapella(&v1.ExecutionPayloadHeaderCapella{
+			BlockNumber:       1,
+			BaseFeePerGas:     bytesutil.PadTo([]byte{42}, 32),
+			TransactionsCount: 7,
+			ActivitiesRoot:    types.EmptyActivitiesHash.Bytes(),
+		}, 0)
+		require.NoError(t, err)
+		require.NoError(t, st.SetLatestExecutionPayloadHeader(header))
+		server := &Server{ExecutionEngineCaller: &powtesting.EngineClient{ErrGetBlockActivities: errors.New("timeout")}}
+
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		require.NoError(t, server.setActivities(context.Background(), blk, st))
+		require.Equal(t, uint64(42), blk.Block().Body().BaseFee())
+		require.Equal(t, uint64(7), blk.Block().Body().TransactionsCount())
+		require.Equal(t, 0, len(blk.Block().Body().ActivityChanges()))
+	})
+	t.Run("Execution layer unavailable, non empty activities", func(t *testing.T) {
+		st := capellaTransitionState.Copy()
+		header, err := blocks.WrappedExecutionPayloadHeaderCapella(&v1.ExecutionPayloadHeaderCapella{
+			BlockNumber:    1,
+			ActivitiesRoot: bytesutil.PadTo([]byte{1}, 32),
+		}, 0)
+		require.NoError(t, err)
+		require.NoError(t, st.SetLatestExecutionPayloadHeader(header))
+		server := &Server{ExecutionEngineCaller: &powtesting.EngineClient{ErrGetBlockActivities: errors.New("timeout")}}
+
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		err = server.setActivities(context.Background(), blk, st)
+		require.ErrorContains(t, "could not get block activities from execution layer: timeout", err)
+	})
+}
+
+func TestServer_verifyBuilderPayloadActivities(t *testing.T) {
+	elActivities := types.Activities{
+		{Address: common.BytesToAddress(bytesutil.PadTo([]byte("contract-1"), 20)), DeltaActivity: 123},
+		{Address: common.BytesToAddress(bytesutil.PadTo([]byte("contract-2"), 20)), DeltaActivity: 123},
+	}
+	elRoot := types.DeriveSha(elActivities, trie.NewStackTrie(nil))
+
+	builderBlock := func(activitiesRoot []byte, txCount uint64) interfaces.ReadOnlySignedBeaconBlock {
+		b := util.NewBeaconBlockCapella()
+		b.Block.Body.ExecutionPayload.ActivitiesRoot = activitiesRoot
+		b.Block.Body.ExecutionPayload.TransactionsCount = txCount
+		wb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		return wb
+	}
+
+	t.Run("matches execution layer", func(t *testing.T) {
+		vs := &Server{ExecutionEngineCaller: &powtesting.EngineClient{}}
+		require.NoError(t, vs.verifyBuilderPayloadActivities(context.Background(), builderBlock(elRoot.Bytes(), 123)))
+	})
+	t.Run("activities root mismatch", func(t *testing.T) {
+		vs := &Server{ExecutionEngineCaller: &powtesting.EngineClient{}}
+		err := vs.verifyBuilderPayloadActivities(context.Background(), builderBlock(types.EmptyActivitiesHash.Bytes(), 123))
+		require.ErrorContains(t, "does not match the execution layer", err)
+	})
+	t.Run("transactions count mismatch", func(t *testing.T) {
+		vs := &Server{ExecutionEngineCaller: &powtesting.EngineClient{}}
+		err := vs.verifyBuilderPayloadActivities(context.Background(), builderBlock(elRoot.Bytes(), 1))
+		require.ErrorContains(t, "builder payload transactions count 1 does not match the execution layer 123", err)
+	})
+	t.Run("execution layer unavailable", func(t *testing.T) {
+		vs := &Server{ExecutionEngineCaller: &powtesting.EngineClient{ErrGetBlockActivities: errors.New("timeout")}}
+		err := vs.verifyBuilderPayloadActivities(context.Background(), builderBlock(elRoot.Bytes(), 123))
+		require.ErrorContains(t, "could not get block activities from execution layer: timeout", err)
+	})
+}
+
+func TestMatchingActivities(t *testing.T) {
+	txs := [][]byte{{'a'}, {'b'}}
+	local, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{
+		Transactions:      txs,
+		ActivitiesRoot:    types.EmptyActivitiesHash.Bytes(),
+		TransactionsCount: 2,
+	}, 0)
+	require.NoError(t, err)
+	localTxRoot, err := ssz.TransactionsRoot(txs)
+	require.NoError(t, err)
+
+	builderHeader := func(txRoot, activitiesRoot []byte, txCount uint64) interfaces.ExecutionData {
+		h, err := blocks.WrappedExecutionPayloadHeaderCapella(&v1.ExecutionPayloadHeaderCapella{
+			TransactionsRoot:  txRoot,
+			ActivitiesRoot:    activitiesRoot,
+			TransactionsCount: txCount,
+		}, 0)
+		require.NoError(t, err)
+		return h
+	}
+	otherTxRoot := bytesutil.PadTo([]byte{1}, fieldparams.RootLength)
+
+	tests := []struct {
+		name    string
+		builder interfaces.ExecutionData
+		want    bool
+		wantErr string
+	}{
+		{
+			name:    "other transactions",
+			builder: builderHeader(otherTxRoot, bytesutil.PadTo([]byte{2}, fieldparams.RootLength), 5),
+			want:    true,
+		},
+		{
+			name:    "same transactions and activities",
+			builder: builderHeader(localTxRoot[:], types.EmptyActivitiesHash.Bytes(), 2),
+			want:    true,
+		},
+		{
+			name:    "zero transactions count",
+			builder: builderHeader(otherTxRoot, types.EmptyActivitiesHash.Bytes(), 0),
+		},
+		{
+			name:    "same transactions, other activities root",
+			builder: builderHeader(localTxRoot[:], bytesutil.PadTo([]byte{2}, fieldparams.RootLength), 2),
+		},
+		{
+			name:    "same transactions, other transactions count",
+			builder: builderHeader(localTxRoot[:], types.EmptyActivitiesHash.Bytes(), 3),
+		},
+		{
+			name:    "malformed activities root",
+			builder: builderHeader(otherTxRoot, []byte{1}, 5),
+			wantErr: "activities root of length 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchingActivities(local, tt.builder)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
 }
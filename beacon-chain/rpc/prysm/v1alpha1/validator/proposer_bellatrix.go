@@ -79,6 +79,12 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
 			return blk.SetExecution(localPayload)
 		}
+		activitiesMatched, err := matchingActivities(localPayload, builderPayload)
+		if err != nil {
+			tracing.AnnotateError(span, err)
+			log.WithError(err).Warn("Proposer: failed to match activities")
+			return blk.SetExecution(localPayload)
+		}
 
 		// Use builder payload if the following in true:
 		// builder_bid_value * 100 > local_block_value * (local-block-value-boost + 100)
@@ -86,7 +92,7 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 		higherValueBuilder := builderValueGwei*100 > localValueGwei*(100+boost)
 
 		// If we can't get the builder value, just use local block.
-		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
+		if higherValueBuilder && withdrawalsMatched && activitiesMatched { // Builder value is higher, withdrawals and activities match.
 			blk.SetBlinded(true)
 			if err := blk.SetExecution(builderPayload); err != nil {
 				log.WithError(err).Warn("Proposer: failed to set builder payload")
@@ -193,6 +199,12 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 		return nil, nil, errors.New("builder returned header with an empty tx root")
 	}
 
+	if bid.Version() >= version.Capella {
+		if err := validateBidActivities(header); err != nil {
+			return nil, nil, errors.Wrap(err, "could not validate builder activities")
+		}
+	}
+
 	if !bytes.Equal(header.ParentHash(), h.BlockHash()) {
 		return nil, nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), h.BlockHash())
 	}
@@ -67,7 +67,7 @@ func TestServer_setExecutionData(t *testing.T) {
 	}}
 	id := &v1.PayloadIDBytes{0x1}
 	vs := &Server{
-		ExecutionEngineCaller:  &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: withdrawals}, BlockValue: 0},
+		ExecutionEngineCaller:  &powtesting.EngineClient{PayloadIDBytes: id, ExecutionPayloadCapella: &v1.ExecutionPayloadCapella{BlockNumber: 1, Withdrawals: withdrawals, ActivitiesRoot: make([]byte, fieldparams.RootLength)}, BlockValue: 0},
 		HeadFetcher:            &blockchainTest.ChainService{State: capellaTransitionState},
 		FinalizationFetcher:    &blockchainTest.ChainService{},
 		BeaconDB:               beaconDB,
@@ -100,19 +100,20 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, err)
 		bid := &ethpb.BuilderBidCapella{
 			Header: &v1.ExecutionPayloadHeaderCapella{
-				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-				StateRoot:        make([]byte, fieldparams.RootLength),
-				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-				PrevRandao:       make([]byte, fieldparams.RootLength),
-				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-				BlockHash:        make([]byte, fieldparams.RootLength),
-				ActivitiesRoot:   make([]byte, fieldparams.RootLength),
-				TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
-				ParentHash:       params.BeaconConfig().ZeroHash[:],
-				Timestamp:        uint64(ti.Unix()),
-				BlockNumber:      2,
-				WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+				FeeRecipient:      make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:         make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:      make([]byte, fieldparams.RootLength),
+				LogsBloom:         make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:        make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:     make([]byte, fieldparams.RootLength),
+				BlockHash:         make([]byte, fieldparams.RootLength),
+				ActivitiesRoot:    make([]byte, fieldparams.RootLength),
+				TransactionsRoot:  bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				TransactionsCount: 1,
+				ParentHash:        params.BeaconConfig().ZeroHash[:],
+				Timestamp:         uint64(ti.Unix()),
+				BlockNumber:       2,
+				WithdrawalsRoot:   make([]byte, fieldparams.RootLength),
 			},
 			Pubkey: sk.PublicKey().Marshal(),
 			Value:  bytesutil.PadTo([]byte{1}, 32),
@@ -163,19 +164,20 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderValue := bytesutil.ReverseByteOrder(big.NewInt(1e9).Bytes())
 		bid := &ethpb.BuilderBidCapella{
 			Header: &v1.ExecutionPayloadHeaderCapella{
-				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-				StateRoot:        make([]byte, fieldparams.RootLength),
-				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-				PrevRandao:       make([]byte, fieldparams.RootLength),
-				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-				BlockHash:        make([]byte, fieldparams.RootLength),
-				ActivitiesRoot:   make([]byte, fieldparams.RootLength),
-				TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
-				ParentHash:       params.BeaconConfig().ZeroHash[:],
-				Timestamp:        uint64(ti.Unix()),
-				BlockNumber:      2,
-				WithdrawalsRoot:  wr[:],
+				FeeRecipient:      make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:         make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:      make([]byte, fieldparams.RootLength),
+				LogsBloom:         make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:        make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:     make([]byte, fieldparams.RootLength),
+				BlockHash:         make([]byte, fieldparams.RootLength),
+				ActivitiesRoot:    bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				TransactionsRoot:  bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				TransactionsCount: 1,
+				ParentHash:        params.BeaconConfig().ZeroHash[:],
+				Timestamp:         uint64(ti.Unix()),
+				BlockNumber:       2,
+				WithdrawalsRoot:   wr[:],
 			},
 			Pubkey: sk.PublicKey().Marshal(),
 			Value:  bytesutil.PadTo(builderValue, 32),
@@ -210,7 +212,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		require.NoError(t, setExecutionData(context.Background(), blk, localPayload, builderPayload))
 		e, err := blk.Block().Body().Execution()
 		require.NoError(t, err)
-		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
+		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block, although its activities root differs from the local payload
 	})
 	t.Run("Builder configured. Local block has higher value", func(t *testing.T) {
 		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
@@ -314,21 +316,22 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderValue := bytesutil.ReverseByteOrder(big.NewInt(1e9).Bytes())
 		bid := &ethpb.BuilderBidDeneb{
 			Header: &v1.ExecutionPayloadHeaderDeneb{
-				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-				StateRoot:        make([]byte, fieldparams.RootLength),
-				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-				PrevRandao:       make([]byte, fieldparams.RootLength),
-				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-				BlockHash:        make([]byte, fieldparams.RootLength),
-				ActivitiesRoot:   make([]byte, fieldparams.RootLength),
-				TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
-				ParentHash:       params.BeaconConfig().ZeroHash[:],
-				Timestamp:        uint64(ti.Unix()),
-				BlockNumber:      2,
-				WithdrawalsRoot:  wr[:],
-				BlobGasUsed:      123,
-				ExcessBlobGas:    456,
+				FeeRecipient:      make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:         make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:      make([]byte, fieldparams.RootLength),
+				LogsBloom:         make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:        make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:     make([]byte, fieldparams.RootLength),
+				BlockHash:         make([]byte, fieldparams.RootLength),
+				ActivitiesRoot:    make([]byte, fieldparams.RootLength),
+				TransactionsRoot:  bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				TransactionsCount: 1,
+				ParentHash:        params.BeaconConfig().ZeroHash[:],
+				Timestamp:         uint64(ti.Unix()),
+				BlockNumber:       2,
+				WithdrawalsRoot:   wr[:],
+				BlobGasUsed:       123,
+				ExcessBlobGas:     456,
 			},
 			Pubkey: sk.PublicKey().Marshal(),
 			Value:  bytesutil.PadTo(builderValue, 32),
@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+// Sets the contract transfers for a block.
+func (vs *Server) setContractTransfers(blk interfaces.SignedBeaconBlock, headState state.BeaconState) {
+	if blk.Version() < version.Electra {
+		return
+	}
+	if err := blk.SetContractTransfers([]*ethpb.SignedContractTransfer{}); err != nil {
+		log.WithError(err).Error("Could not set contract transfers in block")
+		return
+	}
+	transfers, err := vs.ContractTransferPool.ContractTransfersForInclusion(headState)
+	if err != nil {
+		log.WithError(err).Error("Could not get contract transfers")
+		return
+	}
+	if err := blk.SetContractTransfers(transfers); err != nil {
+		log.WithError(err).Error("Could not set contract transfers")
+	}
+}
@@ -0,0 +1,41 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers/mock"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestServer_setContractTransfers(t *testing.T) {
+	transfers := []*ethpb.SignedContractTransfer{{
+		Message: &ethpb.ContractTransfer{
+			ValidatorIndex: 1,
+			RecipientIndex: 2,
+			Contract:       make([]byte, 20),
+		},
+		Signature:          make([]byte, 96),
+		RecipientSignature: make([]byte, 96),
+	}}
+	vs := &Server{ContractTransferPool: &mock.PoolMock{Transfers: transfers}}
+	st, _ := util.DeterministicGenesisStateElectra(t, 4)
+
+	t.Run("pre Electra block", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		require.NoError(t, err)
+		vs.setContractTransfers(blk, st)
+		_, err = blk.Block().Body().ContractTransfers()
+		require.ErrorContains(t, "ContractTransfers", err)
+	})
+	t.Run("Electra block", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockElectra())
+		require.NoError(t, err)
+		vs.setContractTransfers(blk, st)
+		got, err := blk.Block().Body().ContractTransfers()
+		require.NoError(t, err)
+		require.DeepEqual(t, transfers, got)
+	})
+}
@@ -21,11 +21,13 @@ import (
 	coretime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits"
@@ -638,6 +640,7 @@ func getProposerServer(db db.HeadAccessDatabase, headState state.BeaconState, he
 		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
 		BeaconDB:               db,
 		BLSChangesPool:         blstoexec.NewPool(),
+		ContractTransferPool:   contracttransfers.NewPool(),
 		BlockBuilder:           &builderTest.MockBuilderService{HasConfigured: true},
 	}
 }
@@ -743,14 +746,23 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				blockToPropose := util.NewBeaconBlockDeneb()
 				blockToPropose.Block.Slot = 5
 				blockToPropose.Block.ParentRoot = parent[:]
+				root, err := blockToPropose.Block.HashTreeRoot()
+				require.NoError(t, err)
+				sidecars := make([]*ethpb.SignedBlobSidecar, 4)
+				for i := range sidecars {
+					sidecars[i] = &ethpb.SignedBlobSidecar{Message: &ethpb.BlobSidecar{
+						BlockRoot:       root[:],
+						Index:           uint64(i),
+						Slot:            5,
+						BlockParentRoot: parent[:],
+						Blob:            make([]byte, fieldparams.BlobLength),
+						KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
+						KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
+					}}
+				}
 				blk := &ethpb.GenericSignedBeaconBlock_Deneb{Deneb: &ethpb.SignedBeaconBlockAndBlobsDeneb{
 					Block: blockToPropose,
-					Blobs: []*ethpb.SignedBlobSidecar{
-						{Message: &ethpb.BlobSidecar{Index: 0, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 1, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 2, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 3, Slot: 5, BlockParentRoot: parent[:]}},
-					},
+					Blobs: sidecars,
 				}}
 				return &ethpb.GenericSignedBeaconBlock{Block: blk}
 			},
@@ -794,13 +806,13 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 					SignedBlindedBlobSidecars: []*ethpb.SignedBlindedBlobSidecar{
 						{
 							Message: &ethpb.BlindedBlobSidecar{
-								BlockRoot:       []byte{0x01},
+								BlockRoot:       bytesutil.PadTo([]byte{0x01}, fieldparams.RootLength),
 								Slot:            2,
-								BlockParentRoot: []byte{0x03},
+								BlockParentRoot: bytesutil.PadTo([]byte{0x03}, fieldparams.RootLength),
 								ProposerIndex:   3,
 								BlobRoot:        []byte{0x04},
-								KzgCommitment:   []byte{0x05},
-								KzgProof:        []byte{0x06},
+								KzgCommitment:   bytesutil.PadTo([]byte{0x05}, fieldparams.BLSPubkeyLength),
+								KzgProof:        bytesutil.PadTo([]byte{0x06}, fieldparams.BLSPubkeyLength),
 							},
 							Signature: []byte{0x07},
 						},
@@ -822,12 +834,15 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 
 			c := &mock.ChainService{Root: bsRoot[:], State: beaconState}
 			db := dbutil.SetupDB(t)
+			bs := filesystem.NewEphemeralBlobStorage(t)
 			proposerServer := &Server{
-				BlockReceiver: c,
-				BlockNotifier: c.BlockNotifier(),
-				P2P:           mockp2p.NewTestP2P(t),
-				BlockBuilder:  &builderTest.MockBuilderService{HasConfigured: true, PayloadCapella: emptyPayloadCapella(), PayloadDeneb: emptyPayloadDeneb(), BlobBundle: &enginev1.BlobsBundle{KzgCommitments: [][]byte{{0x01}}, Proofs: [][]byte{{0x02}}, Blobs: [][]byte{{0x03}}}},
-				BeaconDB:      db,
+				BlockReceiver:         c,
+				BlockNotifier:         c.BlockNotifier(),
+				P2P:                   mockp2p.NewTestP2P(t),
+				BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true, PayloadCapella: emptyPayloadCapella(), PayloadDeneb: emptyPayloadDeneb(), BlobBundle: &enginev1.BlobsBundle{KzgCommitments: [][]byte{{0x01}}, Proofs: [][]byte{{0x02}}, Blobs: [][]byte{bytesutil.PadTo([]byte{0x03}, fieldparams.BlobLength)}}},
+				BeaconDB:              db,
+				BlobStorage:           bs,
+				ExecutionEngineCaller: &mockExecution.EngineClient{},
 			}
 			blockToPropose := tt.block(bsRoot)
 			res, err := proposerServer.ProposeBeaconBlock(context.Background(), blockToPropose)
@@ -840,7 +855,7 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				}
 			}
 			if tt.name == "deneb block has blobs" {
-				scs, err := db.BlobSidecarsBySlot(ctx, blockToPropose.GetDeneb().Block.Block.Slot)
+				scs, err := bs.Sidecars(bytesutil.ToBytes32(res.BlockRoot))
 				require.NoError(t, err)
 				assert.Equal(t, 4, len(scs))
 				for i, sc := range scs {
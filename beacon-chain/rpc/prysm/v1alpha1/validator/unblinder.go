@@ -100,6 +100,12 @@ func (u *unblinder) unblindBuilderBlock(ctx context.Context) (interfaces.SignedB
 		return nil, nil, errors.Wrap(err, "could not get transactions from payload")
 	}
 
+	if wb.Version() >= version.Capella {
+		if err := validatePayloadTransactionsCount(payload, txs); err != nil {
+			return nil, nil, errors.Wrap(err, "could not validate builder payload")
+		}
+	}
+
 	if wb.Version() >= version.Bellatrix && blobsBundle != nil {
 		log.WithField("blobCount", len(blobsBundle.Blobs))
 	}
@@ -158,6 +164,10 @@ func copyBlockData(src interfaces.SignedBeaconBlock, dst interfaces.SignedBeacon
 	if err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
 		return errors.Wrap(err, "could not get bls to execution changes")
 	}
+	contractTransfers, err := src.Block().Body().ContractTransfers()
+	if err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
+		return errors.Wrap(err, "could not get contract transfers")
+	}
 	kzgCommitments, err := src.Block().Body().BlobKzgCommitments()
 	if err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
 		return errors.Wrap(err, "could not get blob kzg commitments")
@@ -186,6 +196,9 @@ func copyBlockData(src interfaces.SignedBeaconBlock, dst interfaces.SignedBeacon
 	if err = dst.SetBLSToExecutionChanges(blsToExecChanges); err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
 		return errors.Wrap(err, "could not set bls to execution changes")
 	}
+	if err = dst.SetContractTransfers(contractTransfers); err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
+		return errors.Wrap(err, "could not set contract transfers")
+	}
 	if err = dst.SetBlobKzgCommitments(kzgCommitments); err != nil && !errors.Is(err, consensus_types.ErrUnsupportedField) {
 		return errors.Wrap(err, "could not set bls to execution changes")
 	}
@@ -213,6 +226,12 @@ func (u *unblinder) blindedProtoBlock() (proto.Message, error) {
 				Body: &ethpb.BlindedBeaconBlockBodyDeneb{},
 			},
 		}, nil
+	case version.Electra:
+		return &ethpb.SignedBlindedBeaconBlockElectra{
+			Message: &ethpb.BlindedBeaconBlockElectra{
+				Body: &ethpb.BlindedBeaconBlockBodyElectra{},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid version %s", version.String(u.b.Version()))
 	}
@@ -238,6 +257,12 @@ func (u *unblinder) protoBlock() (proto.Message, error) {
 				Body: &ethpb.BeaconBlockBodyDeneb{},
 			},
 		}, nil
+	case version.Electra:
+		return &ethpb.SignedBeaconBlockElectra{
+			Block: &ethpb.BeaconBlockElectra{
+				Body: &ethpb.BeaconBlockBodyElectra{},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("invalid version %s", version.String(u.b.Version()))
 	}
@@ -23,6 +23,9 @@ func Test_unblindBuilderBlock(t *testing.T) {
 	p.GasLimit = 123
 	pCapella := emptyPayloadCapella()
 	pCapella.GasLimit = 123
+	pCapellaWrongTxCount := emptyPayloadCapella()
+	pCapellaWrongTxCount.GasLimit = 123
+	pCapellaWrongTxCount.TransactionsCount = 1
 	pDeneb := emptyPayloadDeneb()
 	pDeneb.GasLimit = 123
 	pDeneb.ExcessBlobGas = 456
@@ -256,6 +259,41 @@ func Test_unblindBuilderBlock(t *testing.T) {
 				return wb
 			}(),
 		},
+		{
+			name: "payload transactions count mismatch Capella",
+			blk: func() interfaces.SignedBeaconBlock {
+				b := util.NewBlindedBeaconBlockCapella()
+				b.Block.Slot = 1
+				b.Block.ProposerIndex = 2
+				txRoot, err := ssz.TransactionsRoot([][]byte{})
+				require.NoError(t, err)
+				withdrawalsRoot, err := ssz.WithdrawalSliceRoot([]*v1.Withdrawal{}, fieldparams.MaxWithdrawalsPerPayload)
+				require.NoError(t, err)
+				b.Block.Body.ExecutionPayloadHeader = &v1.ExecutionPayloadHeaderCapella{
+					ParentHash:        make([]byte, fieldparams.RootLength),
+					FeeRecipient:      make([]byte, fieldparams.FeeRecipientLength),
+					StateRoot:         make([]byte, fieldparams.RootLength),
+					ReceiptsRoot:      make([]byte, fieldparams.RootLength),
+					LogsBloom:         make([]byte, fieldparams.LogsBloomLength),
+					PrevRandao:        make([]byte, fieldparams.RootLength),
+					BaseFeePerGas:     make([]byte, fieldparams.RootLength),
+					BlockHash:         make([]byte, fieldparams.RootLength),
+					ActivitiesRoot:    make([]byte, fieldparams.RootLength),
+					TransactionsCount: 1,
+					TransactionsRoot:  txRoot[:],
+					WithdrawalsRoot:   withdrawalsRoot[:],
+					GasLimit:          123,
+				}
+				wb, err := blocks.NewSignedBeaconBlock(b)
+				require.NoError(t, err)
+				return wb
+			}(),
+			mock: &builderTest.MockBuilderService{
+				HasConfigured:  true,
+				PayloadCapella: pCapellaWrongTxCount,
+			},
+			err: "payload transactions count 1 does not match its 0 transactions",
+		},
 		{
 			name: "can get payload and blobs Deneb",
 			blindBlobs: func() []*eth.SignedBlindedBlobSidecar {
@@ -15,6 +15,7 @@ import (
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/anomaly"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
@@ -23,9 +24,11 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits"
@@ -36,9 +39,12 @@ import (
 	rpcBuilder "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/debug"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/events"
+	lightclient "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/light-client"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/node"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/rewards"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/validator"
+	fastexbeacon "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/fastex/beacon"
+	fastexvalidator "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/fastex/validator"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/lookup"
 	nodeprysm "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/node"
 	beaconv1alpha1 "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/v1alpha1/beacon"
@@ -50,6 +56,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/io/logs"
@@ -89,7 +96,10 @@ type Config struct {
 	BeaconMonitoringHost          string
 	BeaconMonitoringPort          int
 	BeaconDB                      db.HeadAccessDatabase
+	BlobStorage                   *filesystem.BlobStorage
+	BackfillStatus                *backfill.Status
 	ChainInfoFetcher              blockchain.ChainInfoFetcher
+	SharedActivitySubscriber      blockchain.SharedActivitySubscriber
 	HeadFetcher                   blockchain.HeadFetcher
 	CanonicalFetcher              blockchain.CanonicalFetcher
 	ForkFetcher                   blockchain.ForkFetcher
@@ -110,6 +120,8 @@ type Config struct {
 	SlashingChecker               slasherservice.SlashingChecker
 	SyncCommitteeObjectPool       synccommittee.Pool
 	BLSChangesPool                blstoexec.PoolManager
+	ContractTransferPool          contracttransfers.PoolManager
+	ActivityAnomalyFetcher        anomaly.FindingsFetcher
 	SyncService                   chainSync.Checker
 	Broadcaster                   p2p.Broadcaster
 	PeersFetcher                  p2p.PeersProvider
@@ -125,6 +137,7 @@ type Config struct {
 	ExecutionEngineCaller         execution.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
+	LightClientFetcher            blockchain.LightClientFetcher
 	BlockBuilder                  builder.BlockBuilder
 	Router                        *mux.Router
 	ClockWaiter                   startup.ClockWaiter
@@ -238,9 +251,22 @@ func (s *Service) Start() {
 	blobServer := &blob.Server{
 		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
 		BeaconDB:         s.cfg.BeaconDB,
+		BlobStorage:      s.cfg.BlobStorage,
 	}
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/blob_sidecars/{block_id}", blobServer.Blobs).Methods(http.MethodGet)
 
+	if features.Get().EnableLightClient {
+		lightClientServer := &lightclient.Server{
+			BeaconDB:           s.cfg.BeaconDB,
+			StateGen:           s.cfg.StateGen,
+			LightClientFetcher: s.cfg.LightClientFetcher,
+		}
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/bootstrap/{block_root}", lightClientServer.GetLightClientBootstrap).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/updates", lightClientServer.GetLightClientUpdatesByRange).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/finality_update", lightClientServer.GetLightClientFinalityUpdate).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/optimistic_update", lightClientServer.GetLightClientOptimisticUpdate).Methods(http.MethodGet)
+	}
+
 	coreService := &core.Service{
 		HeadFetcher:        s.cfg.HeadFetcher,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
@@ -283,9 +309,11 @@ func (s *Service) Start() {
 		ReplayerBuilder:        ch,
 		ExecutionEngineCaller:  s.cfg.ExecutionEngineCaller,
 		BeaconDB:               s.cfg.BeaconDB,
+		BlobStorage:            s.cfg.BlobStorage,
 		ProposerSlotIndexCache: s.cfg.ProposerIdsCache,
 		BlockBuilder:           s.cfg.BlockBuilder,
 		BLSChangesPool:         s.cfg.BLSChangesPool,
+		ContractTransferPool:   s.cfg.ContractTransferPool,
 		ClockWaiter:            s.cfg.ClockWaiter,
 		CoreService:            coreService,
 	}
@@ -349,6 +377,7 @@ func (s *Service) Start() {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		BackfillStatus:            s.cfg.BackfillStatus,
 	}
 
 	s.cfg.Router.HandleFunc("/eth/v1/node/syncing", nodeServerEth.GetSyncStatus).Methods(http.MethodGet)
@@ -433,6 +462,26 @@ func (s *Service) Start() {
 	//todo unit act
 	s.cfg.Router.HandleFunc("/prysm/validators/performance", httpServer.GetValidatorPerformance).Methods(http.MethodPost)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/validator_count", httpServer.GetValidatorCount).Methods(http.MethodGet)
+	fastexValidatorServer := &fastexvalidator.Server{
+		HeadFetcher:      s.cfg.HeadFetcher,
+		CanonicalFetcher: s.cfg.CanonicalFetcher,
+		BeaconDB:         s.cfg.BeaconDB,
+	}
+	s.cfg.Router.HandleFunc("/fastex/v1/validators/{validator_id}/activity_history", fastexValidatorServer.GetActivityHistory).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/fastex/v1/validators/{validator_id}/deposit_status", fastexValidatorServer.GetDepositStatus).Methods(http.MethodGet)
+	fastexBeaconServer := &fastexbeacon.Server{
+		HeadFetcher:          s.cfg.HeadFetcher,
+		CanonicalFetcher:     s.cfg.CanonicalFetcher,
+		BeaconDB:             s.cfg.BeaconDB,
+		ContractTransferPool: s.cfg.ContractTransferPool,
+		Broadcaster:          s.cfg.Broadcaster,
+		OperationNotifier:    s.cfg.OperationNotifier,
+		AnomalyFetcher:       s.cfg.ActivityAnomalyFetcher,
+	}
+	s.cfg.Router.HandleFunc("/fastex/v1/beacon/pool/contract_transfers", fastexBeaconServer.ListContractTransfers).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/fastex/v1/beacon/pool/contract_transfers", fastexBeaconServer.SubmitContractTransfers).Methods(http.MethodPost)
+	s.cfg.Router.HandleFunc("/fastex/v1/beacon/activity_anomalies", fastexBeaconServer.ListActivityAnomalies).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/fastex/v1/beacon/activity_summaries", fastexBeaconServer.GetEpochActivitySummaries).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/committees", beaconChainServerV1.GetCommittees).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/fork", beaconChainServerV1.GetStateFork).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/blocks", beaconChainServerV1.PublishBlock).Methods(http.MethodPost)
@@ -456,6 +505,8 @@ func (s *Service) Start() {
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/validator_activities", beaconChainServerV1.GetValidatorActivities).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/validator_powers", beaconChainServerV1.GetValidatorPowers).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/shared_activities", beaconChainServerV1.GetSharedActivities).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/contracts", beaconChainServerV1.GetContracts).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/eth/v1/beacon/states/{state_id}/contracts/{address}", beaconChainServerV1.GetContract).Methods(http.MethodGet)
 
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerEth)
@@ -463,11 +514,12 @@ func (s *Service) Start() {
 	ethpbv1alpha1.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbservice.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	ethpbservice.RegisterEventsServer(s.grpcServer, &events.Server{
-		Ctx:               s.ctx,
-		StateNotifier:     s.cfg.StateNotifier,
-		OperationNotifier: s.cfg.OperationNotifier,
-		HeadFetcher:       s.cfg.HeadFetcher,
-		ChainInfoFetcher:  s.cfg.ChainInfoFetcher,
+		Ctx:                      s.ctx,
+		StateNotifier:            s.cfg.StateNotifier,
+		OperationNotifier:        s.cfg.OperationNotifier,
+		HeadFetcher:              s.cfg.HeadFetcher,
+		ChainInfoFetcher:         s.cfg.ChainInfoFetcher,
+		SharedActivitySubscriber: s.cfg.SharedActivitySubscriber,
 	})
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
@@ -126,6 +126,11 @@ type ReadOnlyValidators interface {
 	ValidatorIndexByContract(key [fieldparams.ContractAddressLength]byte) (primitives.ValidatorIndex, bool)
 	PubkeyAtIndex(idx primitives.ValidatorIndex) [fieldparams.BLSPubkeyLength]byte
 	ContractAtIndex(idx primitives.ValidatorIndex) ([fieldparams.ContractAddressLength]byte, bool)
+	ContractsAtIndex(idx primitives.ValidatorIndex) ([][fieldparams.ContractAddressLength]byte, error)
+	AdditionalContracts() ([]*ethpb.ValidatorContract, error)
+	RejectedContractBindings() ([]*ethpb.RejectedContractBinding, error)
+	ContractTransferNonces() ([]*ethpb.ContractTransferNonce, error)
+	ContractTransferNonceAtIndex(idx primitives.ValidatorIndex) (uint64, error)
 	NumValidators() int
 	ReadFromEveryValidator(f func(idx int, val ReadOnlyValidator) error) error
 }
@@ -142,6 +147,8 @@ type ReadOnlyActivities interface {
 	Activities() []uint64
 	ActivityAtIndex(idx primitives.ValidatorIndex) (uint64, error)
 	ActivitiesLength() int
+	PendingActivityCredits() ([]*ethpb.PendingActivityCredit, error)
+	PendingActivityCreditsLength() (int, error)
 }
 
 // ReadOnlyCheckpoint defines a struct which only has read access to checkpoint methods.
@@ -240,6 +247,11 @@ type WriteOnlyValidators interface {
 	ApplyToEveryValidator(f func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error
 	UpdateValidatorAtIndex(idx primitives.ValidatorIndex, val *ethpb.Validator) error
 	AppendValidator(val *ethpb.Validator) error
+	AppendAdditionalContract(idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error
+	RemoveAdditionalContract(idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error
+	SetRejectedContractBindings(val []*ethpb.RejectedContractBinding) error
+	AppendRejectedContractBinding(val *ethpb.RejectedContractBinding) error
+	IncrementContractTransferNonce(idx primitives.ValidatorIndex) error
 }
 
 // WriteOnlyBalances defines a struct which only has write access to balances methods.
@@ -254,6 +266,8 @@ type WriteOnlyActivities interface {
 	SetActivities(val []uint64) error
 	UpdateActivityAtIndex(idx primitives.ValidatorIndex, val uint64) error
 	AppendActivity(bal uint64) error
+	SetPendingActivityCredits(val []*ethpb.PendingActivityCredit) error
+	AppendPendingActivityCredit(val *ethpb.PendingActivityCredit) error
 }
 
 // WriteOnlyRandaoMixes defines a struct which only has write access to randao mixes methods.
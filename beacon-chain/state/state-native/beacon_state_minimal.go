@@ -32,6 +32,11 @@ type BeaconState struct {
 	stateRootsMultiValue                *MultiValueStateRoots
 	historicalRoots                     customtypes.HistoricalRoots
 	historicalSummaries                 []*ethpb.HistoricalSummary
+	additionalContracts                 []*ethpb.ValidatorContract
+	additionalContractsIndex            map[primitives.ValidatorIndex][]*ethpb.ValidatorContract
+	pendingActivityCredits              []*ethpb.PendingActivityCredit
+	rejectedContractBindings            []*ethpb.RejectedContractBinding
+	contractTransferNonces              []*ethpb.ContractTransferNonce
 	eth1Data                            *ethpb.Eth1Data
 	eth1DataVotes                       []*ethpb.Eth1Data
 	eth1DepositIndex                    uint64
@@ -41,6 +46,7 @@ type BeaconState struct {
 	balances                            []uint64
 	balancesMultiValue                  *MultiValueBalances
 	activities                          []uint64
+	activitiesMultiValue                *MultiValueActivities
 	randaoMixes                         customtypes.RandaoMixes
 	randaoMixesMultiValue               *MultiValueRandaoMixes
 	slashings                           []uint64
@@ -85,6 +91,10 @@ type beaconStateMarshalable struct {
 	StateRoots                          customtypes.StateRoots                  `json:"state_roots" yaml:"state_roots"`
 	HistoricalRoots                     customtypes.HistoricalRoots             `json:"historical_roots" yaml:"historical_roots"`
 	HistoricalSummaries                 []*ethpb.HistoricalSummary              `json:"historical_summaries" yaml:"historical_summaries"`
+	AdditionalContracts                 []*ethpb.ValidatorContract              `json:"additional_contracts" yaml:"additional_contracts"`
+	PendingActivityCredits              []*ethpb.PendingActivityCredit          `json:"pending_activity_credits" yaml:"pending_activity_credits"`
+	RejectedContractBindings            []*ethpb.RejectedContractBinding        `json:"rejected_contract_bindings" yaml:"rejected_contract_bindings"`
+	ContractTransferNonces              []*ethpb.ContractTransferNonce          `json:"contract_transfer_nonces" yaml:"contract_transfer_nonces"`
 	Eth1Data                            *ethpb.Eth1Data                         `json:"eth_1_data" yaml:"eth_1_data"`
 	Eth1DataVotes                       []*ethpb.Eth1Data                       `json:"eth_1_data_votes" yaml:"eth_1_data_votes"`
 	Eth1DepositIndex                    uint64                                  `json:"eth_1_deposit_index" yaml:"eth_1_deposit_index"`
@@ -117,6 +127,7 @@ func (b *BeaconState) MarshalJSON() ([]byte, error) {
 	var mixes customtypes.RandaoMixes
 	var balances []uint64
 	var inactivityScores []uint64
+	var activities []uint64
 	var vals []*ethpb.Validator
 
 	if features.Get().EnableExperimentalState {
@@ -125,6 +136,7 @@ func (b *BeaconState) MarshalJSON() ([]byte, error) {
 		mixes = b.randaoMixesMultiValue.Value(b)
 		balances = b.balancesMultiValue.Value(b)
 		inactivityScores = b.inactivityScoresMultiValue.Value(b)
+		activities = b.activitiesMultiValue.Value(b)
 		vals = b.validatorsMultiValue.Value(b)
 	} else {
 		bRoots = b.blockRoots
@@ -132,6 +144,7 @@ func (b *BeaconState) MarshalJSON() ([]byte, error) {
 		mixes = b.randaoMixes
 		balances = b.balances
 		inactivityScores = b.inactivityScores
+		activities = b.activities
 		vals = b.validators
 	}
 	marshalable := &beaconStateMarshalable{
@@ -145,13 +158,17 @@ func (b *BeaconState) MarshalJSON() ([]byte, error) {
 		StateRoots:                          sRoots,
 		HistoricalRoots:                     b.historicalRoots,
 		HistoricalSummaries:                 b.historicalSummaries,
+		AdditionalContracts:                 b.additionalContracts,
+		PendingActivityCredits:              b.pendingActivityCredits,
+		RejectedContractBindings:            b.rejectedContractBindings,
+		ContractTransferNonces:              b.contractTransferNonces,
 		Eth1Data:                            b.eth1Data,
 		Eth1DataVotes:                       b.eth1DataVotes,
 		Eth1DepositIndex:                    b.eth1DepositIndex,
 		SharedActivity:                      b.sharedActivity,
 		Validators:                          vals,
 		Balances:                            balances,
-		Activities:                          b.activities,
+		Activities:                          activities,
 		RandaoMixes:                         mixes,
 		Slashings:                           b.slashings,
 		PreviousEpochAttestations:           b.previousEpochAttestations,
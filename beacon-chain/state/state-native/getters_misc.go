@@ -1,6 +1,7 @@
 package state_native
 
 import (
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
@@ -109,9 +110,11 @@ func (b *BeaconState) historicalSummariesVal() []*ethpb.HistoricalSummary {
 // activitiesLength returns the length of the activities slice.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) activitiesLength() int {
-	if b.activities == nil {
-		return 0
+	if features.Get().EnableExperimentalState {
+		if b.activitiesMultiValue == nil {
+			return 0
+		}
+		return b.activitiesMultiValue.Len(b)
 	}
-
 	return len(b.activities)
 }
@@ -22,12 +22,15 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 	rm := b.randaoMixesVal().Slice()
 	var vals []*ethpb.Validator
 	var bals []uint64
+	var acts []uint64
 	if features.Get().EnableExperimentalState {
 		vals = b.validatorsVal()
 		bals = b.balancesVal()
+		acts = b.activitiesVal()
 	} else {
 		vals = b.validators
 		bals = b.balances
+		acts = b.activities
 	}
 
 	switch b.version {
@@ -47,7 +50,7 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			SharedActivity:              b.sharedActivity,
 			Validators:                  vals,
 			Balances:                    bals,
-			Activities:                  b.activities,
+			Activities:                  acts,
 			RandaoMixes:                 rm,
 			Slashings:                   b.slashings,
 			PreviousEpochAttestations:   b.previousEpochAttestations,
@@ -73,7 +76,7 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			SharedActivity:              b.sharedActivity,
 			Validators:                  vals,
 			Balances:                    bals,
-			Activities:                  b.activities,
+			Activities:                  acts,
 			RandaoMixes:                 rm,
 			Slashings:                   b.slashings,
 			PreviousEpochParticipation:  b.previousEpochParticipation,
@@ -102,7 +105,7 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			SharedActivity:               b.sharedActivity,
 			Validators:                   vals,
 			Balances:                     bals,
-			Activities:                   b.activities,
+			Activities:                   acts,
 			RandaoMixes:                  rm,
 			Slashings:                    b.slashings,
 			PreviousEpochParticipation:   b.previousEpochParticipation,
@@ -132,7 +135,7 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			SharedActivity:               b.sharedActivity,
 			Validators:                   vals,
 			Balances:                     bals,
-			Activities:                   b.activities,
+			Activities:                   acts,
 			RandaoMixes:                  rm,
 			Slashings:                    b.slashings,
 			PreviousEpochParticipation:   b.previousEpochParticipation,
@@ -165,7 +168,7 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			SharedActivity:               b.sharedActivity,
 			Validators:                   vals,
 			Balances:                     bals,
-			Activities:                   b.activities,
+			Activities:                   acts,
 			RandaoMixes:                  rm,
 			Slashings:                    b.slashings,
 			PreviousEpochParticipation:   b.previousEpochParticipation,
@@ -182,6 +185,43 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			NextWithdrawalValidatorIndex: b.nextWithdrawalValidatorIndex,
 			HistoricalSummaries:          b.historicalSummaries,
 		}
+	case version.Electra:
+		return &ethpb.BeaconStateElectra{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        gvrCopy[:],
+			Slot:                         b.slot,
+			Fork:                         b.fork,
+			LatestBlockHeader:            b.latestBlockHeader,
+			BlockRoots:                   br,
+			StateRoots:                   sr,
+			HistoricalRoots:              b.historicalRoots.Slice(),
+			Eth1Data:                     b.eth1Data,
+			Eth1DataVotes:                b.eth1DataVotes,
+			Eth1DepositIndex:             b.eth1DepositIndex,
+			SharedActivity:               b.sharedActivity,
+			Validators:                   vals,
+			Balances:                     bals,
+			Activities:                   acts,
+			RandaoMixes:                  rm,
+			Slashings:                    b.slashings,
+			PreviousEpochParticipation:   b.previousEpochParticipation,
+			CurrentEpochParticipation:    b.currentEpochParticipation,
+			JustificationBits:            b.justificationBits,
+			PreviousJustifiedCheckpoint:  b.previousJustifiedCheckpoint,
+			CurrentJustifiedCheckpoint:   b.currentJustifiedCheckpoint,
+			FinalizedCheckpoint:          b.finalizedCheckpoint,
+			InactivityScores:             b.inactivityScores,
+			CurrentSyncCommittee:         b.currentSyncCommittee,
+			NextSyncCommittee:            b.nextSyncCommittee,
+			LatestExecutionPayloadHeader: b.latestExecutionPayloadHeaderDeneb,
+			NextWithdrawalIndex:          b.nextWithdrawalIndex,
+			NextWithdrawalValidatorIndex: b.nextWithdrawalValidatorIndex,
+			HistoricalSummaries:          b.historicalSummaries,
+			AdditionalContracts:          b.additionalContracts,
+			PendingActivityCredits:       b.pendingActivityCredits,
+			RejectedContractBindings:     b.rejectedContractBindings,
+			ContractTransferNonces:       b.contractTransferNonces,
+		}
 	default:
 		return nil
 	}
@@ -358,6 +398,43 @@ func (b *BeaconState) ToProto() interface{} {
 			NextWithdrawalValidatorIndex: b.nextWithdrawalValidatorIndex,
 			HistoricalSummaries:          b.historicalSummariesVal(),
 		}
+	case version.Electra:
+		return &ethpb.BeaconStateElectra{
+			GenesisTime:                  b.genesisTime,
+			GenesisValidatorsRoot:        gvrCopy[:],
+			Slot:                         b.slot,
+			Fork:                         b.forkVal(),
+			LatestBlockHeader:            b.latestBlockHeaderVal(),
+			BlockRoots:                   br,
+			StateRoots:                   sr,
+			HistoricalRoots:              b.historicalRoots.Slice(),
+			Eth1Data:                     b.eth1DataVal(),
+			Eth1DataVotes:                b.eth1DataVotesVal(),
+			Eth1DepositIndex:             b.eth1DepositIndex,
+			SharedActivity:               b.sharedActivityVal(),
+			Validators:                   b.validatorsVal(),
+			Balances:                     b.balancesVal(),
+			Activities:                   b.activitiesVal(),
+			RandaoMixes:                  rm,
+			Slashings:                    b.slashingsVal(),
+			PreviousEpochParticipation:   b.previousEpochParticipationVal(),
+			CurrentEpochParticipation:    b.currentEpochParticipationVal(),
+			JustificationBits:            b.justificationBitsVal(),
+			PreviousJustifiedCheckpoint:  b.previousJustifiedCheckpointVal(),
+			CurrentJustifiedCheckpoint:   b.currentJustifiedCheckpointVal(),
+			FinalizedCheckpoint:          b.finalizedCheckpointVal(),
+			InactivityScores:             b.inactivityScoresVal(),
+			CurrentSyncCommittee:         b.currentSyncCommitteeVal(),
+			NextSyncCommittee:            b.nextSyncCommitteeVal(),
+			LatestExecutionPayloadHeader: b.latestExecutionPayloadHeaderDenebVal(),
+			NextWithdrawalIndex:          b.nextWithdrawalIndex,
+			NextWithdrawalValidatorIndex: b.nextWithdrawalValidatorIndex,
+			HistoricalSummaries:          b.historicalSummariesVal(),
+			AdditionalContracts:          b.additionalContractsVal(),
+			PendingActivityCredits:       b.pendingActivityCreditsVal(),
+			RejectedContractBindings:     b.rejectedContractBindingsVal(),
+			ContractTransferNonces:       b.contractTransferNoncesVal(),
+		}
 	default:
 		return nil
 	}
@@ -473,3 +550,13 @@ func ProtobufBeaconStateDeneb(s interface{}) (*ethpb.BeaconStateDeneb, error) {
 	}
 	return pbState, nil
 }
+
+// ProtobufBeaconStateElectra transforms an input into beacon state Electra in the form of protobuf.
+// Error is returned if the input is not type protobuf beacon state.
+func ProtobufBeaconStateElectra(s interface{}) (*ethpb.BeaconStateElectra, error) {
+	pbState, ok := s.(*ethpb.BeaconStateElectra)
+	if !ok {
+		return nil, errors.New("input is not type pb.ProtobufBeaconStateElectra")
+	}
+	return pbState, nil
+}
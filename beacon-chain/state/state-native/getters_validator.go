@@ -5,11 +5,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	consensus_types "github.com/prysmaticlabs/prysm/v4/consensus-types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
 
 // Validators participating in consensus on the beacon chain.
@@ -155,6 +157,8 @@ func (b *BeaconState) ValidatorIndexByPubkey(key [fieldparams.BLSPubkeyLength]by
 }
 
 // ValidatorIndexByContract returns a given validator by its 20-byte contract address.
+// Starting from Electra, contracts of validators that exited before the current epoch are
+// not returned, as the contract index shared between state copies may still hold them.
 func (b *BeaconState) ValidatorIndexByContract(key [fieldparams.ContractAddressLength]byte) (primitives.ValidatorIndex, bool) {
 	if b == nil || b.contractMapHandler == nil || b.contractMapHandler.IsNil() {
 		return 0, false
@@ -162,13 +166,41 @@ func (b *BeaconState) ValidatorIndexByContract(key [fieldparams.ContractAddressL
 
 	b.lock.RLock()
 	defer b.lock.RUnlock()
-	numOfVals := len(b.validators)
 
 	idx, ok := b.contractMapHandler.Get(key)
-	if ok && primitives.ValidatorIndex(numOfVals) <= idx {
+	if !ok {
 		return 0, false
 	}
-	return idx, ok
+	if b.version < version.Electra {
+		var numOfVals int
+		if features.Get().EnableExperimentalState {
+			numOfVals = b.validatorsMultiValue.Len(b)
+		} else {
+			numOfVals = len(b.validators)
+		}
+		if primitives.ValidatorIndex(numOfVals) <= idx {
+			return 0, false
+		}
+		return idx, true
+	}
+
+	var v *ethpb.Validator
+	if features.Get().EnableExperimentalState {
+		var err error
+		v, err = b.validatorsMultiValue.At(b, uint64(idx))
+		if err != nil {
+			return 0, false
+		}
+	} else {
+		if uint64(idx) >= uint64(len(b.validators)) {
+			return 0, false
+		}
+		v = b.validators[idx]
+	}
+	if v == nil || v.ExitEpoch < slots.ToEpoch(b.slot) {
+		return 0, false
+	}
+	return idx, true
 }
 
 // PubkeyAtIndex returns the pubkey at the given
@@ -212,6 +244,147 @@ func (b *BeaconState) ContractAtIndex(idx primitives.ValidatorIndex) ([fieldpara
 	return bytesutil.ToBytes20(b.validators[idx].Contract), true
 }
 
+// ContractsAtIndex returns the non-zero contracts linked to the validator at the given index.
+// The contract kept in the validator record comes first, followed by the additional contracts
+// in the order they were linked.
+func (b *BeaconState) ContractsAtIndex(idx primitives.ValidatorIndex) ([][fieldparams.ContractAddressLength]byte, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	val, err := b.validatorAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	contracts := make([][fieldparams.ContractAddressLength]byte, 0, 1)
+	if contract := bytesutil.ToBytes20(val.Contract); contract != params.BeaconConfig().ZeroContract {
+		contracts = append(contracts, contract)
+	}
+	for _, c := range b.additionalContractsIndex[idx] {
+		contracts = append(contracts, bytesutil.ToBytes20(c.Contract))
+	}
+	return contracts, nil
+}
+
+// AdditionalContracts returns the contracts linked to validators in addition to
+// the one kept in their validator records.
+func (b *BeaconState) AdditionalContracts() ([]*ethpb.ValidatorContract, error) {
+	if b.version < version.Electra {
+		return nil, errNotSupported("AdditionalContracts", b.version)
+	}
+
+	if b.additionalContracts == nil {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return b.additionalContractsVal(), nil
+}
+
+// additionalContractsVal of the beacon state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) additionalContractsVal() []*ethpb.ValidatorContract {
+	return ethpb.CopyValidatorContracts(b.additionalContracts)
+}
+
+// PendingActivityCredits returns the activity waiting for validators to activate.
+func (b *BeaconState) PendingActivityCredits() ([]*ethpb.PendingActivityCredit, error) {
+	if b.version < version.Electra {
+		return nil, errNotSupported("PendingActivityCredits", b.version)
+	}
+
+	if b.pendingActivityCredits == nil {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return b.pendingActivityCreditsVal(), nil
+}
+
+// PendingActivityCreditsLength returns the number of queued pending activity credits.
+func (b *BeaconState) PendingActivityCreditsLength() (int, error) {
+	if b.version < version.Electra {
+		return 0, errNotSupported("PendingActivityCreditsLength", b.version)
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return len(b.pendingActivityCredits), nil
+}
+
+// pendingActivityCreditsVal of the beacon state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) pendingActivityCreditsVal() []*ethpb.PendingActivityCredit {
+	return ethpb.CopyPendingActivityCredits(b.pendingActivityCredits)
+}
+
+// RejectedContractBindings returns the deposit contracts that could not be bound to
+// their depositors, oldest first.
+func (b *BeaconState) RejectedContractBindings() ([]*ethpb.RejectedContractBinding, error) {
+	if b.version < version.Electra {
+		return nil, errNotSupported("RejectedContractBindings", b.version)
+	}
+
+	if b.rejectedContractBindings == nil {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return b.rejectedContractBindingsVal(), nil
+}
+
+// rejectedContractBindingsVal of the beacon state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) rejectedContractBindingsVal() []*ethpb.RejectedContractBinding {
+	return ethpb.CopyRejectedContractBindings(b.rejectedContractBindings)
+}
+
+// ContractTransferNonces returns the contract transfer nonces of the validators that transferred a contract.
+func (b *BeaconState) ContractTransferNonces() ([]*ethpb.ContractTransferNonce, error) {
+	if b.version < version.Electra {
+		return nil, errNotSupported("ContractTransferNonces", b.version)
+	}
+
+	if b.contractTransferNonces == nil {
+		return nil, nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return b.contractTransferNoncesVal(), nil
+}
+
+// ContractTransferNonceAtIndex returns the number of contract transfers processed for
+// the validator at the given index.
+func (b *BeaconState) ContractTransferNonceAtIndex(idx primitives.ValidatorIndex) (uint64, error) {
+	if b.version < version.Electra {
+		return 0, errNotSupported("ContractTransferNonceAtIndex", b.version)
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for _, n := range b.contractTransferNonces {
+		if n.ValidatorIndex == idx {
+			return n.Nonce, nil
+		}
+	}
+	return 0, nil
+}
+
+// contractTransferNoncesVal of the beacon state.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) contractTransferNoncesVal() []*ethpb.ContractTransferNonce {
+	return ethpb.CopyContractTransferNonces(b.contractTransferNonces)
+}
+
 // NumValidators returns the size of the validator registry.
 func (b *BeaconState) NumValidators() int {
 	b.lock.RLock()
@@ -334,10 +507,6 @@ func (b *BeaconState) BalancesLength() int {
 
 // Activities of validators participating in consensus on the beacon chain.
 func (b *BeaconState) Activities() []uint64 {
-	if b.activities == nil {
-		return nil
-	}
-
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
@@ -347,10 +516,15 @@ func (b *BeaconState) Activities() []uint64 {
 // activitiesVal of validators participating in consensus on the beacon chain.
 // This assumes that a lock is already held on BeaconState.
 func (b *BeaconState) activitiesVal() []uint64 {
+	if features.Get().EnableExperimentalState {
+		if b.activitiesMultiValue == nil {
+			return nil
+		}
+		return b.activitiesMultiValue.Value(b)
+	}
 	if b.activities == nil {
 		return nil
 	}
-
 	res := make([]uint64, len(b.activities))
 	copy(res, b.activities)
 	return res
@@ -358,13 +532,18 @@ func (b *BeaconState) activitiesVal() []uint64 {
 
 // ActivityAtIndex of validator with the provided index.
 func (b *BeaconState) ActivityAtIndex(idx primitives.ValidatorIndex) (uint64, error) {
-	if b.activities == nil {
-		return 0, nil
-	}
-
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
+	if features.Get().EnableExperimentalState {
+		if b.activitiesMultiValue == nil {
+			return 0, nil
+		}
+		return b.activitiesMultiValue.At(b, uint64(idx))
+	}
+	if b.activities == nil {
+		return 0, nil
+	}
 	if uint64(len(b.activities)) <= uint64(idx) {
 		return 0, errors.Wrapf(consensus_types.ErrOutOfBounds, "activity index %d does not exist", idx)
 	}
@@ -373,10 +552,6 @@ func (b *BeaconState) ActivityAtIndex(idx primitives.ValidatorIndex) (uint64, er
 
 // ActivitiesLength returns the length of the activities slice.
 func (b *BeaconState) ActivitiesLength() int {
-	if b.activities == nil {
-		return 0
-	}
-
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
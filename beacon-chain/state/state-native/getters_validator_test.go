@@ -9,6 +9,7 @@ import (
 	statenative "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
 	testtmpl "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/testing"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -97,4 +98,69 @@ func TestContractIndexes(t *testing.T) {
 		_, ok = dState.ValidatorIndexByContract([fieldparams.ContractAddressLength]byte{'A'})
 		require.Equal(t, false, ok)
 	})
+
+	t.Run("copies do not share bindings", func(t *testing.T) {
+		st := dState.Copy()
+		cp := st.Copy()
+		contract := [fieldparams.ContractAddressLength]byte(expecetedContracts[0])
+		val, err := cp.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		val.Contract = params.BeaconConfig().ZeroContract[:]
+		require.NoError(t, cp.UpdateValidatorAtIndex(0, val))
+
+		_, ok := cp.ValidatorIndexByContract(contract)
+		require.Equal(t, false, ok)
+		idx, ok := st.ValidatorIndexByContract(contract)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(0), idx)
+
+		newContract := [fieldparams.ContractAddressLength]byte{'n', 'e', 'w'}
+		require.NoError(t, st.AppendValidator(&ethpb.Validator{
+			PublicKey: bytesutil.PadTo([]byte("new"), fieldparams.BLSPubkeyLength),
+			Contract:  newContract[:],
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}))
+		idx, ok = st.ValidatorIndexByContract(newContract)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(3), idx)
+		_, ok = cp.ValidatorIndexByContract(newContract)
+		require.Equal(t, false, ok)
+	})
+
+	t.Run("exited validators are returned before Electra", func(t *testing.T) {
+		st := dState.Copy()
+		contract := [fieldparams.ContractAddressLength]byte(expecetedContracts[1])
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.ExitEpoch = 2
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+		idx, ok := st.ValidatorIndexByContract(contract)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(1), idx)
+	})
+
+	t.Run("exited validators are not returned from Electra", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 3)
+		vals := st.Validators()
+		for i, val := range vals {
+			val.Contract = expecetedContracts[i]
+		}
+		require.NoError(t, st.SetValidators(vals))
+		contract := [fieldparams.ContractAddressLength]byte(expecetedContracts[1])
+		val, err := st.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.ExitEpoch = 2
+		require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2))
+		idx, ok := st.ValidatorIndexByContract(contract)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(1), idx)
+
+		require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+		_, ok = st.ValidatorIndexByContract(contract)
+		require.Equal(t, false, ok)
+	})
 }
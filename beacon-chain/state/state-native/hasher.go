@@ -35,6 +35,8 @@ func ComputeFieldRootsWithHasher(ctx context.Context, state *BeaconState) ([][]b
 		fieldRoots = make([][]byte, params.BeaconConfig().BeaconStateCapellaFieldCount)
 	case version.Deneb:
 		fieldRoots = make([][]byte, params.BeaconConfig().BeaconStateDenebFieldCount)
+	case version.Electra:
+		fieldRoots = make([][]byte, params.BeaconConfig().BeaconStateElectraFieldCount)
 	}
 
 	// Genesis time root.
@@ -249,7 +251,7 @@ func ComputeFieldRootsWithHasher(ctx context.Context, state *BeaconState) ([][]b
 		fieldRoots[types.LatestExecutionPayloadHeaderCapella.RealPosition()] = executionPayloadRoot[:]
 	}
 
-	if state.version == version.Deneb {
+	if state.version >= version.Deneb {
 		// Execution payload root.
 		executionPayloadRoot, err := state.latestExecutionPayloadHeaderDeneb.HashTreeRoot()
 		if err != nil {
@@ -277,5 +279,35 @@ func ComputeFieldRootsWithHasher(ctx context.Context, state *BeaconState) ([][]b
 		fieldRoots[types.HistoricalSummaries.RealPosition()] = historicalSummaryRoot[:]
 	}
 
+	if state.version >= version.Electra {
+		// Additional contracts root.
+		additionalContractsRoot, err := stateutil.AdditionalContractsRoot(state.additionalContracts)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute additional contracts merkleization")
+		}
+		fieldRoots[types.AdditionalContracts.RealPosition()] = additionalContractsRoot[:]
+
+		// Pending activity credits root.
+		pendingActivityCreditsRoot, err := stateutil.PendingActivityCreditsRoot(state.pendingActivityCredits)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute pending activity credits merkleization")
+		}
+		fieldRoots[types.PendingActivityCredits.RealPosition()] = pendingActivityCreditsRoot[:]
+
+		// Rejected contract bindings root.
+		rejectedContractBindingsRoot, err := stateutil.RejectedContractBindingsRoot(state.rejectedContractBindings)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute rejected contract bindings merkleization")
+		}
+		fieldRoots[types.RejectedContractBindings.RealPosition()] = rejectedContractBindingsRoot[:]
+
+		// Contract transfer nonces root.
+		contractTransferNoncesRoot, err := stateutil.ContractTransferNoncesRoot(state.contractTransferNonces)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute contract transfer nonces merkleization")
+		}
+		fieldRoots[types.ContractTransferNonces.RealPosition()] = contractTransferNoncesRoot[:]
+	}
+
 	return fieldRoots, nil
 }
@@ -30,6 +30,9 @@ var (
 	multiValueInactivityScoresCountGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "multi_value_inactivity_scores_count",
 	})
+	multiValueActivitiesCountGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "multi_value_activities_count",
+	})
 )
 
 // MultiValueRandaoMixes is a multi-value slice of randao mixes.
@@ -108,6 +111,20 @@ func NewMultiValueInactivityScores(scores []uint64) *MultiValueInactivityScores
 	return mv
 }
 
+// MultiValueActivities is a multi-value slice of validator activities.
+type MultiValueActivities = multi_value_slice.Slice[uint64, *BeaconState]
+
+// NewMultiValueActivities creates a new slice whose shared items will be populated with copies of input values.
+func NewMultiValueActivities(activities []uint64) *MultiValueActivities {
+	items := make([]uint64, len(activities))
+	copy(items, activities)
+	mv := &MultiValueActivities{}
+	mv.Init(items)
+	multiValueActivitiesCountGauge.Inc()
+	runtime.SetFinalizer(mv, activitiesFinalizer)
+	return mv
+}
+
 // MultiValueValidators is a multi-value slice of validator references.
 type MultiValueValidators = multi_value_slice.Slice[*ethpb.Validator, *BeaconState]
 
@@ -143,3 +160,7 @@ func validatorsFinalizer(m *MultiValueValidators) {
 func inactivityScoresFinalizer(m *MultiValueInactivityScores) {
 	multiValueInactivityScoresCountGauge.Dec()
 }
+
+func activitiesFinalizer(m *MultiValueActivities) {
+	multiValueActivitiesCountGauge.Dec()
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stateutil"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	consensus_types "github.com/prysmaticlabs/prysm/v4/consensus-types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -35,7 +36,7 @@ func (b *BeaconState) SetValidators(val []*ethpb.Validator) error {
 	b.markFieldAsDirty(types.Validators)
 	b.rebuildTrie[types.Validators] = true
 	b.valMapHandler = stateutil.NewValMapHandler(val)
-	b.contractMapHandler = stateutil.NewContractMapHandler(val, slots.ToEpoch(b.slot))
+	b.contractMapHandler = stateutil.NewContractMapHandler(val, b.additionalContracts, slots.ToEpoch(b.slot))
 	return nil
 }
 
@@ -100,6 +101,11 @@ func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator
 // UpdateValidatorAtIndex for the beacon state. Updates the validator
 // at a specific index to a new value.
 func (b *BeaconState) UpdateValidatorAtIndex(idx primitives.ValidatorIndex, val *ethpb.Validator) error {
+	var oldContract [fieldparams.ContractAddressLength]byte
+	if oldVal, err := b.ValidatorAtIndexReadOnly(idx); err == nil {
+		oldContract = oldVal.Contract()
+	}
+
 	if features.Get().EnableExperimentalState {
 		if err := b.validatorsMultiValue.UpdateAt(b, uint64(idx), val); err != nil {
 			return errors.Wrap(err, "could not update validator")
@@ -129,8 +135,13 @@ func (b *BeaconState) UpdateValidatorAtIndex(idx primitives.ValidatorIndex, val
 	// If validator has non-zero contract address,
 	// then add its contract address to contract map.
 	// todo unit act
-	if bytesutil.ToBytes20(val.Contract) != params.BeaconConfig().ZeroContract {
-		b.contractMapHandler.Set(bytesutil.ToBytes20(val.Contract), idx)
+	newContract := bytesutil.ToBytes20(val.Contract)
+	if newContract != params.BeaconConfig().ZeroContract {
+		b.bindContract(newContract, idx)
+	}
+	// If the contract was transferred or unbound, it is no longer linked to this validator.
+	if oldContract != newContract && oldContract != params.BeaconConfig().ZeroContract {
+		b.unbindContract(oldContract, idx)
 	}
 
 	b.markFieldAsDirty(types.Validators)
@@ -138,6 +149,205 @@ func (b *BeaconState) UpdateValidatorAtIndex(idx primitives.ValidatorIndex, val
 	return nil
 }
 
+// AppendAdditionalContract links an additional contract to the validator at the given index.
+func (b *BeaconState) AppendAdditionalContract(idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("AppendAdditionalContract", b.version)
+	}
+
+	contracts := b.additionalContracts
+	index := b.additionalContractsIndex
+	if b.sharedFieldReferences[types.AdditionalContracts].Refs() > 1 {
+		contracts = make([]*ethpb.ValidatorContract, 0, len(b.additionalContracts)+1)
+		contracts = append(contracts, b.additionalContracts...)
+		index = copyAdditionalContractsIndex(b.additionalContractsIndex)
+		b.sharedFieldReferences[types.AdditionalContracts].MinusRef()
+		b.sharedFieldReferences[types.AdditionalContracts] = stateutil.NewRef(1)
+	}
+
+	c := &ethpb.ValidatorContract{
+		ValidatorIndex: idx,
+		Contract:       bytesutil.SafeCopyBytes(contract[:]),
+	}
+	b.additionalContracts = append(contracts, c)
+	// The per validator lists may be shared with copies of the state, so they are never appended to in place.
+	owned := make([]*ethpb.ValidatorContract, 0, len(index[idx])+1)
+	index[idx] = append(append(owned, index[idx]...), c)
+	b.additionalContractsIndex = index
+	b.bindContract(contract, idx)
+	b.markFieldAsDirty(types.AdditionalContracts)
+	return nil
+}
+
+// RemoveAdditionalContract unlinks an additional contract from the validator at the given index.
+// The order of the remaining additional contracts is preserved.
+func (b *BeaconState) RemoveAdditionalContract(idx primitives.ValidatorIndex, contract [fieldparams.ContractAddressLength]byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("RemoveAdditionalContract", b.version)
+	}
+
+	pos := -1
+	for i, c := range b.additionalContracts {
+		if c.ValidatorIndex == idx && bytesutil.ToBytes20(c.Contract) == contract {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return errors.Errorf("contract %#x is not linked to validator %d", contract, idx)
+	}
+
+	// The list is always rebuilt, so the shared reference is released.
+	contracts := make([]*ethpb.ValidatorContract, 0, len(b.additionalContracts)-1)
+	contracts = append(contracts, b.additionalContracts[:pos]...)
+	contracts = append(contracts, b.additionalContracts[pos+1:]...)
+	index := b.additionalContractsIndex
+	if b.sharedFieldReferences[types.AdditionalContracts].Refs() > 1 {
+		index = copyAdditionalContractsIndex(b.additionalContractsIndex)
+	}
+	b.sharedFieldReferences[types.AdditionalContracts].MinusRef()
+	b.sharedFieldReferences[types.AdditionalContracts] = stateutil.NewRef(1)
+	b.additionalContracts = contracts
+
+	owned := make([]*ethpb.ValidatorContract, 0, len(index[idx]))
+	for _, c := range index[idx] {
+		if bytesutil.ToBytes20(c.Contract) != contract {
+			owned = append(owned, c)
+		}
+	}
+	if len(owned) == 0 {
+		delete(index, idx)
+	} else {
+		index[idx] = owned
+	}
+	b.additionalContractsIndex = index
+
+	b.unbindContract(contract, idx)
+	b.markFieldAsDirty(types.AdditionalContracts)
+	return nil
+}
+
+// SetPendingActivityCredits for the beacon state. Updates the entire
+// list to a new value by overwriting the previous one.
+func (b *BeaconState) SetPendingActivityCredits(val []*ethpb.PendingActivityCredit) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("SetPendingActivityCredits", b.version)
+	}
+
+	b.sharedFieldReferences[types.PendingActivityCredits].MinusRef()
+	b.sharedFieldReferences[types.PendingActivityCredits] = stateutil.NewRef(1)
+
+	b.pendingActivityCredits = val
+	b.markFieldAsDirty(types.PendingActivityCredits)
+	return nil
+}
+
+// AppendPendingActivityCredit for the beacon state. Appends the new value
+// to the the end of list.
+func (b *BeaconState) AppendPendingActivityCredit(val *ethpb.PendingActivityCredit) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("AppendPendingActivityCredit", b.version)
+	}
+
+	credits := b.pendingActivityCredits
+	if b.sharedFieldReferences[types.PendingActivityCredits].Refs() > 1 {
+		credits = make([]*ethpb.PendingActivityCredit, 0, len(b.pendingActivityCredits)+1)
+		credits = append(credits, b.pendingActivityCredits...)
+		b.sharedFieldReferences[types.PendingActivityCredits].MinusRef()
+		b.sharedFieldReferences[types.PendingActivityCredits] = stateutil.NewRef(1)
+	}
+
+	b.pendingActivityCredits = append(credits, val)
+	b.markFieldAsDirty(types.PendingActivityCredits)
+	return nil
+}
+
+// SetRejectedContractBindings for the beacon state. Updates the entire
+// list to a new value by overwriting the previous one.
+func (b *BeaconState) SetRejectedContractBindings(val []*ethpb.RejectedContractBinding) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("SetRejectedContractBindings", b.version)
+	}
+
+	b.sharedFieldReferences[types.RejectedContractBindings].MinusRef()
+	b.sharedFieldReferences[types.RejectedContractBindings] = stateutil.NewRef(1)
+
+	b.rejectedContractBindings = val
+	b.markFieldAsDirty(types.RejectedContractBindings)
+	return nil
+}
+
+// AppendRejectedContractBinding for the beacon state. Appends the new value
+// to the the end of list.
+func (b *BeaconState) AppendRejectedContractBinding(val *ethpb.RejectedContractBinding) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("AppendRejectedContractBinding", b.version)
+	}
+
+	bindings := b.rejectedContractBindings
+	if b.sharedFieldReferences[types.RejectedContractBindings].Refs() > 1 {
+		bindings = make([]*ethpb.RejectedContractBinding, 0, len(b.rejectedContractBindings)+1)
+		bindings = append(bindings, b.rejectedContractBindings...)
+		b.sharedFieldReferences[types.RejectedContractBindings].MinusRef()
+		b.sharedFieldReferences[types.RejectedContractBindings] = stateutil.NewRef(1)
+	}
+
+	b.rejectedContractBindings = append(bindings, val)
+	b.markFieldAsDirty(types.RejectedContractBindings)
+	return nil
+}
+
+// IncrementContractTransferNonce for the beacon state. Increments the contract transfer
+// nonce of the validator at the given index, adding an entry for its first transfer.
+func (b *BeaconState) IncrementContractTransferNonce(idx primitives.ValidatorIndex) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.version < version.Electra {
+		return errNotSupported("IncrementContractTransferNonce", b.version)
+	}
+
+	nonces := b.contractTransferNonces
+	if b.sharedFieldReferences[types.ContractTransferNonces].Refs() > 1 {
+		nonces = ethpb.CopyContractTransferNonces(b.contractTransferNonces)
+		b.sharedFieldReferences[types.ContractTransferNonces].MinusRef()
+		b.sharedFieldReferences[types.ContractTransferNonces] = stateutil.NewRef(1)
+	}
+
+	found := false
+	for _, n := range nonces {
+		if n.ValidatorIndex == idx {
+			n.Nonce++
+			found = true
+			break
+		}
+	}
+	if !found {
+		nonces = append(nonces, &ethpb.ContractTransferNonce{ValidatorIndex: idx, Nonce: 1})
+	}
+	b.contractTransferNonces = nonces
+	b.markFieldAsDirty(types.ContractTransferNonces)
+	return nil
+}
+
 // SetBalances for the beacon state. Updates the entire
 // list to a new value by overwriting the previous one.
 func (b *BeaconState) SetBalances(val []uint64) error {
@@ -200,10 +410,17 @@ func (b *BeaconState) SetActivities(val []uint64) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[types.Activities].MinusRef()
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+	if features.Get().EnableExperimentalState {
+		if b.activitiesMultiValue != nil {
+			b.activitiesMultiValue.Detach(b)
+		}
+		b.activitiesMultiValue = NewMultiValueActivities(val)
+	} else {
+		b.sharedFieldReferences[types.Activities].MinusRef()
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+		b.activities = val
+	}
 
-	b.activities = val
 	b.markFieldAsDirty(types.Activities)
 	b.rebuildTrie[types.Activities] = true
 	return nil
@@ -212,21 +429,32 @@ func (b *BeaconState) SetActivities(val []uint64) error {
 // UpdateActivityAtIndex for the beacon state. This method updates the activity
 // at a specific index to a new value.
 func (b *BeaconState) UpdateActivityAtIndex(idx primitives.ValidatorIndex, val uint64) error {
-	if uint64(len(b.activities)) <= uint64(idx) {
-		return errors.Errorf("invalid index provided %d", idx)
+	if features.Get().EnableExperimentalState {
+		if err := b.activitiesMultiValue.UpdateAt(b, uint64(idx), val); err != nil {
+			return errors.Wrap(err, "could not update activities")
+		}
+	} else {
+		if uint64(len(b.activities)) <= uint64(idx) {
+			return errors.Errorf("invalid index provided %d", idx)
+		}
+
+		b.lock.Lock()
+
+		acts := b.activities
+		if b.sharedFieldReferences[types.Activities].Refs() > 1 {
+			acts = b.activitiesVal()
+			b.sharedFieldReferences[types.Activities].MinusRef()
+			b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+		}
+		acts[idx] = val
+		b.activities = acts
+
+		b.lock.Unlock()
 	}
+
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	acts := b.activities
-	if b.sharedFieldReferences[types.Activities].Refs() > 1 {
-		acts = b.activitiesVal()
-		b.sharedFieldReferences[types.Activities].MinusRef()
-		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
-	}
-
-	acts[idx] = val
-	b.activities = acts
 	b.markFieldAsDirty(types.Activities)
 	b.addDirtyIndices(types.Activities, []uint64{uint64(idx)})
 	return nil
@@ -302,7 +530,7 @@ func (b *BeaconState) AppendValidator(val *ethpb.Validator) error {
 	// then add its contract address to contract map.
 	// todo unit act
 	if bytesutil.ToBytes20(val.Contract) != params.BeaconConfig().ZeroContract {
-		b.contractMapHandler.Set(bytesutil.ToBytes20(val.Contract), valIdx)
+		b.bindContract(bytesutil.ToBytes20(val.Contract), valIdx)
 	}
 
 	b.markFieldAsDirty(types.Validators)
@@ -345,20 +573,32 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 // AppendActivity for the beacon state. Appends the new value
 // to the the end of list.
 func (b *BeaconState) AppendActivity(act uint64) error {
-	b.lock.Lock()
-	defer b.lock.Unlock()
+	var actIdx uint64
+	if features.Get().EnableExperimentalState {
+		b.activitiesMultiValue.Append(b, act)
+		actIdx = uint64(b.activitiesMultiValue.Len(b) - 1)
+	} else {
+		b.lock.Lock()
 
-	acts := b.activities
-	if b.sharedFieldReferences[types.Activities].Refs() > 1 {
-		acts = b.activitiesVal()
-		b.sharedFieldReferences[types.Activities].MinusRef()
-		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+		acts := b.activities
+		if b.sharedFieldReferences[types.Activities].Refs() > 1 {
+			acts = make([]uint64, 0, len(b.activities)+1)
+			acts = append(acts, b.activities...)
+			b.sharedFieldReferences[types.Activities].MinusRef()
+			b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+		}
+
+		b.activities = append(acts, act)
+		actIdx = uint64(len(b.activities) - 1)
+
+		b.lock.Unlock()
 	}
 
-	b.activities = append(acts, act)
-	actIdx := len(b.activities) - 1
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
 	b.markFieldAsDirty(types.Activities)
-	b.addDirtyIndices(types.Activities, []uint64{uint64(actIdx)})
+	b.addDirtyIndices(types.Activities, []uint64{actIdx})
 	return nil
 }
 
@@ -416,3 +656,39 @@ func (b *BeaconState) SetInactivityScores(val []uint64) error {
 	b.markFieldAsDirty(types.InactivityScores)
 	return nil
 }
+
+// bindContract links the contract to the validator at the given index in the contract index.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) bindContract(contract [fieldparams.ContractAddressLength]byte, idx primitives.ValidatorIndex) {
+	if owner, ok := b.contractMapHandler.Get(contract); ok && owner == idx {
+		return
+	}
+	b.contractMapHandler.Set(contract, idx)
+}
+
+// unbindContract removes the contract from the contract index if it is linked to the validator at the given index.
+// This assumes that a lock is already held on BeaconState.
+func (b *BeaconState) unbindContract(contract [fieldparams.ContractAddressLength]byte, idx primitives.ValidatorIndex) {
+	if owner, ok := b.contractMapHandler.Get(contract); !ok || owner != idx {
+		return
+	}
+	b.contractMapHandler.Delete(contract)
+}
+
+// buildAdditionalContractsIndex groups the additional contracts by the validator they are bound to.
+func buildAdditionalContractsIndex(contracts []*ethpb.ValidatorContract) map[primitives.ValidatorIndex][]*ethpb.ValidatorContract {
+	index := make(map[primitives.ValidatorIndex][]*ethpb.ValidatorContract)
+	for _, c := range contracts {
+		index[c.ValidatorIndex] = append(index[c.ValidatorIndex], c)
+	}
+	return index
+}
+
+// copyAdditionalContractsIndex returns a shallow copy of the index. The per validator lists are shared.
+func copyAdditionalContractsIndex(index map[primitives.ValidatorIndex][]*ethpb.ValidatorContract) map[primitives.ValidatorIndex][]*ethpb.ValidatorContract {
+	cpy := make(map[primitives.ValidatorIndex][]*ethpb.ValidatorContract, len(index))
+	for idx, contracts := range index {
+		cpy[idx] = contracts
+	}
+	return cpy
+}
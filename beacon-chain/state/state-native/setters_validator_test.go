@@ -1,12 +1,19 @@
 package state_native_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
 )
 
 func BenchmarkAppendBalance(b *testing.B) {
@@ -45,6 +52,63 @@ func BenchmarkAppendInactivityScore(b *testing.B) {
 	}
 }
 
+func BenchmarkAppendActivity(b *testing.B) {
+	st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{})
+	require.NoError(b, err)
+
+	max := uint64(16777216)
+	for i := uint64(0); i < max-2; i++ {
+		require.NoError(b, st.AppendActivity(i))
+	}
+
+	ref := st.Copy()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, ref.AppendActivity(uint64(i)))
+		ref = st.Copy()
+	}
+}
+
+// BenchmarkCopyAndUpdateActivities measures the cost of the common block processing
+// pattern of copying a state and updating the activities of a few validators,
+// for both the legacy copy-on-write slice and the multi-value slice.
+func BenchmarkCopyAndUpdateActivities(b *testing.B) {
+	const numValidators = 1 << 20
+
+	for _, tt := range []struct {
+		name         string
+		experimental bool
+	}{
+		{name: "copy-on-write", experimental: false},
+		{name: "multi-value", experimental: true},
+	} {
+		b.Run(tt.name, func(b *testing.B) {
+			resetCfg := features.InitWithReset(&features.Flags{
+				EnableExperimentalState: tt.experimental,
+			})
+			defer resetCfg()
+
+			acts := make([]uint64, numValidators)
+			for i := range acts {
+				acts[i] = uint64(i)
+			}
+			st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{Activities: acts})
+			require.NoError(b, err)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cp := st.Copy()
+				for j := 0; j < 128; j++ {
+					idx := primitives.ValidatorIndex((i*128 + j) % numValidators)
+					require.NoError(b, cp.UpdateActivityAtIndex(idx, uint64(i)))
+				}
+			}
+		})
+	}
+}
+
 func TestActivities(t *testing.T) {
 	t.Run("activities check", func(t *testing.T) {
 		st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{})
@@ -72,4 +136,258 @@ func TestActivities(t *testing.T) {
 		require.Equal(t, 4, st.ActivitiesLength())
 		require.DeepEqual(t, expectedActivities, st.Activities())
 	})
+	t.Run("experimental state", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{
+			EnableExperimentalState: true,
+		})
+		defer resetCfg()
+
+		st, err := state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{Activities: []uint64{1, 3, 5}})
+		require.NoError(t, err)
+		cp := st.Copy()
+
+		require.ErrorContains(t, "could not update activities", cp.UpdateActivityAtIndex(primitives.ValidatorIndex(5), 15))
+		require.NoError(t, cp.UpdateActivityAtIndex(primitives.ValidatorIndex(1), 15))
+		require.NoError(t, cp.AppendActivity(78))
+
+		_, err = cp.ActivityAtIndex(primitives.ValidatorIndex(15))
+		require.ErrorContains(t, "out of bounds", err)
+		act, err := cp.ActivityAtIndex(primitives.ValidatorIndex(1))
+		require.NoError(t, err)
+		require.Equal(t, uint64(15), act)
+		require.Equal(t, 4, cp.ActivitiesLength())
+		require.DeepEqual(t, []uint64{1, 15, 5, 78}, cp.Activities())
+
+		// The original state must not observe changes made to its copy.
+		require.Equal(t, 3, st.ActivitiesLength())
+		require.DeepEqual(t, []uint64{1, 3, 5}, st.Activities())
+
+		require.NoError(t, cp.SetActivities([]uint64{7}))
+		require.DeepEqual(t, []uint64{7}, cp.Activities())
+		require.DeepEqual(t, []uint64{1, 3, 5}, st.Activities())
+	})
+	t.Run("experimental state root matches", func(t *testing.T) {
+		update := func(st state.BeaconState) [32]byte {
+			require.NoError(t, st.SetActivities(make([]uint64, 64)))
+			_, err := st.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			cp := st.Copy()
+			require.NoError(t, cp.UpdateActivityAtIndex(primitives.ValidatorIndex(7), 42))
+			require.NoError(t, cp.AppendActivity(78))
+			root, err := cp.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			return root
+		}
+
+		st, err := util.NewBeaconStateCapella()
+		require.NoError(t, err)
+		want := update(st)
+
+		resetCfg := features.InitWithReset(&features.Flags{
+			EnableExperimentalState: true,
+		})
+		defer resetCfg()
+		st, err = util.NewBeaconStateCapella()
+		require.NoError(t, err)
+		require.Equal(t, want, update(st))
+	})
+}
+
+func TestAdditionalContracts(t *testing.T) {
+	contract := bytesutil.ToBytes20([]byte("contract"))
+	extra1 := bytesutil.ToBytes20([]byte("extra_1"))
+	extra2 := bytesutil.ToBytes20([]byte("extra_2"))
+
+	t.Run("not supported before electra", func(t *testing.T) {
+		st, err := state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+		require.NoError(t, err)
+		_, err = st.AdditionalContracts()
+		require.ErrorContains(t, "not supported", err)
+		require.ErrorContains(t, "not supported", st.AppendAdditionalContract(0, extra1))
+		require.ErrorContains(t, "not supported", st.RemoveAdditionalContract(0, extra1))
+	})
+	t.Run("append and remove", func(t *testing.T) {
+		st, err := state_native.InitializeFromProtoElectra(&ethpb.BeaconStateElectra{
+			Validators: []*ethpb.Validator{
+				{Contract: contract[:], ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+				{Contract: params.BeaconConfig().ZeroContract[:], ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, st.AppendAdditionalContract(0, extra1))
+		require.NoError(t, st.AppendAdditionalContract(1, extra2))
+		contracts, err := st.ContractsAtIndex(0)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][fieldparams.ContractAddressLength]byte{contract, extra1}, contracts)
+		contracts, err = st.ContractsAtIndex(1)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][fieldparams.ContractAddressLength]byte{extra2}, contracts)
+		idx, ok := st.ValidatorIndexByContract(extra2)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(1), idx)
+
+		// Changes to a copy do not leak into the original state.
+		cp := st.Copy()
+		require.NoError(t, cp.RemoveAdditionalContract(0, extra1))
+		_, ok = cp.ValidatorIndexByContract(extra1)
+		require.Equal(t, false, ok)
+		_, ok = st.ValidatorIndexByContract(extra1)
+		require.Equal(t, true, ok)
+		contracts, err = cp.ContractsAtIndex(0)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][fieldparams.ContractAddressLength]byte{contract}, contracts)
+		contracts, err = st.ContractsAtIndex(0)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][fieldparams.ContractAddressLength]byte{contract, extra1}, contracts)
+		additional, err := st.AdditionalContracts()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(additional))
+		additional, err = cp.AdditionalContracts()
+		require.NoError(t, err)
+		require.DeepEqual(t, []*ethpb.ValidatorContract{{ValidatorIndex: 1, Contract: extra2[:]}}, additional)
+
+		require.ErrorContains(t, "is not linked to validator", cp.RemoveAdditionalContract(0, extra1))
+	})
+	t.Run("state root", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		_, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+
+		require.NoError(t, st.AppendAdditionalContract(0, extra1))
+		root, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pb, ok := st.ToProtoUnsafe().(*ethpb.BeaconStateElectra)
+		require.Equal(t, true, ok)
+		want, err := pb.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, root)
+
+		// A state initialized with the additional contracts indexes them.
+		st, err = state_native.InitializeFromProtoElectra(pb)
+		require.NoError(t, err)
+		idx, ok := st.ValidatorIndexByContract(extra1)
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.ValidatorIndex(0), idx)
+		contracts, err := st.ContractsAtIndex(0)
+		require.NoError(t, err)
+		// Genesis validators have no primary contract, so only the additional one is returned.
+		require.DeepEqual(t, [][fieldparams.ContractAddressLength]byte{extra1}, contracts)
+	})
+}
+
+func TestPendingActivityCredits(t *testing.T) {
+	t.Run("not supported before electra", func(t *testing.T) {
+		st, err := state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+		require.NoError(t, err)
+		_, err = st.PendingActivityCredits()
+		require.ErrorContains(t, "not supported", err)
+		require.ErrorContains(t, "not supported", st.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{}))
+		require.ErrorContains(t, "not supported", st.SetPendingActivityCredits(nil))
+	})
+	t.Run("append and set", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		require.NoError(t, st.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{ValidatorIndex: 1, Activity: 42}))
+
+		// Changes to a copy do not leak into the original state.
+		cp := st.Copy()
+		require.NoError(t, cp.AppendPendingActivityCredit(&ethpb.PendingActivityCredit{ValidatorIndex: 2, Activity: 7}))
+		credits, err := cp.PendingActivityCredits()
+		require.NoError(t, err)
+		credits[0].Activity = 4200
+		require.NoError(t, cp.SetPendingActivityCredits(credits))
+
+		credits, err = st.PendingActivityCredits()
+		require.NoError(t, err)
+		require.DeepEqual(t, []*ethpb.PendingActivityCredit{{ValidatorIndex: 1, Activity: 42}}, credits)
+		credits, err = cp.PendingActivityCredits()
+		require.NoError(t, err)
+		require.DeepEqual(t, []*ethpb.PendingActivityCredit{{ValidatorIndex: 1, Activity: 4200}, {ValidatorIndex: 2, Activity: 7}}, credits)
+
+		root, err := cp.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pb, ok := cp.ToProtoUnsafe().(*ethpb.BeaconStateElectra)
+		require.Equal(t, true, ok)
+		want, err := pb.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, root)
+	})
+}
+
+func TestRejectedContractBindings(t *testing.T) {
+	contract := bytesutil.ToBytes20([]byte("contract"))
+
+	t.Run("not supported before electra", func(t *testing.T) {
+		st, err := state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+		require.NoError(t, err)
+		_, err = st.RejectedContractBindings()
+		require.ErrorContains(t, "not supported", err)
+		require.ErrorContains(t, "not supported", st.AppendRejectedContractBinding(&ethpb.RejectedContractBinding{}))
+		require.ErrorContains(t, "not supported", st.SetRejectedContractBindings(nil))
+	})
+	t.Run("append and set", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		binding := &ethpb.RejectedContractBinding{ValidatorIndex: 3, Contract: contract[:], OwnerIndex: 1, Epoch: 5}
+		require.NoError(t, st.AppendRejectedContractBinding(binding))
+
+		cp := st.Copy()
+		require.NoError(t, cp.SetRejectedContractBindings([]*ethpb.RejectedContractBinding{}))
+		bindings, err := st.RejectedContractBindings()
+		require.NoError(t, err)
+		require.DeepEqual(t, []*ethpb.RejectedContractBinding{binding}, bindings)
+		bindings, err = cp.RejectedContractBindings()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(bindings))
+
+		root, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pb, ok := st.ToProtoUnsafe().(*ethpb.BeaconStateElectra)
+		require.Equal(t, true, ok)
+		want, err := pb.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, root)
+	})
+}
+
+func TestContractTransferNonces(t *testing.T) {
+	t.Run("not supported before electra", func(t *testing.T) {
+		st, err := state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+		require.NoError(t, err)
+		_, err = st.ContractTransferNonceAtIndex(0)
+		require.ErrorContains(t, "not supported", err)
+		_, err = st.ContractTransferNonces()
+		require.ErrorContains(t, "not supported", err)
+		require.ErrorContains(t, "not supported", st.IncrementContractTransferNonce(0))
+	})
+	t.Run("increment", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisStateElectra(t, 4)
+		require.NoError(t, st.IncrementContractTransferNonce(2))
+
+		cp := st.Copy()
+		require.NoError(t, cp.IncrementContractTransferNonce(2))
+		require.NoError(t, cp.IncrementContractTransferNonce(3))
+		nonce, err := st.ContractTransferNonceAtIndex(2)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), nonce)
+		nonce, err = st.ContractTransferNonceAtIndex(3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), nonce)
+		nonce, err = cp.ContractTransferNonceAtIndex(2)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), nonce)
+		nonce, err = cp.ContractTransferNonceAtIndex(3)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), nonce)
+		nonces, err := cp.ContractTransferNonces()
+		require.NoError(t, err)
+		require.DeepEqual(t, []*ethpb.ContractTransferNonce{{ValidatorIndex: 2, Nonce: 2}, {ValidatorIndex: 3, Nonce: 1}}, nonces)
+
+		root, err := cp.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pb, ok := cp.ToProtoUnsafe().(*ethpb.BeaconStateElectra)
+		require.Equal(t, true, ok)
+		want, err := pb.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, root)
+	})
 }
@@ -75,7 +75,7 @@ func TestContractMap_DistinctCopy(t *testing.T) {
 			WithdrawableEpoch:          1,
 		})
 	}
-	handler := stateutil.NewContractMapHandler(vals, 0)
+	handler := stateutil.NewContractMapHandler(vals, nil, 0)
 	newHandler := handler.Copy()
 	wantedContract := strconv.Itoa(22)
 	handler.Set(bytesutil.ToBytes20([]byte(wantedContract)), 27)
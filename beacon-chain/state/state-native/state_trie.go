@@ -99,18 +99,31 @@ var denebFields = append(
 	types.HistoricalSummaries,
 )
 
+var electraFields = append(
+	altairFields,
+	types.LatestExecutionPayloadHeaderDeneb,
+	types.NextWithdrawalIndex,
+	types.NextWithdrawalValidatorIndex,
+	types.HistoricalSummaries,
+	types.AdditionalContracts,
+	types.PendingActivityCredits,
+	types.RejectedContractBindings,
+	types.ContractTransferNonces,
+)
+
 const (
-	phase0SharedFieldRefCount    = 11
-	altairSharedFieldRefCount    = 12
-	bellatrixSharedFieldRefCount = 13
-	capellaSharedFieldRefCount   = 15
-	denebSharedFieldRefCount     = 15
-	//todo: make activities like experimental multi-value array(not urgent)
+	phase0SharedFieldRefCount                     = 11
+	altairSharedFieldRefCount                     = 12
+	bellatrixSharedFieldRefCount                  = 13
+	capellaSharedFieldRefCount                    = 15
+	denebSharedFieldRefCount                      = 15
+	electraSharedFieldRefCount                    = 19
 	experimentalStatePhase0SharedFieldRefCount    = 5
 	experimentalStateAltairSharedFieldRefCount    = 5
 	experimentalStateBellatrixSharedFieldRefCount = 6
 	experimentalStateCapellaSharedFieldRefCount   = 8
 	experimentalStateDenebSharedFieldRefCount     = 8
+	experimentalStateElectraSharedFieldRefCount   = 12
 )
 
 // InitializeFromProtoPhase0 the beacon state from a protobuf representation.
@@ -138,6 +151,11 @@ func InitializeFromProtoDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconState, er
 	return InitializeFromProtoUnsafeDeneb(proto.Clone(st).(*ethpb.BeaconStateDeneb))
 }
 
+// InitializeFromProtoElectra the beacon state from a protobuf representation.
+func InitializeFromProtoElectra(st *ethpb.BeaconStateElectra) (state.BeaconState, error) {
+	return InitializeFromProtoUnsafeElectra(proto.Clone(st).(*ethpb.BeaconStateElectra))
+}
+
 // InitializeFromProtoUnsafePhase0 directly uses the beacon state protobuf fields
 // and sets them as fields of the BeaconState type.
 func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState, error) {
@@ -164,7 +182,6 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		eth1DataVotes:               st.Eth1DataVotes,
 		eth1DepositIndex:            st.Eth1DepositIndex,
 		sharedActivity:              st.SharedActivity,
-		activities:                  st.Activities,
 		slashings:                   st.Slashings,
 		previousEpochAttestations:   st.PreviousEpochAttestations,
 		currentEpochAttestations:    st.CurrentEpochAttestations,
@@ -180,7 +197,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
 		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
 		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
-		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, slots.ToEpoch(st.Slot)),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, nil, slots.ToEpoch(st.Slot)),
 	}
 
 	if features.Get().EnableExperimentalState {
@@ -189,6 +206,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
 		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
 		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStatePhase0SharedFieldRefCount)
 	} else {
 		bRoots := make([][32]byte, fieldparams.BlockRootsLength)
@@ -211,6 +229,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 
 		b.balances = st.Balances
 		b.validators = st.Validators
+		b.activities = st.Activities
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, phase0SharedFieldRefCount)
 	}
@@ -229,7 +248,6 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 	// Initialize field reference tracking for shared data.
 	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.PreviousEpochAttestations] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.CurrentEpochAttestations] = stateutil.NewRef(1)
@@ -239,6 +257,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	}
 
 	state.StateCount.Inc()
@@ -274,7 +293,6 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		eth1DataVotes:               st.Eth1DataVotes,
 		eth1DepositIndex:            st.Eth1DepositIndex,
 		sharedActivity:              st.SharedActivity,
-		activities:                  st.Activities,
 		slashings:                   st.Slashings,
 		previousEpochParticipation:  st.PreviousEpochParticipation,
 		currentEpochParticipation:   st.CurrentEpochParticipation,
@@ -292,7 +310,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
 		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
 		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
-		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, slots.ToEpoch(st.Slot)),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, nil, slots.ToEpoch(st.Slot)),
 	}
 
 	if features.Get().EnableExperimentalState {
@@ -301,6 +319,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
 		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
 		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
 		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(st.InactivityScores)
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateAltairSharedFieldRefCount)
 	} else {
@@ -324,6 +343,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 
 		b.balances = st.Balances
 		b.validators = st.Validators
+		b.activities = st.Activities
 		b.inactivityScores = st.InactivityScores
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, altairSharedFieldRefCount)
@@ -344,7 +364,6 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 	//todo unit act
 	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.PreviousEpochParticipationBits] = stateutil.NewRef(1) // New in Altair.
 	b.sharedFieldReferences[types.CurrentEpochParticipationBits] = stateutil.NewRef(1)  // New in Altair.
@@ -354,6 +373,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
@@ -389,7 +409,6 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		eth1DataVotes:                st.Eth1DataVotes,
 		eth1DepositIndex:             st.Eth1DepositIndex,
 		sharedActivity:               st.SharedActivity,
-		activities:                   st.Activities,
 		slashings:                    st.Slashings,
 		previousEpochParticipation:   st.PreviousEpochParticipation,
 		currentEpochParticipation:    st.CurrentEpochParticipation,
@@ -408,7 +427,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
 		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
 		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
-		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, slots.ToEpoch(st.Slot)),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, nil, slots.ToEpoch(st.Slot)),
 	}
 
 	if features.Get().EnableExperimentalState {
@@ -417,6 +436,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
 		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
 		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
 		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(st.InactivityScores)
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateBellatrixSharedFieldRefCount)
 	} else {
@@ -440,6 +460,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 
 		b.balances = st.Balances
 		b.validators = st.Validators
+		b.activities = st.Activities
 		b.inactivityScores = st.InactivityScores
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, bellatrixSharedFieldRefCount)
@@ -460,7 +481,6 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 	// todo unit act
 	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.PreviousEpochParticipationBits] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.CurrentEpochParticipationBits] = stateutil.NewRef(1)
@@ -471,6 +491,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
@@ -506,7 +527,6 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		eth1DataVotes:                       st.Eth1DataVotes,
 		eth1DepositIndex:                    st.Eth1DepositIndex,
 		sharedActivity:                      st.SharedActivity,
-		activities:                          st.Activities,
 		slashings:                           st.Slashings,
 		previousEpochParticipation:          st.PreviousEpochParticipation,
 		currentEpochParticipation:           st.CurrentEpochParticipation,
@@ -528,7 +548,7 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
 		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
 		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
-		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, slots.ToEpoch(st.Slot)),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, nil, slots.ToEpoch(st.Slot)),
 	}
 
 	if features.Get().EnableExperimentalState {
@@ -537,6 +557,7 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
 		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
 		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
 		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(st.InactivityScores)
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateCapellaSharedFieldRefCount)
 	} else {
@@ -560,6 +581,7 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 
 		b.balances = st.Balances
 		b.validators = st.Validators
+		b.activities = st.Activities
 		b.inactivityScores = st.InactivityScores
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, capellaSharedFieldRefCount)
@@ -580,7 +602,6 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 	// todo unit act
 	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.PreviousEpochParticipationBits] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.CurrentEpochParticipationBits] = stateutil.NewRef(1)
@@ -592,6 +613,7 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
@@ -627,7 +649,6 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		eth1DataVotes:                     st.Eth1DataVotes,
 		eth1DepositIndex:                  st.Eth1DepositIndex,
 		sharedActivity:                    st.SharedActivity,
-		activities:                        st.Activities,
 		slashings:                         st.Slashings,
 		previousEpochParticipation:        st.PreviousEpochParticipation,
 		currentEpochParticipation:         st.CurrentEpochParticipation,
@@ -647,7 +668,7 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
 		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
 		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
-		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, slots.ToEpoch(st.Slot)),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, nil, slots.ToEpoch(st.Slot)),
 	}
 
 	if features.Get().EnableExperimentalState {
@@ -656,6 +677,7 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
 		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
 		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
 		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(st.InactivityScores)
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateDenebSharedFieldRefCount)
 	} else {
@@ -679,6 +701,7 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 
 		b.balances = st.Balances
 		b.validators = st.Validators
+		b.activities = st.Activities
 		b.inactivityScores = st.InactivityScores
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, denebSharedFieldRefCount)
@@ -699,7 +722,6 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 	// todo unit act
 	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.PreviousEpochParticipationBits] = stateutil.NewRef(1)
 	b.sharedFieldReferences[types.CurrentEpochParticipationBits] = stateutil.NewRef(1)
@@ -711,6 +733,134 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
+	}
+
+	state.StateCount.Inc()
+	// Finalizer runs when dst is being destroyed in garbage collection.
+	runtime.SetFinalizer(b, finalizerCleanup)
+	return b, nil
+}
+
+// InitializeFromProtoUnsafeElectra directly uses the beacon state protobuf fields
+// and sets them as fields of the BeaconState type.
+func InitializeFromProtoUnsafeElectra(st *ethpb.BeaconStateElectra) (state.BeaconState, error) {
+	if st == nil {
+		return nil, errors.New("received nil state")
+	}
+
+	hRoots := customtypes.HistoricalRoots(make([][32]byte, len(st.HistoricalRoots)))
+	for i, r := range st.HistoricalRoots {
+		hRoots[i] = bytesutil.ToBytes32(r)
+	}
+
+	fieldCount := params.BeaconConfig().BeaconStateElectraFieldCount
+	b := &BeaconState{
+		version:                           version.Electra,
+		genesisTime:                       st.GenesisTime,
+		genesisValidatorsRoot:             bytesutil.ToBytes32(st.GenesisValidatorsRoot),
+		slot:                              st.Slot,
+		fork:                              st.Fork,
+		latestBlockHeader:                 st.LatestBlockHeader,
+		historicalRoots:                   hRoots,
+		eth1Data:                          st.Eth1Data,
+		eth1DataVotes:                     st.Eth1DataVotes,
+		eth1DepositIndex:                  st.Eth1DepositIndex,
+		sharedActivity:                    st.SharedActivity,
+		slashings:                         st.Slashings,
+		previousEpochParticipation:        st.PreviousEpochParticipation,
+		currentEpochParticipation:         st.CurrentEpochParticipation,
+		justificationBits:                 st.JustificationBits,
+		previousJustifiedCheckpoint:       st.PreviousJustifiedCheckpoint,
+		currentJustifiedCheckpoint:        st.CurrentJustifiedCheckpoint,
+		finalizedCheckpoint:               st.FinalizedCheckpoint,
+		currentSyncCommittee:              st.CurrentSyncCommittee,
+		nextSyncCommittee:                 st.NextSyncCommittee,
+		latestExecutionPayloadHeaderDeneb: st.LatestExecutionPayloadHeader,
+		nextWithdrawalIndex:               st.NextWithdrawalIndex,
+		nextWithdrawalValidatorIndex:      st.NextWithdrawalValidatorIndex,
+		historicalSummaries:               st.HistoricalSummaries,
+		additionalContracts:               st.AdditionalContracts,
+		additionalContractsIndex:          buildAdditionalContractsIndex(st.AdditionalContracts),
+		pendingActivityCredits:            st.PendingActivityCredits,
+		rejectedContractBindings:          st.RejectedContractBindings,
+		contractTransferNonces:            st.ContractTransferNonces,
+
+		dirtyFields:        make(map[types.FieldIndex]bool, fieldCount),
+		dirtyIndices:       make(map[types.FieldIndex][]uint64, fieldCount),
+		stateFieldLeaves:   make(map[types.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
+		rebuildTrie:        make(map[types.FieldIndex]bool, fieldCount),
+		valMapHandler:      stateutil.NewValMapHandler(st.Validators),
+		contractMapHandler: stateutil.NewContractMapHandler(st.Validators, st.AdditionalContracts, slots.ToEpoch(st.Slot)),
+	}
+
+	if features.Get().EnableExperimentalState {
+		b.blockRootsMultiValue = NewMultiValueBlockRoots(st.BlockRoots)
+		b.stateRootsMultiValue = NewMultiValueStateRoots(st.StateRoots)
+		b.randaoMixesMultiValue = NewMultiValueRandaoMixes(st.RandaoMixes)
+		b.balancesMultiValue = NewMultiValueBalances(st.Balances)
+		b.validatorsMultiValue = NewMultiValueValidators(st.Validators)
+		b.activitiesMultiValue = NewMultiValueActivities(st.Activities)
+		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(st.InactivityScores)
+		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateElectraSharedFieldRefCount)
+	} else {
+		bRoots := make([][32]byte, fieldparams.BlockRootsLength)
+		for i, r := range st.BlockRoots {
+			bRoots[i] = bytesutil.ToBytes32(r)
+		}
+		b.blockRoots = bRoots
+
+		sRoots := make([][32]byte, fieldparams.StateRootsLength)
+		for i, r := range st.StateRoots {
+			sRoots[i] = bytesutil.ToBytes32(r)
+		}
+		b.stateRoots = sRoots
+
+		mixes := make([][32]byte, fieldparams.RandaoMixesLength)
+		for i, m := range st.RandaoMixes {
+			mixes[i] = bytesutil.ToBytes32(m)
+		}
+		b.randaoMixes = mixes
+
+		b.balances = st.Balances
+		b.validators = st.Validators
+		b.activities = st.Activities
+		b.inactivityScores = st.InactivityScores
+
+		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, electraSharedFieldRefCount)
+	}
+
+	for _, f := range electraFields {
+		b.dirtyFields[f] = true
+		b.rebuildTrie[f] = true
+		b.dirtyIndices[f] = []uint64{}
+		trie, err := fieldtrie.NewFieldTrie(f, types.BasicArray, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		b.stateFieldLeaves[f] = trie
+	}
+
+	// Initialize field reference tracking for shared data.
+	b.sharedFieldReferences[types.HistoricalRoots] = stateutil.NewRef(1)
+	b.sharedFieldReferences[types.Eth1DataVotes] = stateutil.NewRef(1)
+	b.sharedFieldReferences[types.Slashings] = stateutil.NewRef(1)
+	b.sharedFieldReferences[types.PreviousEpochParticipationBits] = stateutil.NewRef(1)
+	b.sharedFieldReferences[types.CurrentEpochParticipationBits] = stateutil.NewRef(1)
+	b.sharedFieldReferences[types.LatestExecutionPayloadHeaderDeneb] = stateutil.NewRef(1) // New in Deneb.
+	b.sharedFieldReferences[types.HistoricalSummaries] = stateutil.NewRef(1)               // New in Capella.
+	b.sharedFieldReferences[types.AdditionalContracts] = stateutil.NewRef(1)               // New in Electra.
+	b.sharedFieldReferences[types.PendingActivityCredits] = stateutil.NewRef(1)            // New in Electra.
+	b.sharedFieldReferences[types.RejectedContractBindings] = stateutil.NewRef(1)          // New in Electra.
+	b.sharedFieldReferences[types.ContractTransferNonces] = stateutil.NewRef(1)            // New in Electra.
+	if !features.Get().EnableExperimentalState {
+		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
+		b.sharedFieldReferences[types.Activities] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
@@ -737,6 +887,8 @@ func (b *BeaconState) Copy() state.BeaconState {
 		fieldCount = params.BeaconConfig().BeaconStateCapellaFieldCount
 	case version.Deneb:
 		fieldCount = params.BeaconConfig().BeaconStateDenebFieldCount
+	case version.Electra:
+		fieldCount = params.BeaconConfig().BeaconStateElectraFieldCount
 	}
 
 	// todo unit act
@@ -766,8 +918,14 @@ func (b *BeaconState) Copy() state.BeaconState {
 		balances:                   b.balances,
 		balancesMultiValue:         b.balancesMultiValue,
 		activities:                 b.activities,
+		activitiesMultiValue:       b.activitiesMultiValue,
 		historicalRoots:            b.historicalRoots,
 		historicalSummaries:        b.historicalSummaries,
+		additionalContracts:        b.additionalContracts,
+		additionalContractsIndex:   b.additionalContractsIndex,
+		pendingActivityCredits:     b.pendingActivityCredits,
+		rejectedContractBindings:   b.rejectedContractBindings,
+		contractTransferNonces:     b.contractTransferNonces,
 		validators:                 b.validators,
 		validatorsMultiValue:       b.validatorsMultiValue,
 		previousEpochParticipation: b.previousEpochParticipation,
@@ -808,6 +966,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 		b.stateRootsMultiValue.Copy(b, dst)
 		b.randaoMixesMultiValue.Copy(b, dst)
 		b.balancesMultiValue.Copy(b, dst)
+		b.activitiesMultiValue.Copy(b, dst)
 		if b.version > version.Phase0 {
 			b.inactivityScoresMultiValue.Copy(b, dst)
 		}
@@ -826,6 +985,8 @@ func (b *BeaconState) Copy() state.BeaconState {
 			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateCapellaSharedFieldRefCount)
 		case version.Deneb:
 			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateDenebSharedFieldRefCount)
+		case version.Electra:
+			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, experimentalStateElectraSharedFieldRefCount)
 		}
 	} else {
 		switch b.version {
@@ -839,6 +1000,8 @@ func (b *BeaconState) Copy() state.BeaconState {
 			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, capellaSharedFieldRefCount)
 		case version.Deneb:
 			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, denebSharedFieldRefCount)
+		case version.Electra:
+			dst.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, electraSharedFieldRefCount)
 		}
 	}
 
@@ -850,7 +1013,6 @@ func (b *BeaconState) Copy() state.BeaconState {
 	// Increment ref for validator map
 	// todo unit act
 	b.valMapHandler.AddRef()
-	//b.contractMapHandler.AddRef()
 
 	for i := range b.dirtyFields {
 		dst.dirtyFields[i] = true
@@ -920,7 +1082,10 @@ func (b *BeaconState) initializeMerkleLayers(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	layers := stateutil.Merkleize(fieldRoots)
+	layers, err := merkleizeFieldRoots(fieldRoots)
+	if err != nil {
+		return err
+	}
 	b.merkleLayers = layers
 	switch b.version {
 	case version.Phase0:
@@ -933,11 +1098,40 @@ func (b *BeaconState) initializeMerkleLayers(ctx context.Context) error {
 		b.dirtyFields = make(map[types.FieldIndex]bool, params.BeaconConfig().BeaconStateCapellaFieldCount)
 	case version.Deneb:
 		b.dirtyFields = make(map[types.FieldIndex]bool, params.BeaconConfig().BeaconStateDenebFieldCount)
+	case version.Electra:
+		b.dirtyFields = make(map[types.FieldIndex]bool, params.BeaconConfig().BeaconStateElectraFieldCount)
 	}
 
 	return nil
 }
 
+// merkleizeFieldRoots returns the Merkle layers of the given field roots. stateutil.Merkleize pads the
+// leaves to a length of 32, states with more fields are padded to the next power of two instead.
+func merkleizeFieldRoots(fieldRoots [][]byte) ([][][]byte, error) {
+	if len(fieldRoots) <= 32 {
+		return stateutil.Merkleize(fieldRoots), nil
+	}
+	depth := ssz.Depth(uint64(len(fieldRoots)))
+	leaves := make([][32]byte, 1<<depth)
+	for i, r := range fieldRoots {
+		leaves[i] = bytesutil.ToBytes32(r)
+	}
+	hashLayers := make([][][32]byte, depth+1)
+	hashLayers[0] = leaves
+	hashLayers, _, err := stateutil.MerkleizeTrieLeaves(hashLayers, leaves)
+	if err != nil {
+		return nil, err
+	}
+	layers := make([][][]byte, len(hashLayers))
+	for i, hashLayer := range hashLayers {
+		layers[i] = make([][]byte, len(hashLayer))
+		for j := range hashLayer {
+			layers[i][j] = hashLayer[j][:]
+		}
+	}
+	return layers, nil
+}
+
 // Recomputes the Merkle layers for the dirty fields in the state.
 //
 // WARNING: Caller must acquire the mutex before using.
@@ -1099,6 +1293,14 @@ func (b *BeaconState) rootSelector(ctx context.Context, field types.FieldIndex)
 		return ssz.Uint64Root(uint64(b.nextWithdrawalValidatorIndex)), nil
 	case types.HistoricalSummaries:
 		return stateutil.HistoricalSummariesRoot(b.historicalSummaries)
+	case types.AdditionalContracts:
+		return stateutil.AdditionalContractsRoot(b.additionalContracts)
+	case types.PendingActivityCredits:
+		return stateutil.PendingActivityCreditsRoot(b.pendingActivityCredits)
+	case types.RejectedContractBindings:
+		return stateutil.RejectedContractBindingsRoot(b.rejectedContractBindings)
+	case types.ContractTransferNonces:
+		return stateutil.ContractTransferNoncesRoot(b.contractTransferNonces)
 	}
 	return [32]byte{}, errors.New("invalid field index provided")
 }
@@ -1212,6 +1414,9 @@ func finalizerCleanup(b *BeaconState) {
 		if b.balancesMultiValue != nil {
 			b.balancesMultiValue.Detach(b)
 		}
+		if b.activitiesMultiValue != nil {
+			b.activitiesMultiValue.Detach(b)
+		}
 		if b.inactivityScoresMultiValue != nil {
 			b.inactivityScoresMultiValue.Detach(b)
 		}
@@ -1317,19 +1522,36 @@ func (b *BeaconState) balancesRootSelector(field types.FieldIndex) ([32]byte, er
 
 func (b *BeaconState) activitiesRootSelector(field types.FieldIndex) ([32]byte, error) {
 	if b.rebuildTrie[field] {
-		err := b.resetFieldTrie(field, b.activities, stateutil.ValidatorLimitForBalancesChunks())
-		if err != nil {
-			return [32]byte{}, err
+		if features.Get().EnableExperimentalState {
+			err := b.resetFieldTrie(field, b.activitiesMultiValue.Value(b), stateutil.ValidatorLimitForBalancesChunks())
+			if err != nil {
+				return [32]byte{}, err
+			}
+		} else {
+			err := b.resetFieldTrie(field, b.activities, stateutil.ValidatorLimitForBalancesChunks())
+			if err != nil {
+				return [32]byte{}, err
+			}
 		}
 		delete(b.rebuildTrie, field)
 		return b.stateFieldLeaves[field].TrieRoot()
 	}
 
 	// This "if" is related to FirstFixFork, please see FirstFixFork for details.
+	// The faulty mainnet block at slot 429123 committed to the balances in the activities root, so the
+	// balances are hashed here on purpose, whichever storage holds them.
 	if params.BeaconConfig().FirstFixFork && b.slot == primitives.Slot(429123) {
-		return b.recomputeFieldTrie(field, b.balances)
+		if features.Get().EnableExperimentalState {
+			return b.recomputeFieldTrie(field, b.balancesMultiValue.Value(b))
+		} else {
+			return b.recomputeFieldTrie(field, b.balances)
+		}
+	}
+	if features.Get().EnableExperimentalState {
+		return b.recomputeFieldTrie(field, b.activitiesMultiValue.Value(b))
+	} else {
+		return b.recomputeFieldTrie(field, b.activities)
 	}
-	return b.recomputeFieldTrie(field, b.activities)
 }
 
 func (b *BeaconState) randaoMixesRootSelector(field types.FieldIndex) ([32]byte, error) {
@@ -94,6 +94,14 @@ func (f FieldIndex) String() string {
 		return "NextWithdrawalValidatorIndex"
 	case HistoricalSummaries:
 		return "HistoricalSummaries"
+	case AdditionalContracts:
+		return "AdditionalContracts"
+	case PendingActivityCredits:
+		return "PendingActivityCredits"
+	case RejectedContractBindings:
+		return "RejectedContractBindings"
+	case ContractTransferNonces:
+		return "ContractTransferNonces"
 	default:
 		return ""
 	}
@@ -163,6 +171,14 @@ func (f FieldIndex) RealPosition() int {
 		return 28
 	case HistoricalSummaries:
 		return 29
+	case AdditionalContracts:
+		return 30
+	case PendingActivityCredits:
+		return 31
+	case RejectedContractBindings:
+		return 32
+	case ContractTransferNonces:
+		return 33
 	default:
 		return -1
 	}
@@ -220,6 +236,10 @@ const (
 	NextWithdrawalIndex
 	NextWithdrawalValidatorIndex
 	HistoricalSummaries
+	AdditionalContracts
+	PendingActivityCredits
+	RejectedContractBindings
+	ContractTransferNonces
 )
 
 // Enumerator keeps track of the number of states created since the node's start.
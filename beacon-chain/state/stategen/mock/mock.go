@@ -22,8 +22,8 @@ func NewMockService() *MockStateManager {
 }
 
 // StateByRootIfCachedNoCopy --
-func (_ *MockStateManager) StateByRootIfCachedNoCopy(_ [32]byte) state.BeaconState {
-	panic("implement me")
+func (m *MockStateManager) StateByRootIfCachedNoCopy(blockRoot [32]byte) state.BeaconState {
+	return m.StatesByRoot[blockRoot]
 }
 
 // Resume --
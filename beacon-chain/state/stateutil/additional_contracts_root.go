@@ -0,0 +1,43 @@
+package stateutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// AdditionalContractsRoot computes the hash tree root of the additional validator contracts list.
+func AdditionalContractsRoot(contracts []*ethpb.ValidatorContract) ([32]byte, error) {
+	max := uint64(fieldparams.AdditionalContractsLength)
+	if uint64(len(contracts)) > max {
+		return [32]byte{}, fmt.Errorf("additional contracts exceed max length %d", max)
+	}
+
+	roots := make([][32]byte, len(contracts))
+	for i := 0; i < len(contracts); i++ {
+		r, err := contracts[i].HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not merkleize validator contract")
+		}
+		roots[i] = r
+	}
+
+	contractsRoot, err := ssz.BitwiseMerkleize(roots, uint64(len(roots)), fieldparams.AdditionalContractsLength)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute additional contracts merkleization")
+	}
+	contractsLenBuf := new(bytes.Buffer)
+	if err := binary.Write(contractsLenBuf, binary.LittleEndian, uint64(len(contracts))); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not marshal additional contracts length")
+	}
+	// We need to mix in the length of the slice.
+	contractsLenRoot := make([]byte, 32)
+	copy(contractsLenRoot, contractsLenBuf.Bytes())
+	res := ssz.MixInLength(contractsRoot, contractsLenRoot)
+	return res, nil
+}
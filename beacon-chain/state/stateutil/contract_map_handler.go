@@ -9,48 +9,68 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
-// ContractMapHandler is a container to hold the map and a reference tracker for how many
-// states shared this.
+// contractMapEntry is the binding of a contract address recorded in a layer.
+// A deleted entry hides the bindings of the layers below it.
+type contractMapEntry struct {
+	index   primitives.ValidatorIndex
+	deleted bool
+}
+
+// contractMapLayer is an immutable set of contract bindings on top of its parent layer.
+// Layers are shared by every map handler derived from the handler that froze them.
+// A layer is always less than half the size of its parent, which keeps the number of
+// layers a lookup walks through logarithmic in the number of bindings.
+type contractMapLayer struct {
+	entries map[[fieldparams.ContractAddressLength]byte]contractMapEntry
+	parent  *contractMapLayer
+}
+
+// ContractMapHandler is a container to hold the contract index of a state. The index is a stack of
+// frozen layers shared with the handlers it was copied from, topped by a layer only this handler writes
+// to, so copying the handler does not copy the map and every state copy gets its own handler.
 type ContractMapHandler struct {
-	valIdxMap     map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex
-	mapRef        *Reference
-	*sync.RWMutex // Todo cut external functionality of mutex. Use this variant of `guard *sync.RWMutex`.
+	top    map[[fieldparams.ContractAddressLength]byte]contractMapEntry
+	layers *contractMapLayer
+	*sync.RWMutex
 }
 
-// NewContractMapHandler returns a new contract map handler.
-func NewContractMapHandler(vals []*ethpb.Validator, epoch primitives.Epoch) *ContractMapHandler {
+// NewContractMapHandler returns a new contract map handler. Contracts of validators that exited
+// before the given epoch are not indexed.
+func NewContractMapHandler(vals []*ethpb.Validator, additional []*ethpb.ValidatorContract, epoch primitives.Epoch) *ContractMapHandler {
+	m := coreutils.ContractIndexMap(vals, additional, epoch)
+	top := make(map[[fieldparams.ContractAddressLength]byte]contractMapEntry, len(m))
+	for k, v := range m {
+		top[k] = contractMapEntry{index: v}
+	}
 	return &ContractMapHandler{
-		valIdxMap: coreutils.ContractIndexMap(vals, epoch),
-		mapRef:    &Reference{refs: 1},
-		RWMutex:   new(sync.RWMutex),
+		top:     top,
+		RWMutex: new(sync.RWMutex),
 	}
 }
 
-// AddRef copies the whole map and returns a map handler with the copied map.
-func (c *ContractMapHandler) AddRef() {
-	c.mapRef.AddRef()
-}
-
-// IsNil returns true if the underlying validator index map is nil.
+// IsNil returns true if the underlying contract index map is nil.
 func (c *ContractMapHandler) IsNil() bool {
-	return c.mapRef == nil || c.valIdxMap == nil
+	return c.top == nil
 }
 
-// Copy the whole map and returns a map handler with the copied map.
+// Copy returns a map handler with the same bindings whose writes are not visible to this handler
+// and vice versa. The bindings are not copied: the pending writes of this handler are frozen into
+// a layer shared by both handlers.
 func (c *ContractMapHandler) Copy() *ContractMapHandler {
-	if c == nil || c.valIdxMap == nil {
-		return &ContractMapHandler{valIdxMap: map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex{}, mapRef: new(Reference), RWMutex: new(sync.RWMutex)}
+	if c == nil || c.top == nil {
+		return &ContractMapHandler{top: map[[fieldparams.ContractAddressLength]byte]contractMapEntry{}, RWMutex: new(sync.RWMutex)}
 	}
-	c.RLock()
-	defer c.RUnlock()
-	m := make(map[[fieldparams.ContractAddressLength]byte]primitives.ValidatorIndex, len(c.valIdxMap))
-	for k, v := range c.valIdxMap {
-		m[k] = v
+	c.Lock()
+	defer c.Unlock()
+
+	if len(c.top) > 0 {
+		c.layers = pushContractMapLayer(c.top, c.layers)
+		c.top = make(map[[fieldparams.ContractAddressLength]byte]contractMapEntry)
 	}
 	return &ContractMapHandler{
-		valIdxMap: m,
-		mapRef:    &Reference{refs: 1},
-		RWMutex:   new(sync.RWMutex),
+		top:     make(map[[fieldparams.ContractAddressLength]byte]contractMapEntry),
+		layers:  c.layers,
+		RWMutex: new(sync.RWMutex),
 	}
 }
 
@@ -58,16 +78,58 @@ func (c *ContractMapHandler) Copy() *ContractMapHandler {
 func (c *ContractMapHandler) Get(key [fieldparams.ContractAddressLength]byte) (primitives.ValidatorIndex, bool) {
 	c.RLock()
 	defer c.RUnlock()
-	idx, ok := c.valIdxMap[key]
-	if !ok {
+	e, ok := c.top[key]
+	for l := c.layers; !ok && l != nil; l = l.parent {
+		e, ok = l.entries[key]
+	}
+	if !ok || e.deleted {
 		return 0, false
 	}
-	return idx, true
+	return e.index, true
 }
 
 // Set the validator index using the corresponding contract address.
 func (c *ContractMapHandler) Set(key [fieldparams.ContractAddressLength]byte, index primitives.ValidatorIndex) {
 	c.Lock()
 	defer c.Unlock()
-	c.valIdxMap[key] = index
+	c.top[key] = contractMapEntry{index: index}
+}
+
+// Delete the contract address from the map.
+func (c *ContractMapHandler) Delete(key [fieldparams.ContractAddressLength]byte) {
+	c.Lock()
+	defer c.Unlock()
+	if c.layers == nil {
+		delete(c.top, key)
+		return
+	}
+	c.top[key] = contractMapEntry{deleted: true}
+}
+
+// pushContractMapLayer freezes the entries on top of the given layers. The new layer is merged
+// with its parents for as long as it is not smaller than half of them, so that the cost of merging
+// is amortized over the writes that filled the layer.
+func pushContractMapLayer(
+	entries map[[fieldparams.ContractAddressLength]byte]contractMapEntry,
+	parent *contractMapLayer,
+) *contractMapLayer {
+	for parent != nil && 2*len(entries) >= len(parent.entries) {
+		merged := make(map[[fieldparams.ContractAddressLength]byte]contractMapEntry, len(parent.entries)+len(entries))
+		for k, e := range parent.entries {
+			merged[k] = e
+		}
+		for k, e := range entries {
+			merged[k] = e
+		}
+		entries, parent = merged, parent.parent
+	}
+	if parent == nil {
+		// Nothing is left to hide at the bottom layer.
+		for k, e := range entries {
+			if e.deleted {
+				delete(entries, k)
+			}
+		}
+	}
+	return &contractMapLayer{entries: entries, parent: parent}
 }
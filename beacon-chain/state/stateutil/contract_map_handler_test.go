@@ -34,7 +34,7 @@ func TestContractMapHandler(t *testing.T) {
 		},
 	}
 	ep := primitives.Epoch(10)
-	contractMapHandler := stateutil.NewContractMapHandler(vals, ep)
+	contractMapHandler := stateutil.NewContractMapHandler(vals, nil, ep)
 
 	vIdx, exists := contractMapHandler.Get([field_params.ContractAddressLength]byte{'b', 'c'})
 	require.Equal(t, primitives.ValidatorIndex(2), vIdx)
@@ -54,4 +54,73 @@ func TestContractMapHandler(t *testing.T) {
 	cVIdx, exists := copyOf.Get(contractAddress)
 	require.Equal(t, true, exists)
 	require.Equal(t, vIdx, cVIdx)
+
+	contractMapHandler.Delete(contractAddress)
+	_, exists = contractMapHandler.Get(contractAddress)
+	require.Equal(t, false, exists)
+	// The copy is not affected.
+	_, exists = copyOf.Get(contractAddress)
+	require.Equal(t, true, exists)
+}
+
+func TestContractMapHandler_CopyIsLayered(t *testing.T) {
+	vals := make([]*ethpb.Validator, 64)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{Contract: []byte{byte(i + 1)}, ExitEpoch: 1000}
+	}
+	base := stateutil.NewContractMapHandler(vals, nil, 0)
+
+	// Every copy freezes the writes of its source and writes on top of the shared layers.
+	handlers := []*stateutil.ContractMapHandler{base}
+	for i := 0; i < 3*16; i++ {
+		prev := handlers[len(handlers)-1]
+		next := prev.Copy()
+		next.Set([field_params.ContractAddressLength]byte{'n', byte(i)}, primitives.ValidatorIndex(100+i))
+		next.Delete([field_params.ContractAddressLength]byte{byte(i + 1)})
+		handlers = append(handlers, next)
+	}
+
+	for i, h := range handlers {
+		for j := 0; j < 3*16; j++ {
+			idx, ok := h.Get([field_params.ContractAddressLength]byte{'n', byte(j)})
+			require.Equal(t, j < i, ok)
+			if ok {
+				require.Equal(t, primitives.ValidatorIndex(100+j), idx)
+			}
+			idx, ok = h.Get([field_params.ContractAddressLength]byte{byte(j + 1)})
+			require.Equal(t, j >= i, ok)
+			if ok {
+				require.Equal(t, primitives.ValidatorIndex(j), idx)
+			}
+		}
+	}
+
+	// Writing to the source after a copy does not leak into the copy, and vice versa.
+	cp := base.Copy()
+	contract := [field_params.ContractAddressLength]byte{'x'}
+	base.Set(contract, 1)
+	_, ok := cp.Get(contract)
+	require.Equal(t, false, ok)
+	cp.Set(contract, 2)
+	idx, ok := base.Get(contract)
+	require.Equal(t, true, ok)
+	require.Equal(t, primitives.ValidatorIndex(1), idx)
+}
+
+func BenchmarkContractMapHandler_CopyAndSet(b *testing.B) {
+	vals := make([]*ethpb.Validator, 1<<20)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{
+			Contract:  []byte{byte(i), byte(i >> 8), byte(i >> 16), 1},
+			ExitEpoch: 1000,
+		}
+	}
+	h := stateutil.NewContractMapHandler(vals, nil, 0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h = h.Copy()
+		h.Set([field_params.ContractAddressLength]byte{byte(i), byte(i >> 8), 2}, primitives.ValidatorIndex(i))
+	}
 }
@@ -0,0 +1,43 @@
+package stateutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// ContractTransferNoncesRoot computes the hash tree root of the contract transfer nonces list.
+func ContractTransferNoncesRoot(nonces []*ethpb.ContractTransferNonce) ([32]byte, error) {
+	max := uint64(fieldparams.ValidatorRegistryLimit)
+	if uint64(len(nonces)) > max {
+		return [32]byte{}, fmt.Errorf("contract transfer nonces exceed max length %d", max)
+	}
+
+	roots := make([][32]byte, len(nonces))
+	for i := 0; i < len(nonces); i++ {
+		r, err := nonces[i].HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not merkleize contract transfer nonce")
+		}
+		roots[i] = r
+	}
+
+	noncesRoot, err := ssz.BitwiseMerkleize(roots, uint64(len(roots)), fieldparams.ValidatorRegistryLimit)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute contract transfer nonces merkleization")
+	}
+	noncesLenBuf := new(bytes.Buffer)
+	if err := binary.Write(noncesLenBuf, binary.LittleEndian, uint64(len(nonces))); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not marshal contract transfer nonces length")
+	}
+	// We need to mix in the length of the slice.
+	noncesLenRoot := make([]byte, 32)
+	copy(noncesLenRoot, noncesLenBuf.Bytes())
+	res := ssz.MixInLength(noncesRoot, noncesLenRoot)
+	return res, nil
+}
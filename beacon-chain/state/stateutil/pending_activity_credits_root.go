@@ -0,0 +1,43 @@
+package stateutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// PendingActivityCreditsRoot computes the hash tree root of the pending activity credits list.
+func PendingActivityCreditsRoot(credits []*ethpb.PendingActivityCredit) ([32]byte, error) {
+	max := uint64(fieldparams.PendingActivityCreditsLength)
+	if uint64(len(credits)) > max {
+		return [32]byte{}, fmt.Errorf("pending activity credits exceed max length %d", max)
+	}
+
+	roots := make([][32]byte, len(credits))
+	for i := 0; i < len(credits); i++ {
+		r, err := credits[i].HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not merkleize pending activity credit")
+		}
+		roots[i] = r
+	}
+
+	creditsRoot, err := ssz.BitwiseMerkleize(roots, uint64(len(roots)), fieldparams.PendingActivityCreditsLength)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute pending activity credits merkleization")
+	}
+	creditsLenBuf := new(bytes.Buffer)
+	if err := binary.Write(creditsLenBuf, binary.LittleEndian, uint64(len(credits))); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not marshal pending activity credits length")
+	}
+	// We need to mix in the length of the slice.
+	creditsLenRoot := make([]byte, 32)
+	copy(creditsLenRoot, creditsLenBuf.Bytes())
+	res := ssz.MixInLength(creditsRoot, creditsLenRoot)
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package stateutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// RejectedContractBindingsRoot computes the hash tree root of the rejected contract bindings list.
+func RejectedContractBindingsRoot(bindings []*ethpb.RejectedContractBinding) ([32]byte, error) {
+	max := uint64(fieldparams.RejectedContractBindingsLength)
+	if uint64(len(bindings)) > max {
+		return [32]byte{}, fmt.Errorf("rejected contract bindings exceed max length %d", max)
+	}
+
+	roots := make([][32]byte, len(bindings))
+	for i := 0; i < len(bindings); i++ {
+		r, err := bindings[i].HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not merkleize rejected contract binding")
+		}
+		roots[i] = r
+	}
+
+	bindingsRoot, err := ssz.BitwiseMerkleize(roots, uint64(len(roots)), fieldparams.RejectedContractBindingsLength)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute rejected contract bindings merkleization")
+	}
+	bindingsLenBuf := new(bytes.Buffer)
+	if err := binary.Write(bindingsLenBuf, binary.LittleEndian, uint64(len(bindings))); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not marshal rejected contract bindings length")
+	}
+	// We need to mix in the length of the slice.
+	bindingsLenRoot := make([]byte, 32)
+	copy(bindingsLenRoot, bindingsLenBuf.Bytes())
+	res := ssz.MixInLength(bindingsRoot, bindingsLenRoot)
+	return res, nil
+}
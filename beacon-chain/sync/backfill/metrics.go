@@ -0,0 +1,47 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_low_slot",
+			Help: "Slot of the lowest block of the backfilled chain history",
+		},
+	)
+	backfillRemainingSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_remaining_slots",
+			Help: "Number of slots between genesis and the lowest backfilled block",
+		},
+	)
+	backfillBlocksImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_imported_total",
+			Help: "Number of blocks written to the database by the backfill service",
+		},
+	)
+	backfillBatchesImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_batches_imported_total",
+			Help: "Number of batches verified and written to the database by the backfill service",
+		},
+	)
+	backfillBatchesFailed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "backfill_batches_failed_total",
+			Help: "Number of backfill batches which could not be downloaded or verified",
+		},
+		[]string{"reason"},
+	)
+	backfillBatchVerifyDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_verify_milliseconds",
+			Help:    "Time taken to verify the parent chain and proposer signatures of a backfill batch",
+			Buckets: []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500},
+		},
+	)
+)
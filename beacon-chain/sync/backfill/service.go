@@ -0,0 +1,376 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBatchSize is the number of slots requested from a single peer in one batch.
+	DefaultBatchSize = 64
+	// DefaultWorkerCount is the number of batches downloaded concurrently, each from a different peer.
+	DefaultWorkerCount = 2
+	// maxBackfillPeers is the number of peers the batches of a round are spread over.
+	maxBackfillPeers = 32
+)
+
+// blockLimiterPeriod is the period of the per peer rate limit of backfill requests.
+var blockLimiterPeriod = 30 * time.Second
+
+var (
+	errNoPeers     = errors.New("no peers to backfill from")
+	errNotRunning  = errors.New("not running")
+	errMissingRoot = errors.New("backfill batches down to genesis did not reach the genesis block")
+)
+
+// BlocksByRangeRequester sends a BeaconBlocksByRange request to the given peer and returns the blocks of
+// the response, checked to be within the requested range and in ascending slot order.
+type BlocksByRangeRequester func(ctx context.Context, clock *startup.Clock, p p2p.SenderEncoder, pid peer.ID,
+	req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error)
+
+// SyncChecker reports whether the node is synced to the head of the chain.
+type SyncChecker interface {
+	Synced() bool
+}
+
+// Store describes the set of DB methods that the backfill Service needs to function.
+type Store interface {
+	BackfillDB
+	SaveBlocks(ctx context.Context, blocks []interfaces.ReadOnlySignedBeaconBlock) error
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// Config defines the dependencies of the backfill service.
+type Config struct {
+	DB            Store
+	P2P           p2p.P2P
+	Status        *Status
+	SyncChecker   SyncChecker
+	ClockWaiter   startup.ClockWaiter
+	BlocksByRange BlocksByRangeRequester
+	// BatchSize is the number of slots requested in a single batch, DefaultBatchSize is used when zero.
+	BatchSize uint64
+	// WorkerCount is the number of batches downloaded concurrently, DefaultWorkerCount is used when zero.
+	WorkerCount int
+}
+
+// Service downloads the history below the origin block of a node initialized via checkpoint sync. Batches of
+// blocks are requested backwards from the origin block from several peers at once, verified to form the chain
+// leading to the origin block and to carry valid proposer signatures, and written to the database. The service
+// only runs while the node is synced to the head of the chain and keeps below the peers' rate limits, so that
+// syncing the head of the chain is unaffected.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	clock       *startup.Clock
+	verifier    *verifier
+	rateLimiter *leakybucket.Collector
+	// cursor is the upper end of the next batch. It is below the lowest backfilled block when the batches
+	// below that block did not hold any blocks.
+	cursor primitives.Slot
+	round  int
+
+	// Locks access to isRunning and err.
+	sync.RWMutex
+	isRunning bool
+	err       error
+}
+
+// NewService sets up a new backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.WorkerCount <= 0 {
+		cfg.WorkerCount = DefaultWorkerCount
+	}
+	// Backfill requests count towards the same per peer limits as the requests of initial and regular sync,
+	// so backfill allows itself half of the rate the initial sync fetcher uses.
+	capacity := int64(cfg.BatchSize)
+	if limit := int64(flags.Get().BlockBatchLimit); limit > capacity {
+		capacity = limit
+	}
+	rate := float64(flags.Get().BlockBatchLimit) / 2
+	return &Service{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		rateLimiter: leakybucket.NewCollector(rate, capacity, blockLimiterPeriod, false /* deleteEmptyBuckets */),
+	}
+}
+
+// Start backfills the chain history in the background, if the node was initialized via checkpoint sync.
+func (s *Service) Start() {
+	s.updateMetrics()
+	if s.cfg.Status.Complete() {
+		log.Info("Chain history is complete, backfill is not needed")
+		return
+	}
+	s.Lock()
+	s.isRunning = true
+	s.Unlock()
+	go s.run()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	s.Lock()
+	s.isRunning = false
+	s.Unlock()
+	if s.rateLimiter != nil {
+		s.rateLimiter.Free()
+	}
+	return nil
+}
+
+// Status of the service. The service reports no error once the chain history is complete.
+func (s *Service) Status() error {
+	if s.cfg.Status.Complete() {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return s.err
+	}
+	if !s.isRunning {
+		return errNotRunning
+	}
+	return nil
+}
+
+func (s *Service) run() {
+	if err := s.initialize(); err != nil {
+		if s.ctx.Err() == nil {
+			log.WithError(err).Error("Could not start backfill")
+		}
+		s.Lock()
+		s.isRunning = false
+		s.err = err
+		s.Unlock()
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"lowSlot":    s.cfg.Status.EndGap(),
+		"originSlot": s.cfg.Status.Origin(),
+	}).Info("Backfilling chain history below the checkpoint sync origin")
+
+	pause := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	for !s.cfg.Status.Complete() {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if !s.cfg.SyncChecker.Synced() {
+			s.wait(pause)
+			continue
+		}
+		if err := s.importRound(); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, errNoPeers) {
+				log.Debug("Waiting for peers to backfill from")
+			} else {
+				log.WithError(err).Debug("Could not backfill batch")
+			}
+			s.wait(pause)
+		}
+	}
+	log.WithField("lowSlot", s.cfg.Status.EndGap()).Info("Backfill reached genesis, chain history is complete")
+	s.Lock()
+	s.isRunning = false
+	s.Unlock()
+}
+
+// initialize waits for the genesis data and sets up the verifier with the validator registry of the origin state.
+func (s *Service) initialize() error {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not receive genesis data")
+	}
+	s.clock = clock
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin checkpoint root")
+	}
+	st, err := s.cfg.DB.State(s.ctx, originRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve origin state for root=%#x", originRoot)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("origin state for root=%#x not found", originRoot)
+	}
+	s.verifier = newVerifier(st, clock.GenesisValidatorsRoot())
+	s.cursor = s.cfg.Status.EndGap()
+	return nil
+}
+
+// batch is a range of slots [begin, end) requested from a single peer.
+type batch struct {
+	begin  primitives.Slot
+	end    primitives.Slot
+	pid    peer.ID
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+	err    error
+}
+
+func (b *batch) request() *ethpb.BeaconBlocksByRangeRequest {
+	return &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: b.begin,
+		Count:     uint64(b.end - b.begin),
+		Step:      1,
+	}
+}
+
+// nextBatches returns up to WorkerCount consecutive batches below the cursor, highest first. The genesis
+// block is never requested, it is in the database of every node.
+func (s *Service) nextBatches() []*batch {
+	batches := make([]*batch, 0, s.cfg.WorkerCount)
+	end := s.cursor
+	for len(batches) < s.cfg.WorkerCount && end > 1 {
+		begin := primitives.Slot(1)
+		if end > primitives.Slot(s.cfg.BatchSize)+1 {
+			begin = end - primitives.Slot(s.cfg.BatchSize)
+		}
+		batches = append(batches, &batch{begin: begin, end: end})
+		end = begin
+	}
+	return batches
+}
+
+// importRound downloads the next batches concurrently, each from a different peer, then verifies and saves
+// them from the highest down. Batches below one which fails are discarded and requested again in the next round.
+func (s *Service) importRound() error {
+	batches := s.nextBatches()
+	if len(batches) == 0 {
+		// Every batch down to genesis was empty or imported without reaching the genesis block, so
+		// a peer claimed a batch to be empty when it was not. Request those batches again.
+		s.cursor = s.cfg.Status.EndGap()
+		return errMissingRoot
+	}
+	_, pids := s.cfg.P2P.Peers().BestFinalized(maxBackfillPeers, slots.ToEpoch(s.cfg.Status.Origin()))
+	if len(pids) == 0 {
+		return errNoPeers
+	}
+	for i, b := range batches {
+		b.pid = pids[(s.round+i)%len(pids)]
+	}
+	s.round++
+
+	var wg sync.WaitGroup
+	for _, b := range batches {
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			b.blocks, b.err = s.fetch(b)
+		}(b)
+	}
+	wg.Wait()
+
+	expected := s.cfg.Status.NextParentRoot()
+	for _, b := range batches {
+		if b.err != nil {
+			backfillBatchesFailed.WithLabelValues("download").Inc()
+			return errors.Wrapf(b.err, "could not download batch [%d, %d) from peer %s", b.begin, b.end, b.pid)
+		}
+		start := time.Now()
+		next, err := s.verifier.verify(b.blocks, expected)
+		backfillBatchVerifyDuration.Observe(float64(time.Since(start).Milliseconds()))
+		if err != nil {
+			backfillBatchesFailed.WithLabelValues("verify").Inc()
+			if errors.Is(err, errChainBroken) && s.cursor < s.cfg.Status.EndGap() {
+				// The batch might be valid, with a peer of an earlier round wrongly claiming that a batch
+				// above it held no blocks. Request the batches above again rather than penalizing this peer.
+				s.cursor = s.cfg.Status.EndGap()
+			} else {
+				s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(b.pid, fmt.Sprintf("backfill importRound() invalid batch: %v", err))
+			}
+			return errors.Wrapf(err, "could not verify batch [%d, %d) from peer %s", b.begin, b.end, b.pid)
+		}
+		if err := s.save(b); err != nil {
+			return err
+		}
+		expected = next
+		s.cursor = b.begin
+	}
+	s.updateMetrics()
+	log.WithFields(logrus.Fields{
+		"lowSlot":        s.cfg.Status.EndGap(),
+		"remainingSlots": s.cfg.Status.EndGap() - s.cfg.Status.StartGap(),
+	}).Debug("Backfilled blocks")
+	return nil
+}
+
+// fetch requests the blocks of a batch, waiting for the peer's rate limit to allow it first.
+func (s *Service) fetch(b *batch) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	count := int64(b.end - b.begin)
+	key := b.pid.String()
+	for s.rateLimiter.Remaining(key) < count {
+		d := s.rateLimiter.TillEmpty(key)
+		if d < time.Second {
+			d = time.Second
+		}
+		if !s.wait(d) {
+			return nil, s.ctx.Err()
+		}
+	}
+	s.rateLimiter.Add(key, count)
+	return s.cfg.BlocksByRange(s.ctx, s.clock, s.cfg.P2P, b.pid, b.request())
+}
+
+// save writes the verified blocks of a batch to the database and moves the backfill status down to the
+// lowest of them.
+func (s *Service) save(b *batch) error {
+	if len(b.blocks) == 0 {
+		return nil
+	}
+	if err := s.cfg.DB.SaveBlocks(s.ctx, b.blocks); err != nil {
+		return errors.Wrapf(err, "could not save batch [%d, %d)", b.begin, b.end)
+	}
+	if err := s.cfg.Status.Advance(s.ctx, b.blocks[0]); err != nil {
+		return errors.Wrapf(err, "could not advance backfill status for batch [%d, %d)", b.begin, b.end)
+	}
+	backfillBlocksImported.Add(float64(len(b.blocks)))
+	backfillBatchesImported.Inc()
+	return nil
+}
+
+func (s *Service) updateMetrics() {
+	backfillLowSlot.Set(float64(s.cfg.Status.EndGap()))
+	if s.cfg.Status.Complete() {
+		backfillRemainingSlots.Set(0)
+		return
+	}
+	backfillRemainingSlots.Set(float64(s.cfg.Status.EndGap() - s.cfg.Status.StartGap()))
+}
+
+// wait blocks for the given duration, returning false if the service is stopped in the meantime.
+func (s *Service) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
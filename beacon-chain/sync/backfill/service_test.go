@@ -0,0 +1,176 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+type mockSyncChecker struct {
+	synced bool
+}
+
+func (m *mockSyncChecker) Synced() bool {
+	return m.synced
+}
+
+// setupBackfill saves the genesis block and the highest block of the chain as the checkpoint sync origin, and
+// returns a service backfilling the rest of the chain from a single peer serving the given blocks.
+func setupBackfill(t *testing.T, c *testChain, served []interfaces.ReadOnlySignedBeaconBlock) (*Service, *p2ptest.TestP2P, peer.ID) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 2,
+	})
+	t.Cleanup(func() {
+		flags.Init(resetFlags)
+	})
+
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	util.SaveBlock(t, ctx, beaconDB, c.genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, c.genesisRoot))
+	origin := c.blocks[len(c.blocks)-1]
+	originRoot := c.root(t, len(c.blocks)-1)
+	require.NoError(t, beaconDB.SaveBlock(ctx, origin))
+	require.NoError(t, beaconDB.SaveState(ctx, c.st, originRoot))
+	require.NoError(t, beaconDB.(*kv.Store).SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, originRoot))
+	status := NewStatus(beaconDB)
+	require.NoError(t, status.Reload(ctx))
+
+	p := p2ptest.NewTestP2P(t)
+	pid := peer.ID("backfill-peer")
+	p.Peers().Add(new(enr.Record), pid, nil, network.DirOutbound)
+	p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	p.Peers().SetChainState(pid, &ethpb.Status{
+		FinalizedRoot:  bytesutil.PadTo([]byte("finalized_root"), 32),
+		FinalizedEpoch: 10,
+		HeadRoot:       bytesutil.PadTo([]byte("head_root"), 32),
+		HeadSlot:       400,
+	}, "reason")
+
+	byRange := func(_ context.Context, _ *startup.Clock, _ p2p.SenderEncoder, _ peer.ID,
+		req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+		var res []interfaces.ReadOnlySignedBeaconBlock
+		for _, b := range served {
+			if b.Block().Slot() >= req.StartSlot && b.Block().Slot() < req.StartSlot+primitives.Slot(req.Count) {
+				res = append(res, b)
+			}
+		}
+		return res, nil
+	}
+	cs := startup.NewClockSynchronizer()
+	require.NoError(t, cs.SetClock(startup.NewClock(time.Now(), bytesutil.ToBytes32(c.st.GenesisValidatorsRoot()))))
+	s := NewService(ctx, &Config{
+		DB:            beaconDB,
+		P2P:           p,
+		Status:        status,
+		SyncChecker:   &mockSyncChecker{synced: true},
+		ClockWaiter:   cs,
+		BlocksByRange: byRange,
+		BatchSize:     8,
+	})
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	require.NoError(t, s.initialize())
+	return s, p, pid
+}
+
+func TestService_ImportRound(t *testing.T) {
+	var at []primitives.Slot
+	for i := primitives.Slot(1); i <= 40; i++ {
+		// leave some empty slots and a batch without any block
+		if i%7 != 0 && (i < 17 || i > 24) {
+			at = append(at, i)
+		}
+	}
+	c := newTestChain(t, at)
+	s, _, _ := setupBackfill(t, c, c.blocks[:len(c.blocks)-1])
+	require.Equal(t, primitives.Slot(40), s.cfg.Status.EndGap())
+	require.Equal(t, false, s.cfg.Status.SlotCovered(20))
+
+	for i := 0; !s.cfg.Status.Complete(); i++ {
+		require.Equal(t, true, i < 5, "backfill did not complete")
+		require.NoError(t, s.importRound())
+	}
+	for i := range c.blocks {
+		require.Equal(t, true, s.cfg.DB.(interface {
+			HasBlock(ctx context.Context, blockRoot [32]byte) bool
+		}).HasBlock(context.Background(), c.root(t, i)))
+	}
+	require.Equal(t, primitives.Slot(1), s.cfg.Status.EndGap())
+	require.Equal(t, true, s.cfg.Status.SlotCovered(20))
+	bfRoot, err := s.cfg.DB.BackfillBlockRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, c.root(t, 0), bfRoot)
+}
+
+func TestService_ImportRound_InvalidBatch(t *testing.T) {
+	var at []primitives.Slot
+	for i := primitives.Slot(1); i <= 20; i++ {
+		at = append(at, i)
+	}
+	c := newTestChain(t, at)
+	served := make([]interfaces.ReadOnlySignedBeaconBlock, len(c.blocks)-1)
+	copy(served, c.blocks)
+	served[15] = c.withSignatureOf(t, 15, 14)
+	s, p, pid := setupBackfill(t, c, served)
+
+	require.ErrorIs(t, s.importRound(), errInvalidSignature)
+	require.Equal(t, primitives.Slot(20), s.cfg.Status.EndGap())
+	require.Equal(t, primitives.Slot(20), s.cursor)
+	count, err := p.Peers().Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestService_ImportRound_WithheldBatch(t *testing.T) {
+	var at []primitives.Slot
+	for i := primitives.Slot(1); i <= 20; i++ {
+		at = append(at, i)
+	}
+	c := newTestChain(t, at)
+	// The peer claims the batch right below the origin holds no blocks.
+	s, p, pid := setupBackfill(t, c, c.blocks[:11])
+
+	require.ErrorIs(t, s.importRound(), errChainBroken)
+	require.Equal(t, primitives.Slot(20), s.cfg.Status.EndGap())
+	require.Equal(t, primitives.Slot(20), s.cursor)
+	count, err := p.Peers().Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestService_Status(t *testing.T) {
+	c := newTestChain(t, []primitives.Slot{1, 2})
+	s, _, _ := setupBackfill(t, c, c.blocks[:1])
+	s.Lock()
+	s.isRunning = true
+	s.Unlock()
+	require.NoError(t, s.Status())
+	require.NoError(t, s.importRound())
+	require.Equal(t, true, s.cfg.Status.Complete())
+	s.Lock()
+	s.isRunning = false
+	s.Unlock()
+	require.NoError(t, s.Status())
+}
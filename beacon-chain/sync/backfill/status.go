@@ -2,6 +2,7 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
@@ -19,25 +20,33 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. History is backfilled downwards from the origin block, so the gap always
+// spans from genesis to the lowest block of the chain of blocks leading to the origin. Status provides the means to
+// move the lower end of the chain down via the Advance() method, to check whether a Slot is missing from the
+// database via the SlotCovered() method, and to see the current StartGap() and EndGap().
 type Status struct {
+	sync.RWMutex
 	start       primitives.Slot
 	end         primitives.Slot
+	endParent   [32]byte
+	origin      primitives.Slot
+	genesisRoot [32]byte
 	store       BackfillDB
 	genesisSync bool
+	complete    bool
 }
 
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
 // If the slot is between StartGap() and EndGap(), the result is false.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
-	// short circuit if the node was synced from genesis
-	if s.genesisSync {
+	s.RLock()
+	defer s.RUnlock()
+	// short circuit if the node was synced from genesis or the backfill reached genesis
+	if s.genesisSync || s.complete {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,29 +54,79 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// EndGap returns the slot at the end of the range that needs to be backfilled, which is the slot
+// of the lowest block backfilled so far.
 func (s *Status) EndGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.end
 }
 
-var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
+// Origin returns the slot of the checkpoint sync origin block.
+func (s *Status) Origin() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
+	return s.origin
+}
+
+// NextParentRoot returns the root of the block which is expected to be backfilled next,
+// which is the parent root of the lowest block backfilled so far.
+func (s *Status) NextParentRoot() [32]byte {
+	s.RLock()
+	defer s.RUnlock()
+	return s.endParent
+}
+
+// Complete returns true when there is no gap in the chain history, either because the node synced
+// from genesis or because the backfill reached the genesis block.
+func (s *Status) Complete() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.genesisSync || s.complete
+}
+
+var ErrAdvanceNotBelowEnd = errors.New("cannot advance backfill Status to a block which is not below the end of the gap")
 
-// Advance advances the backfill position to the given slot & root.
+// Advance moves the lower end of the backfilled chain down to the given block. The caller is responsible for
+// checking that the block is an ancestor of the lowest block backfilled so far, with every block in between saved.
 // It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
-func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
-	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+func (s *Status) Advance(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return err
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute backfill block root")
+	}
+	s.Lock()
+	defer s.Unlock()
+	slot := blk.Block().Slot()
+	if slot >= s.end {
+		return errors.Wrapf(ErrAdvanceNotBelowEnd, "advance slot=%d, end slot=%d", slot, s.end)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	s.setEnd(blk.Block())
+	return nil
+}
+
+func (s *Status) setEnd(b interfaces.ReadOnlyBeaconBlock) {
+	s.end = b.Slot()
+	s.endParent = b.ParentRoot()
+	s.complete = s.endParent == s.genesisRoot
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,9 +143,9 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
+	s.origin = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	s.genesisRoot, err = s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
@@ -101,6 +160,12 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	// Databases initialized before history was backfilled downwards from the origin block
+	// point the backfill block root at genesis, meaning nothing has been backfilled yet.
+	if bfRoot == s.genesisRoot {
+		s.setEnd(cpBlock.Block())
+		return nil
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,7 +173,7 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.setEnd(bfBlock.Block())
 	return nil
 }
 
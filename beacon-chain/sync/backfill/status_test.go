@@ -121,19 +121,31 @@ func TestAdvance(t *testing.T) {
 			return nil
 		},
 	}
-	s := &Status{end: 100, store: mdb}
-	var root [32]byte
-	copy(root[:], []byte{0x23, 0x23})
-	require.NoError(t, s.Advance(ctx, 90, root))
+	genesisRoot := [32]byte{0x01}
+	s := &Status{end: 100, store: mdb, genesisRoot: genesisRoot}
+	blk, err := setupTestBlockWithParent(90, [32]byte{0x23, 0x23})
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.Advance(ctx, blk))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	require.Equal(t, primitives.Slot(90), s.EndGap())
+	require.Equal(t, [32]byte{0x23, 0x23}, s.NextParentRoot())
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(50))
+	require.Equal(t, false, s.Complete())
 
 	// this should still be len 1 after failing to advance
+	require.ErrorIs(t, s.Advance(ctx, blk), ErrAdvanceNotBelowEnd)
 	require.Equal(t, 1, len(saveBackfillBuf))
-	require.ErrorIs(t, s.Advance(ctx, s.end+1, root), ErrAdvancePastOrigin)
-	// this has an element in it from the previous test, there shouldn't be an additional one
-	require.Equal(t, 1, len(saveBackfillBuf))
+
+	// advancing to a child of the genesis block completes the backfill
+	blk, err = setupTestBlockWithParent(10, genesisRoot)
+	require.NoError(t, err)
+	require.NoError(t, s.Advance(ctx, blk))
+	require.Equal(t, 2, len(saveBackfillBuf))
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, true, s.SlotCovered(5))
 }
 
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
@@ -151,6 +163,13 @@ func setupTestBlock(slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock,
 	return blocktest.SetBlockSlot(b, slot)
 }
 
+func setupTestBlockWithParent(slot primitives.Slot, parent [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	bRaw := util.NewBeaconBlock()
+	bRaw.Block.Slot = slot
+	bRaw.Block.ParentRoot = parent[:]
+	return blocks.NewSignedBeaconBlock(bRaw)
+}
+
 func TestReload(t *testing.T) {
 	ctx := context.Background()
 	derp := errors.New("derp")
@@ -163,7 +182,7 @@ func TestReload(t *testing.T) {
 
 	backfillSlot := primitives.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
+	copy(backfillRoot[:], []byte{0x02})
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
@@ -334,7 +353,23 @@ func TestReload(t *testing.T) {
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, end: backfillSlot, origin: originSlot},
+		},
+		{
+			name: "backfill root at genesis, nothing backfilled yet",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(params.BeaconConfig().ZeroHash),
+			},
+			expected: &Status{genesisSync: false, end: originSlot, origin: originSlot},
 		},
 	}
 
@@ -354,5 +389,6 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.origin, s.origin)
 	}
 }
@@ -0,0 +1,96 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+var (
+	errUnknownProposer  = errors.New("block proposer is not in the origin validator registry")
+	errInvalidSignature = errors.New("invalid proposer signature in backfill batch")
+	errChainBroken      = errors.New("backfill batch does not chain to the lowest backfilled block")
+	errNotAscending     = errors.New("backfill batch blocks are not in ascending slot order")
+)
+
+// verifier checks that the blocks of a batch form the chain leading to the lowest backfilled block and that
+// they were signed by their proposers. Validator indices are never reused, so the proposer of every block
+// below the origin is found in the validator registry of the origin state.
+type verifier struct {
+	keys [][fieldparams.BLSPubkeyLength]byte
+	gvr  [32]byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState, gvr [32]byte) *verifier {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, st.NumValidators())
+	for i := range keys {
+		keys[i] = st.PubkeyAtIndex(primitives.ValidatorIndex(i))
+	}
+	return &verifier{keys: keys, gvr: gvr}
+}
+
+// verify checks the blocks of a batch, which must be in ascending slot order. The highest block must have the
+// given root and every block must be the parent of the one above it. The root the next lower batch has to end
+// with is returned. A batch without blocks is valid and leaves the expected root unchanged.
+func (v *verifier) verify(blks []interfaces.ReadOnlySignedBeaconBlock, expected [32]byte) ([32]byte, error) {
+	set := bls.NewSet()
+	for i := len(blks) - 1; i >= 0; i-- {
+		b := blks[i]
+		if err := blocks.BeaconBlockIsNil(b); err != nil {
+			return expected, err
+		}
+		if i > 0 && blks[i-1].Block().Slot() >= b.Block().Slot() {
+			return expected, errors.Wrapf(errNotAscending, "slot %d follows slot %d", b.Block().Slot(), blks[i-1].Block().Slot())
+		}
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return expected, errors.Wrapf(err, "could not compute root of block at slot %d", b.Block().Slot())
+		}
+		if root != expected {
+			return expected, errors.Wrapf(errChainBroken, "block root %#x at slot %d, expected %#x", root, b.Block().Slot(), expected)
+		}
+		sigSet, err := v.signatureBatch(b)
+		if err != nil {
+			return expected, err
+		}
+		set.Join(sigSet)
+		expected = b.Block().ParentRoot()
+	}
+	if len(set.Signatures) == 0 {
+		return expected, nil
+	}
+	ok, err := set.Verify()
+	if err != nil {
+		return expected, errors.Wrap(err, "could not verify backfill batch signatures")
+	}
+	if !ok {
+		return expected, errInvalidSignature
+	}
+	return expected, nil
+}
+
+func (v *verifier) signatureBatch(b interfaces.ReadOnlySignedBeaconBlock) (*bls.SignatureBatch, error) {
+	idx := b.Block().ProposerIndex()
+	if uint64(idx) >= uint64(len(v.keys)) {
+		return nil, errors.Wrapf(errUnknownProposer, "proposer index %d, registry size %d", idx, len(v.keys))
+	}
+	epoch := slots.ToEpoch(b.Block().Slot())
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, v.gvr[:])
+	if err != nil {
+		return nil, err
+	}
+	sig := b.Signature()
+	return signing.BlockSignatureBatch(v.keys[idx][:], sig[:], domain, b.Block().HashTreeRoot)
+}
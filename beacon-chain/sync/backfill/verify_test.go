@@ -0,0 +1,127 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+const testValidatorCount = 64
+
+type testChain struct {
+	st          state.BeaconState
+	genesis     *ethpb.SignedBeaconBlock
+	genesisRoot [32]byte
+	// blocks at the given slots in ascending order, the first one is a child of the genesis block.
+	blocks []interfaces.ReadOnlySignedBeaconBlock
+}
+
+func newTestChain(t *testing.T, at []primitives.Slot) *testChain {
+	st, keys := util.DeterministicGenesisState(t, testValidatorCount)
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	c := &testChain{st: st, genesis: genesis, genesisRoot: genesisRoot}
+	parent := genesisRoot
+	for _, sl := range at {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = sl
+		b.Block.ProposerIndex = primitives.ValidatorIndex(uint64(sl) % testValidatorCount)
+		b.Block.ParentRoot = parent[:]
+		// The chain crosses the altair fork, the signatures of later blocks use the altair fork version.
+		fork := &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+			Epoch:           params.BeaconConfig().AltairForkEpoch,
+		}
+		domain, err := signing.Domain(fork, slots.ToEpoch(sl), params.BeaconConfig().DomainBeaconProposer, st.GenesisValidatorsRoot())
+		require.NoError(t, err)
+		sr, err := signing.ComputeSigningRoot(b.Block, domain)
+		require.NoError(t, err)
+		b.Signature = keys[b.Block.ProposerIndex].Sign(sr[:]).Marshal()
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		parent, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		c.blocks = append(c.blocks, wsb)
+	}
+	return c
+}
+
+func (c *testChain) verifier() *verifier {
+	return newVerifier(c.st, bytesutil.ToBytes32(c.st.GenesisValidatorsRoot()))
+}
+
+func (c *testChain) root(t *testing.T, i int) [32]byte {
+	root, err := c.blocks[i].Block().HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// withSignatureOf returns the i-th block of the chain with the signature of the j-th block.
+func (c *testChain) withSignatureOf(t *testing.T, i, j int) interfaces.ReadOnlySignedBeaconBlock {
+	cp, err := c.blocks[i].Copy()
+	require.NoError(t, err)
+	b, ok := cp.(interfaces.SignedBeaconBlock)
+	require.Equal(t, true, ok)
+	sig := c.blocks[j].Signature()
+	b.SetSignature(sig[:])
+	return b
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	c := newTestChain(t, []primitives.Slot{1, 2, 4, 5, 9})
+	v := c.verifier()
+
+	t.Run("valid chain", func(t *testing.T) {
+		next, err := v.verify(c.blocks[2:], c.root(t, 4))
+		require.NoError(t, err)
+		require.Equal(t, c.root(t, 1), next)
+		next, err = v.verify(c.blocks[:2], next)
+		require.NoError(t, err)
+		require.Equal(t, c.genesisRoot, next)
+	})
+	t.Run("empty batch", func(t *testing.T) {
+		next, err := v.verify(nil, c.root(t, 1))
+		require.NoError(t, err)
+		require.Equal(t, c.root(t, 1), next)
+	})
+	t.Run("not the expected root", func(t *testing.T) {
+		_, err := v.verify(c.blocks[:2], c.root(t, 4))
+		require.ErrorIs(t, err, errChainBroken)
+	})
+	t.Run("gap in the chain", func(t *testing.T) {
+		_, err := v.verify([]interfaces.ReadOnlySignedBeaconBlock{c.blocks[0], c.blocks[2]}, c.root(t, 2))
+		require.ErrorIs(t, err, errChainBroken)
+	})
+	t.Run("not ascending", func(t *testing.T) {
+		_, err := v.verify([]interfaces.ReadOnlySignedBeaconBlock{c.blocks[1], c.blocks[0]}, c.root(t, 0))
+		require.ErrorIs(t, err, errNotAscending)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		wsb := c.withSignatureOf(t, 1, 0)
+		_, err := v.verify([]interfaces.ReadOnlySignedBeaconBlock{c.blocks[0], wsb}, c.root(t, 1))
+		require.ErrorIs(t, err, errInvalidSignature)
+	})
+	t.Run("unknown proposer", func(t *testing.T) {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = 1
+		b.Block.ProposerIndex = testValidatorCount
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		_, err = v.verify([]interfaces.ReadOnlySignedBeaconBlock{wsb}, root)
+		require.ErrorIs(t, err, errUnknownProposer)
+	})
+}
@@ -162,8 +162,8 @@ func (s *Service) processFetchedDataRegSync(
 	invalidBlocks := 0
 	blksWithoutParentCount := 0
 	for _, b := range data.bwb {
-		if len(b.Blobs) > 0 {
-			if err := s.cfg.DB.SaveBlobSidecar(ctx, b.Blobs); err != nil {
+		for _, sc := range b.Blobs {
+			if err := s.cfg.BlobStorage.Save(sc); err != nil {
 				log.WithError(err).Warn("Failed to save blob sidecar")
 			}
 		}
@@ -323,11 +323,10 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	s.logBatchSyncStatus(genesis, first, len(bwb))
 	blobCount := 0
 	for _, bb := range bwb {
-		if len(bb.Blobs) == 0 {
-			continue
-		}
-		if err := s.cfg.DB.SaveBlobSidecar(ctx, bb.Blobs); err != nil {
-			return errors.Wrapf(err, "failed to save blobs for block %#x", bb.Block.Root())
+		for _, sc := range bb.Blobs {
+			if err := s.cfg.BlobStorage.Save(sc); err != nil {
+				return errors.Wrapf(err, "failed to save blobs for block %#x", bb.Block.Root())
+			}
 		}
 		blobCount += len(bb.Blobs)
 	}
@@ -14,6 +14,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
@@ -36,6 +37,7 @@ type blockchainService interface {
 type Config struct {
 	P2P                 p2p.P2P
 	DB                  db.NoHeadAccessDatabase
+	BlobStorage         *filesystem.BlobStorage
 	Chain               blockchainService
 	StateNotifier       statefeed.Notifier
 	BlockNotifier       blockfeed.Notifier
@@ -149,7 +149,9 @@ func (s *Service) requestPendingBlobs(ctx context.Context, b interfaces.ReadOnly
 
 	for _, sidecar := range blobSidecars {
 		log.WithFields(blobFields(sidecar)).Debug("Received blob sidecar gossip RPC")
+		if err := s.cfg.blobStorage.Save(sidecar); err != nil {
+			return err
+		}
 	}
-
-	return s.cfg.beaconDB.SaveBlobSidecar(ctx, blobSidecars)
+	return nil
 }
@@ -30,8 +30,8 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 	defer span.End()
 	for _, b := range batch.canonical() {
 		root := b.Root()
-		scs, err := s.cfg.beaconDB.BlobSidecarsByRoot(ctx, b.Root())
-		if errors.Is(err, db.ErrNotFound) {
+		scs, err := s.cfg.blobStorage.Sidecars(root)
+		if db.IsNotFound(err) {
 			continue
 		}
 		if err != nil {
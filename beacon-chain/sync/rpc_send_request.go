@@ -258,8 +258,8 @@ func readChunkedBlobSidecar(stream network.Stream, encoding encoder.NetworkEncod
 	if !found {
 		return nil, errors.Wrapf(errBlobUnmarshal, fmt.Sprintf("unrecognized fork digest %#x", ctxb))
 	}
-	// Only deneb is supported at this time, because we lack a fork-spanning interface/union type for blobs.
-	if v != version.Deneb {
+	// BlobSidecar is unchanged since deneb, because we lack a fork-spanning interface/union type for blobs.
+	if v < version.Deneb {
 		return nil, fmt.Errorf("unexpected context bytes for deneb BlobSidecar, ctx=%#x, v=%s", ctxb, version.String(v))
 	}
 	sc := &pb.BlobSidecar{}
@@ -0,0 +1,26 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) contractTransferSubscriber(_ context.Context, msg proto.Message) error {
+	transfer, ok := msg.(*ethpb.SignedContractTransfer)
+	if !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpb.SignedContractTransfer{}, msg)
+	}
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ContractTransferReceived,
+		Data: &opfeed.ContractTransferReceivedData{
+			Transfer: transfer,
+		},
+	})
+	s.cfg.contractTransferPool.InsertContractTransfer(transfer)
+	return nil
+}
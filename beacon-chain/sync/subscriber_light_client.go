@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber has nothing to do: the node derives its own finality
+// update from the chain and only forwards gossiped ones that match it.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpbv2.LightClientFinalityUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpbv2.LightClientFinalityUpdate{}, msg)
+	}
+	return nil
+}
+
+// lightClientOptimisticUpdateSubscriber has nothing to do: the node derives its own optimistic
+// update from the chain and only forwards gossiped ones that match it.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*ethpbv2.LightClientOptimisticUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &ethpbv2.LightClientOptimisticUpdate{}, msg)
+	}
+	return nil
+}
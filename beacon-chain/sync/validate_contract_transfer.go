@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"context"
+	"errors"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+func (s *Service) validateContractTransfer(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// The head state will be too far away to validate any contract transfer.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateContractTransfer")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	transfer, ok := m.(*ethpb.SignedContractTransfer)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if transfer.Message == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+
+	// Check that the validator hasn't submitted a previous contract transfer.
+	if s.cfg.contractTransferPool.ValidatorExists(transfer.Message.ValidatorIndex) {
+		return pubsub.ValidationIgnore, nil
+	}
+	st, err := s.cfg.chain.HeadStateReadOnly(ctx)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	// Validate that the contract transfer object is valid.
+	_, err = blocks.ValidateContractTransfer(st, transfer)
+	if err != nil {
+		// Apart from a transfer of the zero contract, every failure depends on the clock and the head of
+		// this node: ownership, nonce, expiry and the recipient. The transfer may have been valid when it
+		// was forwarded, so the peer is not penalized.
+		if errors.Is(err, blocks.ErrZeroContractTransfer) {
+			return pubsub.ValidationReject, err
+		}
+		return pubsub.ValidationIgnore, err
+	}
+	// Validate the signature of the message using our batch gossip verifier.
+	sigBatch, err := blocks.ContractTransfersSignatureBatch(st, []*ethpb.SignedContractTransfer{transfer})
+	if err != nil {
+		return pubsub.ValidationReject, err
+	}
+	res, err := s.validateWithBatchVerifier(ctx, "contract transfer", sigBatch)
+	if res != pubsub.ValidationAccept {
+		return res, err
+	}
+	msg.ValidatorData = transfer // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
@@ -0,0 +1,255 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	testingdb "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/contracttransfers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/encoder"
+	mockp2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestService_ValidateContractTransfer(t *testing.T) {
+	beaconDB := testingdb.SetupDB(t)
+	defaultTopic := p2p.ContractTransferSubnetTopicFormat + "/" + encoder.ProtocolSuffixSSZSnappy
+	fakeDigest := []byte{0xAB, 0x00, 0xCC, 0x9E}
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+	chainService := &mockChain.ChainService{
+		Genesis:        time.Now(),
+		ValidatorsRoot: [32]byte{'A'},
+	}
+	clock := startup.NewClock(time.Now().Add(-time.Second*time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Duration(10)), [32]byte{'A'})
+
+	// signedTransfer sets up a head state where validator 50 owns the contract and
+	// returns a transfer of it to validator 51 signed by the keys at signer and recipientSigner.
+	signedTransfer := func(t *testing.T, s *Service, signer, recipientSigner int) *ethpb.SignedContractTransfer {
+		st, keys := util.DeterministicGenesisStateElectra(t, 128)
+		vals := st.Validators()
+		for _, v := range vals {
+			v.Contract = params.BeaconConfig().ZeroContract[:]
+		}
+		vals[50].Contract = contract
+		require.NoError(t, st.SetValidators(vals))
+		s.cfg.chain = &mockChain.ChainService{State: st}
+
+		message := &ethpb.ContractTransfer{
+			ValidatorIndex:  50,
+			RecipientIndex:  51,
+			Contract:        contract,
+			ValidUntilEpoch: 10,
+		}
+		c := params.BeaconConfig()
+		domain, err := signing.ComputeDomain(c.DomainContractTransfer, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+		require.NoError(t, err)
+		htr, err := signing.SigningData(message.HashTreeRoot, domain)
+		require.NoError(t, err)
+		return &ethpb.SignedContractTransfer{
+			Message:            message,
+			Signature:          keys[signer].Sign(htr[:]).Marshal(),
+			RecipientSignature: keys[recipientSigner].Sign(htr[:]).Marshal(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		syncing bool
+		topic   string
+		// setup returns the message to validate.
+		setup func(t *testing.T, s *Service) *ethpb.SignedContractTransfer
+		want  pubsub.ValidationResult
+	}{
+		{
+			name:    "Is syncing",
+			syncing: true,
+			topic:   "junk",
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				return signedTransfer(t, s, 50, 51)
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Bad Topic",
+			topic: "junk",
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				return signedTransfer(t, s, 50, 51)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name:  "Already Seen Message",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				s.cfg.contractTransferPool.InsertContractTransfer(msg)
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Contract Not Owned",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				msg.Message.Contract = bytesutil.PadTo([]byte{0xbb}, 20)
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Zero Contract",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				msg.Message.Contract = params.BeaconConfig().ZeroContract[:]
+				return msg
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name:  "Unknown Validator",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				msg.Message.RecipientIndex = 1000
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Recipient Exiting",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				st, err := s.cfg.chain.HeadState(context.Background())
+				require.NoError(t, err)
+				recipient, err := st.ValidatorAtIndex(51)
+				require.NoError(t, err)
+				recipient.ExitEpoch = 20
+				require.NoError(t, st.UpdateValidatorAtIndex(51, recipient))
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Recipient Contract Limit",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				st, err := s.cfg.chain.HeadState(context.Background())
+				require.NoError(t, err)
+				recipient, err := st.ValidatorAtIndex(51)
+				require.NoError(t, err)
+				recipient.Contract = bytesutil.PadTo([]byte{0x01}, 20)
+				require.NoError(t, st.UpdateValidatorAtIndex(51, recipient))
+				for i := uint64(1); i < params.BeaconConfig().MaxContractsPerValidator; i++ {
+					require.NoError(t, st.AppendAdditionalContract(51, bytesutil.ToBytes20([]byte{0x01, byte(i)})))
+				}
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Invalid Signature",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				return signedTransfer(t, s, 51, 51)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name:  "Stale Nonce",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				msg.Message.Nonce = 1
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Expired",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				msg := signedTransfer(t, s, 50, 51)
+				st, err := s.cfg.chain.HeadState(context.Background())
+				require.NoError(t, err)
+				require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(11)))
+				return msg
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name:  "Not Accepted By Recipient",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				return signedTransfer(t, s, 50, 50)
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name:  "Valid Contract Transfer",
+			topic: fmt.Sprintf(defaultTopic, fakeDigest),
+			setup: func(t *testing.T, s *Service) *ethpb.SignedContractTransfer {
+				return signedTransfer(t, s, 50, 51)
+			},
+			want: pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			cw := startup.NewClockSynchronizer()
+			svc := NewService(ctx,
+				WithClockWaiter(cw),
+				WithP2P(mockp2p.NewTestP2P(t)),
+				WithInitialSync(&mockSync.Sync{IsSyncing: tt.syncing}),
+				WithChainService(chainService),
+				WithOperationNotifier(chainService.OperationNotifier()),
+				WithContractTransferPool(contracttransfers.NewPool()),
+			)
+			svc.cfg.stateGen = stategen.New(beaconDB, doublylinkedtree.New())
+			svc.cfg.beaconDB = beaconDB
+			svc.initCaches()
+			transfer := tt.setup(t, svc)
+			go svc.Start()
+			require.NoError(t, cw.SetClock(clock))
+
+			marshalledObj, err := transfer.MarshalSSZ()
+			assert.NoError(t, err)
+			marshalledObj = snappy.Encode(nil, marshalledObj)
+			topic := tt.topic
+			msg := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  marshalledObj,
+					Topic: &topic,
+				},
+			}
+			if got, err := svc.validateContractTransfer(ctx, peer.ID("random"), msg); got != tt.want {
+				t.Errorf("validateContractTransfer() = %v, want %v, err = %v", got, tt.want, err)
+			}
+		})
+	}
+}
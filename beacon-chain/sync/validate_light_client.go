@@ -0,0 +1,139 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate checks that the received finality update is the one our own node
+// derived from the chain, and that it is newer than the last finality update we forwarded.
+//
+// Spec pseudocode definition:
+//
+//	[IGNORE] The finalized_header.beacon.slot is greater than that of all previously forwarded finality_updates.
+//	[IGNORE] The finality_update is received after the block at signature_slot was given enough time to
+//	  propagate through the network -- i.e. validate that one-third of finality_update.signature_slot has
+//	  transpired (SECONDS_PER_SLOT / INTERVALS_PER_SLOT seconds after the start of the slot, with a
+//	  MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+//	[IGNORE] The received finality_update matches the locally computed one exactly.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We have no local update to compare against while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	update, ok := m.(*ethpbv2.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+
+	if update.FinalizedHeader.Slot <= s.lastLightClientFinalitySlot {
+		return pubsub.ValidationIgnore, nil
+	}
+	if s.isLightClientUpdateEarly(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientFinalityUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	s.lastLightClientFinalitySlot = update.FinalizedHeader.Slot
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate checks that the received optimistic update is the one our own node
+// derived from the chain, and that it is newer than the last optimistic update we forwarded.
+//
+// Spec pseudocode definition:
+//
+//	[IGNORE] The attested_header.beacon.slot is greater than that of all previously forwarded optimistic_updates.
+//	[IGNORE] The optimistic_update is received after the block at signature_slot was given enough time to
+//	  propagate through the network.
+//	[IGNORE] The received optimistic_update matches the locally computed one exactly.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We have no local update to compare against while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	update, ok := m.(*ethpbv2.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+
+	if update.AttestedHeader.Slot <= s.lastLightClientOptimisticSlot {
+		return pubsub.ValidationIgnore, nil
+	}
+	if s.isLightClientUpdateEarly(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientOptimisticUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	s.lastLightClientOptimisticSlot = update.AttestedHeader.Slot
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// isLightClientUpdateEarly returns true if a light client update signed at the given slot arrives
+// before the first interval of that slot has elapsed, allowing for gossip clock disparity.
+func (s *Service) isLightClientUpdateEarly(signatureSlot primitives.Slot) bool {
+	slotStart := slots.StartTime(uint64(s.cfg.clock.GenesisTime().Unix()), signatureSlot)
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second
+	earliest := slotStart.Add(interval - params.BeaconNetworkConfig().MaximumGossipClockDisparity)
+	return s.cfg.clock.Now().Before(earliest)
+}
@@ -0,0 +1,220 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/encoder"
+	mockp2p "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testLightClientHeader(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+	return &ethpbv1.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func testLightClientSyncAggregate() *ethpbv1.SyncAggregate {
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      bitfield.NewBitvector512(),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func testLightClientBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+// lightClientTestService returns a sync service whose clock is halfway through slot 10.
+func lightClientTestService(t *testing.T, chain *mockChain.ChainService, syncing bool) *Service {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	genesis := time.Now().Add(-10*secondsPerSlot - secondsPerSlot/2)
+	return &Service{
+		cfg: &config{
+			p2p:         mockp2p.NewTestP2P(t),
+			initialSync: &mockSync.Sync{IsSyncing: syncing},
+			chain:       chain,
+			clock:       startup.NewClock(genesis, [32]byte{'A'}),
+		},
+	}
+}
+
+func lightClientPubsubMessage(t *testing.T, topicFormat string, m interface{ MarshalSSZ() ([]byte, error) }) *pubsub.Message {
+	marshalled, err := m.MarshalSSZ()
+	require.NoError(t, err)
+	topic := fmt.Sprintf(topicFormat+"/"+encoder.ProtocolSuffixSSZSnappy, []byte{0xAB, 0x00, 0xCC, 0x9E})
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  snappy.Encode(nil, marshalled),
+			Topic: &topic,
+		},
+	}
+}
+
+func TestService_ValidateLightClientFinalityUpdate(t *testing.T) {
+	local := &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientHeader(9),
+		FinalizedHeader: testLightClientHeader(4),
+		FinalityBranch:  testLightClientBranch(6),
+		SyncAggregate:   testLightClientSyncAggregate(),
+		SignatureSlot:   10,
+	}
+
+	tests := []struct {
+		name    string
+		syncing bool
+		// setup returns the message to validate.
+		setup func(s *Service) *ethpbv2.LightClientFinalityUpdate
+		want  pubsub.ValidationResult
+	}{
+		{
+			name:    "Is syncing",
+			syncing: true,
+			setup: func(_ *Service) *ethpbv2.LightClientFinalityUpdate {
+				return local
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Already forwarded a newer update",
+			setup: func(s *Service) *ethpbv2.LightClientFinalityUpdate {
+				s.lastLightClientFinalitySlot = 4
+				return local
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Received too early",
+			setup: func(s *Service) *ethpbv2.LightClientFinalityUpdate {
+				early := proto.Clone(local).(*ethpbv2.LightClientFinalityUpdate)
+				early.SignatureSlot = 11
+				s.cfg.chain = &mockChain.ChainService{LightClientFinality: early}
+				return early
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Does not match the local update",
+			setup: func(_ *Service) *ethpbv2.LightClientFinalityUpdate {
+				other := proto.Clone(local).(*ethpbv2.LightClientFinalityUpdate)
+				other.AttestedHeader.Slot = 8
+				return other
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Valid finality update",
+			setup: func(_ *Service) *ethpbv2.LightClientFinalityUpdate {
+				return local
+			},
+			want: pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := lightClientTestService(t, &mockChain.ChainService{LightClientFinality: local}, tt.syncing)
+			update := tt.setup(s)
+			msg := lightClientPubsubMessage(t, p2p.LightClientFinalityUpdateTopicFormat, update)
+			got, err := s.validateLightClientFinalityUpdate(context.Background(), peer.ID("random"), msg)
+			require.Equal(t, tt.want, got, fmt.Sprintf("err = %v", err))
+			if got == pubsub.ValidationAccept {
+				require.Equal(t, update.FinalizedHeader.Slot, s.lastLightClientFinalitySlot)
+			}
+		})
+	}
+}
+
+func TestService_ValidateLightClientOptimisticUpdate(t *testing.T) {
+	local := &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(9),
+		SyncAggregate:  testLightClientSyncAggregate(),
+		SignatureSlot:  10,
+	}
+
+	tests := []struct {
+		name    string
+		syncing bool
+		// setup returns the message to validate.
+		setup func(s *Service) *ethpbv2.LightClientOptimisticUpdate
+		want  pubsub.ValidationResult
+	}{
+		{
+			name:    "Is syncing",
+			syncing: true,
+			setup: func(_ *Service) *ethpbv2.LightClientOptimisticUpdate {
+				return local
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Already forwarded a newer update",
+			setup: func(s *Service) *ethpbv2.LightClientOptimisticUpdate {
+				s.lastLightClientOptimisticSlot = 9
+				return local
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Received too early",
+			setup: func(s *Service) *ethpbv2.LightClientOptimisticUpdate {
+				early := proto.Clone(local).(*ethpbv2.LightClientOptimisticUpdate)
+				early.SignatureSlot = 11
+				s.cfg.chain = &mockChain.ChainService{LightClientOptimistic: early}
+				return early
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "No local update",
+			setup: func(s *Service) *ethpbv2.LightClientOptimisticUpdate {
+				s.cfg.chain = &mockChain.ChainService{}
+				return local
+			},
+			want: pubsub.ValidationIgnore,
+		},
+		{
+			name: "Valid optimistic update",
+			setup: func(_ *Service) *ethpbv2.LightClientOptimisticUpdate {
+				return local
+			},
+			want: pubsub.ValidationAccept,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := lightClientTestService(t, &mockChain.ChainService{LightClientOptimistic: local}, tt.syncing)
+			update := tt.setup(s)
+			msg := lightClientPubsubMessage(t, p2p.LightClientOptimisticUpdateTopicFormat, update)
+			got, err := s.validateLightClientOptimisticUpdate(context.Background(), peer.ID("random"), msg)
+			require.Equal(t, tt.want, got, fmt.Sprintf("err = %v", err))
+			if got == pubsub.ValidationAccept {
+				require.Equal(t, update.AttestedHeader.Slot, s.lastLightClientOptimisticSlot)
+			}
+		})
+	}
+}
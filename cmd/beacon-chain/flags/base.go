@@ -266,4 +266,21 @@ var (
 		Usage: "Extend blob retention epoch period to beyond default 4096 epochs (~18 days). The node will error at start if input value is less than 4096 epochs.",
 		Value: uint64(params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest),
 	}
+	// ActivityHistoryRetentionEpochs specifies how many finalized epochs of activity history are kept in the database.
+	ActivityHistoryRetentionEpochs = &cli.Uint64Flag{
+		Name:  "activity-history-retention-epochs",
+		Usage: "The number of epochs before the finalized epoch for which the activity history saved with --save-activity-history is kept. The history is kept forever when set to 0.",
+	}
+	// BackfillBatchSize specifies the number of slots requested in a single batch when backfilling.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of slots requested from a peer in a single batch when backfilling the chain history below the checkpoint sync origin.",
+		Value: 64,
+	}
+	// BackfillWorkerCount specifies the number of batches downloaded concurrently when backfilling.
+	BackfillWorkerCount = &cli.IntFlag{
+		Name:  "backfill-worker-count",
+		Usage: "The number of batches downloaded concurrently, each from a different peer, when backfilling the chain history below the checkpoint sync origin.",
+		Value: 2,
+	}
 )
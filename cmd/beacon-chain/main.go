@@ -77,6 +77,9 @@ var appFlags = []cli.Flag{
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
 	flags.BlobRetentionEpoch,
+	flags.ActivityHistoryRetentionEpochs,
+	flags.BackfillBatchSize,
+	flags.BackfillWorkerCount,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
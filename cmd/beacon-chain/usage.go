@@ -129,6 +129,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SlasherDirFlag,
 			flags.LocalBlockValueBoost,
 			flags.BlobRetentionEpoch,
+			flags.ActivityHistoryRetentionEpochs,
+			flags.BackfillBatchSize,
+			flags.BackfillWorkerCount,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/db"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/deprecated"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/p2p"
+	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/rewards"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/testnet"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/validator"
 	"github.com/prysmaticlabs/prysm/v4/cmd/prysmctl/weaksubjectivity"
@@ -37,4 +38,5 @@ func init() {
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
 	prysmctlCommands = append(prysmctlCommands, validator.Commands...)
+	prysmctlCommands = append(prysmctlCommands, rewards.Commands...)
 }
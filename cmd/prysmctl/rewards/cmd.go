@@ -0,0 +1,13 @@
+package rewards
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "rewards",
+		Usage: "commands for estimating validator rewards offline",
+		Subcommands: []*cli.Command{
+			simulateCmd,
+		},
+	},
+}
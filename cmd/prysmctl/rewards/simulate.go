@@ -0,0 +1,213 @@
+package rewards
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+var simulateFlags = struct {
+	StatePath        string
+	ChainConfigFile  string
+	ValidatorIndex   uint64
+	AddActivity      uint64
+	EffectiveBalance uint64
+	Epochs           uint64
+	SampleProposers  bool
+	Output           string
+}{}
+
+var simulateCmd = &cli.Command{
+	Name:  "simulate",
+	Usage: "Project the proposals, proposer rewards and APR of a validator from an SSZ state, with and without a what-if change",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionSimulate(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not simulate rewards")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "state",
+			Usage:       "Path to an SSZ encoded beacon state",
+			Destination: &simulateFlags.StatePath,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "The path to a YAML file with chain config values, for states of networks without a built-in config",
+			Destination: &simulateFlags.ChainConfigFile,
+		},
+		&cli.Uint64Flag{
+			Name:        "validator-index",
+			Usage:       "Index of the validator to project the rewards for",
+			Destination: &simulateFlags.ValidatorIndex,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "add-activity",
+			Usage:       "Gas per epoch added to the activity of the validator, e.g. by binding a contract",
+			Destination: &simulateFlags.AddActivity,
+		},
+		&cli.Uint64Flag{
+			Name:        "effective-balance",
+			Usage:       "Effective balance in Gwei to use for the validator instead of the one in the state",
+			Destination: &simulateFlags.EffectiveBalance,
+		},
+		&cli.Uint64Flag{
+			Name:        "epochs",
+			Usage:       "Number of epochs to project over",
+			Destination: &simulateFlags.Epochs,
+			Value:       225,
+		},
+		&cli.BoolFlag{
+			Name:        "sample-proposers",
+			Usage:       "Also run the proposer selection for every projected slot and count the validator's proposals. Slow on large validator sets",
+			Destination: &simulateFlags.SampleProposers,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "Output format, table or json",
+			Destination: &simulateFlags.Output,
+			Value:       outputTable,
+		},
+	},
+}
+
+// simulationResult holds the projection for the state as is and the one for the what-if scenario.
+type simulationResult struct {
+	Baseline *projection `json:"baseline"`
+	Scenario *projection `json:"scenario"`
+}
+
+func cliActionSimulate(cliCtx *cli.Context) error {
+	f := simulateFlags
+	if f.Output != outputTable && f.Output != outputJSON {
+		return fmt.Errorf("unknown output format %s, expected %s or %s", f.Output, outputTable, outputJSON)
+	}
+	if f.ChainConfigFile != "" {
+		if err := params.LoadChainConfigFile(f.ChainConfigFile, nil); err != nil {
+			return errors.Wrap(err, "could not load chain config file")
+		}
+	}
+	st, err := loadState(f.StatePath)
+	if err != nil {
+		return err
+	}
+
+	baseline := &scenario{
+		index:           primitives.ValidatorIndex(f.ValidatorIndex),
+		epochs:          f.Epochs,
+		sampleProposers: f.SampleProposers,
+	}
+	whatIf := *baseline
+	whatIf.addActivity = f.AddActivity
+	whatIf.effectiveBalance = f.EffectiveBalance
+
+	res := &simulationResult{}
+	if res.Baseline, err = simulate(cliCtx.Context, st, baseline); err != nil {
+		return errors.Wrap(err, "could not project baseline rewards")
+	}
+	if res.Scenario, err = simulate(cliCtx.Context, st, &whatIf); err != nil {
+		return errors.Wrap(err, "could not project scenario rewards")
+	}
+	if f.Output == outputJSON {
+		return writeJSON(cliCtx.App.Writer, res)
+	}
+	return writeTable(cliCtx.App.Writer, res)
+}
+
+func loadState(path string) (state.BeaconState, error) {
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state file")
+	}
+	vu, err := detect.FromState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect state version")
+	}
+	if err := params.SetActive(vu.Config.Copy()); err != nil {
+		return nil, err
+	}
+	st, err := vu.UnmarshalBeaconState(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return st, nil
+}
+
+func writeJSON(w io.Writer, res *simulationResult) error {
+	enc, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(enc))
+	return err
+}
+
+type tableRow struct {
+	name               string
+	baseline, scenario string
+}
+
+func writeTable(w io.Writer, res *simulationResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	b, s := res.Baseline, res.Scenario
+	rows := []tableRow{
+		{"effective balance (Gwei)", formatUint(b.EffectiveBalance), formatUint(s.EffectiveBalance)},
+		{"effective activity", formatUint(b.EffectiveActivity), formatUint(s.EffectiveActivity)},
+		{"effective power", formatUint(b.EffectivePower), formatUint(s.EffectivePower)},
+		{"power share", formatFloat(b.PowerShare), formatFloat(s.PowerShare)},
+		{"expected proposals", formatFloat(b.ExpectedProposals), formatFloat(s.ExpectedProposals)},
+		{"sync proposer rewards (Gwei)", formatGwei(b.SyncProposerRewards), formatGwei(s.SyncProposerRewards)},
+		{"attestation proposer rewards (Gwei)", formatGwei(b.AttestationProposerRewards), formatGwei(s.AttestationProposerRewards)},
+		{"attestation rewards (Gwei)", formatGwei(b.AttestationRewards), formatGwei(s.AttestationRewards)},
+		{"total rewards (Gwei)", formatGwei(b.TotalRewards), formatGwei(s.TotalRewards)},
+		{"APR", formatPercent(b.APR), formatPercent(s.APR)},
+	}
+	if b.SampledProposals != nil && s.SampledProposals != nil {
+		rows = append(rows, tableRow{"sampled proposals", formatUint(*b.SampledProposals), formatUint(*s.SampledProposals)})
+	}
+
+	if _, err := fmt.Fprintf(tw, "validator %d over %d epochs\tbaseline\tscenario\n", b.ValidatorIndex, b.Epochs); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", r.name, r.baseline, r.scenario); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func formatUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
+
+func formatGwei(v float64) string {
+	return strconv.FormatFloat(v, 'f', 0, 64)
+}
+
+func formatPercent(v float64) string {
+	return strconv.FormatFloat(v*100, 'f', 4, 64) + "%"
+}
@@ -0,0 +1,196 @@
+package rewards
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+const secondsPerYear = 365.25 * 24 * 60 * 60
+
+// scenario is a what-if change applied to a copy of the state before projecting the rewards of a validator.
+type scenario struct {
+	// index of the validator the projection is made for.
+	index primitives.ValidatorIndex
+	// addActivity is the gas per epoch added to the activity of the validator. It is folded into the
+	// effective activity with the same moving average as the epoch processing does.
+	addActivity uint64
+	// effectiveBalance replaces the effective balance of the validator, zero keeps it as is.
+	effectiveBalance uint64
+	// epochs is the number of epochs to project over.
+	epochs uint64
+	// sampleProposers runs the proposer selection for every projected slot in addition to the expected value.
+	sampleProposers bool
+}
+
+// projection is the expected outcome for a validator over the projected epochs. Rewards are in Gwei.
+// The projection assumes full participation and that the rest of the validator set and the shared
+// activity stay as they are in the state.
+type projection struct {
+	ValidatorIndex             primitives.ValidatorIndex `json:"validator_index"`
+	Epochs                     uint64                    `json:"epochs"`
+	EffectiveBalance           uint64                    `json:"effective_balance"`
+	EffectiveActivity          uint64                    `json:"effective_activity"`
+	EffectivePower             uint64                    `json:"effective_power"`
+	TotalEffectivePower        uint64                    `json:"total_effective_power"`
+	PowerShare                 float64                   `json:"power_share"`
+	ExpectedProposals          float64                   `json:"expected_proposals"`
+	SampledProposals           *uint64                   `json:"sampled_proposals,omitempty"`
+	SyncProposerRewards        float64                   `json:"sync_proposer_rewards"`
+	AttestationProposerRewards float64                   `json:"attestation_proposer_rewards"`
+	AttestationRewards         float64                   `json:"attestation_rewards"`
+	TotalRewards               float64                   `json:"total_rewards"`
+	APR                        float64                   `json:"apr"`
+}
+
+// simulate projects the rewards of the scenario's validator on a copy of the given state. The state itself
+// is not modified.
+func simulate(ctx context.Context, base state.BeaconState, sc *scenario) (*projection, error) {
+	if sc.epochs == 0 {
+		return nil, errors.New("number of epochs must be positive")
+	}
+	if uint64(sc.index) >= uint64(base.NumValidators()) {
+		return nil, errors.Errorf("validator index %d out of range, the state has %d validators", sc.index, base.NumValidators())
+	}
+	cfg := params.BeaconConfig()
+	st := base.Copy()
+	// The committee, proposer and balance caches are keyed by block roots, which the scenario does not change.
+	helpers.ClearCache()
+	defer helpers.ClearCache()
+
+	val, err := st.ValidatorAtIndex(sc.index)
+	if err != nil {
+		return nil, err
+	}
+	if sc.effectiveBalance != 0 {
+		val.EffectiveBalance = sc.effectiveBalance
+		if err := st.UpdateValidatorAtIndex(sc.index, val); err != nil {
+			return nil, err
+		}
+	}
+	baseActivity := val.EffectiveActivity
+
+	epoch := time.CurrentEpoch(st)
+	activeIndices, err := helpers.ActiveValidatorIndices(ctx, st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator indices")
+	}
+	if len(activeIndices) == 0 {
+		return nil, errors.New("empty active indices list")
+	}
+	v, err := st.ValidatorAtIndexReadOnly(sc.index)
+	if err != nil {
+		return nil, err
+	}
+	active := helpers.IsActiveValidatorUsingTrie(v, epoch)
+	proposerSeed, err := helpers.Seed(st, epoch, cfg.DomainBeaconProposer)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer seed")
+	}
+
+	attestationWeight := cfg.TimelySourceWeight + cfg.TimelyTargetWeight + cfg.TimelyHeadWeight
+	committees := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+	p := &projection{ValidatorIndex: sc.index, Epochs: sc.epochs}
+	var extraActivity, sampled uint64
+	for e := uint64(0); e < sc.epochs; e++ {
+		if sc.addActivity != 0 {
+			extraActivity, err = helpers.ActivityMovingAverage(epoch, "simulated activity", extraActivity, sc.addActivity, uint64(cfg.EpochsPerActivityPeriod))
+			if err != nil {
+				return nil, err
+			}
+			if val.EffectiveActivity, err = helpers.ActivityAdd(epoch, "simulated effective activity", baseActivity, extraActivity); err != nil {
+				return nil, err
+			}
+			if err := st.UpdateValidatorAtIndex(sc.index, val); err != nil {
+				return nil, err
+			}
+		}
+		if !active {
+			continue
+		}
+
+		totalPower, totalEffectivePower, err := helpers.Powers(ctx, st)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not calculate powers")
+		}
+		baseProposerReward, err := altair.BaseProposerReward(st, totalPower, totalEffectivePower)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not calculate base proposer reward")
+		}
+		activeBalance, err := helpers.TotalActiveBalance(st)
+		if err != nil {
+			return nil, err
+		}
+		syncProposerReward, _, err := altair.SyncRewards(activeBalance, baseProposerReward, epoch)
+		if err != nil {
+			return nil, err
+		}
+		v, err := st.ValidatorAtIndexReadOnly(sc.index)
+		if err != nil {
+			return nil, err
+		}
+		power, err := helpers.EffectivePower(v, st.SharedActivity().TransactionsGasPerPeriod/uint64(len(activeIndices)), epoch)
+		if err != nil {
+			return nil, err
+		}
+		baseReward, err := altair.BaseReward(st, sc.index)
+		if err != nil {
+			return nil, err
+		}
+
+		share := 0.0
+		if totalEffectivePower != 0 {
+			share = float64(power) / float64(totalEffectivePower)
+		}
+		proposals := share * float64(cfg.SlotsPerEpoch)
+		p.ExpectedProposals += proposals
+		// A block including the aggregates of all committees of the slot earns the attestation share of the
+		// base proposer reward for each of them, see altair.SetParticipationAndRewardProposer.
+		p.SyncProposerRewards += proposals * float64(syncProposerReward*cfg.SyncCommitteeSize)
+		p.AttestationProposerRewards += proposals * float64(committees) * float64(baseProposerReward) * float64(attestationWeight) / float64(cfg.WeightDenominator)
+		p.AttestationRewards += float64(baseReward) * float64(attestationWeight) / float64(cfg.WeightDenominator)
+
+		p.EffectivePower = power
+		p.TotalEffectivePower = totalEffectivePower
+		p.PowerShare = share
+
+		if sc.sampleProposers {
+			// The proposer of a slot is selected with the seed mixed with the slot, see helpers.BeaconProposerIndex.
+			start, err := slots.EpochStart(epoch + primitives.Epoch(e))
+			if err != nil {
+				return nil, err
+			}
+			for s := primitives.Slot(0); s < cfg.SlotsPerEpoch; s++ {
+				slotSeed := hash.Hash(append(proposerSeed[:], bytesutil.Bytes8(uint64(start+s))...))
+				proposer, err := helpers.ComputeProposerIndex(st, activeIndices, slotSeed)
+				if err != nil {
+					return nil, errors.Wrap(err, "could not compute proposer index")
+				}
+				if proposer == sc.index {
+					sampled++
+				}
+			}
+		}
+	}
+	if sc.sampleProposers {
+		p.SampledProposals = &sampled
+	}
+
+	p.EffectiveBalance = val.EffectiveBalance
+	p.EffectiveActivity = val.EffectiveActivity
+	p.TotalRewards = p.SyncProposerRewards + p.AttestationProposerRewards + p.AttestationRewards
+	if p.EffectiveBalance != 0 {
+		epochsPerYear := secondsPerYear / float64(cfg.SecondsPerSlot*uint64(cfg.SlotsPerEpoch))
+		p.APR = p.TotalRewards / float64(sc.epochs) * epochsPerYear / float64(p.EffectiveBalance)
+	}
+	return p, nil
+}
@@ -0,0 +1,107 @@
+package rewards
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func simulatorState(t *testing.T) state.BeaconState {
+	st, _ := util.DeterministicGenesisStateCapella(t, 64)
+	vals := st.Validators()
+	for i, v := range vals {
+		v.EffectiveActivity = uint64(i) * 21_000
+	}
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetSharedActivity(&ethpb.SharedActivity{
+		TransactionsGasPerPeriod: 21_000 * 10_000,
+		BaseFeePerPeriod:         1_000_000_000,
+	}))
+	return st
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	st := simulatorState(t)
+	sc := &scenario{index: 3, epochs: 10, sampleProposers: true}
+
+	baseline, err := simulate(ctx, st, sc)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3*21_000), baseline.EffectiveActivity)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, baseline.EffectiveBalance)
+	assert.Equal(t, true, baseline.ExpectedProposals > 0)
+	assert.Equal(t, true, baseline.SyncProposerRewards > 0)
+	assert.Equal(t, true, baseline.AttestationProposerRewards > 0)
+	assert.Equal(t, true, baseline.AttestationRewards > 0)
+	assert.Equal(t, true, baseline.APR > 0)
+	require.NotNil(t, baseline.SampledProposals)
+
+	// The expected proposals of all validators add up to the projected slots.
+	var total float64
+	for i := 0; i < st.NumValidators(); i++ {
+		p, err := simulate(ctx, st, &scenario{index: primitives.ValidatorIndex(i), epochs: 10})
+		require.NoError(t, err)
+		total += p.ExpectedProposals
+	}
+	assert.Equal(t, true, total > 10*float64(params.BeaconConfig().SlotsPerEpoch)-1e-6)
+	assert.Equal(t, true, total < 10*float64(params.BeaconConfig().SlotsPerEpoch)+1e-6)
+
+	active := *sc
+	active.addActivity = 1_000_000
+	withActivity, err := simulate(ctx, st, &active)
+	require.NoError(t, err)
+	assert.Equal(t, true, withActivity.EffectiveActivity > baseline.EffectiveActivity)
+	assert.Equal(t, true, withActivity.ExpectedProposals > baseline.ExpectedProposals)
+	assert.Equal(t, true, withActivity.APR > baseline.APR)
+
+	lowBalance := *sc
+	lowBalance.effectiveBalance = params.BeaconConfig().MaxEffectiveBalance / 2
+	withLowBalance, err := simulate(ctx, st, &lowBalance)
+	require.NoError(t, err)
+	assert.Equal(t, lowBalance.effectiveBalance, withLowBalance.EffectiveBalance)
+	assert.Equal(t, true, withLowBalance.ExpectedProposals < baseline.ExpectedProposals)
+
+	// The scenarios run on copies of the state.
+	v, err := st.ValidatorAtIndexReadOnly(3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3*21_000), v.EffectiveActivity())
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, v.EffectiveBalance())
+}
+
+func TestSimulate_Errors(t *testing.T) {
+	st := simulatorState(t)
+	_, err := simulate(context.Background(), st, &scenario{index: 3})
+	assert.ErrorContains(t, "number of epochs must be positive", err)
+	_, err = simulate(context.Background(), st, &scenario{index: 64, epochs: 1})
+	assert.ErrorContains(t, "validator index 64 out of range", err)
+}
+
+func TestWriteOutput(t *testing.T) {
+	sampled := uint64(2)
+	res := &simulationResult{
+		Baseline: &projection{ValidatorIndex: 3, Epochs: 10, EffectiveActivity: 63_000, APR: 0.05, SampledProposals: &sampled},
+		Scenario: &projection{ValidatorIndex: 3, Epochs: 10, EffectiveActivity: 563_000, APR: 0.06, SampledProposals: &sampled},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeTable(buf, res))
+	assert.StringContains(t, "validator 3 over 10 epochs", buf.String())
+	assert.StringContains(t, "5.0000%", buf.String())
+	assert.StringContains(t, "563000", buf.String())
+	assert.StringContains(t, "sampled proposals", buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeJSON(buf, res))
+	decoded := &simulationResult{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	assert.DeepEqual(t, res, decoded)
+}
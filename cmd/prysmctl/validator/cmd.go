@@ -70,6 +70,54 @@ var (
 		Aliases: []string{"t"},
 		Usage:   "keymanager API bearer token, note: currently required but may be removed in the future, this is the same token as the web ui token.",
 	}
+
+	KeystoresDirFlag = &cli.StringFlag{
+		Name:  "keystores-dir",
+		Usage: "path to a directory of EIP-2335 keystores to generate deposit data for",
+	}
+
+	KeystoresPasswordFileFlag = &cli.StringFlag{
+		Name:  "keystores-password-file",
+		Usage: "path to a file containing the password of the keystores in --keystores-dir",
+	}
+
+	MnemonicFileFlag = &cli.StringFlag{
+		Name:  "mnemonic-file",
+		Usage: "path to a file containing the mnemonic to derive validator keys from, used instead of --keystores-dir",
+	}
+
+	MnemonicStartIndexFlag = &cli.Uint64Flag{
+		Name:  "mnemonic-start-index",
+		Usage: "index of the first validator key derived from --mnemonic-file",
+	}
+
+	NumValidatorsFlag = &cli.Uint64Flag{
+		Name:  "num-validators",
+		Usage: "number of validator keys derived from --mnemonic-file",
+		Value: 1,
+	}
+
+	DepositBindingsFlag = &cli.StringFlag{
+		Name: "contract-bindings",
+		Usage: "path to a JSON list binding every validator to a contract, i.e. [{\"pubkey\": \"0x...\", \"contract_address\": \"0x...\", \"amount\": 32000000000}]. " +
+			"The amount is optional and defaults to --amount, a contract can only be bound to a single validator",
+	}
+
+	DepositAmountFlag = &cli.Uint64Flag{
+		Name:  "amount",
+		Usage: "deposit amount in Gwei used for validators without an amount in --contract-bindings, defaults to the max effective balance of the network",
+	}
+
+	WithdrawalCredentialsFlag = &cli.StringFlag{
+		Name:  "withdrawal-credentials",
+		Usage: "32 byte hex withdrawal credentials used for all deposits, defaults to BLS credentials of each validator key",
+	}
+
+	DepositDataOutputFlag = &cli.StringFlag{
+		Name:  "output-path",
+		Usage: "path of the deposit data JSON file to write",
+		Value: "deposit_data.json",
+	}
 )
 
 var Commands = []*cli.Command{
@@ -144,6 +192,41 @@ var Commands = []*cli.Command{
 					return nil
 				},
 			},
+			{
+				Name:  "deposit-data",
+				Usage: "Generates signed deposit data binding each validator to a contract.",
+				Flags: []cli.Flag{
+					cmd.ConfigFileFlag,
+					KeystoresDirFlag,
+					KeystoresPasswordFileFlag,
+					MnemonicFileFlag,
+					MnemonicStartIndexFlag,
+					NumValidatorsFlag,
+					DepositBindingsFlag,
+					DepositAmountFlag,
+					WithdrawalCredentialsFlag,
+					DepositDataOutputFlag,
+					features.Mainnet,
+					features.OasisTestnet,
+					features.OceanTestnet,
+					features.HorizonTestnet,
+					features.PraterTestnet,
+					features.SepoliaTestnet,
+					features.HoleskyTestnet,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+						return err
+					}
+					return features.ConfigureValidator(cliCtx)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := generateDepositData(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not generate deposit data")
+					}
+					return nil
+				},
+			},
 			{
 				Name:    "exit",
 				Aliases: []string{"e", "voluntary-exit"},
@@ -0,0 +1,284 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
+	log "github.com/sirupsen/logrus"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/urfave/cli/v2"
+	util "github.com/wealdtech/go-eth2-util"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"go.opencensus.io/trace"
+)
+
+// depositBinding assigns a contract address and a deposit amount to a validator key.
+type depositBinding struct {
+	PubKey          string `json:"pubkey"`
+	ContractAddress string `json:"contract_address"`
+	Amount          uint64 `json:"amount"`
+}
+
+// depositDataJSON is the signed deposit written by the deposit-data command. The layout follows the
+// staking-deposit-cli output with the Fastex contract address added, so the file can be consumed by
+// `prysmctl testnet generate-genesis --deposit-json-file` as well as by deposit contract frontends.
+type depositDataJSON struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	ContractAddress       string `json:"contract_address"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+func generateDepositData(c *cli.Context) error {
+	_, span := trace.StartSpan(c.Context, "prysmctl.generateDepositData")
+	defer span.End()
+
+	keys, err := depositKeys(c)
+	if err != nil {
+		return err
+	}
+	bindings, err := readDepositBindings(c.String(DepositBindingsFlag.Name))
+	if err != nil {
+		return err
+	}
+	var withdrawalCredentials []byte
+	if raw := c.String(WithdrawalCredentialsFlag.Name); raw != "" {
+		withdrawalCredentials, err = hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+		if err != nil || len(withdrawalCredentials) != 32 {
+			return fmt.Errorf("%s must be a 32 byte hex string", WithdrawalCredentialsFlag.Name)
+		}
+	}
+	amount := c.Uint64(DepositAmountFlag.Name)
+	if amount == 0 {
+		amount = params.BeaconConfig().MaxEffectiveBalance
+	}
+	data, err := depositDataForKeys(keys, bindings, withdrawalCredentials, amount)
+	if err != nil {
+		return err
+	}
+
+	enc, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal deposit data")
+	}
+	outputPath := c.String(DepositDataOutputFlag.Name)
+	if err := file.WriteFile(outputPath, enc); err != nil {
+		return errors.Wrap(err, "could not write deposit data")
+	}
+	log.WithField("path", outputPath).Infof("Wrote deposit data for %d validators", len(data))
+	return nil
+}
+
+// depositDataForKeys signs a deposit for every key. Each key must have a binding and no contract can be
+// bound to more than one validator. Bindings without an amount fall back to defaultAmount and validators
+// without explicit withdrawal credentials use BLS credentials derived from their own key.
+func depositDataForKeys(
+	keys []bls.SecretKey,
+	bindings map[[fieldparams.BLSPubkeyLength]byte]*depositBinding,
+	withdrawalCredentials []byte,
+	defaultAmount uint64,
+) ([]*depositDataJSON, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no validator keys provided")
+	}
+	if len(bindings) != len(keys) {
+		return nil, fmt.Errorf("found %d contract bindings for %d validator keys", len(bindings), len(keys))
+	}
+	forkVersion := params.BeaconConfig().GenesisForkVersion
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, forkVersion, nil /*genesisValidatorsRoot*/)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit domain")
+	}
+
+	contracts := make(map[[fieldparams.ContractAddressLength]byte]string, len(keys))
+	data := make([]*depositDataJSON, 0, len(keys))
+	for _, key := range keys {
+		pubKey := key.PublicKey().Marshal()
+		binding, ok := bindings[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			return nil, fmt.Errorf("no contract binding for validator %#x", pubKey)
+		}
+		contract, err := hex.DecodeString(strings.TrimPrefix(binding.ContractAddress, "0x"))
+		if err != nil || len(contract) != fieldparams.ContractAddressLength {
+			return nil, fmt.Errorf("invalid contract address %q for validator %#x", binding.ContractAddress, pubKey)
+		}
+		if other, ok := contracts[bytesutil.ToBytes20(contract)]; ok {
+			return nil, fmt.Errorf("contract %#x is bound to both validator %s and %#x", contract, other, pubKey)
+		}
+		contracts[bytesutil.ToBytes20(contract)] = fmt.Sprintf("%#x", pubKey)
+
+		amount := binding.Amount
+		if amount == 0 {
+			amount = defaultAmount
+		}
+		creds := withdrawalCredentials
+		if creds == nil {
+			creds = deposit.WithdrawalCredentialsHash(key)
+		}
+		msg := &ethpb.DepositMessage{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: creds,
+			Contract:              contract,
+			Amount:                amount,
+		}
+		msgRoot, err := msg.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit message root")
+		}
+		signingRoot, err := (&ethpb.SigningData{ObjectRoot: msgRoot[:], Domain: domain}).HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute signing root")
+		}
+		dd := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: creds,
+			Contract:              contract,
+			Amount:                amount,
+			Signature:             key.Sign(signingRoot[:]).Marshal(),
+		}
+		dataRoot, err := dd.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit data root")
+		}
+		data = append(data, &depositDataJSON{
+			PubKey:                hex.EncodeToString(dd.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(dd.WithdrawalCredentials),
+			ContractAddress:       hex.EncodeToString(dd.Contract),
+			Amount:                dd.Amount,
+			Signature:             hex.EncodeToString(dd.Signature),
+			DepositMessageRoot:    hex.EncodeToString(msgRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+			ForkVersion:           hex.EncodeToString(forkVersion),
+		})
+	}
+	return data, nil
+}
+
+// readDepositBindings reads the JSON list of contract bindings keyed by validator public key.
+func readDepositBindings(path string) (map[[fieldparams.BLSPubkeyLength]byte]*depositBinding, error) {
+	if path == "" {
+		return nil, fmt.Errorf("--%s is required", DepositBindingsFlag.Name)
+	}
+	enc, err := os.ReadFile(path) // #nosec G304 -- path is provided by the operator
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read contract bindings")
+	}
+	var list []*depositBinding
+	if err := json.Unmarshal(enc, &list); err != nil {
+		return nil, errors.Wrap(err, "could not parse contract bindings")
+	}
+	bindings := make(map[[fieldparams.BLSPubkeyLength]byte]*depositBinding, len(list))
+	for _, b := range list {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(b.PubKey, "0x"))
+		if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("invalid validator public key %q in contract bindings", b.PubKey)
+		}
+		if _, ok := bindings[bytesutil.ToBytes48(pubKey)]; ok {
+			return nil, fmt.Errorf("validator %#x has more than one contract binding", pubKey)
+		}
+		bindings[bytesutil.ToBytes48(pubKey)] = b
+	}
+	return bindings, nil
+}
+
+// depositKeys loads the validator keys either from EIP-2335 keystores or from a mnemonic.
+func depositKeys(c *cli.Context) ([]bls.SecretKey, error) {
+	keystoresDir := c.String(KeystoresDirFlag.Name)
+	mnemonicFile := c.String(MnemonicFileFlag.Name)
+	switch {
+	case keystoresDir != "" && mnemonicFile != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be used", KeystoresDirFlag.Name, MnemonicFileFlag.Name)
+	case keystoresDir != "":
+		password, err := os.ReadFile(c.String(KeystoresPasswordFileFlag.Name)) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keystores password")
+		}
+		return keysFromKeystores(keystoresDir, strings.TrimSpace(string(password)))
+	case mnemonicFile != "":
+		mnemonic, err := os.ReadFile(mnemonicFile) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read mnemonic")
+		}
+		return keysFromMnemonic(strings.TrimSpace(string(mnemonic)), c.Uint64(MnemonicStartIndexFlag.Name), c.Uint64(NumValidatorsFlag.Name))
+	default:
+		return nil, fmt.Errorf("one of --%s or --%s is required", KeystoresDirFlag.Name, MnemonicFileFlag.Name)
+	}
+}
+
+func keysFromKeystores(dir, password string) ([]bls.SecretKey, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	decryptor := keystorev4.New()
+	keys := make([]bls.SecretKey, 0, len(paths))
+	for _, p := range paths {
+		enc, err := os.ReadFile(p) // #nosec G304 -- path is provided by the operator
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read keystore %s", p)
+		}
+		ks := &keymanager.Keystore{}
+		if err := json.Unmarshal(enc, ks); err != nil {
+			return nil, errors.Wrapf(err, "could not parse keystore %s", p)
+		}
+		secret, err := decryptor.Decrypt(ks.Crypto, password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decrypt keystore %s", p)
+		}
+		key, err := bls.SecretKeyFromBytes(secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid secret key in keystore %s", p)
+		}
+		if ks.Pubkey != "" {
+			pubKey, err := hex.DecodeString(strings.TrimPrefix(ks.Pubkey, "0x"))
+			if err != nil || !bytes.Equal(pubKey, key.PublicKey().Marshal()) {
+				return nil, fmt.Errorf("keystore %s does not match its public key", p)
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func keysFromMnemonic(mnemonic string, startIndex, count uint64) ([]bls.SecretKey, error) {
+	if count == 0 {
+		return nil, fmt.Errorf("--%s must be greater than zero", NumValidatorsFlag.Name)
+	}
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+	seed := bip39.NewSeed(mnemonic, "" /* 25th word */)
+	keys := make([]bls.SecretKey, 0, count)
+	for i := startIndex; i < startIndex+count; i++ {
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive validator key %d", i)
+		}
+		key, err := bls.SecretKeyFromBytes(privKey.Marshal())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
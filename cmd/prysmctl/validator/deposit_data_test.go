@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const testMnemonic = "tumble turn jewel sudden social great water general cabin jacket bounce dry flip monster advance problem social half flee inform century chicken hard reason"
+
+func testDepositKeys(t *testing.T, n int) []bls.SecretKey {
+	keys := make([]bls.SecretKey, n)
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	return keys
+}
+
+func testBindings(keys []bls.SecretKey, contracts ...byte) map[[fieldparams.BLSPubkeyLength]byte]*depositBinding {
+	bindings := make(map[[fieldparams.BLSPubkeyLength]byte]*depositBinding, len(keys))
+	for i, key := range keys {
+		pubKey := key.PublicKey().Marshal()
+		bindings[bytesutil.ToBytes48(pubKey)] = &depositBinding{
+			PubKey:          hex.EncodeToString(pubKey),
+			ContractAddress: fmt.Sprintf("0x%040x", contracts[i]),
+		}
+	}
+	return bindings
+}
+
+func TestDepositDataForKeys(t *testing.T) {
+	keys := testDepositKeys(t, 2)
+	bindings := testBindings(keys, 1, 2)
+	bindings[bytesutil.ToBytes48(keys[1].PublicKey().Marshal())].Amount = 1_000_000_000
+
+	data, err := depositDataForKeys(keys, bindings, nil, params.BeaconConfig().MaxEffectiveBalance)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(data))
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, data[0].Amount)
+	assert.Equal(t, uint64(1_000_000_000), data[1].Amount)
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	for i, d := range data {
+		dd := depositDataFromJSON(t, d)
+		assert.DeepEqual(t, keys[i].PublicKey().Marshal(), dd.PublicKey)
+		assert.DeepEqual(t, deposit.WithdrawalCredentialsHash(keys[i]), dd.WithdrawalCredentials)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{}, 19), dd.Contract[:19])
+		assert.Equal(t, byte(i+1), dd.Contract[19])
+		require.NoError(t, deposit.VerifyDepositSignature(dd, domain))
+		root, err := dd.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(root[:]), d.DepositDataRoot)
+		assert.Equal(t, hex.EncodeToString(params.BeaconConfig().GenesisForkVersion), d.ForkVersion)
+	}
+}
+
+func TestDepositDataForKeys_WithdrawalCredentials(t *testing.T) {
+	keys := testDepositKeys(t, 1)
+	creds := append([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, make([]byte, 31)...)
+	data, err := depositDataForKeys(keys, testBindings(keys, 1), creds, 1)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(creds), data[0].WithdrawalCredentials)
+}
+
+func TestDepositDataForKeys_Errors(t *testing.T) {
+	keys := testDepositKeys(t, 2)
+
+	_, err := depositDataForKeys(keys, testBindings(keys, 1, 1), nil, 1)
+	require.ErrorContains(t, "is bound to both validator", err)
+
+	_, err = depositDataForKeys(keys, testBindings(keys[:1], 1), nil, 1)
+	require.ErrorContains(t, "found 1 contract bindings for 2 validator keys", err)
+
+	other := testDepositKeys(t, 1)
+	_, err = depositDataForKeys(keys[:1], testBindings(other, 1), nil, 1)
+	require.ErrorContains(t, "no contract binding for validator", err)
+
+	bindings := testBindings(keys[:1], 1)
+	bindings[bytesutil.ToBytes48(keys[0].PublicKey().Marshal())].ContractAddress = "0x01"
+	_, err = depositDataForKeys(keys[:1], bindings, nil, 1)
+	require.ErrorContains(t, "invalid contract address", err)
+}
+
+func TestReadDepositBindings_Duplicate(t *testing.T) {
+	keys := testDepositKeys(t, 1)
+	pubKey := hex.EncodeToString(keys[0].PublicKey().Marshal())
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	enc, err := json.Marshal([]*depositBinding{
+		{PubKey: pubKey, ContractAddress: fmt.Sprintf("0x%040x", 1)},
+		{PubKey: "0x" + pubKey, ContractAddress: fmt.Sprintf("0x%040x", 2)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, enc, 0600))
+
+	_, err = readDepositBindings(path)
+	require.ErrorContains(t, "has more than one contract binding", err)
+}
+
+func TestKeysFromKeystores(t *testing.T) {
+	keys := testDepositKeys(t, 2)
+	dir := t.TempDir()
+	encryptor := keystorev4.New()
+	for i, key := range keys {
+		crypto, err := encryptor.Encrypt(key.Marshal(), "password")
+		require.NoError(t, err)
+		enc, err := json.Marshal(map[string]interface{}{
+			"crypto":  crypto,
+			"pubkey":  hex.EncodeToString(key.PublicKey().Marshal()),
+			"version": encryptor.Version(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("keystore-%d.json", i)), enc, 0600))
+	}
+
+	loaded, err := keysFromKeystores(dir, "password")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(loaded))
+	for i := range keys {
+		assert.DeepEqual(t, keys[i].Marshal(), loaded[i].Marshal())
+	}
+
+	_, err = keysFromKeystores(dir, "wrong")
+	require.ErrorContains(t, "could not decrypt keystore", err)
+}
+
+func TestGenerateDepositData_Mnemonic(t *testing.T) {
+	dir := t.TempDir()
+	mnemonicPath := filepath.Join(dir, "mnemonic.txt")
+	require.NoError(t, os.WriteFile(mnemonicPath, []byte(testMnemonic+"\n"), 0600))
+	keys, err := keysFromMnemonic(testMnemonic, 3, 2)
+	require.NoError(t, err)
+	bindingsPath := filepath.Join(dir, "bindings.json")
+	bindings := make([]*depositBinding, len(keys))
+	for i, key := range keys {
+		bindings[i] = &depositBinding{
+			PubKey:          hex.EncodeToString(key.PublicKey().Marshal()),
+			ContractAddress: fmt.Sprintf("0x%040x", i+1),
+		}
+	}
+	enc, err := json.Marshal(bindings)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bindingsPath, enc, 0600))
+	outputPath := filepath.Join(dir, "deposit_data.json")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(MnemonicFileFlag.Name, mnemonicPath, "")
+	set.Uint64(MnemonicStartIndexFlag.Name, 3, "")
+	set.Uint64(NumValidatorsFlag.Name, 2, "")
+	set.String(DepositBindingsFlag.Name, bindingsPath, "")
+	set.Uint64(DepositAmountFlag.Name, 0, "")
+	set.String(DepositDataOutputFlag.Name, outputPath, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, generateDepositData(cliCtx))
+
+	enc, err = os.ReadFile(outputPath)
+	require.NoError(t, err)
+	var data []*depositDataJSON
+	require.NoError(t, json.Unmarshal(enc, &data))
+	require.Equal(t, 2, len(data))
+	for i, d := range data {
+		assert.Equal(t, bindings[i].PubKey, d.PubKey)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, d.Amount)
+	}
+}
+
+func depositDataFromJSON(t *testing.T, d *depositDataJSON) *ethpb.Deposit_Data {
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+	return &ethpb.Deposit_Data{
+		PublicKey:             decode(d.PubKey),
+		WithdrawalCredentials: decode(d.WithdrawalCredentials),
+		Contract:              decode(d.ContractAddress),
+		Amount:                d.Amount,
+		Signature:             decode(d.Signature),
+	}
+}
@@ -61,7 +61,10 @@ type Flags struct {
 	EnableSlashingProtectionPruning bool // EnableSlashingProtectionPruning for the validator client.
 
 	SaveFullExecutionPayloads bool // Save full beacon blocks with execution payloads in the database.
+	SaveActivityHistory       bool // Save validators' per-epoch activity history in the database.
+	EnableActivityAnomalies   bool // EnableActivityAnomalies reports anomalous contract activities found in processed blocks.
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
+	EnableLightClient         bool // EnableLightClient computes, persists and gossips light client updates for the imported blocks.
 
 	DisableResourceManager      bool // Disables running the node with libp2p's resource manager.
 	DisableStakingContractCheck bool // Disables check for deposit contract when proposing blocks
@@ -261,6 +264,18 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(SaveFullExecutionPayloads)
 		cfg.SaveFullExecutionPayloads = true
 	}
+	if ctx.Bool(saveActivityHistory.Name) {
+		logEnabled(saveActivityHistory)
+		cfg.SaveActivityHistory = true
+	}
+	if ctx.Bool(enableActivityAnomalyDetection.Name) {
+		logEnabled(enableActivityAnomalyDetection)
+		cfg.EnableActivityAnomalies = true
+	}
+	if ctx.Bool(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
 	if ctx.Bool(enableStartupOptimistic.Name) {
 		logEnabled(enableStartupOptimistic)
 		cfg.EnableStartOptimistic = true
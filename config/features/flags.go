@@ -145,6 +145,19 @@ var (
 		Name:  "save-full-execution-payloads",
 		Usage: "Saves beacon blocks with full execution payloads instead of execution payload headers in the database",
 	}
+	saveActivityHistory = &cli.BoolFlag{
+		Name:  "save-activity-history",
+		Usage: "Saves validators' per-epoch activity, effective activity and contract in the database at every epoch transition",
+	}
+	enableActivityAnomalyDetection = &cli.BoolFlag{
+		Name:  "enable-activity-anomaly-detection",
+		Usage: "Inspects the activity changes of processed blocks and reports contracts with anomalous activity",
+	}
+	enableLightClient = &cli.BoolFlag{
+		Name: "enable-lightclient",
+		Usage: "Computes the best light client update of every sync committee period, serves the light client " +
+			"REST API and gossips light client finality and optimistic updates",
+	}
 	EnableBeaconRESTApi = &cli.BoolFlag{
 		Name:  "enable-beacon-rest-api",
 		Usage: "Experimental enable of the beacon REST API when querying a beacon node",
@@ -238,6 +251,9 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableStakingContractCheck,
 	disableReorgLateBlocks,
 	SaveFullExecutionPayloads,
+	saveActivityHistory,
+	enableActivityAnomalyDetection,
+	enableLightClient,
 	enableStartupOptimistic,
 	enableFullSSZDataLogging,
 	enableVerboseSigVerification,
@@ -14,6 +14,9 @@ func testFieldParametersMatchConfig(t *testing.T) {
 	require.Equal(t, params.BeaconConfig().HistoricalRootsLimit, uint64(fieldparams.HistoricalRootsLength))
 	require.Equal(t, uint64(params.BeaconConfig().EpochsPerHistoricalVector), uint64(fieldparams.RandaoMixesLength))
 	require.Equal(t, params.BeaconConfig().ValidatorRegistryLimit, uint64(fieldparams.ValidatorRegistryLimit))
+	require.Equal(t, params.BeaconConfig().ValidatorRegistryLimit*params.BeaconConfig().MaxContractsPerValidator, uint64(fieldparams.AdditionalContractsLength))
+	require.Equal(t, params.BeaconConfig().PendingActivityCreditsLimit, uint64(fieldparams.PendingActivityCreditsLength))
+	require.Equal(t, params.BeaconConfig().RejectedContractBindingsLimit, uint64(fieldparams.RejectedContractBindingsLength))
 	require.Equal(t, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod))), uint64(fieldparams.Eth1DataVotesLength))
 	require.Equal(t, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations)), uint64(fieldparams.PreviousEpochAttestationsLength))
 	require.Equal(t, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations)), uint64(fieldparams.CurrentEpochAttestationsLength))
@@ -9,6 +9,9 @@ const (
 	RandaoMixesLength                     = 64            // EPOCHS_PER_HISTORICAL_VECTOR
 	HistoricalRootsLength                 = 16777216      // HISTORICAL_ROOTS_LIMIT
 	ValidatorRegistryLimit                = 1099511627776 // VALIDATOR_REGISTRY_LIMIT
+	AdditionalContractsLength             = 8796093022208 // VALIDATOR_REGISTRY_LIMIT * MAX_CONTRACTS_PER_VALIDATOR
+	PendingActivityCreditsLength          = 65536         // PENDING_ACTIVITY_CREDITS_LIMIT
+	RejectedContractBindingsLength        = 4096          // REJECTED_CONTRACT_BINDINGS_LIMIT
 	Eth1DataVotesLength                   = 32            // SLOTS_PER_ETH1_VOTING_PERIOD
 	PreviousEpochAttestationsLength       = 1024          // MAX_ATTESTATIONS * SLOTS_PER_EPOCH
 	CurrentEpochAttestationsLength        = 1024          // MAX_ATTESTATIONS * SLOTS_PER_EPOCH
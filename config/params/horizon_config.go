@@ -35,6 +35,8 @@ func HorizonConfig() *BeaconChainConfig {
 	cfg.CapellaForkVersion = []byte{3, 0, 25, 52}
 	cfg.DenebForkEpoch = cfg.FarFutureEpoch
 	cfg.DenebForkVersion = []byte{4, 0, 25, 52}
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	cfg.ElectraForkVersion = []byte{5, 0, 25, 52}
 	cfg.TerminalTotalDifficulty = "167079"
 	cfg.DepositContractAddress = "0xc235be402811955ab4e9a9753e13d103781740f7"
 	cfg.InitializeForkSchedule()
@@ -168,7 +168,12 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DomainBlobSidecar:                 bytesutil.Uint32ToBytes4(0x0B000000),
 
 	// FastexChain consensus constants.
-	EpochsPerActivityPeriod: 1575, // One week (12s * 32 * 1575)
+	EpochsPerActivityPeriod:       1575, // One week (12s * 32 * 1575)
+	MaxContractTransfers:          16,
+	DomainContractTransfer:        bytesutil.Uint32ToBytes4(0x0C000000),
+	MaxContractsPerValidator:      8,
+	PendingActivityCreditsLimit:   65536,
+	RejectedContractBindingsLimit: 4096,
 
 	// Prysm constants.
 	GweiPerEth:                     1000000000,
@@ -192,6 +197,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	BeaconStateBellatrixFieldCount: 27,
 	BeaconStateCapellaFieldCount:   30,
 	BeaconStateDenebFieldCount:     30,
+	BeaconStateElectraFieldCount:   34,
 
 	// Slasher related values.
 	WeakSubjectivityPeriod:          54000,
@@ -212,6 +218,8 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	CapellaForkEpoch:     mainnetCapellaForkEpoch,
 	DenebForkVersion:     []byte{4, 0, 51, 65},
 	DenebForkEpoch:       math.MaxUint64,
+	ElectraForkVersion:   []byte{5, 0, 51, 65},
+	ElectraForkEpoch:     math.MaxUint64,
 
 	// New values introduced in Altair hard fork 1.
 	// Participation flag indices.
@@ -287,16 +295,19 @@ func FillTestVersions(c *BeaconChainConfig, b byte) {
 	c.BellatrixForkVersion = make([]byte, fieldparams.VersionLength)
 	c.CapellaForkVersion = make([]byte, fieldparams.VersionLength)
 	c.DenebForkVersion = make([]byte, fieldparams.VersionLength)
+	c.ElectraForkVersion = make([]byte, fieldparams.VersionLength)
 
 	c.GenesisForkVersion[fieldparams.VersionLength-1] = b
 	c.AltairForkVersion[fieldparams.VersionLength-1] = b
 	c.BellatrixForkVersion[fieldparams.VersionLength-1] = b
 	c.CapellaForkVersion[fieldparams.VersionLength-1] = b
 	c.DenebForkVersion[fieldparams.VersionLength-1] = b
+	c.ElectraForkVersion[fieldparams.VersionLength-1] = b
 
 	c.GenesisForkVersion[0] = 0
 	c.AltairForkVersion[0] = 1
 	c.BellatrixForkVersion[0] = 2
 	c.CapellaForkVersion[0] = 3
 	c.DenebForkVersion[0] = 4
+	c.ElectraForkVersion[0] = 5
 }
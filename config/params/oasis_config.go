@@ -36,6 +36,8 @@ func OasisConfig() *BeaconChainConfig {
 	cfg.CapellaForkVersion = []byte{3, 0, 40, 90}
 	cfg.DenebForkEpoch = cfg.FarFutureEpoch
 	cfg.DenebForkVersion = []byte{4, 0, 40, 90}
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	cfg.ElectraForkVersion = []byte{5, 0, 40, 90}
 	cfg.TerminalTotalDifficulty = "1205400" // ~ 2023-07-25 08:03:10 UTC
 	cfg.DepositContractAddress = "0x84e09dbd827b66bfa66f42fc50dc732c01790122"
 	cfg.InitializeForkSchedule()
@@ -34,6 +34,8 @@ func OceanConfig() *BeaconChainConfig {
 	cfg.CapellaForkVersion = []byte{3, 0, 40, 58}
 	cfg.DenebForkEpoch = cfg.FarFutureEpoch
 	cfg.DenebForkVersion = []byte{4, 0, 40, 58}
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	cfg.ElectraForkVersion = []byte{5, 0, 40, 58}
 	cfg.TerminalTotalDifficulty = "514667"
 	cfg.DepositContractAddress = "0xCC0F57056771a04fB56436F6A565De98dD6B1915"
 	cfg.InitializeForkSchedule()
@@ -44,6 +44,8 @@ func PraterConfig() *BeaconChainConfig {
 	cfg.CapellaForkVersion = []byte{0x3, 0x0, 0x10, 0x20}
 	cfg.DenebForkEpoch = math.MaxUint64
 	cfg.DenebForkVersion = []byte{0x4, 0x0, 0x10, 0x20}
+	cfg.ElectraForkEpoch = math.MaxUint64
+	cfg.ElectraForkVersion = []byte{0x5, 0x0, 0x10, 0x20}
 	cfg.TerminalTotalDifficulty = "10790000"
 	cfg.DepositContractAddress = "0xff50ed3d0ec03aC01D4C79aAd74928BFF48a7b2b"
 	cfg.InitializeForkSchedule()
@@ -64,6 +64,14 @@ func NewSignedBeaconBlock(i interface{}) (interfaces.SignedBeaconBlock, error) {
 		return initBlindedSignedBlockFromProtoDeneb(b)
 	case *eth.GenericSignedBeaconBlock_BlindedDeneb:
 		return initBlindedSignedBlockFromProtoDeneb(b.BlindedDeneb.SignedBlindedBlock)
+	case *eth.GenericSignedBeaconBlock_Electra:
+		return initSignedBlockFromProtoElectra(b.Electra.Block)
+	case *eth.SignedBeaconBlockElectra:
+		return initSignedBlockFromProtoElectra(b)
+	case *eth.SignedBlindedBeaconBlockElectra:
+		return initBlindedSignedBlockFromProtoElectra(b)
+	case *eth.GenericSignedBeaconBlock_BlindedElectra:
+		return initBlindedSignedBlockFromProtoElectra(b.BlindedElectra.SignedBlindedBlock)
 	default:
 		return nil, errors.Wrapf(ErrUnsupportedSignedBeaconBlock, "unable to create block from type %T", i)
 	}
@@ -106,6 +114,14 @@ func NewBeaconBlock(i interface{}) (interfaces.ReadOnlyBeaconBlock, error) {
 		return initBlindedBlockFromProtoDeneb(b)
 	case *eth.GenericBeaconBlock_BlindedDeneb:
 		return initBlindedBlockFromProtoDeneb(b.BlindedDeneb.Block)
+	case *eth.GenericBeaconBlock_Electra:
+		return initBlockFromProtoElectra(b.Electra.Block)
+	case *eth.BeaconBlockElectra:
+		return initBlockFromProtoElectra(b)
+	case *eth.BlindedBeaconBlockElectra:
+		return initBlindedBlockFromProtoElectra(b)
+	case *eth.GenericBeaconBlock_BlindedElectra:
+		return initBlindedBlockFromProtoElectra(b.BlindedElectra.Block)
 	default:
 		return nil, errors.Wrapf(errUnsupportedBeaconBlock, "unable to create block from type %T", i)
 	}
@@ -132,6 +148,10 @@ func NewBeaconBlockBody(i interface{}) (interfaces.ReadOnlyBeaconBlockBody, erro
 		return initBlockBodyFromProtoDeneb(b)
 	case *eth.BlindedBeaconBlockBodyDeneb:
 		return initBlindedBlockBodyFromProtoDeneb(b)
+	case *eth.BeaconBlockBodyElectra:
+		return initBlockBodyFromProtoElectra(b)
+	case *eth.BlindedBeaconBlockBodyElectra:
+		return initBlindedBlockBodyFromProtoElectra(b)
 	default:
 		return nil, errors.Wrapf(errUnsupportedBeaconBlockBody, "unable to create block body from type %T", i)
 	}
@@ -198,6 +218,19 @@ func BuildSignedBeaconBlock(blk interfaces.ReadOnlyBeaconBlock, signature []byte
 			return nil, errIncorrectBlockVersion
 		}
 		return NewSignedBeaconBlock(&eth.SignedBeaconBlockDeneb{Block: pb, Signature: signature})
+	case version.Electra:
+		if blk.IsBlinded() {
+			pb, ok := pb.(*eth.BlindedBeaconBlockElectra)
+			if !ok {
+				return nil, errIncorrectBlockVersion
+			}
+			return NewSignedBeaconBlock(&eth.SignedBlindedBeaconBlockElectra{Message: pb, Signature: signature})
+		}
+		pb, ok := pb.(*eth.BeaconBlockElectra)
+		if !ok {
+			return nil, errIncorrectBlockVersion
+		}
+		return NewSignedBeaconBlock(&eth.SignedBeaconBlockElectra{Block: pb, Signature: signature})
 	default:
 		return nil, errUnsupportedBeaconBlock
 	}
@@ -332,6 +365,40 @@ func BuildSignedBeaconBlockFromExecutionPayload(
 		if err != nil {
 			return nil, err
 		}
+		if b.Version() >= version.Electra {
+			contractTransfers, err := b.Body().ContractTransfers()
+			if err != nil {
+				return nil, err
+			}
+			fullBlock = &eth.SignedBeaconBlockElectra{
+				Block: &eth.BeaconBlockElectra{
+					Slot:          b.Slot(),
+					ProposerIndex: b.ProposerIndex(),
+					ParentRoot:    parentRoot[:],
+					StateRoot:     stateRoot[:],
+					Body: &eth.BeaconBlockBodyElectra{
+						RandaoReveal:          randaoReveal[:],
+						Eth1Data:              b.Body().Eth1Data(),
+						Graffiti:              graffiti[:],
+						ProposerSlashings:     b.Body().ProposerSlashings(),
+						AttesterSlashings:     b.Body().AttesterSlashings(),
+						Attestations:          b.Body().Attestations(),
+						Deposits:              b.Body().Deposits(),
+						VoluntaryExits:        b.Body().VoluntaryExits(),
+						ActivityChanges:       b.Body().ActivityChanges(),
+						TransactionsCount:     b.Body().TransactionsCount(),
+						BaseFee:               b.Body().BaseFee(),
+						SyncAggregate:         syncAgg,
+						ExecutionPayload:      p,
+						BlsToExecutionChanges: blsToExecutionChanges,
+						BlobKzgCommitments:    commitments,
+						ContractTransfers:     contractTransfers,
+					},
+				},
+				Signature: sig[:],
+			}
+			break
+		}
 		fullBlock = &eth.SignedBeaconBlockDeneb{
 			Block: &eth.BeaconBlockDeneb{
 				Slot:          b.Slot(),
@@ -369,6 +436,10 @@ func BuildSignedBeaconBlockFromExecutionPayload(
 // This is particularly useful for using the values from API calls.
 func BeaconBlockContainerToSignedBeaconBlock(obj *eth.BeaconBlockContainer) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	switch obj.Block.(type) {
+	case *eth.BeaconBlockContainer_BlindedElectraBlock:
+		return NewSignedBeaconBlock(obj.GetBlindedElectraBlock())
+	case *eth.BeaconBlockContainer_ElectraBlock:
+		return NewSignedBeaconBlock(obj.GetElectraBlock())
 	case *eth.BeaconBlockContainer_BlindedDenebBlock:
 		return NewSignedBeaconBlock(obj.GetBlindedDenebBlock())
 	case *eth.BeaconBlockContainer_DenebBlock:
@@ -1,10 +1,12 @@
 package blocks
 
 import (
+	"errors"
 	"testing"
 
 	ssz "github.com/prysmaticlabs/fastssz"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	consensus_types "github.com/prysmaticlabs/prysm/v4/consensus-types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
@@ -418,6 +420,19 @@ func Test_BeaconBlockBody_BLSToExecutionChanges(t *testing.T) {
 	assert.DeepSSZEqual(t, result, changes)
 }
 
+func Test_BeaconBlockBody_ContractTransfers(t *testing.T) {
+	transfers := []*eth.SignedContractTransfer{{Message: &eth.ContractTransfer{ValidatorIndex: 1, RecipientIndex: 2, Contract: make([]byte, 20)}}}
+	bb := &SignedBeaconBlock{version: version.Electra, block: &BeaconBlock{body: &BeaconBlockBody{version: version.Electra}}}
+	require.NoError(t, bb.SetContractTransfers(transfers))
+	result, err := bb.Block().Body().ContractTransfers()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, result, transfers)
+
+	bb = &SignedBeaconBlock{version: version.Deneb, block: &BeaconBlock{body: &BeaconBlockBody{version: version.Deneb}}}
+	_, err = bb.Block().Body().ContractTransfers()
+	assert.Equal(t, true, errors.Is(err, consensus_types.ErrUnsupportedField))
+}
+
 func Test_BeaconBlockBody_Execution(t *testing.T) {
 	execution := &pb.ExecutionPayload{BlockNumber: 1}
 	e, err := WrappedExecutionPayload(execution)
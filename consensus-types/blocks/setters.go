@@ -149,10 +149,24 @@ func (b *SignedBeaconBlock) SetBlobKzgCommitments(c [][]byte) error {
 	switch b.version {
 	case version.Phase0, version.Altair, version.Bellatrix, version.Capella:
 		return consensus_types.ErrNotSupported("SetBlobKzgCommitments", b.version)
-	case version.Deneb:
+	case version.Deneb, version.Electra:
 		b.block.body.blobKzgCommitments = c
 		return nil
 	default:
 		return errIncorrectBlockVersion
 	}
 }
+
+// SetContractTransfers sets the contract transfers in the block.
+// This function is not thread safe, it is only used during block creation.
+func (b *SignedBeaconBlock) SetContractTransfers(contractTransfers []*eth.SignedContractTransfer) error {
+	switch b.version {
+	case version.Phase0, version.Altair, version.Bellatrix, version.Capella, version.Deneb:
+		return consensus_types.ErrNotSupported("ContractTransfers", b.version)
+	case version.Electra:
+		b.block.body.contractTransfers = contractTransfers
+		return nil
+	default:
+		return errIncorrectBlockVersion
+	}
+}
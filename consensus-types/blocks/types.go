@@ -53,6 +53,7 @@ type BeaconBlockBody struct {
 	executionPayloadHeader interfaces.ExecutionData
 	blsToExecutionChanges  []*eth.SignedBLSToExecutionChange
 	blobKzgCommitments     [][]byte
+	contractTransfers      []*eth.SignedContractTransfer
 }
 
 // BeaconBlock is the main beacon block structure. It can represent any block type.
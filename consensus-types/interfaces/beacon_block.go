@@ -28,6 +28,8 @@ type ReadOnlySignedBeaconBlock interface {
 	PbDenebBlock() (*ethpb.SignedBeaconBlockDeneb, error)
 	PbBlindedCapellaBlock() (*ethpb.SignedBlindedBeaconBlockCapella, error)
 	PbBlindedDenebBlock() (*ethpb.SignedBlindedBeaconBlockDeneb, error)
+	PbElectraBlock() (*ethpb.SignedBeaconBlockElectra, error)
+	PbBlindedElectraBlock() (*ethpb.SignedBlindedBeaconBlockElectra, error)
 	ssz.Marshaler
 	ssz.Unmarshaler
 	Version() int
@@ -77,6 +79,7 @@ type ReadOnlyBeaconBlockBody interface {
 	Execution() (ExecutionData, error)
 	BLSToExecutionChanges() ([]*ethpb.SignedBLSToExecutionChange, error)
 	BlobKzgCommitments() ([][]byte, error)
+	ContractTransfers() ([]*ethpb.SignedContractTransfer, error)
 }
 
 type SignedBeaconBlock interface {
@@ -84,6 +87,7 @@ type SignedBeaconBlock interface {
 	SetExecution(ExecutionData) error
 	SetBLSToExecutionChanges([]*ethpb.SignedBLSToExecutionChange) error
 	SetBlobKzgCommitments(c [][]byte) error
+	SetContractTransfers([]*ethpb.SignedContractTransfer) error
 	SetSyncAggregate(*ethpb.SyncAggregate) error
 	SetBaseFee(uint64)
 	SetTransactionsCount(uint64)
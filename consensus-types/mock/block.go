@@ -74,6 +74,14 @@ func (SignedBeaconBlock) PbBlindedDenebBlock() (*eth.SignedBlindedBeaconBlockDen
 	panic("implement me")
 }
 
+func (SignedBeaconBlock) PbElectraBlock() (*eth.SignedBeaconBlockElectra, error) {
+	panic("implement me")
+}
+
+func (SignedBeaconBlock) PbBlindedElectraBlock() (*eth.SignedBlindedBeaconBlockElectra, error) {
+	panic("implement me")
+}
+
 func (SignedBeaconBlock) MarshalSSZTo(_ []byte) ([]byte, error) {
 	panic("implement me")
 }
@@ -323,6 +331,11 @@ func (b *BeaconBlockBody) BlobKzgCommitments() ([][]byte, error) {
 	panic("implement me")
 }
 
+// ContractTransfers returns the contract transfers in the block.
+func (b *BeaconBlockBody) ContractTransfers() ([]*eth.SignedContractTransfer, error) {
+	panic("implement me")
+}
+
 func (b *BeaconBlockBody) Attestations() []*eth.Attestation {
 	panic("implement me")
 }
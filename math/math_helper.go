@@ -189,6 +189,20 @@ func Mod64(a, b uint64) (uint64, error) {
 	return val, nil
 }
 
+// MulDiv64 computes a * b / c with a 128-bit intermediate product, so only the quotient has to fit
+// into 64 bits. It returns ErrOverflow if it does not.
+func MulDiv64(a, b, c uint64) (uint64, error) {
+	if c == 0 {
+		return 0, ErrDivByZero
+	}
+	hi, lo := bits.Mul64(a, b)
+	if hi >= c {
+		return 0, ErrOverflow
+	}
+	val, _ := bits.Div64(hi, lo, c)
+	return val, nil
+}
+
 // Int returns the integer value of the uint64 argument. If there is an overflow, then an error is
 // returned.
 func Int(u uint64) (int, error) {
@@ -401,6 +401,31 @@ func TestMul64(t *testing.T) {
 	}
 }
 
+func TestMulDiv64(t *testing.T) {
+	tests := []struct {
+		a, b, c uint64
+		res     uint64
+		err     error
+	}{
+		{a: 0, b: 1, c: 1, res: 0},
+		{a: 10, b: 3, c: 4, res: 7},
+		{a: 1 << 63, b: 4, c: 8, res: 1 << 62},
+		{a: stdmath.MaxUint64, b: stdmath.MaxUint64, c: stdmath.MaxUint64, res: stdmath.MaxUint64},
+		{a: stdmath.MaxUint64, b: 2, c: 1, err: math.ErrOverflow},
+		{a: 1 << 32, b: 1 << 32, c: 1, err: math.ErrOverflow},
+		{a: 1, b: 1, c: 0, err: math.ErrDivByZero},
+	}
+	for _, tt := range tests {
+		got, err := math.MulDiv64(tt.a, tt.b, tt.c)
+		if tt.err != nil {
+			require.ErrorIs(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tt.res, got)
+	}
+}
+
 func TestAdd64(t *testing.T) {
 	type args struct {
 		a uint64
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 55b2b05c400bd24ca3ba55c72dbb8ecad944165701af27d270abb0079f8ce14e
+// Hash: 86eda8ce5ee59b3ccc1187a0232fa2119ba68731f019d82604dc6e172f7397c6
 package eth
 
 import (
@@ -800,6 +800,232 @@ func (s *SignedBlindedBeaconBlockDeneb) HashTreeRootWith(hh *ssz.Hasher) (err er
 	return
 }
 
+// MarshalSSZ ssz marshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockElectra object to a target array
+func (s *SignedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Message == nil {
+		s.Message = new(BeaconBlockElectra)
+	}
+	offset += s.Message.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(BeaconBlockElectra)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BeaconBlockElectra)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockElectra object with a hasher
+func (s *SignedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockElectra object to a target array
+func (s *SignedBlindedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Message == nil {
+		s.Message = new(BlindedBeaconBlockElectra)
+	}
+	offset += s.Message.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(BlindedBeaconBlockElectra)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BlindedBeaconBlockElectra)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockElectra object with a hasher
+func (s *SignedBlindedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the BeaconBlockAltair object
 func (b *BeaconBlockAltair) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -1857,235 +2083,2958 @@ func (b *BlindedBeaconBlockDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
-func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockElectra object to a target array
+func (b *BeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(400)
+	offset := int(84)
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
 		return
 	}
+	dst = append(dst, b.ParentRoot...)
 
-	// Field (2) 'Graffiti'
-	if size := len(b.Graffiti); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
 		return
 	}
-	dst = append(dst, b.Graffiti...)
-
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+	dst = append(dst, b.StateRoot...)
 
-	// Offset (4) 'AttesterSlashings'
+	// Offset (4) 'Body'
 	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyElectra)
 	}
+	offset += b.Body.SizeSSZ()
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1260
+	return
+}
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
+// UnmarshalSSZ ssz unmarshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
 
-	// Offset (8) 'ActivityChanges'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ActivityChanges) * 28
+	tail := buf
+	var o4 uint64
 
-	// Field (9) 'TransactionsCount'
-	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+	// Field (0) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
 
-	// Field (10) 'BaseFee'
-	dst = ssz.MarshalUint64(dst, b.BaseFee)
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
 
-	// Field (11) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(v1.SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
 	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
-		return
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
 	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
-		return
+	if o4 < 84 {
+		return ssz.ErrInvalidVariableOffset
 	}
+
+	// Field (4) 'Body'
 	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBodyElectra)
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
 		}
 	}
+	return err
+}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
-		return
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockElectra object
+func (b *BeaconBlockElectra) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyElectra)
 	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
-		}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockElectra object
+func (b *BeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockElectra object with a hasher
+func (b *BeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
 	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
 	}
+	hh.PutBytes(b.StateRoot)
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockElectra object to a target array
+func (b *BlindedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
 	}
+	dst = append(dst, b.ParentRoot...)
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
 		return
 	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	dst = append(dst, b.StateRoot...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Body == nil {
+		b.Body = new(BlindedBeaconBlockBodyElectra)
 	}
+	offset += b.Body.SizeSSZ()
 
-	// Field (8) 'ActivityChanges'
-	if size := len(b.ActivityChanges); size > 1073741824 {
-		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.ActivityChanges); ii++ {
-		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 400 {
+	if size < 84 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8 uint64
+	var o4 uint64
 
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	// Field (0) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
 	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
 
-	// Field (2) 'Graffiti'
-	if cap(b.Graffiti) == 0 {
-		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
 	}
-	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 400 {
+	if o4 < 84 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BlindedBeaconBlockBodyElectra)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
+	return err
+}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 84
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BlindedBeaconBlockBodyElectra)
 	}
+	size += b.Body.SizeSSZ()
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
-	}
+	return
+}
 
-	// Offset (8) 'ActivityChanges'
-	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
-		return ssz.ErrOffset
-	}
+// HashTreeRoot ssz hashes the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (9) 'TransactionsCount'
-	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockElectra object with a hasher
+func (b *BlindedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (10) 'BaseFee'
-	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
+func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(400)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 400 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 400 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*v1.Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(v1.Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*v1.Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(v1.Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
+	size = 400
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyAltair object
+func (b *BeaconBlockBodyAltair) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyAltair object with a hasher
+func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyBellatrix object to a target array
+func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(404)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayload)
+	}
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 404 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 404 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'ExecutionPayload'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*v1.Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(v1.Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*v1.Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(v1.Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayload'
+	{
+		buf = tail[o12:]
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(v11.ExecutionPayload)
+		}
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
+	size = 404
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	// Field (12) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayload)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyBellatrix object
+func (b *BeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyBellatrix object with a hasher
+func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyBellatrix object
+func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyBellatrix object to a target array
+func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(404)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'ExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+	}
+	offset += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyBellatrix object
+func (b *BlindedBeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 404 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 404 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'ExecutionPayloadHeader'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*v1.Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(v1.Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*v1.Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(v1.Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	{
+		buf = tail[o12:]
+		if b.ExecutionPayloadHeader == nil {
+			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+		}
+		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyBellatrix object
+func (b *BlindedBeaconBlockBodyBellatrix) SizeSSZ() (size int) {
+	size = 404
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+	}
+	size += b.ExecutionPayloadHeader.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyBellatrix object
+func (b *BlindedBeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyBellatrix object with a hasher
+func (b *BlindedBeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBodyCapella object to a target array
+func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(408)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
+	}
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 408 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12, o13 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 408 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'ExecutionPayload'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*v1.Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(v1.Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*v1.Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(v1.Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayload'
+	{
+		buf = tail[o12:o13]
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
+		}
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		buf = tail[o13:]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) SizeSSZ() (size int) {
+	size = 408
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	// Field (12) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyCapella object
+func (b *BeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyCapella object with a hasher
+func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyCapella object
+func (b *BlindedBeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyCapella object to a target array
+func (b *BlindedBeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(408)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'ExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+	}
+	offset += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyCapella object
+func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 408 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12, o13 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(v1.Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 408 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
 
 	// Field (11) 'SyncAggregate'
 	if b.SyncAggregate == nil {
@@ -2095,6 +5044,16 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
+	// Offset (12) 'ExecutionPayloadHeader'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
 	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
@@ -2193,19 +5152,48 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (8) 'ActivityChanges'
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	{
+		buf = tail[o12:o13]
+		if b.ExecutionPayloadHeader == nil {
+			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+		}
+		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
 	{
-		buf = tail[o8:]
-		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		buf = tail[o13:]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
 		if err != nil {
 			return err
 		}
-		b.ActivityChanges = make([]*v1.ActivityChange, num)
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
 		for ii := 0; ii < num; ii++ {
-			if b.ActivityChanges[ii] == nil {
-				b.ActivityChanges[ii] = new(v1.ActivityChange)
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
 			}
-			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
 				return err
 			}
 		}
@@ -2213,9 +5201,9 @@ func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
-	size = 400
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyCapella object
+func (b *BlindedBeaconBlockBodyCapella) SizeSSZ() (size int) {
+	size = 408
 
 	// Field (3) 'ProposerSlashings'
 	size += len(b.ProposerSlashings) * 416
@@ -2241,16 +5229,25 @@ func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
 	// Field (8) 'ActivityChanges'
 	size += len(b.ActivityChanges) * 28
 
+	// Field (12) 'ExecutionPayloadHeader'
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+	}
+	size += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyCapella object
+func (b *BlindedBeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyAltair object with a hasher
-func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyCapella object with a hasher
+func (b *BlindedBeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -2403,6 +5400,31 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		return
 	}
 
+	// Field (12) 'ExecutionPayloadHeader'
+	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -2411,15 +5433,15 @@ func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyBellatrix object to a target array
-func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyDeneb object to a target array
+func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(404)
+	offset := int(412)
 
 	// Field (0) 'RandaoReveal'
 	if size := len(b.RandaoReveal); size != 96 {
@@ -2487,12 +5509,20 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 		return
 	}
 
-	// Offset (12) 'ExecutionPayload'
+	// Offset (12) 'ExecutionPayloadHeader'
 	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayload)
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 	}
-	offset += b.ExecutionPayload.SizeSSZ()
+	offset += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Offset (14) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
 
 	// Field (3) 'ProposerSlashings'
 	if size := len(b.ProposerSlashings); size > 16 {
@@ -2574,24 +5604,48 @@ func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err err
 		}
 	}
 
-	// Field (12) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+	// Field (12) 'ExecutionPayloadHeader'
+	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
 		return
 	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, b.BlobKzgCommitments[ii]...)
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 404 {
+	if size < 412 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12 uint64
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14 uint64
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
@@ -2618,7 +5672,7 @@ func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 404 {
+	if o3 < 412 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2661,11 +5715,21 @@ func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
-	// Offset (12) 'ExecutionPayload'
+	// Offset (12) 'ExecutionPayloadHeader'
 	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
 		return ssz.ErrOffset
 	}
 
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'BlobKzgCommitments'
+	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
 	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
@@ -2782,22 +5846,56 @@ func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (12) 'ExecutionPayload'
+	// Field (12) 'ExecutionPayloadHeader'
 	{
-		buf = tail[o12:]
-		if b.ExecutionPayload == nil {
-			b.ExecutionPayload = new(v11.ExecutionPayload)
+		buf = tail[o12:o13]
+		if b.ExecutionPayloadHeader == nil {
+			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 		}
-		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		buf = tail[o14:]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
 			return err
 		}
+		b.BlobKzgCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobKzgCommitments[ii]) == 0 {
+				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
-	size = 404
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) SizeSSZ() (size int) {
+	size = 412
 
 	// Field (3) 'ProposerSlashings'
 	size += len(b.ProposerSlashings) * 416
@@ -2823,22 +5921,28 @@ func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
 	// Field (8) 'ActivityChanges'
 	size += len(b.ActivityChanges) * 28
 
-	// Field (12) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayload)
+	// Field (12) 'ExecutionPayloadHeader'
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 	}
-	size += b.ExecutionPayload.SizeSSZ()
+	size += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	// Field (14) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyBellatrix object with a hasher
-func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyDeneb object with a hasher
+func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -2991,11 +6095,54 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error)
 		return
 	}
 
-	// Field (12) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+	// Field (12) 'ExecutionPayloadHeader'
+	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+		}
+	}
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -3004,15 +6151,15 @@ func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error)
 	return
 }
 
-// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyBellatrix object
-func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockBodyDeneb object
+func (b *BeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyBellatrix object to a target array
-func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockBodyDeneb object to a target array
+func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(404)
+	offset := int(412)
 
 	// Field (0) 'RandaoReveal'
 	if size := len(b.RandaoReveal); size != 96 {
@@ -3080,12 +6227,20 @@ func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte,
 		return
 	}
 
-	// Offset (12) 'ExecutionPayloadHeader'
+	// Offset (12) 'ExecutionPayload'
 	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 	}
-	offset += b.ExecutionPayloadHeader.SizeSSZ()
+	offset += b.ExecutionPayload.SizeSSZ()
+
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Offset (14) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
 
 	// Field (3) 'ProposerSlashings'
 	if size := len(b.ProposerSlashings); size > 16 {
@@ -3167,24 +6322,48 @@ func (b *BlindedBeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte,
 		}
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+	// Field (12) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, b.BlobKzgCommitments[ii]...)
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyBellatrix object
-func (b *BlindedBeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyDeneb object
+func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 404 {
+	if size < 412 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12 uint64
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14 uint64
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
@@ -3211,7 +6390,7 @@ func (b *BlindedBeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 404 {
+	if o3 < 412 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -3254,11 +6433,21 @@ func (b *BlindedBeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
-	// Offset (12) 'ExecutionPayloadHeader'
+	// Offset (12) 'ExecutionPayload'
 	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
 		return ssz.ErrOffset
 	}
 
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'BlobKzgCommitments'
+	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
 	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
@@ -3375,22 +6564,56 @@ func (b *BlindedBeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
+	// Field (12) 'ExecutionPayload'
 	{
-		buf = tail[o12:]
-		if b.ExecutionPayloadHeader == nil {
-			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+		buf = tail[o12:o13]
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 		}
-		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		buf = tail[o14:]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
 			return err
 		}
+		b.BlobKzgCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobKzgCommitments[ii]) == 0 {
+				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyBellatrix object
-func (b *BlindedBeaconBlockBodyBellatrix) SizeSSZ() (size int) {
-	size = 404
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyDeneb object
+func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
+	size = 412
 
 	// Field (3) 'ProposerSlashings'
 	size += len(b.ProposerSlashings) * 416
@@ -3416,22 +6639,28 @@ func (b *BlindedBeaconBlockBodyBellatrix) SizeSSZ() (size int) {
 	// Field (8) 'ActivityChanges'
 	size += len(b.ActivityChanges) * 28
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeader)
+	// Field (12) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 	}
-	size += b.ExecutionPayloadHeader.SizeSSZ()
+	size += b.ExecutionPayload.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	// Field (14) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyBellatrix object
-func (b *BlindedBeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockBodyDeneb object
+func (b *BeaconBlockBodyDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyBellatrix object with a hasher
-func (b *BlindedBeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockBodyDeneb object with a hasher
+func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -3584,198 +6813,95 @@ func (b *BlindedBeaconBlockBodyBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err
 		return
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
-	}
-	return
-}
-
-// MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconBlockBodyCapella object to a target array
-func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(408)
-
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
-
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (2) 'Graffiti'
-	if size := len(b.Graffiti); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
-		return
-	}
-	dst = append(dst, b.Graffiti...)
-
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
-
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
-
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
-
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1260
-
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
-
-	// Offset (8) 'ActivityChanges'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ActivityChanges) * 28
-
-	// Field (9) 'TransactionsCount'
-	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
-
-	// Field (10) 'BaseFee'
-	dst = ssz.MarshalUint64(dst, b.BaseFee)
-
-	// Field (11) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(v1.SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Offset (12) 'ExecutionPayload'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
-	}
-	offset += b.ExecutionPayload.SizeSSZ()
-
-	// Offset (13) 'BlsToExecutionChanges'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.BlsToExecutionChanges) * 172
-
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
-
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+	// Field (12) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
 		return
 	}
+
+	// Field (13) 'BlsToExecutionChanges'
 	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
-	}
-
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
 		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
 		}
 	}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+	// Field (14) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
 			return
 		}
-	}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
 		}
 	}
 
-	// Field (8) 'ActivityChanges'
-	if size := len(b.ActivityChanges); size > 1073741824 {
-		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
-		return
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
-	for ii := 0; ii < len(b.ActivityChanges); ii++ {
-		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockContentsDeneb object
+func (s *SignedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockContentsDeneb object to a target array
+func (s *SignedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.SignedBlock == nil {
+		s.SignedBlock = new(SignedBeaconBlockDeneb)
 	}
+	offset += s.SignedBlock.SizeSSZ()
 
-	// Field (12) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+	// Offset (1) 'SignedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SignedBlobSidecars) * 131352
+
+	// Field (0) 'SignedBlock'
+	if dst, err = s.SignedBlock.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (13) 'BlsToExecutionChanges'
-	if size := len(b.BlsToExecutionChanges); size > 16 {
-		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+	// Field (1) 'SignedBlobSidecars'
+	if size := len(s.SignedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.SignedBlobSidecars", size, 6)
 		return
 	}
-	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
-		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < len(s.SignedBlobSidecars); ii++ {
+		if dst, err = s.SignedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
 	}
@@ -3783,235 +6909,363 @@ func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockContentsDeneb object
+func (s *SignedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 408 {
+	if size < 8 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12, o13 uint64
+	var o0, o1 uint64
 
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	// Offset (0) 'SignedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+
+	// Offset (1) 'SignedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
 	}
 
-	// Field (2) 'Graffiti'
-	if cap(b.Graffiti) == 0 {
-		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	// Field (0) 'SignedBlock'
+	{
+		buf = tail[o0:o1]
+		if s.SignedBlock == nil {
+			s.SignedBlock = new(SignedBeaconBlockDeneb)
+		}
+		if err = s.SignedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
-	b.Graffiti = append(b.Graffiti, buf[168:200]...)
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
+	// Field (1) 'SignedBlobSidecars'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131352, 6)
+		if err != nil {
+			return err
+		}
+		s.SignedBlobSidecars = make([]*SignedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.SignedBlobSidecars[ii] == nil {
+				s.SignedBlobSidecars[ii] = new(SignedBlobSidecar)
+			}
+			if err = s.SignedBlobSidecars[ii].UnmarshalSSZ(buf[ii*131352 : (ii+1)*131352]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockContentsDeneb object
+func (s *SignedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedBlock'
+	if s.SignedBlock == nil {
+		s.SignedBlock = new(SignedBeaconBlockDeneb)
+	}
+	size += s.SignedBlock.SizeSSZ()
+
+	// Field (1) 'SignedBlobSidecars'
+	size += len(s.SignedBlobSidecars) * 131352
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockContentsDeneb object
+func (s *SignedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockContentsDeneb object with a hasher
+func (s *SignedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedBlock'
+	if err = s.SignedBlock.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'SignedBlobSidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.SignedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.SignedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockContentsDeneb object
+func (s *SignedBlindedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockContentsDeneb object to a target array
+func (s *SignedBlindedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedBlindedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
 	}
+	offset += s.SignedBlindedBlock.SizeSSZ()
 
-	if o3 < 408 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SignedBlindedBlobSidecars) * 312
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+	// Field (0) 'SignedBlindedBlock'
+	if dst, err = s.SignedBlindedBlock.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
+	// Field (1) 'SignedBlindedBlobSidecars'
+	if size := len(s.SignedBlindedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.SignedBlindedBlobSidecars", size, 6)
+		return
 	}
-
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+	for ii := 0; ii < len(s.SignedBlindedBlobSidecars); ii++ {
+		if dst, err = s.SignedBlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
-	}
+	return
+}
 
-	// Offset (8) 'ActivityChanges'
-	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
-		return ssz.ErrOffset
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockContentsDeneb object
+func (s *SignedBlindedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
 	}
 
-	// Field (9) 'TransactionsCount'
-	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
-
-	// Field (10) 'BaseFee'
-	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+	tail := buf
+	var o0, o1 uint64
 
-	// Field (11) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(v1.SyncAggregate)
-	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
-		return err
+	// Offset (0) 'SignedBlindedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Offset (12) 'ExecutionPayload'
-	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
-		return ssz.ErrOffset
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (13) 'BlsToExecutionChanges'
-	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
 		return ssz.ErrOffset
 	}
 
-	// Field (3) 'ProposerSlashings'
+	// Field (0) 'SignedBlindedBlock'
 	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
+		buf = tail[o0:o1]
+		if s.SignedBlindedBlock == nil {
+			s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
 		}
-		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
+		if err = s.SignedBlindedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
 		}
 	}
 
-	// Field (4) 'AttesterSlashings'
+	// Field (1) 'SignedBlindedBlobSidecars'
 	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 312, 6)
 		if err != nil {
 			return err
 		}
-		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
+		s.SignedBlindedBlobSidecars = make([]*SignedBlindedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.SignedBlindedBlobSidecars[ii] == nil {
+				s.SignedBlindedBlobSidecars[ii] = new(SignedBlindedBlobSidecar)
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+			if err = s.SignedBlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*312 : (ii+1)*312]); err != nil {
 				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
 	}
+	return err
+}
 
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
-		}
-		b.Attestations = make([]*v1.Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(v1.Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockContentsDeneb object
+func (s *SignedBlindedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedBlindedBlock'
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
 	}
+	size += s.SignedBlindedBlock.SizeSSZ()
 
-	// Field (6) 'Deposits'
+	// Field (1) 'SignedBlindedBlobSidecars'
+	size += len(s.SignedBlindedBlobSidecars) * 312
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockContentsDeneb object
+func (s *SignedBlindedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockContentsDeneb object with a hasher
+func (s *SignedBlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedBlindedBlock'
+	if err = s.SignedBlindedBlock.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'SignedBlindedBlobSidecars'
 	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1260, 16)
-		if err != nil {
-			return err
+		subIndx := hh.Index()
+		num := uint64(len(s.SignedBlindedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
-		b.Deposits = make([]*v1.Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(v1.Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
-				return err
+		for _, elem := range s.SignedBlindedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
 		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:o8]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
-			}
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockContentsDeneb object
+func (b *BeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockContentsDeneb object to a target array
+func (b *BeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Block == nil {
+		b.Block = new(BeaconBlockDeneb)
+	}
+	offset += b.Block.SizeSSZ()
+
+	// Offset (1) 'BlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobSidecars) * 131256
+
+	// Field (0) 'Block'
+	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobSidecars'
+	if size := len(b.BlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.BlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.BlobSidecars); ii++ {
+		if dst, err = b.BlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
 		}
 	}
 
-	// Field (8) 'ActivityChanges'
-	{
-		buf = tail[o8:o12]
-		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
-		if err != nil {
-			return err
-		}
-		b.ActivityChanges = make([]*v1.ActivityChange, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ActivityChanges[ii] == nil {
-				b.ActivityChanges[ii] = new(v1.ActivityChange)
-			}
-			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
-				return err
-			}
-		}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockContentsDeneb object
+func (b *BeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (12) 'ExecutionPayload'
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Block'
 	{
-		buf = tail[o12:o13]
-		if b.ExecutionPayload == nil {
-			b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
+		buf = tail[o0:o1]
+		if b.Block == nil {
+			b.Block = new(BeaconBlockDeneb)
 		}
-		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+		if err = b.Block.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 
-	// Field (13) 'BlsToExecutionChanges'
+	// Field (1) 'BlobSidecars'
 	{
-		buf = tail[o13:]
-		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131256, 6)
 		if err != nil {
 			return err
 		}
-		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		b.BlobSidecars = make([]*BlobSidecar, num)
 		for ii := 0; ii < num; ii++ {
-			if b.BlsToExecutionChanges[ii] == nil {
-				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			if b.BlobSidecars[ii] == nil {
+				b.BlobSidecars[ii] = new(BlobSidecar)
 			}
-			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+			if err = b.BlobSidecars[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
 				return err
 			}
 		}
@@ -4019,227 +7273,207 @@ func (b *BeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) SizeSSZ() (size int) {
-	size = 408
-
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
-
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
-
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
-	}
-
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1260
-
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
-
-	// Field (8) 'ActivityChanges'
-	size += len(b.ActivityChanges) * 28
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockContentsDeneb object
+func (b *BeaconBlockContentsDeneb) SizeSSZ() (size int) {
+	size = 8
 
-	// Field (12) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayloadCapella)
+	// Field (0) 'Block'
+	if b.Block == nil {
+		b.Block = new(BeaconBlockDeneb)
 	}
-	size += b.ExecutionPayload.SizeSSZ()
+	size += b.Block.SizeSSZ()
 
-	// Field (13) 'BlsToExecutionChanges'
-	size += len(b.BlsToExecutionChanges) * 172
+	// Field (1) 'BlobSidecars'
+	size += len(b.BlobSidecars) * 131256
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockContentsDeneb object
+func (b *BeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyCapella object with a hasher
-func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockContentsDeneb object with a hasher
+func (b *BeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
-	}
-	hh.PutBytes(b.RandaoReveal)
-
-	// Field (1) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (2) 'Graffiti'
-	if size := len(b.Graffiti); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+	// Field (0) 'Block'
+	if err = b.Block.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(b.Graffiti)
 
-	// Field (3) 'ProposerSlashings'
+	// Field (1) 'BlobSidecars'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
+		num := uint64(len(b.BlobSidecars))
+		if num > 6 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.ProposerSlashings {
+		for _, elem := range b.BlobSidecars {
 			if err = elem.HashTreeRootWith(hh); err != nil {
 				return
 			}
 		}
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
+			hh.MerkleizeWithMixin(subIndx, num, 6)
 		}
 	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 2)
-		}
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 128)
-		}
+// MarshalSSZ ssz marshals the BlindedBeaconBlockContentsDeneb object
+func (b *BlindedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockContentsDeneb object to a target array
+func (b *BlindedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'BlindedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.BlindedBlock == nil {
+		b.BlindedBlock = new(BlindedBeaconBlockDeneb)
 	}
+	offset += b.BlindedBlock.SizeSSZ()
 
-	// Field (6) 'Deposits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
+	// Offset (1) 'BlindedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlindedBlobSidecars) * 216
+
+	// Field (0) 'BlindedBlock'
+	if dst, err = b.BlindedBlock.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlindedBlobSidecars'
+	if size := len(b.BlindedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.BlindedBlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.BlindedBlobSidecars); ii++ {
+		if dst, err = b.BlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
-		}
 	}
 
-	// Field (7) 'VoluntaryExits'
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockContentsDeneb object
+func (b *BlindedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'BlindedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlindedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'BlindedBlock'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		buf = tail[o0:o1]
+		if b.BlindedBlock == nil {
+			b.BlindedBlock = new(BlindedBeaconBlockDeneb)
 		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
+		if err = b.BlindedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
 		}
 	}
 
-	// Field (8) 'ActivityChanges'
+	// Field (1) 'BlindedBlobSidecars'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ActivityChanges))
-		if num > 1073741824 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 216, 6)
+		if err != nil {
+			return err
 		}
-		for _, elem := range b.ActivityChanges {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
+		b.BlindedBlobSidecars = make([]*BlindedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlindedBlobSidecars[ii] == nil {
+				b.BlindedBlobSidecars[ii] = new(BlindedBlobSidecar)
+			}
+			if err = b.BlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*216 : (ii+1)*216]); err != nil {
+				return err
 			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
 		}
 	}
+	return err
+}
 
-	// Field (9) 'TransactionsCount'
-	hh.PutUint64(b.TransactionsCount)
-
-	// Field (10) 'BaseFee'
-	hh.PutUint64(b.BaseFee)
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockContentsDeneb object
+func (b *BlindedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
+	size = 8
 
-	// Field (11) 'SyncAggregate'
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
+	// Field (0) 'BlindedBlock'
+	if b.BlindedBlock == nil {
+		b.BlindedBlock = new(BlindedBeaconBlockDeneb)
 	}
+	size += b.BlindedBlock.SizeSSZ()
 
-	// Field (12) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'BlindedBlobSidecars'
+	size += len(b.BlindedBlobSidecars) * 216
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockContentsDeneb object
+func (b *BlindedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockContentsDeneb object with a hasher
+func (b *BlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlindedBlock'
+	if err = b.BlindedBlock.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (13) 'BlsToExecutionChanges'
+	// Field (1) 'BlindedBlobSidecars'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.BlsToExecutionChanges))
-		if num > 16 {
+		num := uint64(len(b.BlindedBlobSidecars))
+		if num > 6 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.BlsToExecutionChanges {
+		for _, elem := range b.BlindedBlobSidecars {
 			if err = elem.HashTreeRootWith(hh); err != nil {
 				return
 			}
 		}
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
+			hh.MerkleizeWithMixin(subIndx, num, 6)
 		}
 	}
 
@@ -4251,15 +7485,15 @@ func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyCapella object
-func (b *BlindedBeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyCapella object to a target array
-func (b *BlindedBeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyElectra object to a target array
+func (b *BlindedBeaconBlockBodyElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(408)
+	offset := int(416)
 
 	// Field (0) 'RandaoReveal'
 	if size := len(b.RandaoReveal); size != 96 {
@@ -4330,7 +7564,7 @@ func (b *BlindedBeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, er
 	// Offset (12) 'ExecutionPayloadHeader'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 	}
 	offset += b.ExecutionPayloadHeader.SizeSSZ()
 
@@ -4338,6 +7572,14 @@ func (b *BlindedBeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, er
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.BlsToExecutionChanges) * 172
 
+	// Offset (14) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
+
+	// Offset (15) 'ContractTransfers'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ContractTransfers) * 244
+
 	// Field (3) 'ProposerSlashings'
 	if size := len(b.ProposerSlashings); size > 16 {
 		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
@@ -4434,19 +7676,43 @@ func (b *BlindedBeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, er
 		}
 	}
 
+	// Field (14) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, b.BlobKzgCommitments[ii]...)
+	}
+
+	// Field (15) 'ContractTransfers'
+	if size := len(b.ContractTransfers); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ContractTransfers", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ContractTransfers); ii++ {
+		if dst, err = b.ContractTransfers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyCapella object
-func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 408 {
+	if size < 416 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12, o13 uint64
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14, o15 uint64
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
@@ -4473,7 +7739,7 @@ func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 408 {
+	if o3 < 416 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -4526,6 +7792,16 @@ func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (14) 'BlobKzgCommitments'
+	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (15) 'ContractTransfers'
+	if o15 = ssz.ReadOffset(buf[412:416]); o15 > size || o14 > o15 {
+		return ssz.ErrOffset
+	}
+
 	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
@@ -4646,7 +7922,7 @@ func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o12:o13]
 		if b.ExecutionPayloadHeader == nil {
-			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 		}
 		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
 			return err
@@ -4655,7 +7931,7 @@ func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 
 	// Field (13) 'BlsToExecutionChanges'
 	{
-		buf = tail[o13:]
+		buf = tail[o13:o14]
 		num, err := ssz.DivideInt2(len(buf), 172, 16)
 		if err != nil {
 			return err
@@ -4670,12 +7946,46 @@ func (b *BlindedBeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
 			}
 		}
 	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		buf = tail[o14:o15]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobKzgCommitments[ii]) == 0 {
+				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DivideInt2(len(buf), 244, 16)
+		if err != nil {
+			return err
+		}
+		b.ContractTransfers = make([]*SignedContractTransfer, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ContractTransfers[ii] == nil {
+				b.ContractTransfers[ii] = new(SignedContractTransfer)
+			}
+			if err = b.ContractTransfers[ii].UnmarshalSSZ(buf[ii*244 : (ii+1)*244]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyCapella object
-func (b *BlindedBeaconBlockBodyCapella) SizeSSZ() (size int) {
-	size = 408
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) SizeSSZ() (size int) {
+	size = 416
 
 	// Field (3) 'ProposerSlashings'
 	size += len(b.ProposerSlashings) * 416
@@ -4703,23 +8013,29 @@ func (b *BlindedBeaconBlockBodyCapella) SizeSSZ() (size int) {
 
 	// Field (12) 'ExecutionPayloadHeader'
 	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderCapella)
+		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
 	}
 	size += b.ExecutionPayloadHeader.SizeSSZ()
 
 	// Field (13) 'BlsToExecutionChanges'
 	size += len(b.BlsToExecutionChanges) * 172
 
+	// Field (14) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	// Field (15) 'ContractTransfers'
+	size += len(b.ContractTransfers) * 244
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyCapella object
-func (b *BlindedBeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyCapella object with a hasher
-func (b *BlindedBeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyElectra object with a hasher
+func (b *BlindedBeaconBlockBodyElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -4897,6 +8213,49 @@ func (b *BlindedBeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err er
 		}
 	}
 
+	// Field (14) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractTransfers))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ContractTransfers {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -4905,15 +8264,15 @@ func (b *BlindedBeaconBlockBodyCapella) HashTreeRootWith(hh *ssz.Hasher) (err er
 	return
 }
 
-// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyDeneb object
-func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyDeneb object to a target array
-func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockBodyElectra object to a target array
+func (b *BeaconBlockBodyElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(412)
+	offset := int(416)
 
 	// Field (0) 'RandaoReveal'
 	if size := len(b.RandaoReveal); size != 96 {
@@ -4981,12 +8340,12 @@ func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 		return
 	}
 
-	// Offset (12) 'ExecutionPayloadHeader'
+	// Offset (12) 'ExecutionPayload'
 	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 	}
-	offset += b.ExecutionPayloadHeader.SizeSSZ()
+	offset += b.ExecutionPayload.SizeSSZ()
 
 	// Offset (13) 'BlsToExecutionChanges'
 	dst = ssz.WriteOffset(dst, offset)
@@ -4996,6 +8355,10 @@ func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.BlobKzgCommitments) * 48
 
+	// Offset (15) 'ContractTransfers'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ContractTransfers) * 244
+
 	// Field (3) 'ProposerSlashings'
 	if size := len(b.ProposerSlashings); size > 16 {
 		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
@@ -5076,8 +8439,8 @@ func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 		}
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+	// Field (12) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
@@ -5105,19 +8468,30 @@ func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err
 		dst = append(dst, b.BlobKzgCommitments[ii]...)
 	}
 
+	// Field (15) 'ContractTransfers'
+	if size := len(b.ContractTransfers); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ContractTransfers", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ContractTransfers); ii++ {
+		if dst, err = b.ContractTransfers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyDeneb object
-func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 412 {
+	if size < 416 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12, o13, o14 uint64
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14, o15 uint64
 
 	// Field (0) 'RandaoReveal'
 	if cap(b.RandaoReveal) == 0 {
@@ -5144,7 +8518,7 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 412 {
+	if o3 < 416 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -5187,7 +8561,7 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
-	// Offset (12) 'ExecutionPayloadHeader'
+	// Offset (12) 'ExecutionPayload'
 	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
 		return ssz.ErrOffset
 	}
@@ -5202,6 +8576,11 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (15) 'ContractTransfers'
+	if o15 = ssz.ReadOffset(buf[412:416]); o15 > size || o14 > o15 {
+		return ssz.ErrOffset
+	}
+
 	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
@@ -5318,13 +8697,13 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
+	// Field (12) 'ExecutionPayload'
 	{
 		buf = tail[o12:o13]
-		if b.ExecutionPayloadHeader == nil {
-			b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 		}
-		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
@@ -5349,7 +8728,7 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 
 	// Field (14) 'BlobKzgCommitments'
 	{
-		buf = tail[o14:]
+		buf = tail[o14:o15]
 		num, err := ssz.DivideInt2(len(buf), 48, 4096)
 		if err != nil {
 			return err
@@ -5362,12 +8741,30 @@ func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
 		}
 	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DivideInt2(len(buf), 244, 16)
+		if err != nil {
+			return err
+		}
+		b.ContractTransfers = make([]*SignedContractTransfer, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ContractTransfers[ii] == nil {
+				b.ContractTransfers[ii] = new(SignedContractTransfer)
+			}
+			if err = b.ContractTransfers[ii].UnmarshalSSZ(buf[ii*244 : (ii+1)*244]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyDeneb object
-func (b *BlindedBeaconBlockBodyDeneb) SizeSSZ() (size int) {
-	size = 412
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) SizeSSZ() (size int) {
+	size = 416
 
 	// Field (3) 'ProposerSlashings'
 	size += len(b.ProposerSlashings) * 416
@@ -5393,11 +8790,11 @@ func (b *BlindedBeaconBlockBodyDeneb) SizeSSZ() (size int) {
 	// Field (8) 'ActivityChanges'
 	size += len(b.ActivityChanges) * 28
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if b.ExecutionPayloadHeader == nil {
-		b.ExecutionPayloadHeader = new(v11.ExecutionPayloadHeaderDeneb)
+	// Field (12) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
 	}
-	size += b.ExecutionPayloadHeader.SizeSSZ()
+	size += b.ExecutionPayload.SizeSSZ()
 
 	// Field (13) 'BlsToExecutionChanges'
 	size += len(b.BlsToExecutionChanges) * 172
@@ -5405,16 +8802,19 @@ func (b *BlindedBeaconBlockBodyDeneb) SizeSSZ() (size int) {
 	// Field (14) 'BlobKzgCommitments'
 	size += len(b.BlobKzgCommitments) * 48
 
+	// Field (15) 'ContractTransfers'
+	size += len(b.ContractTransfers) * 244
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyDeneb object
-func (b *BlindedBeaconBlockBodyDeneb) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyDeneb object with a hasher
-func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockBodyElectra object with a hasher
+func (b *BeaconBlockBodyElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'RandaoReveal'
@@ -5567,8 +8967,8 @@ func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err erro
 		return
 	}
 
-	// Field (12) 'ExecutionPayloadHeader'
-	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+	// Field (12) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
@@ -5615,6 +9015,26 @@ func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err erro
 		}
 	}
 
+	// Field (15) 'ContractTransfers'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractTransfers))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ContractTransfers {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -5623,715 +9043,822 @@ func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err erro
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyDeneb object
-func (b *BeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZ ssz marshals the SignedBeaconBlockContentsElectra object
+func (s *SignedBeaconBlockContentsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyDeneb object to a target array
-func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedBeaconBlockContentsElectra object to a target array
+func (s *SignedBeaconBlockContentsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(412)
+	offset := int(8)
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
-		return
+	// Offset (0) 'SignedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.SignedBlock == nil {
+		s.SignedBlock = new(SignedBeaconBlockElectra)
 	}
-	dst = append(dst, b.RandaoReveal...)
+	offset += s.SignedBlock.SizeSSZ()
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+	// Offset (1) 'SignedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SignedBlobSidecars) * 131352
 
-	// Field (2) 'Graffiti'
-	if size := len(b.Graffiti); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+	// Field (0) 'SignedBlock'
+	if dst, err = s.SignedBlock.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, b.Graffiti...)
-
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
+	// Field (1) 'SignedBlobSidecars'
+	if size := len(s.SignedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.SignedBlobSidecars", size, 6)
+		return
 	}
-
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
+	for ii := 0; ii < len(s.SignedBlobSidecars); ii++ {
+		if dst, err = s.SignedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1260
-
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
-
-	// Offset (8) 'ActivityChanges'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ActivityChanges) * 28
+	return
+}
 
-	// Field (9) 'TransactionsCount'
-	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockContentsElectra object
+func (s *SignedBeaconBlockContentsElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
 
-	// Field (10) 'BaseFee'
-	dst = ssz.MarshalUint64(dst, b.BaseFee)
+	tail := buf
+	var o0, o1 uint64
 
-	// Field (11) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(v1.SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
+	// Offset (0) 'SignedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Offset (12) 'ExecutionPayload'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	offset += b.ExecutionPayload.SizeSSZ()
-
-	// Offset (13) 'BlsToExecutionChanges'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.BlsToExecutionChanges) * 172
-
-	// Offset (14) 'BlobKzgCommitments'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.BlobKzgCommitments) * 48
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	// Offset (1) 'SignedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
 	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
-		return
-	}
+	// Field (0) 'SignedBlock'
 	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		buf = tail[o0:o1]
+		if s.SignedBlock == nil {
+			s.SignedBlock = new(SignedBeaconBlockElectra)
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+		if err = s.SignedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
 		}
 	}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
-		return
-	}
+	// Field (1) 'SignedBlobSidecars'
 	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131352, 6)
+		if err != nil {
+			return err
 		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+		s.SignedBlobSidecars = make([]*SignedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.SignedBlobSidecars[ii] == nil {
+				s.SignedBlobSidecars[ii] = new(SignedBlobSidecar)
+			}
+			if err = s.SignedBlobSidecars[ii].UnmarshalSSZ(buf[ii*131352 : (ii+1)*131352]); err != nil {
+				return err
+			}
 		}
 	}
+	return err
+}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockContentsElectra object
+func (s *SignedBeaconBlockContentsElectra) SizeSSZ() (size int) {
+	size = 8
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	// Field (0) 'SignedBlock'
+	if s.SignedBlock == nil {
+		s.SignedBlock = new(SignedBeaconBlockElectra)
 	}
+	size += s.SignedBlock.SizeSSZ()
 
-	// Field (8) 'ActivityChanges'
-	if size := len(b.ActivityChanges); size > 1073741824 {
-		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+	// Field (1) 'SignedBlobSidecars'
+	size += len(s.SignedBlobSidecars) * 131352
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockContentsElectra object
+func (s *SignedBeaconBlockContentsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockContentsElectra object with a hasher
+func (s *SignedBeaconBlockContentsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedBlock'
+	if err = s.SignedBlock.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.ActivityChanges); ii++ {
-		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+
+	// Field (1) 'SignedBlobSidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.SignedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
+		for _, elem := range s.SignedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
 
-	// Field (12) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
-		return
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (13) 'BlsToExecutionChanges'
-	if size := len(b.BlsToExecutionChanges); size > 16 {
-		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
-		return
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockContentsElectra object
+func (s *SignedBlindedBeaconBlockContentsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockContentsElectra object to a target array
+func (s *SignedBlindedBeaconBlockContentsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedBlindedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
 	}
-	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
-		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	offset += s.SignedBlindedBlock.SizeSSZ()
+
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SignedBlindedBlobSidecars) * 312
+
+	// Field (0) 'SignedBlindedBlock'
+	if dst, err = s.SignedBlindedBlock.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Field (14) 'BlobKzgCommitments'
-	if size := len(b.BlobKzgCommitments); size > 4096 {
-		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+	// Field (1) 'SignedBlindedBlobSidecars'
+	if size := len(s.SignedBlindedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.SignedBlindedBlobSidecars", size, 6)
 		return
 	}
-	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
-		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
-			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+	for ii := 0; ii < len(s.SignedBlindedBlobSidecars); ii++ {
+		if dst, err = s.SignedBlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		dst = append(dst, b.BlobKzgCommitments[ii]...)
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyDeneb object
-func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockContentsElectra object
+func (s *SignedBlindedBeaconBlockContentsElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 412 {
+	if size < 8 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o8, o12, o13, o14 uint64
-
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	var o0, o1 uint64
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(v1.Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+	// Offset (0) 'SignedBlindedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (2) 'Graffiti'
-	if cap(b.Graffiti) == 0 {
-		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	b.Graffiti = append(b.Graffiti, buf[168:200]...)
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 412 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (0) 'SignedBlindedBlock'
+	{
+		buf = tail[o0:o1]
+		if s.SignedBlindedBlock == nil {
+			s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
+		}
+		if err = s.SignedBlindedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+	// Field (1) 'SignedBlindedBlobSidecars'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 312, 6)
+		if err != nil {
+			return err
+		}
+		s.SignedBlindedBlobSidecars = make([]*SignedBlindedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.SignedBlindedBlobSidecars[ii] == nil {
+				s.SignedBlindedBlobSidecars[ii] = new(SignedBlindedBlobSidecar)
+			}
+			if err = s.SignedBlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*312 : (ii+1)*312]); err != nil {
+				return err
+			}
+		}
 	}
+	return err
+}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockContentsElectra object
+func (s *SignedBlindedBeaconBlockContentsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedBlindedBlock'
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
 	}
+	size += s.SignedBlindedBlock.SizeSSZ()
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+	// Field (1) 'SignedBlindedBlobSidecars'
+	size += len(s.SignedBlindedBlobSidecars) * 312
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockContentsElectra object
+func (s *SignedBlindedBeaconBlockContentsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockContentsElectra object with a hasher
+func (s *SignedBlindedBeaconBlockContentsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedBlindedBlock'
+	if err = s.SignedBlindedBlock.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
+	// Field (1) 'SignedBlindedBlobSidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.SignedBlindedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.SignedBlindedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
 
-	// Offset (8) 'ActivityChanges'
-	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
-		return ssz.ErrOffset
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (9) 'TransactionsCount'
-	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+// MarshalSSZ ssz marshals the BeaconBlockContentsElectra object
+func (b *BeaconBlockContentsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
 
-	// Field (10) 'BaseFee'
-	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+// MarshalSSZTo ssz marshals the BeaconBlockContentsElectra object to a target array
+func (b *BeaconBlockContentsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
 
-	// Field (11) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(v1.SyncAggregate)
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Block == nil {
+		b.Block = new(BeaconBlockElectra)
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
-		return err
+	offset += b.Block.SizeSSZ()
+
+	// Offset (1) 'BlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobSidecars) * 131256
+
+	// Field (0) 'Block'
+	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Offset (12) 'ExecutionPayload'
-	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
-		return ssz.ErrOffset
+	// Field (1) 'BlobSidecars'
+	if size := len(b.BlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.BlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.BlobSidecars); ii++ {
+		if dst, err = b.BlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
 
-	// Offset (13) 'BlsToExecutionChanges'
-	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
-		return ssz.ErrOffset
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockContentsElectra object
+func (b *BeaconBlockContentsElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
 	}
 
-	// Offset (14) 'BlobKzgCommitments'
-	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
-		}
-		b.ProposerSlashings = make([]*v1.ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(v1.ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
-		}
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*v1.AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(v1.AttesterSlashing)
-			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+	// Offset (1) 'BlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
 	}
 
-	// Field (5) 'Attestations'
+	// Field (0) 'Block'
 	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
+		buf = tail[o0:o1]
+		if b.Block == nil {
+			b.Block = new(BeaconBlockElectra)
 		}
-		b.Attestations = make([]*v1.Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(v1.Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
+		if err = b.Block.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 
-	// Field (6) 'Deposits'
+	// Field (1) 'BlobSidecars'
 	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131256, 6)
 		if err != nil {
 			return err
 		}
-		b.Deposits = make([]*v1.Deposit, num)
+		b.BlobSidecars = make([]*BlobSidecar, num)
 		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(v1.Deposit)
+			if b.BlobSidecars[ii] == nil {
+				b.BlobSidecars[ii] = new(BlobSidecar)
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+			if err = b.BlobSidecars[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
 				return err
 			}
 		}
 	}
+	return err
+}
 
-	// Field (7) 'VoluntaryExits'
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockContentsElectra object
+func (b *BeaconBlockContentsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Block'
+	if b.Block == nil {
+		b.Block = new(BeaconBlockElectra)
+	}
+	size += b.Block.SizeSSZ()
+
+	// Field (1) 'BlobSidecars'
+	size += len(b.BlobSidecars) * 131256
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockContentsElectra object
+func (b *BeaconBlockContentsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockContentsElectra object with a hasher
+func (b *BeaconBlockContentsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = b.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobSidecars'
 	{
-		buf = tail[o7:o8]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
+		subIndx := hh.Index()
+		num := uint64(len(b.BlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
-		b.VoluntaryExits = make([]*v1.SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(v1.SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
+		for _, elem := range b.BlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
 		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
 
-	// Field (8) 'ActivityChanges'
-	{
-		buf = tail[o8:o12]
-		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
-		if err != nil {
-			return err
-		}
-		b.ActivityChanges = make([]*v1.ActivityChange, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ActivityChanges[ii] == nil {
-				b.ActivityChanges[ii] = new(v1.ActivityChange)
-			}
-			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
-				return err
-			}
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockContentsElectra object
+func (b *BlindedBeaconBlockContentsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockContentsElectra object to a target array
+func (b *BlindedBeaconBlockContentsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'BlindedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.BlindedBlock == nil {
+		b.BlindedBlock = new(BlindedBeaconBlockElectra)
+	}
+	offset += b.BlindedBlock.SizeSSZ()
+
+	// Offset (1) 'BlindedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlindedBlobSidecars) * 216
+
+	// Field (0) 'BlindedBlock'
+	if dst, err = b.BlindedBlock.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlindedBlobSidecars'
+	if size := len(b.BlindedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.BlindedBlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.BlindedBlobSidecars); ii++ {
+		if dst, err = b.BlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
 		}
 	}
 
-	// Field (12) 'ExecutionPayload'
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockContentsElectra object
+func (b *BlindedBeaconBlockContentsElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'BlindedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlindedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'BlindedBlock'
 	{
-		buf = tail[o12:o13]
-		if b.ExecutionPayload == nil {
-			b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
+		buf = tail[o0:o1]
+		if b.BlindedBlock == nil {
+			b.BlindedBlock = new(BlindedBeaconBlockElectra)
 		}
-		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+		if err = b.BlindedBlock.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 
-	// Field (13) 'BlsToExecutionChanges'
+	// Field (1) 'BlindedBlobSidecars'
 	{
-		buf = tail[o13:o14]
-		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 216, 6)
 		if err != nil {
 			return err
 		}
-		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		b.BlindedBlobSidecars = make([]*BlindedBlobSidecar, num)
 		for ii := 0; ii < num; ii++ {
-			if b.BlsToExecutionChanges[ii] == nil {
-				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			if b.BlindedBlobSidecars[ii] == nil {
+				b.BlindedBlobSidecars[ii] = new(BlindedBlobSidecar)
 			}
-			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+			if err = b.BlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*216 : (ii+1)*216]); err != nil {
 				return err
 			}
 		}
 	}
+	return err
+}
 
-	// Field (14) 'BlobKzgCommitments'
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockContentsElectra object
+func (b *BlindedBeaconBlockContentsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'BlindedBlock'
+	if b.BlindedBlock == nil {
+		b.BlindedBlock = new(BlindedBeaconBlockElectra)
+	}
+	size += b.BlindedBlock.SizeSSZ()
+
+	// Field (1) 'BlindedBlobSidecars'
+	size += len(b.BlindedBlobSidecars) * 216
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockContentsElectra object
+func (b *BlindedBeaconBlockContentsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockContentsElectra object with a hasher
+func (b *BlindedBeaconBlockContentsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlindedBlock'
+	if err = b.BlindedBlock.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlindedBlobSidecars'
 	{
-		buf = tail[o14:]
-		num, err := ssz.DivideInt2(len(buf), 48, 4096)
-		if err != nil {
-			return err
+		subIndx := hh.Index()
+		num := uint64(len(b.BlindedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
-		b.BlobKzgCommitments = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.BlobKzgCommitments[ii]) == 0 {
-				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+		for _, elem := range b.BlindedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
-			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
 		}
 	}
-	return err
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyDeneb object
-func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
-	size = 412
+// MarshalSSZ ssz marshals the ContractTransfer object
+func (c *ContractTransfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+// MarshalSSZTo ssz marshals the ContractTransfer object to a target array
+func (c *ContractTransfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.ValidatorIndex))
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+	// Field (1) 'RecipientIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.RecipientIndex))
+
+	// Field (2) 'Contract'
+	if size := len(c.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
 	}
+	dst = append(dst, c.Contract...)
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1260
+	// Field (3) 'ValidUntilEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(c.ValidUntilEpoch))
 
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
 
-	// Field (8) 'ActivityChanges'
-	size += len(b.ActivityChanges) * 28
+	return
+}
 
-	// Field (12) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(v11.ExecutionPayloadDeneb)
+// UnmarshalSSZ ssz unmarshals the ContractTransfer object
+func (c *ContractTransfer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 52 {
+		return ssz.ErrSize
 	}
-	size += b.ExecutionPayload.SizeSSZ()
 
-	// Field (13) 'BlsToExecutionChanges'
-	size += len(b.BlsToExecutionChanges) * 172
+	// Field (0) 'ValidatorIndex'
+	c.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
 
-	// Field (14) 'BlobKzgCommitments'
-	size += len(b.BlobKzgCommitments) * 48
+	// Field (1) 'RecipientIndex'
+	c.RecipientIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Contract'
+	if cap(c.Contract) == 0 {
+		c.Contract = make([]byte, 0, len(buf[16:36]))
+	}
+	c.Contract = append(c.Contract, buf[16:36]...)
+
+	// Field (3) 'ValidUntilEpoch'
+	c.ValidUntilEpoch = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[36:44]))
+
+	// Field (4) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[44:52])
+
+	return err
+}
 
+// SizeSSZ returns the ssz encoded size in bytes for the ContractTransfer object
+func (c *ContractTransfer) SizeSSZ() (size int) {
+	size = 52
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyDeneb object
-func (b *BeaconBlockBodyDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// HashTreeRoot ssz hashes the ContractTransfer object
+func (c *ContractTransfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyDeneb object with a hasher
-func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the ContractTransfer object with a hasher
+func (c *ContractTransfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(c.ValidatorIndex))
+
+	// Field (1) 'RecipientIndex'
+	hh.PutUint64(uint64(c.RecipientIndex))
+
+	// Field (2) 'Contract'
+	if size := len(c.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
 		return
 	}
-	hh.PutBytes(b.RandaoReveal)
+	hh.PutBytes(c.Contract)
 
-	// Field (1) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+	// Field (3) 'ValidUntilEpoch'
+	hh.PutUint64(uint64(c.ValidUntilEpoch))
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (2) 'Graffiti'
-	if size := len(b.Graffiti); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+// MarshalSSZ ssz marshals the SignedContractTransfer object
+func (s *SignedContractTransfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedContractTransfer object to a target array
+func (s *SignedContractTransfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContractTransfer)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	hh.PutBytes(b.Graffiti)
 
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
-		}
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
 	}
+	dst = append(dst, s.Signature...)
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 2)
-		}
+	// Field (2) 'RecipientSignature'
+	if size := len(s.RecipientSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RecipientSignature", size, 96)
+		return
 	}
+	dst = append(dst, s.RecipientSignature...)
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 128)
-		}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedContractTransfer object
+func (s *SignedContractTransfer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 244 {
+		return ssz.ErrSize
 	}
 
-	// Field (6) 'Deposits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
-		}
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContractTransfer)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:52]); err != nil {
+		return err
 	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
-		}
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[52:148]))
 	}
+	s.Signature = append(s.Signature, buf[52:148]...)
 
-	// Field (8) 'ActivityChanges'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ActivityChanges))
-		if num > 1073741824 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.ActivityChanges {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
-		}
+	// Field (2) 'RecipientSignature'
+	if cap(s.RecipientSignature) == 0 {
+		s.RecipientSignature = make([]byte, 0, len(buf[148:244]))
 	}
+	s.RecipientSignature = append(s.RecipientSignature, buf[148:244]...)
 
-	// Field (9) 'TransactionsCount'
-	hh.PutUint64(b.TransactionsCount)
+	return err
+}
 
-	// Field (10) 'BaseFee'
-	hh.PutUint64(b.BaseFee)
+// SizeSSZ returns the ssz encoded size in bytes for the SignedContractTransfer object
+func (s *SignedContractTransfer) SizeSSZ() (size int) {
+	size = 244
+	return
+}
 
-	// Field (11) 'SyncAggregate'
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// HashTreeRoot ssz hashes the SignedContractTransfer object
+func (s *SignedContractTransfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Field (12) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// HashTreeRootWith ssz hashes the SignedContractTransfer object with a hasher
+func (s *SignedContractTransfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (13) 'BlsToExecutionChanges'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.BlsToExecutionChanges))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.BlsToExecutionChanges {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16)
-		}
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (14) 'BlobKzgCommitments'
-	{
-		if size := len(b.BlobKzgCommitments); size > 4096 {
-			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.BlobKzgCommitments {
-			if len(i) != 48 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.PutBytes(i)
-		}
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
 
-		numItems := uint64(len(b.BlobKzgCommitments))
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
-		}
+	// Field (2) 'RecipientSignature'
+	if size := len(s.RecipientSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RecipientSignature", size, 96)
+		return
 	}
+	hh.PutBytes(s.RecipientSignature)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -6341,149 +9868,127 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockContentsDeneb object
-func (s *SignedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// MarshalSSZ ssz marshals the LightClientBootstrap object
+func (l *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockContentsDeneb object to a target array
-func (s *SignedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the LightClientBootstrap object to a target array
+func (l *LightClientBootstrap) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
 
-	// Offset (0) 'SignedBlock'
-	dst = ssz.WriteOffset(dst, offset)
-	if s.SignedBlock == nil {
-		s.SignedBlock = new(SignedBeaconBlockDeneb)
+	// Field (0) 'Header'
+	if l.Header == nil {
+		l.Header = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.Header.MarshalSSZTo(dst); err != nil {
+		return
 	}
-	offset += s.SignedBlock.SizeSSZ()
-
-	// Offset (1) 'SignedBlobSidecars'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(s.SignedBlobSidecars) * 131352
 
-	// Field (0) 'SignedBlock'
-	if dst, err = s.SignedBlock.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (1) 'SignedBlobSidecars'
-	if size := len(s.SignedBlobSidecars); size > 6 {
-		err = ssz.ErrListTooBigFn("--.SignedBlobSidecars", size, 6)
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	if size := len(l.CurrentSyncCommitteeBranch); size != 5 {
+		err = ssz.ErrVectorLengthFn("--.CurrentSyncCommitteeBranch", size, 5)
 		return
 	}
-	for ii := 0; ii < len(s.SignedBlobSidecars); ii++ {
-		if dst, err = s.SignedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < 5; ii++ {
+		if size := len(l.CurrentSyncCommitteeBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.CurrentSyncCommitteeBranch[ii]", size, 32)
 			return
 		}
+		dst = append(dst, l.CurrentSyncCommitteeBranch[ii]...)
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockContentsDeneb object
-func (s *SignedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the LightClientBootstrap object
+func (l *LightClientBootstrap) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size != 24896 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0, o1 uint64
-
-	// Offset (0) 'SignedBlock'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'Header'
+	if l.Header == nil {
+		l.Header = new(v1.BeaconBlockHeader)
 	}
-
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	if err = l.Header.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
 	}
 
-	// Offset (1) 'SignedBlobSidecars'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
 	}
-
-	// Field (0) 'SignedBlock'
-	{
-		buf = tail[o0:o1]
-		if s.SignedBlock == nil {
-			s.SignedBlock = new(SignedBeaconBlockDeneb)
-		}
-		if err = s.SignedBlock.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if err = l.CurrentSyncCommittee.UnmarshalSSZ(buf[112:24736]); err != nil {
+		return err
 	}
 
-	// Field (1) 'SignedBlobSidecars'
-	{
-		buf = tail[o1:]
-		num, err := ssz.DivideInt2(len(buf), 131352, 6)
-		if err != nil {
-			return err
-		}
-		s.SignedBlobSidecars = make([]*SignedBlobSidecar, num)
-		for ii := 0; ii < num; ii++ {
-			if s.SignedBlobSidecars[ii] == nil {
-				s.SignedBlobSidecars[ii] = new(SignedBlobSidecar)
-			}
-			if err = s.SignedBlobSidecars[ii].UnmarshalSSZ(buf[ii*131352 : (ii+1)*131352]); err != nil {
-				return err
-			}
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	l.CurrentSyncCommitteeBranch = make([][]byte, 5)
+	for ii := 0; ii < 5; ii++ {
+		if cap(l.CurrentSyncCommitteeBranch[ii]) == 0 {
+			l.CurrentSyncCommitteeBranch[ii] = make([]byte, 0, len(buf[24736:24896][ii*32:(ii+1)*32]))
 		}
+		l.CurrentSyncCommitteeBranch[ii] = append(l.CurrentSyncCommitteeBranch[ii], buf[24736:24896][ii*32:(ii+1)*32]...)
 	}
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockContentsDeneb object
-func (s *SignedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
-	size = 8
-
-	// Field (0) 'SignedBlock'
-	if s.SignedBlock == nil {
-		s.SignedBlock = new(SignedBeaconBlockDeneb)
-	}
-	size += s.SignedBlock.SizeSSZ()
-
-	// Field (1) 'SignedBlobSidecars'
-	size += len(s.SignedBlobSidecars) * 131352
-
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientBootstrap object
+func (l *LightClientBootstrap) SizeSSZ() (size int) {
+	size = 24896
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockContentsDeneb object
-func (s *SignedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the LightClientBootstrap object
+func (l *LightClientBootstrap) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockContentsDeneb object with a hasher
-func (s *SignedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the LightClientBootstrap object with a hasher
+func (l *LightClientBootstrap) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'SignedBlock'
-	if err = s.SignedBlock.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Header'
+	if err = l.Header.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (1) 'SignedBlobSidecars'
+	// Field (1) 'CurrentSyncCommittee'
+	if err = l.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(s.SignedBlobSidecars))
-		if num > 6 {
-			err = ssz.ErrIncorrectListSize
+		if size := len(l.CurrentSyncCommitteeBranch); size != 5 {
+			err = ssz.ErrVectorLengthFn("--.CurrentSyncCommitteeBranch", size, 5)
 			return
 		}
-		for _, elem := range s.SignedBlobSidecars {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range l.CurrentSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
+
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+			hh.MerkleizeVectorizedHTR(subIndx)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 6)
+			hh.Merkleize(subIndx)
 		}
 	}
 
@@ -6495,152 +10000,225 @@ func (s *SignedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err e
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockContentsDeneb object
-func (s *SignedBlindedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// MarshalSSZ ssz marshals the LightClientUpdate object
+func (l *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
 }
 
-// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockContentsDeneb object to a target array
-func (s *SignedBlindedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the LightClientUpdate object to a target array
+func (l *LightClientUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
 
-	// Offset (0) 'SignedBlindedBlock'
-	dst = ssz.WriteOffset(dst, offset)
-	if s.SignedBlindedBlock == nil {
-		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
 	}
-	offset += s.SignedBlindedBlock.SizeSSZ()
 
-	// Offset (1) 'SignedBlindedBlobSidecars'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(s.SignedBlindedBlobSidecars) * 312
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (0) 'SignedBlindedBlock'
-	if dst, err = s.SignedBlindedBlock.MarshalSSZTo(dst); err != nil {
+	// Field (2) 'NextSyncCommitteeBranch'
+	if size := len(l.NextSyncCommitteeBranch); size != 5 {
+		err = ssz.ErrVectorLengthFn("--.NextSyncCommitteeBranch", size, 5)
 		return
 	}
+	for ii := 0; ii < 5; ii++ {
+		if size := len(l.NextSyncCommitteeBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.NextSyncCommitteeBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.NextSyncCommitteeBranch[ii]...)
+	}
 
-	// Field (1) 'SignedBlindedBlobSidecars'
-	if size := len(s.SignedBlindedBlobSidecars); size > 6 {
-		err = ssz.ErrListTooBigFn("--.SignedBlindedBlobSidecars", size, 6)
+	// Field (3) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	for ii := 0; ii < len(s.SignedBlindedBlobSidecars); ii++ {
-		if dst, err = s.SignedBlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+
+	// Field (4) 'FinalityBranch'
+	if size := len(l.FinalityBranch); size != 6 {
+		err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
+		return
+	}
+	for ii := 0; ii < 6; ii++ {
+		if size := len(l.FinalityBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.FinalityBranch[ii]", size, 32)
 			return
 		}
+		dst = append(dst, l.FinalityBranch[ii]...)
 	}
 
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockContentsDeneb object
-func (s *SignedBlindedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the LightClientUpdate object
+func (l *LightClientUpdate) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size != 25368 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0, o1 uint64
-
-	// Offset (0) 'SignedBlindedBlock'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
 	}
-
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
 	}
 
-	// Offset (1) 'SignedBlindedBlobSidecars'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = l.NextSyncCommittee.UnmarshalSSZ(buf[112:24736]); err != nil {
+		return err
 	}
 
-	// Field (0) 'SignedBlindedBlock'
-	{
-		buf = tail[o0:o1]
-		if s.SignedBlindedBlock == nil {
-			s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
-		}
-		if err = s.SignedBlindedBlock.UnmarshalSSZ(buf); err != nil {
-			return err
+	// Field (2) 'NextSyncCommitteeBranch'
+	l.NextSyncCommitteeBranch = make([][]byte, 5)
+	for ii := 0; ii < 5; ii++ {
+		if cap(l.NextSyncCommitteeBranch[ii]) == 0 {
+			l.NextSyncCommitteeBranch[ii] = make([]byte, 0, len(buf[24736:24896][ii*32:(ii+1)*32]))
 		}
+		l.NextSyncCommitteeBranch[ii] = append(l.NextSyncCommitteeBranch[ii], buf[24736:24896][ii*32:(ii+1)*32]...)
 	}
 
-	// Field (1) 'SignedBlindedBlobSidecars'
-	{
-		buf = tail[o1:]
-		num, err := ssz.DivideInt2(len(buf), 312, 6)
-		if err != nil {
-			return err
-		}
-		s.SignedBlindedBlobSidecars = make([]*SignedBlindedBlobSidecar, num)
-		for ii := 0; ii < num; ii++ {
-			if s.SignedBlindedBlobSidecars[ii] == nil {
-				s.SignedBlindedBlobSidecars[ii] = new(SignedBlindedBlobSidecar)
-			}
-			if err = s.SignedBlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*312 : (ii+1)*312]); err != nil {
-				return err
-			}
-		}
+	// Field (3) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.FinalizedHeader.UnmarshalSSZ(buf[24896:25008]); err != nil {
+		return err
 	}
-	return err
-}
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockContentsDeneb object
-func (s *SignedBlindedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
-	size = 8
+	// Field (4) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := 0; ii < 6; ii++ {
+		if cap(l.FinalityBranch[ii]) == 0 {
+			l.FinalityBranch[ii] = make([]byte, 0, len(buf[25008:25200][ii*32:(ii+1)*32]))
+		}
+		l.FinalityBranch[ii] = append(l.FinalityBranch[ii], buf[25008:25200][ii*32:(ii+1)*32]...)
+	}
 
-	// Field (0) 'SignedBlindedBlock'
-	if s.SignedBlindedBlock == nil {
-		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockDeneb)
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[25200:25360]); err != nil {
+		return err
 	}
-	size += s.SignedBlindedBlock.SizeSSZ()
 
-	// Field (1) 'SignedBlindedBlobSidecars'
-	size += len(s.SignedBlindedBlobSidecars) * 312
+	// Field (6) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[25360:25368]))
 
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientUpdate object
+func (l *LightClientUpdate) SizeSSZ() (size int) {
+	size = 25368
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockContentsDeneb object
-func (s *SignedBlindedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// HashTreeRoot ssz hashes the LightClientUpdate object
+func (l *LightClientUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
 }
 
-// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockContentsDeneb object with a hasher
-func (s *SignedBlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the LightClientUpdate object with a hasher
+func (l *LightClientUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'SignedBlindedBlock'
-	if err = s.SignedBlindedBlock.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if err = l.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	{
+		if size := len(l.NextSyncCommitteeBranch); size != 5 {
+			err = ssz.ErrVectorLengthFn("--.NextSyncCommitteeBranch", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.NextSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (1) 'SignedBlindedBlobSidecars'
+	// Field (4) 'FinalityBranch'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(s.SignedBlindedBlobSidecars))
-		if num > 6 {
-			err = ssz.ErrIncorrectListSize
+		if size := len(l.FinalityBranch); size != 6 {
+			err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
 			return
 		}
-		for _, elem := range s.SignedBlindedBlobSidecars {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
+
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+			hh.MerkleizeVectorizedHTR(subIndx)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 6)
+			hh.Merkleize(subIndx)
 		}
 	}
 
+	// Field (5) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -6649,152 +10227,160 @@ func (s *SignedBlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher)
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockContentsDeneb object
-func (b *BeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZ ssz marshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockContentsDeneb object to a target array
-func (b *BeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the LightClientFinalityUpdate object to a target array
+func (l *LightClientFinalityUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
 
-	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.Block == nil {
-		b.Block = new(BeaconBlockDeneb)
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
 	}
-	offset += b.Block.SizeSSZ()
-
-	// Offset (1) 'BlobSidecars'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.BlobSidecars) * 131256
 
-	// Field (0) 'Block'
-	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (1) 'BlobSidecars'
-	if size := len(b.BlobSidecars); size > 6 {
-		err = ssz.ErrListTooBigFn("--.BlobSidecars", size, 6)
+	// Field (2) 'FinalityBranch'
+	if size := len(l.FinalityBranch); size != 6 {
+		err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
 		return
 	}
-	for ii := 0; ii < len(b.BlobSidecars); ii++ {
-		if dst, err = b.BlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < 6; ii++ {
+		if size := len(l.FinalityBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.FinalityBranch[ii]", size, 32)
 			return
 		}
+		dst = append(dst, l.FinalityBranch[ii]...)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
+	// Field (4) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockContentsDeneb object
-func (b *BeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size != 584 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0, o1 uint64
-
-	// Offset (0) 'Block'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
 	}
-
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
 	}
 
-	// Offset (1) 'BlobSidecars'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (1) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
 	}
-
-	// Field (0) 'Block'
-	{
-		buf = tail[o0:o1]
-		if b.Block == nil {
-			b.Block = new(BeaconBlockDeneb)
-		}
-		if err = b.Block.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if err = l.FinalizedHeader.UnmarshalSSZ(buf[112:224]); err != nil {
+		return err
 	}
 
-	// Field (1) 'BlobSidecars'
-	{
-		buf = tail[o1:]
-		num, err := ssz.DivideInt2(len(buf), 131256, 6)
-		if err != nil {
-			return err
-		}
-		b.BlobSidecars = make([]*BlobSidecar, num)
-		for ii := 0; ii < num; ii++ {
-			if b.BlobSidecars[ii] == nil {
-				b.BlobSidecars[ii] = new(BlobSidecar)
-			}
-			if err = b.BlobSidecars[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
-				return err
-			}
+	// Field (2) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := 0; ii < 6; ii++ {
+		if cap(l.FinalityBranch[ii]) == 0 {
+			l.FinalityBranch[ii] = make([]byte, 0, len(buf[224:416][ii*32:(ii+1)*32]))
 		}
+		l.FinalityBranch[ii] = append(l.FinalityBranch[ii], buf[224:416][ii*32:(ii+1)*32]...)
 	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockContentsDeneb object
-func (b *BeaconBlockContentsDeneb) SizeSSZ() (size int) {
-	size = 8
 
-	// Field (0) 'Block'
-	if b.Block == nil {
-		b.Block = new(BeaconBlockDeneb)
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[416:576]); err != nil {
+		return err
 	}
-	size += b.Block.SizeSSZ()
 
-	// Field (1) 'BlobSidecars'
-	size += len(b.BlobSidecars) * 131256
+	// Field (4) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[576:584]))
 
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) SizeSSZ() (size int) {
+	size = 584
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockContentsDeneb object
-func (b *BeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// HashTreeRoot ssz hashes the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockContentsDeneb object with a hasher
-func (b *BeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the LightClientFinalityUpdate object with a hasher
+func (l *LightClientFinalityUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Block'
-	if err = b.Block.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (1) 'BlobSidecars'
+	// Field (1) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'FinalityBranch'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.BlobSidecars))
-		if num > 6 {
-			err = ssz.ErrIncorrectListSize
+		if size := len(l.FinalityBranch); size != 6 {
+			err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
 			return
 		}
-		for _, elem := range b.BlobSidecars {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
 				return
 			}
+			hh.Append(i)
 		}
+
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+			hh.MerkleizeVectorizedHTR(subIndx)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 6)
+			hh.Merkleize(subIndx)
 		}
 	}
 
+	// Field (3) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -6803,152 +10389,95 @@ func (b *BeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error)
 	return
 }
 
-// MarshalSSZ ssz marshals the BlindedBeaconBlockContentsDeneb object
-func (b *BlindedBeaconBlockContentsDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZ ssz marshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
 }
 
-// MarshalSSZTo ssz marshals the BlindedBeaconBlockContentsDeneb object to a target array
-func (b *BlindedBeaconBlockContentsDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the LightClientOptimisticUpdate object to a target array
+func (l *LightClientOptimisticUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(8)
 
-	// Offset (0) 'BlindedBlock'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.BlindedBlock == nil {
-		b.BlindedBlock = new(BlindedBeaconBlockDeneb)
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
 	}
-	offset += b.BlindedBlock.SizeSSZ()
-
-	// Offset (1) 'BlindedBlobSidecars'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.BlindedBlobSidecars) * 216
-
-	// Field (0) 'BlindedBlock'
-	if dst, err = b.BlindedBlock.MarshalSSZTo(dst); err != nil {
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (1) 'BlindedBlobSidecars'
-	if size := len(b.BlindedBlobSidecars); size > 6 {
-		err = ssz.ErrListTooBigFn("--.BlindedBlobSidecars", size, 6)
-		return
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
 	}
-	for ii := 0; ii < len(b.BlindedBlobSidecars); ii++ {
-		if dst, err = b.BlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
+	// Field (2) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockContentsDeneb object
-func (b *BlindedBeaconBlockContentsDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 8 {
+	if size != 280 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0, o1 uint64
-
-	// Offset (0) 'BlindedBlock'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
 	}
-
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
 	}
 
-	// Offset (1) 'BlindedBlobSidecars'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
 	}
-
-	// Field (0) 'BlindedBlock'
-	{
-		buf = tail[o0:o1]
-		if b.BlindedBlock == nil {
-			b.BlindedBlock = new(BlindedBeaconBlockDeneb)
-		}
-		if err = b.BlindedBlock.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[112:272]); err != nil {
+		return err
 	}
 
-	// Field (1) 'BlindedBlobSidecars'
-	{
-		buf = tail[o1:]
-		num, err := ssz.DivideInt2(len(buf), 216, 6)
-		if err != nil {
-			return err
-		}
-		b.BlindedBlobSidecars = make([]*BlindedBlobSidecar, num)
-		for ii := 0; ii < num; ii++ {
-			if b.BlindedBlobSidecars[ii] == nil {
-				b.BlindedBlobSidecars[ii] = new(BlindedBlobSidecar)
-			}
-			if err = b.BlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*216 : (ii+1)*216]); err != nil {
-				return err
-			}
-		}
-	}
+	// Field (2) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[272:280]))
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockContentsDeneb object
-func (b *BlindedBeaconBlockContentsDeneb) SizeSSZ() (size int) {
-	size = 8
-
-	// Field (0) 'BlindedBlock'
-	if b.BlindedBlock == nil {
-		b.BlindedBlock = new(BlindedBeaconBlockDeneb)
-	}
-	size += b.BlindedBlock.SizeSSZ()
-
-	// Field (1) 'BlindedBlobSidecars'
-	size += len(b.BlindedBlobSidecars) * 216
-
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) SizeSSZ() (size int) {
+	size = 280
 	return
 }
 
-// HashTreeRoot ssz hashes the BlindedBeaconBlockContentsDeneb object
-func (b *BlindedBeaconBlockContentsDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// HashTreeRoot ssz hashes the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
 }
 
-// HashTreeRootWith ssz hashes the BlindedBeaconBlockContentsDeneb object with a hasher
-func (b *BlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the LightClientOptimisticUpdate object with a hasher
+func (l *LightClientOptimisticUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'BlindedBlock'
-	if err = b.BlindedBlock.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (1) 'BlindedBlobSidecars'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.BlindedBlobSidecars))
-		if num > 6 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.BlindedBlobSidecars {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 6)
-		}
+	// Field (1) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
+	// Field (2) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -81,6 +81,20 @@ func V1Alpha1BeaconBlockDenebToV2(v1alpha1Block *ethpbalpha.BeaconBlockDeneb) (*
 	return v2Block, nil
 }
 
+// V1Alpha1BeaconBlockElectraToV2 converts a v1alpha1 Electra beacon block to a v2
+// Electra block.
+func V1Alpha1BeaconBlockElectraToV2(v1alpha1Block *ethpbalpha.BeaconBlockElectra) (*ethpbv2.BeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(v1alpha1Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.BeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
 // V1Alpha1SignedBeaconBlockDenebToV2 converts a v1alpha1 signed Deneb beacon block to a v2
 // Deneb block.
 func V1Alpha1SignedBeaconBlockDenebToV2(v1alpha1Block *ethpbalpha.SignedBeaconBlockDeneb) (*ethpbv2.SignedBeaconBlockDeneb, error) {
@@ -95,6 +109,20 @@ func V1Alpha1SignedBeaconBlockDenebToV2(v1alpha1Block *ethpbalpha.SignedBeaconBl
 	return v2Block, nil
 }
 
+// V1Alpha1SignedBeaconBlockElectraToV2 converts a v1alpha1 signed Electra beacon block to a v2
+// Electra block.
+func V1Alpha1SignedBeaconBlockElectraToV2(v1alpha1Block *ethpbalpha.SignedBeaconBlockElectra) (*ethpbv2.SignedBeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(v1alpha1Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.SignedBeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
 // V1Alpha1BlobSidecarsToV2 converts an array of v1alpha1 blinded blob sidecars to its v2 equivalent.
 func V1Alpha1BlobSidecarsToV2(v1alpha1Blobs []*ethpbalpha.BlobSidecar) ([]*ethpbv2.BlobSidecar, error) {
 	v2Blobs := make([]*ethpbv2.BlobSidecar, len(v1alpha1Blobs))
@@ -193,6 +221,20 @@ func V1Alpha1BeaconBlockDenebAndBlobsToV2(v1alpha1Block *ethpbalpha.BeaconBlockA
 	return &ethpbv2.BeaconBlockContentsDeneb{Block: v2Block, BlobSidecars: v2Blobs}, nil
 }
 
+// V1Alpha1BeaconBlockElectraAndBlobsToV2 converts a v1alpha1 Electra beacon block and blobs to a v2
+// Electra block.
+func V1Alpha1BeaconBlockElectraAndBlobsToV2(v1alpha1Block *ethpbalpha.BeaconBlockAndBlobsElectra) (*ethpbv2.BeaconBlockContentsElectra, error) {
+	v2Block, err := V1Alpha1BeaconBlockElectraToV2(v1alpha1Block.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	v2Blobs, err := V1Alpha1BlobSidecarsToV2(v1alpha1Block.Blobs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert blobs")
+	}
+	return &ethpbv2.BeaconBlockContentsElectra{Block: v2Block, BlobSidecars: v2Blobs}, nil
+}
+
 // V1Alpha1SignedBeaconBlockDenebAndBlobsToV2 converts a signed v1alpha1 Deneb beacon block and blobs to a v2
 // Deneb block.
 func V1Alpha1SignedBeaconBlockDenebAndBlobsToV2(v1alpha1Block *ethpbalpha.SignedBeaconBlockAndBlobsDeneb) (*ethpbv2.SignedBeaconBlockContentsDeneb, error) {
@@ -207,6 +249,20 @@ func V1Alpha1SignedBeaconBlockDenebAndBlobsToV2(v1alpha1Block *ethpbalpha.Signed
 	}, nil
 }
 
+// V1Alpha1SignedBeaconBlockElectraAndBlobsToV2 converts a signed v1alpha1 Electra beacon block and blobs to a v2
+// Electra block.
+func V1Alpha1SignedBeaconBlockElectraAndBlobsToV2(v1alpha1Block *ethpbalpha.SignedBeaconBlockAndBlobsElectra) (*ethpbv2.SignedBeaconBlockContentsElectra, error) {
+	v2Block, err := V1Alpha1SignedBeaconBlockElectraToV2(v1alpha1Block.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	v2Blobs := V1Alpha1SignedBlobsToV2(v1alpha1Block.Blobs)
+	return &ethpbv2.SignedBeaconBlockContentsElectra{
+		SignedBlock:        v2Block,
+		SignedBlobSidecars: v2Blobs,
+	}, nil
+}
+
 // V1Alpha1BeaconBlockBlindedBellatrixToV2Blinded converts a v1alpha1 Blinded Bellatrix beacon block to a v2 Blinded Bellatrix block.
 func V1Alpha1BeaconBlockBlindedBellatrixToV2Blinded(v1alpha1Block *ethpbalpha.BlindedBeaconBlockBellatrix) (*ethpbv2.BlindedBeaconBlockBellatrix, error) {
 	marshaledBlk, err := proto.Marshal(v1alpha1Block)
@@ -246,6 +302,19 @@ func V1Alpha1BeaconBlockBlindedDenebToV2Blinded(v1alpha1Block *ethpbalpha.Blinde
 	return v2Block, nil
 }
 
+// V1Alpha1BeaconBlockBlindedElectraToV2Blinded converts a v1alpha1 Blinded Electra beacon block to a v2 Blinded Electra block.
+func V1Alpha1BeaconBlockBlindedElectraToV2Blinded(v1alpha1Block *ethpbalpha.BlindedBeaconBlockElectra) (*ethpbv2.BlindedBeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(v1alpha1Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.BlindedBeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
 // V1Alpha1SignedBeaconBlockBlindedDenebToV2Blinded converts a v1alpha1 Signed Blinded Deneb beacon block to a v2 Blinded Deneb block.
 func V1Alpha1SignedBeaconBlockBlindedDenebToV2Blinded(v1alpha1Block *ethpbalpha.SignedBlindedBeaconBlockDeneb) (*ethpbv2.SignedBlindedBeaconBlockDeneb, error) {
 	marshaledBlk, err := proto.Marshal(v1alpha1Block)
@@ -259,6 +328,19 @@ func V1Alpha1SignedBeaconBlockBlindedDenebToV2Blinded(v1alpha1Block *ethpbalpha.
 	return v2Block, nil
 }
 
+// V1Alpha1SignedBeaconBlockBlindedElectraToV2Blinded converts a v1alpha1 Signed Blinded Electra beacon block to a v2 Blinded Electra block.
+func V1Alpha1SignedBeaconBlockBlindedElectraToV2Blinded(v1alpha1Block *ethpbalpha.SignedBlindedBeaconBlockElectra) (*ethpbv2.SignedBlindedBeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(v1alpha1Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v2Block := &ethpbv2.SignedBlindedBeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v2Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v2Block, nil
+}
+
 // V1Alpha1BlindedBlockAndBlobsDenebToV2Blinded converts a v1alpha1 Deneb blinded beacon block and blobs to v2 blinded block contents.
 func V1Alpha1BlindedBlockAndBlobsDenebToV2Blinded(
 	v1Alpha1BlkAndBlobs *ethpbalpha.BlindedBeaconBlockAndBlobsDeneb,
@@ -274,6 +356,21 @@ func V1Alpha1BlindedBlockAndBlobsDenebToV2Blinded(
 	return &ethpbv2.BlindedBeaconBlockContentsDeneb{BlindedBlock: v2Block, BlindedBlobSidecars: v2Blobs}, nil
 }
 
+// V1Alpha1BlindedBlockAndBlobsElectraToV2Blinded converts a v1alpha1 Electra blinded beacon block and blobs to v2 blinded block contents.
+func V1Alpha1BlindedBlockAndBlobsElectraToV2Blinded(
+	v1Alpha1BlkAndBlobs *ethpbalpha.BlindedBeaconBlockAndBlobsElectra,
+) (*ethpbv2.BlindedBeaconBlockContentsElectra, error) {
+	v2Block, err := V1Alpha1BeaconBlockBlindedElectraToV2Blinded(v1Alpha1BlkAndBlobs.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	v2Blobs, err := V1Alpha1BlindedBlobSidecarsToV2(v1Alpha1BlkAndBlobs.Blobs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert blobs")
+	}
+	return &ethpbv2.BlindedBeaconBlockContentsElectra{BlindedBlock: v2Block, BlindedBlobSidecars: v2Blobs}, nil
+}
+
 // V1Alpha1SignedBlindedBlockAndBlobsDenebToV2Blinded converts a v1alpha1 signed Deneb blinded beacon block and blobs to v2 blinded block contents.
 func V1Alpha1SignedBlindedBlockAndBlobsDenebToV2Blinded(
 	v1Alpha1BlkAndBlobs *ethpbalpha.SignedBlindedBeaconBlockAndBlobsDeneb,
@@ -289,6 +386,21 @@ func V1Alpha1SignedBlindedBlockAndBlobsDenebToV2Blinded(
 	}, nil
 }
 
+// V1Alpha1SignedBlindedBlockAndBlobsElectraToV2Blinded converts a v1alpha1 signed Electra blinded beacon block and blobs to v2 blinded block contents.
+func V1Alpha1SignedBlindedBlockAndBlobsElectraToV2Blinded(
+	v1Alpha1BlkAndBlobs *ethpbalpha.SignedBlindedBeaconBlockAndBlobsElectra,
+) (*ethpbv2.SignedBlindedBeaconBlockContentsElectra, error) {
+	v2Block, err := V1Alpha1SignedBeaconBlockBlindedElectraToV2Blinded(v1Alpha1BlkAndBlobs.SignedBlindedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	v2Blobs := V1Alpha1SignedBlindedBlobSidecarsToV2(v1Alpha1BlkAndBlobs.SignedBlindedBlobSidecars)
+	return &ethpbv2.SignedBlindedBeaconBlockContentsElectra{
+		SignedBlindedBlock:        v2Block,
+		SignedBlindedBlobSidecars: v2Blobs,
+	}, nil
+}
+
 // V1Alpha1BeaconBlockBellatrixToV2Blinded converts a v1alpha1 Bellatrix beacon block to a v2
 // blinded Bellatrix block.
 func V1Alpha1BeaconBlockBellatrixToV2Blinded(v1alpha1Block *ethpbalpha.BeaconBlockBellatrix) (*ethpbv2.BlindedBeaconBlockBellatrix, error) {
@@ -1319,6 +1431,236 @@ func BeaconStateDenebToProto(st state.BeaconState) (*ethpbv2.BeaconStateDeneb, e
 	return result, nil
 }
 
+// BeaconStateElectraToProto converts a state.BeaconState object to its protobuf equivalent.
+func BeaconStateElectraToProto(st state.BeaconState) (*ethpbv2.BeaconStateElectra, error) {
+	sourceFork := st.Fork()
+	sourceLatestBlockHeader := st.LatestBlockHeader()
+	sourceEth1Data := st.Eth1Data()
+	sourceEth1DataVotes := st.Eth1DataVotes()
+	sourceSharedActivity := st.SharedActivity()
+	sourceValidators := st.Validators()
+	sourceJustificationBits := st.JustificationBits()
+	sourcePrevJustifiedCheckpoint := st.PreviousJustifiedCheckpoint()
+	sourceCurrJustifiedCheckpoint := st.CurrentJustifiedCheckpoint()
+	sourceFinalizedCheckpoint := st.FinalizedCheckpoint()
+
+	resultEth1DataVotes := make([]*ethpbv1.Eth1Data, len(sourceEth1DataVotes))
+	for i, vote := range sourceEth1DataVotes {
+		resultEth1DataVotes[i] = &ethpbv1.Eth1Data{
+			DepositRoot:  bytesutil.SafeCopyBytes(vote.DepositRoot),
+			DepositCount: vote.DepositCount,
+			BlockHash:    bytesutil.SafeCopyBytes(vote.BlockHash),
+		}
+	}
+	resultValidators := make([]*ethpbv1.Validator, len(sourceValidators))
+	for i, validator := range sourceValidators {
+		resultValidators[i] = &ethpbv1.Validator{
+			Pubkey:                     bytesutil.SafeCopyBytes(validator.PublicKey),
+			WithdrawalCredentials:      bytesutil.SafeCopyBytes(validator.WithdrawalCredentials),
+			Contract:                   bytesutil.SafeCopyBytes(validator.Contract),
+			EffectiveBalance:           validator.EffectiveBalance,
+			EffectiveActivity:          validator.EffectiveActivity,
+			Slashed:                    validator.Slashed,
+			ActivationEligibilityEpoch: validator.ActivationEligibilityEpoch,
+			ActivationEpoch:            validator.ActivationEpoch,
+			ExitEpoch:                  validator.ExitEpoch,
+			WithdrawableEpoch:          validator.WithdrawableEpoch,
+		}
+	}
+
+	sourcePrevEpochParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous epoch participation")
+	}
+	sourceCurrEpochParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current epoch participation")
+	}
+	sourceInactivityScores, err := st.InactivityScores()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get inactivity scores")
+	}
+	sourceCurrSyncCommittee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	sourceNextSyncCommittee, err := st.NextSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next sync committee")
+	}
+	executionPayloadHeaderInterface, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get latest execution payload header")
+	}
+	sourceLatestExecutionPayloadHeader, ok := executionPayloadHeaderInterface.Proto().(*enginev1.ExecutionPayloadHeaderDeneb)
+	if !ok {
+		return nil, errors.New("execution payload header has incorrect type")
+	}
+	sourceNextWithdrawalIndex, err := st.NextWithdrawalIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next withdrawal index")
+	}
+	sourceNextWithdrawalValIndex, err := st.NextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next withdrawal validator index")
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get historical summaries")
+	}
+	sourceHistoricalSummaries := make([]*ethpbv2.HistoricalSummary, len(summaries))
+	for i, summary := range summaries {
+		sourceHistoricalSummaries[i] = &ethpbv2.HistoricalSummary{
+			BlockSummaryRoot: summary.BlockSummaryRoot,
+			StateSummaryRoot: summary.StateSummaryRoot,
+		}
+	}
+
+	hr, err := st.HistoricalRoots()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get historical roots")
+	}
+	additionalContracts, err := st.AdditionalContracts()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get additional contracts")
+	}
+	sourceAdditionalContracts := make([]*ethpbv2.ValidatorContract, len(additionalContracts))
+	for i, c := range additionalContracts {
+		sourceAdditionalContracts[i] = &ethpbv2.ValidatorContract{
+			ValidatorIndex: c.ValidatorIndex,
+			Contract:       bytesutil.SafeCopyBytes(c.Contract),
+		}
+	}
+	pendingActivityCredits, err := st.PendingActivityCredits()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending activity credits")
+	}
+	sourcePendingActivityCredits := make([]*ethpbv2.PendingActivityCredit, len(pendingActivityCredits))
+	for i, c := range pendingActivityCredits {
+		sourcePendingActivityCredits[i] = &ethpbv2.PendingActivityCredit{
+			ValidatorIndex: c.ValidatorIndex,
+			Activity:       c.Activity,
+		}
+	}
+	rejectedContractBindings, err := st.RejectedContractBindings()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get rejected contract bindings")
+	}
+	sourceRejectedContractBindings := make([]*ethpbv2.RejectedContractBinding, len(rejectedContractBindings))
+	for i, b := range rejectedContractBindings {
+		sourceRejectedContractBindings[i] = &ethpbv2.RejectedContractBinding{
+			ValidatorIndex: b.ValidatorIndex,
+			Contract:       bytesutil.SafeCopyBytes(b.Contract),
+			OwnerIndex:     b.OwnerIndex,
+			Epoch:          b.Epoch,
+		}
+	}
+	contractTransferNonces, err := st.ContractTransferNonces()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get contract transfer nonces")
+	}
+	sourceContractTransferNonces := make([]*ethpbv2.ContractTransferNonce, len(contractTransferNonces))
+	for i, n := range contractTransferNonces {
+		sourceContractTransferNonces[i] = &ethpbv2.ContractTransferNonce{
+			ValidatorIndex: n.ValidatorIndex,
+			Nonce:          n.Nonce,
+		}
+	}
+
+	result := &ethpbv2.BeaconStateElectra{
+		GenesisTime:           st.GenesisTime(),
+		GenesisValidatorsRoot: bytesutil.SafeCopyBytes(st.GenesisValidatorsRoot()),
+		Slot:                  st.Slot(),
+		Fork: &ethpbv1.Fork{
+			PreviousVersion: bytesutil.SafeCopyBytes(sourceFork.PreviousVersion),
+			CurrentVersion:  bytesutil.SafeCopyBytes(sourceFork.CurrentVersion),
+			Epoch:           sourceFork.Epoch,
+		},
+		LatestBlockHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:          sourceLatestBlockHeader.Slot,
+			ProposerIndex: sourceLatestBlockHeader.ProposerIndex,
+			ParentRoot:    bytesutil.SafeCopyBytes(sourceLatestBlockHeader.ParentRoot),
+			StateRoot:     bytesutil.SafeCopyBytes(sourceLatestBlockHeader.StateRoot),
+			BodyRoot:      bytesutil.SafeCopyBytes(sourceLatestBlockHeader.BodyRoot),
+		},
+		BlockRoots:      bytesutil.SafeCopy2dBytes(st.BlockRoots()),
+		StateRoots:      bytesutil.SafeCopy2dBytes(st.StateRoots()),
+		HistoricalRoots: bytesutil.SafeCopy2dBytes(hr),
+		Eth1Data: &ethpbv1.Eth1Data{
+			DepositRoot:  bytesutil.SafeCopyBytes(sourceEth1Data.DepositRoot),
+			DepositCount: sourceEth1Data.DepositCount,
+			BlockHash:    bytesutil.SafeCopyBytes(sourceEth1Data.BlockHash),
+		},
+		Eth1DataVotes:    resultEth1DataVotes,
+		Eth1DepositIndex: st.Eth1DepositIndex(),
+		SharedActivity: &ethpbv1.SharedActivity{
+			TransactionsGasPerPeriod: sourceSharedActivity.TransactionsGasPerPeriod,
+			TransactionsGasPerEpoch:  sourceSharedActivity.TransactionsGasPerEpoch,
+			BaseFeePerPeriod:         sourceSharedActivity.BaseFeePerPeriod,
+			BaseFeePerEpoch:          sourceSharedActivity.BaseFeePerEpoch,
+		},
+		Validators:                 resultValidators,
+		Balances:                   st.Balances(),
+		Activities:                 st.Activities(),
+		RandaoMixes:                bytesutil.SafeCopy2dBytes(st.RandaoMixes()),
+		Slashings:                  st.Slashings(),
+		PreviousEpochParticipation: bytesutil.SafeCopyBytes(sourcePrevEpochParticipation),
+		CurrentEpochParticipation:  bytesutil.SafeCopyBytes(sourceCurrEpochParticipation),
+		JustificationBits:          bytesutil.SafeCopyBytes(sourceJustificationBits),
+		PreviousJustifiedCheckpoint: &ethpbv1.Checkpoint{
+			Epoch: sourcePrevJustifiedCheckpoint.Epoch,
+			Root:  bytesutil.SafeCopyBytes(sourcePrevJustifiedCheckpoint.Root),
+		},
+		CurrentJustifiedCheckpoint: &ethpbv1.Checkpoint{
+			Epoch: sourceCurrJustifiedCheckpoint.Epoch,
+			Root:  bytesutil.SafeCopyBytes(sourceCurrJustifiedCheckpoint.Root),
+		},
+		FinalizedCheckpoint: &ethpbv1.Checkpoint{
+			Epoch: sourceFinalizedCheckpoint.Epoch,
+			Root:  bytesutil.SafeCopyBytes(sourceFinalizedCheckpoint.Root),
+		},
+		InactivityScores: sourceInactivityScores,
+		CurrentSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         bytesutil.SafeCopy2dBytes(sourceCurrSyncCommittee.Pubkeys),
+			AggregatePubkey: bytesutil.SafeCopyBytes(sourceCurrSyncCommittee.AggregatePubkey),
+		},
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         bytesutil.SafeCopy2dBytes(sourceNextSyncCommittee.Pubkeys),
+			AggregatePubkey: bytesutil.SafeCopyBytes(sourceNextSyncCommittee.AggregatePubkey),
+		},
+		LatestExecutionPayloadHeader: &enginev1.ExecutionPayloadHeaderDeneb{
+			ParentHash:        bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.ParentHash),
+			FeeRecipient:      bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.FeeRecipient),
+			StateRoot:         bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.StateRoot),
+			ReceiptsRoot:      bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.ReceiptsRoot),
+			ActivitiesRoot:    bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.ActivitiesRoot),
+			TransactionsCount: sourceLatestExecutionPayloadHeader.TransactionsCount,
+			LogsBloom:         bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.LogsBloom),
+			PrevRandao:        bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.PrevRandao),
+			BlockNumber:       sourceLatestExecutionPayloadHeader.BlockNumber,
+			GasLimit:          sourceLatestExecutionPayloadHeader.GasLimit,
+			GasUsed:           sourceLatestExecutionPayloadHeader.GasUsed,
+			Timestamp:         sourceLatestExecutionPayloadHeader.Timestamp,
+			ExtraData:         bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.ExtraData),
+			BaseFeePerGas:     bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.BaseFeePerGas),
+			BlobGasUsed:       sourceLatestExecutionPayloadHeader.BlobGasUsed,
+			ExcessBlobGas:     sourceLatestExecutionPayloadHeader.ExcessBlobGas,
+			BlockHash:         bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.BlockHash),
+			TransactionsRoot:  bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.TransactionsRoot),
+			WithdrawalsRoot:   bytesutil.SafeCopyBytes(sourceLatestExecutionPayloadHeader.WithdrawalsRoot),
+		},
+		NextWithdrawalIndex:          sourceNextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: sourceNextWithdrawalValIndex,
+		HistoricalSummaries:          sourceHistoricalSummaries,
+		AdditionalContracts:          sourceAdditionalContracts,
+		PendingActivityCredits:       sourcePendingActivityCredits,
+		RejectedContractBindings:     sourceRejectedContractBindings,
+		ContractTransferNonces:       sourceContractTransferNonces,
+	}
+
+	return result, nil
+}
+
 // V1Alpha1SignedContributionAndProofToV2 converts a v1alpha1 SignedContributionAndProof object to its v2 equivalent.
 func V1Alpha1SignedContributionAndProofToV2(alphaContribution *ethpbalpha.SignedContributionAndProof) *ethpbv2.SignedContributionAndProof {
 	result := &ethpbv2.SignedContributionAndProof{
@@ -916,3 +916,91 @@ func TestV1Alpha1SignedBLSToExecChangeToV2(t *testing.T) {
 	assert.DeepEqual(t, bytesutil.PadTo([]byte("fromblspubkey"), 48), change.Message.FromBlsPubkey)
 	assert.DeepEqual(t, bytesutil.PadTo([]byte("toexecutionaddress"), 20), change.Message.ToExecutionAddress)
 }
+
+func Test_V1Alpha1SignedBeaconBlockElectraToV2(t *testing.T) {
+	alphaBlock := util.HydrateSignedBeaconBlockElectra(&ethpbalpha.SignedBeaconBlockElectra{})
+	alphaBlock.Block.Slot = slot
+	alphaBlock.Block.ProposerIndex = validatorIndex
+	alphaBlock.Block.ParentRoot = parentRoot
+	alphaBlock.Block.StateRoot = stateRoot
+	alphaBlock.Block.Body.RandaoReveal = randaoReveal
+	alphaBlock.Block.Body.ContractTransfers = []*ethpbalpha.SignedContractTransfer{
+		{
+			Message: &ethpbalpha.ContractTransfer{
+				ValidatorIndex:  validatorIndex,
+				RecipientIndex:  validatorIndex + 1,
+				Contract:        feeRecipient,
+				ValidUntilEpoch: epoch,
+				Nonce:           1,
+			},
+			Signature:          signature,
+			RecipientSignature: signature,
+		},
+	}
+	alphaBlock.Signature = signature
+
+	v2Block, err := V1Alpha1SignedBeaconBlockElectraToV2(alphaBlock)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+	require.Equal(t, 1, len(v2Block.Message.Body.ContractTransfers))
+	assert.DeepEqual(t, feeRecipient, v2Block.Message.Body.ContractTransfers[0].Message.Contract)
+}
+
+func Test_V1Alpha1SignedBeaconBlockBlindedElectraToV2Blinded(t *testing.T) {
+	alphaBlock := util.HydrateSignedBlindedBeaconBlockElectra(&ethpbalpha.SignedBlindedBeaconBlockElectra{})
+	alphaBlock.Message.Slot = slot
+	alphaBlock.Message.ProposerIndex = validatorIndex
+	alphaBlock.Message.Body.ContractTransfers = []*ethpbalpha.SignedContractTransfer{
+		{
+			Message: &ethpbalpha.ContractTransfer{
+				ValidatorIndex: validatorIndex,
+				RecipientIndex: validatorIndex + 1,
+				Contract:       feeRecipient,
+			},
+			Signature:          signature,
+			RecipientSignature: signature,
+		},
+	}
+
+	v2Block, err := V1Alpha1SignedBeaconBlockBlindedElectraToV2Blinded(alphaBlock)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaRoot, v2Root)
+}
+
+func TestBeaconStateElectraToProto(t *testing.T) {
+	source, _ := util.DeterministicGenesisStateElectra(t, 4)
+	require.NoError(t, source.AppendAdditionalContract(1, [20]byte{'a'}))
+	require.NoError(t, source.SetPendingActivityCredits([]*ethpbalpha.PendingActivityCredit{{ValidatorIndex: 2, Activity: 10}}))
+	require.NoError(t, source.SetRejectedContractBindings([]*ethpbalpha.RejectedContractBinding{
+		{ValidatorIndex: 3, Contract: bytesutil.PadTo([]byte("rejected"), 20), OwnerIndex: 1, Epoch: 5},
+	}))
+	require.NoError(t, source.IncrementContractTransferNonce(0))
+
+	result, err := BeaconStateElectraToProto(source)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, source.GenesisTime(), result.GenesisTime)
+	assert.Equal(t, source.NumValidators(), len(result.Validators))
+	require.Equal(t, 1, len(result.AdditionalContracts))
+	assert.Equal(t, primitives.ValidatorIndex(1), result.AdditionalContracts[0].ValidatorIndex)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("a"), 20), result.AdditionalContracts[0].Contract)
+	require.Equal(t, 1, len(result.PendingActivityCredits))
+	assert.Equal(t, primitives.ValidatorIndex(2), result.PendingActivityCredits[0].ValidatorIndex)
+	assert.Equal(t, uint64(10), result.PendingActivityCredits[0].Activity)
+	require.Equal(t, 1, len(result.RejectedContractBindings))
+	assert.Equal(t, primitives.ValidatorIndex(3), result.RejectedContractBindings[0].ValidatorIndex)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("rejected"), 20), result.RejectedContractBindings[0].Contract)
+	assert.Equal(t, primitives.ValidatorIndex(1), result.RejectedContractBindings[0].OwnerIndex)
+	assert.Equal(t, primitives.Epoch(5), result.RejectedContractBindings[0].Epoch)
+	require.Equal(t, 1, len(result.ContractTransferNonces))
+	assert.Equal(t, primitives.ValidatorIndex(0), result.ContractTransferNonces[0].ValidatorIndex)
+	assert.Equal(t, uint64(1), result.ContractTransferNonces[0].Nonce)
+}
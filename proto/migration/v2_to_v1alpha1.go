@@ -60,6 +60,19 @@ func DenebToV1Alpha1SignedBlock(denebBlk *ethpbv2.SignedBeaconBlockDeneb) (*ethp
 	return v1alpha1Block, nil
 }
 
+// ElectraToV1Alpha1SignedBlock converts a v2 SignedBeaconBlockElectra proto to a v1alpha1 proto.
+func ElectraToV1Alpha1SignedBlock(electraBlk *ethpbv2.SignedBeaconBlockElectra) (*ethpbalpha.SignedBeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(electraBlk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1Block := &ethpbalpha.SignedBeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1Block, nil
+}
+
 // V2BeaconBlockDenebToV1Alpha1 converts a v2 Deneb beacon block to a v1alpha1
 // Deneb block.
 func V2BeaconBlockDenebToV1Alpha1(v2block *ethpbv2.BeaconBlockDeneb) (*ethpbalpha.BeaconBlockDeneb, error) {
@@ -74,6 +87,20 @@ func V2BeaconBlockDenebToV1Alpha1(v2block *ethpbv2.BeaconBlockDeneb) (*ethpbalph
 	return v1alpha1block, nil
 }
 
+// V2BeaconBlockElectraToV1Alpha1 converts a v2 Electra beacon block to a v1alpha1
+// Electra block.
+func V2BeaconBlockElectraToV1Alpha1(v2block *ethpbv2.BeaconBlockElectra) (*ethpbalpha.BeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(v2block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1block := &ethpbalpha.BeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1block, nil
+}
+
 // BlindedBellatrixToV1Alpha1SignedBlock converts a v2 SignedBlindedBeaconBlockBellatrix proto to a v1alpha1 proto.
 func BlindedBellatrixToV1Alpha1SignedBlock(bellatrixBlk *ethpbv2.SignedBlindedBeaconBlockBellatrix) (*ethpbalpha.SignedBlindedBeaconBlockBellatrix, error) {
 	marshaledBlk, err := proto.Marshal(bellatrixBlk)
@@ -113,6 +140,19 @@ func BlindedDenebToV1Alpha1SignedBlock(denebBlk *ethpbv2.SignedBlindedBeaconBloc
 	return v1alpha1Block, nil
 }
 
+// BlindedElectraToV1Alpha1SignedBlock converts a v2 SignedBlindedBeaconBlockElectra proto to a v1alpha1 proto.
+func BlindedElectraToV1Alpha1SignedBlock(electraBlk *ethpbv2.SignedBlindedBeaconBlockElectra) (*ethpbalpha.SignedBlindedBeaconBlockElectra, error) {
+	marshaledBlk, err := proto.Marshal(electraBlk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1Block := &ethpbalpha.SignedBlindedBeaconBlockElectra{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1Block, nil
+}
+
 // SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs converts an array of v2 SignedBlindedBlobSidecar objects to its v1alpha1 equivalent.
 func SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(sidecars []*ethpbv2.SignedBlindedBlobSidecar) []*ethpbalpha.SignedBlindedBlobSidecar {
 	result := make([]*ethpbalpha.SignedBlindedBlobSidecar, len(sidecars))
@@ -165,6 +205,16 @@ func DenebBlockContentsToV1Alpha1(blockcontents *ethpbv2.SignedBeaconBlockConten
 	return &ethpbalpha.SignedBeaconBlockAndBlobsDeneb{Block: block, Blobs: blobs}, nil
 }
 
+// ElectraBlockContentsToV1Alpha1 converts signed electra block contents to signed beacon block and blobs electra
+func ElectraBlockContentsToV1Alpha1(blockcontents *ethpbv2.SignedBeaconBlockContentsElectra) (*ethpbalpha.SignedBeaconBlockAndBlobsElectra, error) {
+	block, err := ElectraToV1Alpha1SignedBlock(blockcontents.SignedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	blobs := SignedBlobsToV1Alpha1SignedBlobs(blockcontents.SignedBlobSidecars)
+	return &ethpbalpha.SignedBeaconBlockAndBlobsElectra{Block: block, Blobs: blobs}, nil
+}
+
 // BlindedDenebBlockContentsToV1Alpha1 converts signed blinded deneb block contents to signed blinded beacon block and blobs deneb
 func BlindedDenebBlockContentsToV1Alpha1(blockcontents *ethpbv2.SignedBlindedBeaconBlockContentsDeneb) (*ethpbalpha.SignedBlindedBeaconBlockAndBlobsDeneb, error) {
 	block, err := BlindedDenebToV1Alpha1SignedBlock(blockcontents.SignedBlindedBlock)
@@ -174,3 +224,13 @@ func BlindedDenebBlockContentsToV1Alpha1(blockcontents *ethpbv2.SignedBlindedBea
 	blobs := SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(blockcontents.SignedBlindedBlobSidecars)
 	return &ethpbalpha.SignedBlindedBeaconBlockAndBlobsDeneb{SignedBlindedBlock: block, SignedBlindedBlobSidecars: blobs}, nil
 }
+
+// BlindedElectraBlockContentsToV1Alpha1 converts signed blinded electra block contents to signed blinded beacon block and blobs electra
+func BlindedElectraBlockContentsToV1Alpha1(blockcontents *ethpbv2.SignedBlindedBeaconBlockContentsElectra) (*ethpbalpha.SignedBlindedBeaconBlockAndBlobsElectra, error) {
+	block, err := BlindedElectraToV1Alpha1SignedBlock(blockcontents.SignedBlindedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	blobs := SignedBlindedBlobsToV1Alpha1SignedBlindedBlobs(blockcontents.SignedBlindedBlobSidecars)
+	return &ethpbalpha.SignedBlindedBeaconBlockAndBlobsElectra{SignedBlindedBlock: block, SignedBlindedBlobSidecars: blobs}, nil
+}
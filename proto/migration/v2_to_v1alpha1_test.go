@@ -130,3 +130,47 @@ func Test_BlindedBellatrixToV1Alpha1SignedBlock(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, v2Root, alphaRoot)
 }
+
+func Test_ElectraToV1Alpha1SignedBlock(t *testing.T) {
+	v2Block := &ethpbv2.SignedBeaconBlockElectra{
+		Message: &ethpbv2.BeaconBlockElectra{
+			Slot:          slot,
+			ProposerIndex: validatorIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			Body: &ethpbv2.BeaconBlockBodyElectra{
+				RandaoReveal: randaoReveal,
+				Eth1Data: &ethpbv1.Eth1Data{
+					DepositRoot:  depositRoot,
+					DepositCount: depositCount,
+					BlockHash:    blockHash,
+				},
+				ContractTransfers: []*ethpbv2.SignedContractTransfer{
+					{
+						Message: &ethpbv2.ContractTransfer{
+							ValidatorIndex:  validatorIndex,
+							RecipientIndex:  validatorIndex + 1,
+							Contract:        feeRecipient,
+							ValidUntilEpoch: epoch,
+							Nonce:           1,
+						},
+						Signature:          signature,
+						RecipientSignature: signature,
+					},
+				},
+			},
+		},
+		Signature: signature,
+	}
+
+	alphaBlock, err := ElectraToV1Alpha1SignedBlock(v2Block)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(alphaBlock.Block.Body.ContractTransfers))
+	transfer := alphaBlock.Block.Body.ContractTransfers[0]
+	assert.Equal(t, validatorIndex, transfer.Message.ValidatorIndex)
+	assert.Equal(t, validatorIndex+1, transfer.Message.RecipientIndex)
+	assert.DeepEqual(t, feeRecipient, transfer.Message.Contract)
+	assert.Equal(t, epoch, transfer.Message.ValidUntilEpoch)
+	assert.Equal(t, uint64(1), transfer.Message.Nonce)
+	assert.DeepEqual(t, signature, transfer.RecipientSignature)
+}
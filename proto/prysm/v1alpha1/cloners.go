@@ -628,6 +628,56 @@ func CopyBlindedBeaconBlockBodyDeneb(body *BlindedBeaconBlockBodyDeneb) *Blinded
 	}
 }
 
+// CopySignedBlindedBeaconBlockElectra copies the provided SignedBlindedBeaconBlockElectra.
+func CopySignedBlindedBeaconBlockElectra(sigBlock *SignedBlindedBeaconBlockElectra) *SignedBlindedBeaconBlockElectra {
+	if sigBlock == nil {
+		return nil
+	}
+	return &SignedBlindedBeaconBlockElectra{
+		Message:   CopyBlindedBeaconBlockElectra(sigBlock.Message),
+		Signature: bytesutil.SafeCopyBytes(sigBlock.Signature),
+	}
+}
+
+// CopyBlindedBeaconBlockElectra copies the provided BlindedBeaconBlockElectra.
+func CopyBlindedBeaconBlockElectra(block *BlindedBeaconBlockElectra) *BlindedBeaconBlockElectra {
+	if block == nil {
+		return nil
+	}
+	return &BlindedBeaconBlockElectra{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(block.ParentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(block.StateRoot),
+		Body:          CopyBlindedBeaconBlockBodyElectra(block.Body),
+	}
+}
+
+// CopyBlindedBeaconBlockBodyElectra copies the provided BlindedBeaconBlockBodyElectra.
+func CopyBlindedBeaconBlockBodyElectra(body *BlindedBeaconBlockBodyElectra) *BlindedBeaconBlockBodyElectra {
+	if body == nil {
+		return nil
+	}
+	return &BlindedBeaconBlockBodyElectra{
+		RandaoReveal:           bytesutil.SafeCopyBytes(body.RandaoReveal),
+		Eth1Data:               CopyETH1Data(body.Eth1Data),
+		Graffiti:               bytesutil.SafeCopyBytes(body.Graffiti),
+		ProposerSlashings:      CopyProposerSlashings(body.ProposerSlashings),
+		AttesterSlashings:      CopyAttesterSlashings(body.AttesterSlashings),
+		Attestations:           CopyAttestations(body.Attestations),
+		Deposits:               CopyDeposits(body.Deposits),
+		VoluntaryExits:         CopySignedVoluntaryExits(body.VoluntaryExits),
+		ActivityChanges:        CopyActivityChanges(body.ActivityChanges),
+		TransactionsCount:      body.TransactionsCount,
+		BaseFee:                body.BaseFee,
+		SyncAggregate:          CopySyncAggregate(body.SyncAggregate),
+		ExecutionPayloadHeader: CopyExecutionPayloadHeaderDeneb(body.ExecutionPayloadHeader),
+		BlsToExecutionChanges:  CopyBLSToExecutionChanges(body.BlsToExecutionChanges),
+		BlobKzgCommitments:     CopyBlobKZGs(body.BlobKzgCommitments),
+		ContractTransfers:      CopyContractTransfers(body.ContractTransfers),
+	}
+}
+
 // CopyExecutionPayload copies the provided execution payload.
 func CopyExecutionPayload(payload *enginev1.ExecutionPayload) *enginev1.ExecutionPayload {
 	if payload == nil {
@@ -825,6 +875,30 @@ func CopyBLSToExecutionChanges(changes []*SignedBLSToExecutionChange) []*SignedB
 	return res
 }
 
+// CopyContractTransfers copies the provided contract transfers.
+func CopyContractTransfers(transfers []*SignedContractTransfer) []*SignedContractTransfer {
+	if transfers == nil {
+		return nil
+	}
+
+	res := make([]*SignedContractTransfer, len(transfers))
+	for i := 0; i < len(transfers); i++ {
+		res[i] = &SignedContractTransfer{
+			Message: &ContractTransfer{
+				ValidatorIndex:  transfers[i].Message.ValidatorIndex,
+				RecipientIndex:  transfers[i].Message.RecipientIndex,
+				Contract:        bytesutil.SafeCopyBytes(transfers[i].Message.Contract),
+				ValidUntilEpoch: transfers[i].Message.ValidUntilEpoch,
+				Nonce:           transfers[i].Message.Nonce,
+			},
+			Signature:          bytesutil.SafeCopyBytes(transfers[i].Signature),
+			RecipientSignature: bytesutil.SafeCopyBytes(transfers[i].RecipientSignature),
+		}
+	}
+
+	return res
+}
+
 // CopyBlobKZGs copies the provided blob kzgs object.
 func CopyBlobKZGs(b [][]byte) [][]byte {
 	return bytesutil.SafeCopy2dBytes(b)
@@ -880,6 +954,56 @@ func CopyBeaconBlockBodyDeneb(body *BeaconBlockBodyDeneb) *BeaconBlockBodyDeneb
 	}
 }
 
+// CopySignedBeaconBlockElectra copies the provided SignedBeaconBlockElectra.
+func CopySignedBeaconBlockElectra(sigBlock *SignedBeaconBlockElectra) *SignedBeaconBlockElectra {
+	if sigBlock == nil {
+		return nil
+	}
+	return &SignedBeaconBlockElectra{
+		Block:     CopyBeaconBlockElectra(sigBlock.Block),
+		Signature: bytesutil.SafeCopyBytes(sigBlock.Signature),
+	}
+}
+
+// CopyBeaconBlockElectra copies the provided BeaconBlockElectra.
+func CopyBeaconBlockElectra(block *BeaconBlockElectra) *BeaconBlockElectra {
+	if block == nil {
+		return nil
+	}
+	return &BeaconBlockElectra{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(block.ParentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(block.StateRoot),
+		Body:          CopyBeaconBlockBodyElectra(block.Body),
+	}
+}
+
+// CopyBeaconBlockBodyElectra copies the provided BeaconBlockBodyElectra.
+func CopyBeaconBlockBodyElectra(body *BeaconBlockBodyElectra) *BeaconBlockBodyElectra {
+	if body == nil {
+		return nil
+	}
+	return &BeaconBlockBodyElectra{
+		RandaoReveal:          bytesutil.SafeCopyBytes(body.RandaoReveal),
+		Eth1Data:              CopyETH1Data(body.Eth1Data),
+		Graffiti:              bytesutil.SafeCopyBytes(body.Graffiti),
+		ProposerSlashings:     CopyProposerSlashings(body.ProposerSlashings),
+		AttesterSlashings:     CopyAttesterSlashings(body.AttesterSlashings),
+		Attestations:          CopyAttestations(body.Attestations),
+		Deposits:              CopyDeposits(body.Deposits),
+		VoluntaryExits:        CopySignedVoluntaryExits(body.VoluntaryExits),
+		ActivityChanges:       CopyActivityChanges(body.ActivityChanges),
+		TransactionsCount:     body.TransactionsCount,
+		BaseFee:               body.BaseFee,
+		SyncAggregate:         CopySyncAggregate(body.SyncAggregate),
+		ExecutionPayload:      CopyExecutionPayloadDeneb(body.ExecutionPayload),
+		BlsToExecutionChanges: CopyBLSToExecutionChanges(body.BlsToExecutionChanges),
+		BlobKzgCommitments:    CopyBlobKZGs(body.BlobKzgCommitments),
+		ContractTransfers:     CopyContractTransfers(body.ContractTransfers),
+	}
+}
+
 // todo unit act
 // CopyExecutionPayloadHeaderDeneb copies the provided execution payload object.
 func CopyExecutionPayloadHeaderDeneb(payload *enginev1.ExecutionPayloadHeaderDeneb) *enginev1.ExecutionPayloadHeaderDeneb {
@@ -952,3 +1076,65 @@ func CopyHistoricalSummaries(summaries []*HistoricalSummary) []*HistoricalSummar
 	}
 	return newSummaries
 }
+
+// CopyValidatorContracts copies the provided validator contracts.
+func CopyValidatorContracts(contracts []*ValidatorContract) []*ValidatorContract {
+	if contracts == nil {
+		return nil
+	}
+	newContracts := make([]*ValidatorContract, len(contracts))
+	for i, c := range contracts {
+		newContracts[i] = &ValidatorContract{
+			ValidatorIndex: c.ValidatorIndex,
+			Contract:       bytesutil.SafeCopyBytes(c.Contract),
+		}
+	}
+	return newContracts
+}
+
+// CopyPendingActivityCredits copies the provided pending activity credits.
+func CopyPendingActivityCredits(credits []*PendingActivityCredit) []*PendingActivityCredit {
+	if credits == nil {
+		return nil
+	}
+	newCredits := make([]*PendingActivityCredit, len(credits))
+	for i, c := range credits {
+		newCredits[i] = &PendingActivityCredit{
+			ValidatorIndex: c.ValidatorIndex,
+			Activity:       c.Activity,
+		}
+	}
+	return newCredits
+}
+
+// CopyRejectedContractBindings copies the provided rejected contract bindings.
+func CopyRejectedContractBindings(bindings []*RejectedContractBinding) []*RejectedContractBinding {
+	if bindings == nil {
+		return nil
+	}
+	newBindings := make([]*RejectedContractBinding, len(bindings))
+	for i, b := range bindings {
+		newBindings[i] = &RejectedContractBinding{
+			ValidatorIndex: b.ValidatorIndex,
+			Contract:       bytesutil.SafeCopyBytes(b.Contract),
+			OwnerIndex:     b.OwnerIndex,
+			Epoch:          b.Epoch,
+		}
+	}
+	return newBindings
+}
+
+// CopyContractTransferNonces copies the provided contract transfer nonces.
+func CopyContractTransferNonces(nonces []*ContractTransferNonce) []*ContractTransferNonce {
+	if nonces == nil {
+		return nil
+	}
+	newNonces := make([]*ContractTransferNonce, len(nonces))
+	for i, n := range nonces {
+		newNonces[i] = &ContractTransferNonce{
+			ValidatorIndex: n.ValidatorIndex,
+			Nonce:          n.Nonce,
+		}
+	}
+	return newNonces
+}
@@ -514,6 +514,15 @@ func TestCopyBLSToExecutionChanges(t *testing.T) {
 	}
 }
 
+func TestCopyContractTransfers(t *testing.T) {
+	transfers := genContractTransfers(10)
+
+	got := v1alpha1.CopyContractTransfers(transfers)
+	if !reflect.DeepEqual(got, transfers) {
+		t.Errorf("TestCopyContractTransfers() = %v, want %v", got, transfers)
+	}
+}
+
 func TestCopyHistoricalSummaries(t *testing.T) {
 	summaries := []*v1alpha1.HistoricalSummary{
 		{BlockSummaryRoot: []byte("block summary root 0"), StateSummaryRoot: []byte("state summary root 0")},
@@ -1034,3 +1043,21 @@ func genBLSToExecutionChange() *v1alpha1.SignedBLSToExecutionChange {
 		Signature: bytes(96),
 	}
 }
+
+func genContractTransfers(num int) []*v1alpha1.SignedContractTransfer {
+	transfers := make([]*v1alpha1.SignedContractTransfer, num)
+	for i := 0; i < num; i++ {
+		transfers[i] = &v1alpha1.SignedContractTransfer{
+			Message: &v1alpha1.ContractTransfer{
+				ValidatorIndex:  123456,
+				RecipientIndex:  654321,
+				Contract:        bytes(20),
+				ValidUntilEpoch: 789,
+				Nonce:           3,
+			},
+			Signature:          bytes(96),
+			RecipientSignature: bytes(96),
+		}
+	}
+	return transfers
+}
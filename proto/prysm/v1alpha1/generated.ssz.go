@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 2e1ce8d496365ae856794882fb2815c3130d5a6ddeded1afc5a93940dee77f7a
+// Hash: f8d3e81f39a09ee22c575fcf56581ab627b4c45c77cb692030cbb2ab8897c20b
 package eth
 
 import (
@@ -3290,6 +3290,215 @@ func (a *ActivityChange) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the ContractTransfer object
+func (c *ContractTransfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractTransfer object to a target array
+func (c *ContractTransfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.ValidatorIndex))
+
+	// Field (1) 'RecipientIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.RecipientIndex))
+
+	// Field (2) 'Contract'
+	if size := len(c.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	dst = append(dst, c.Contract...)
+
+	// Field (3) 'ValidUntilEpoch'
+	dst = ssz.MarshalUint64(dst, uint64(c.ValidUntilEpoch))
+
+	// Field (4) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractTransfer object
+func (c *ContractTransfer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 52 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	c.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'RecipientIndex'
+	c.RecipientIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Contract'
+	if cap(c.Contract) == 0 {
+		c.Contract = make([]byte, 0, len(buf[16:36]))
+	}
+	c.Contract = append(c.Contract, buf[16:36]...)
+
+	// Field (3) 'ValidUntilEpoch'
+	c.ValidUntilEpoch = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[36:44]))
+
+	// Field (4) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[44:52])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractTransfer object
+func (c *ContractTransfer) SizeSSZ() (size int) {
+	size = 52
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractTransfer object
+func (c *ContractTransfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractTransfer object with a hasher
+func (c *ContractTransfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(c.ValidatorIndex))
+
+	// Field (1) 'RecipientIndex'
+	hh.PutUint64(uint64(c.RecipientIndex))
+
+	// Field (2) 'Contract'
+	if size := len(c.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	hh.PutBytes(c.Contract)
+
+	// Field (3) 'ValidUntilEpoch'
+	hh.PutUint64(uint64(c.ValidUntilEpoch))
+
+	// Field (4) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedContractTransfer object
+func (s *SignedContractTransfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedContractTransfer object to a target array
+func (s *SignedContractTransfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContractTransfer)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (2) 'RecipientSignature'
+	if size := len(s.RecipientSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RecipientSignature", size, 96)
+		return
+	}
+	dst = append(dst, s.RecipientSignature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedContractTransfer object
+func (s *SignedContractTransfer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 244 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ContractTransfer)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:52]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[52:148]))
+	}
+	s.Signature = append(s.Signature, buf[52:148]...)
+
+	// Field (2) 'RecipientSignature'
+	if cap(s.RecipientSignature) == 0 {
+		s.RecipientSignature = make([]byte, 0, len(buf[148:244]))
+	}
+	s.RecipientSignature = append(s.RecipientSignature, buf[148:244]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedContractTransfer object
+func (s *SignedContractTransfer) SizeSSZ() (size int) {
+	size = 244
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedContractTransfer object
+func (s *SignedContractTransfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedContractTransfer object with a hasher
+func (s *SignedContractTransfer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	// Field (2) 'RecipientSignature'
+	if size := len(s.RecipientSignature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RecipientSignature", size, 96)
+		return
+	}
+	hh.PutBytes(s.RecipientSignature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the SignedBeaconBlockBellatrix object
 func (s *SignedBeaconBlockBellatrix) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -9420,101 +9629,151 @@ func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh *ssz.Hasher) (err erro
 	return
 }
 
-// MarshalSSZ ssz marshals the ValidatorRegistrationV1 object
-func (v *ValidatorRegistrationV1) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(v)
+// MarshalSSZ ssz marshals the SignedBeaconBlockAndBlobsElectra object
+func (s *SignedBeaconBlockAndBlobsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the ValidatorRegistrationV1 object to a target array
-func (v *ValidatorRegistrationV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedBeaconBlockAndBlobsElectra object to a target array
+func (s *SignedBeaconBlockAndBlobsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := int(8)
 
-	// Field (0) 'FeeRecipient'
-	if size := len(v.FeeRecipient); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.FeeRecipient", size, 20)
-		return
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Block == nil {
+		s.Block = new(SignedBeaconBlockElectra)
 	}
-	dst = append(dst, v.FeeRecipient...)
+	offset += s.Block.SizeSSZ()
 
-	// Field (1) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, v.GasLimit)
+	// Offset (1) 'Blobs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Blobs) * 131352
 
-	// Field (2) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, v.Timestamp)
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (3) 'Pubkey'
-	if size := len(v.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (1) 'Blobs'
+	if size := len(s.Blobs); size > 6 {
+		err = ssz.ErrListTooBigFn("--.Blobs", size, 6)
 		return
 	}
-	dst = append(dst, v.Pubkey...)
+	for ii := 0; ii < len(s.Blobs); ii++ {
+		if dst, err = s.Blobs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ValidatorRegistrationV1 object
-func (v *ValidatorRegistrationV1) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockAndBlobsElectra object
+func (s *SignedBeaconBlockAndBlobsElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 84 {
+	if size < 8 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'FeeRecipient'
-	if cap(v.FeeRecipient) == 0 {
-		v.FeeRecipient = make([]byte, 0, len(buf[0:20]))
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	v.FeeRecipient = append(v.FeeRecipient, buf[0:20]...)
 
-	// Field (1) 'GasLimit'
-	v.GasLimit = ssz.UnmarshallUint64(buf[20:28])
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
 
-	// Field (2) 'Timestamp'
-	v.Timestamp = ssz.UnmarshallUint64(buf[28:36])
+	// Offset (1) 'Blobs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
 
-	// Field (3) 'Pubkey'
-	if cap(v.Pubkey) == 0 {
-		v.Pubkey = make([]byte, 0, len(buf[36:84]))
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:o1]
+		if s.Block == nil {
+			s.Block = new(SignedBeaconBlockElectra)
+		}
+		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
-	v.Pubkey = append(v.Pubkey, buf[36:84]...)
 
+	// Field (1) 'Blobs'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131352, 6)
+		if err != nil {
+			return err
+		}
+		s.Blobs = make([]*SignedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.Blobs[ii] == nil {
+				s.Blobs[ii] = new(SignedBlobSidecar)
+			}
+			if err = s.Blobs[ii].UnmarshalSSZ(buf[ii*131352 : (ii+1)*131352]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ValidatorRegistrationV1 object
-func (v *ValidatorRegistrationV1) SizeSSZ() (size int) {
-	size = 84
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockAndBlobsElectra object
+func (s *SignedBeaconBlockAndBlobsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(SignedBeaconBlockElectra)
+	}
+	size += s.Block.SizeSSZ()
+
+	// Field (1) 'Blobs'
+	size += len(s.Blobs) * 131352
+
 	return
 }
 
-// HashTreeRoot ssz hashes the ValidatorRegistrationV1 object
-func (v *ValidatorRegistrationV1) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(v)
+// HashTreeRoot ssz hashes the SignedBeaconBlockAndBlobsElectra object
+func (s *SignedBeaconBlockAndBlobsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the ValidatorRegistrationV1 object with a hasher
-func (v *ValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the SignedBeaconBlockAndBlobsElectra object with a hasher
+func (s *SignedBeaconBlockAndBlobsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'FeeRecipient'
-	if size := len(v.FeeRecipient); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.FeeRecipient", size, 20)
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(v.FeeRecipient)
 
-	// Field (1) 'GasLimit'
-	hh.PutUint64(v.GasLimit)
-
-	// Field (2) 'Timestamp'
-	hh.PutUint64(v.Timestamp)
-
-	// Field (3) 'Pubkey'
-	if size := len(v.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
-		return
+	// Field (1) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.Blobs))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.Blobs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
-	hh.PutBytes(v.Pubkey)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -9524,22 +9783,22 @@ func (v *ValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SignedValidatorRegistrationV1 object
-func (s *SignedValidatorRegistrationV1) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the SignedValidatorRegistrationV1 object to a target array
-func (s *SignedValidatorRegistrationV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SignedBeaconBlockElectra object to a target array
+func (s *SignedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := int(100)
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(ValidatorRegistrationV1)
-	}
-	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
-		return
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Block == nil {
+		s.Block = new(BeaconBlockElectra)
 	}
+	offset += s.Block.SizeSSZ()
 
 	// Field (1) 'Signature'
 	if size := len(s.Signature); size != 96 {
@@ -9548,51 +9807,77 @@ func (s *SignedValidatorRegistrationV1) MarshalSSZTo(buf []byte) (dst []byte, er
 	}
 	dst = append(dst, s.Signature...)
 
+	// Field (0) 'Block'
+	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedValidatorRegistrationV1 object
-func (s *SignedValidatorRegistrationV1) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 180 {
+	if size < 100 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(ValidatorRegistrationV1)
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	if err = s.Message.UnmarshalSSZ(buf[0:84]); err != nil {
-		return err
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
 	}
 
 	// Field (1) 'Signature'
 	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[84:180]))
+		s.Signature = make([]byte, 0, len(buf[4:100]))
 	}
-	s.Signature = append(s.Signature, buf[84:180]...)
+	s.Signature = append(s.Signature, buf[4:100]...)
 
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:]
+		if s.Block == nil {
+			s.Block = new(BeaconBlockElectra)
+		}
+		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedValidatorRegistrationV1 object
-func (s *SignedValidatorRegistrationV1) SizeSSZ() (size int) {
-	size = 180
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Block'
+	if s.Block == nil {
+		s.Block = new(BeaconBlockElectra)
+	}
+	size += s.Block.SizeSSZ()
+
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedValidatorRegistrationV1 object
-func (s *SignedValidatorRegistrationV1) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the SignedBeaconBlockElectra object
+func (s *SignedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the SignedValidatorRegistrationV1 object with a hasher
-func (s *SignedValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the SignedBeaconBlockElectra object with a hasher
+func (s *SignedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Message'
-	if err = s.Message.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Block'
+	if err = s.Block.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
@@ -9611,130 +9896,151 @@ func (s *SignedValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err er
 	return
 }
 
-// MarshalSSZ ssz marshals the BuilderBid object
-func (b *BuilderBid) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockAndBlobsElectra object
+func (b *BeaconBlockAndBlobsElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BuilderBid object to a target array
-func (b *BuilderBid) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockAndBlobsElectra object to a target array
+func (b *BeaconBlockAndBlobsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(84)
+	offset := int(8)
 
-	// Offset (0) 'Header'
+	// Offset (0) 'Block'
 	dst = ssz.WriteOffset(dst, offset)
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeader)
+	if b.Block == nil {
+		b.Block = new(BeaconBlockElectra)
 	}
-	offset += b.Header.SizeSSZ()
+	offset += b.Block.SizeSSZ()
 
-	// Field (1) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
-		return
-	}
-	dst = append(dst, b.Value...)
+	// Offset (1) 'Blobs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Blobs) * 131256
 
-	// Field (2) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (0) 'Block'
+	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, b.Pubkey...)
 
-	// Field (0) 'Header'
-	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'Blobs'
+	if size := len(b.Blobs); size > 6 {
+		err = ssz.ErrListTooBigFn("--.Blobs", size, 6)
 		return
 	}
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		if dst, err = b.Blobs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BuilderBid object
-func (b *BuilderBid) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockAndBlobsElectra object
+func (b *BeaconBlockAndBlobsElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 84 {
+	if size < 8 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0 uint64
+	var o0, o1 uint64
 
-	// Offset (0) 'Header'
+	// Offset (0) 'Block'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 84 {
+	if o0 < 8 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (1) 'Value'
-	if cap(b.Value) == 0 {
-		b.Value = make([]byte, 0, len(buf[4:36]))
+	// Offset (1) 'Blobs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
 	}
-	b.Value = append(b.Value, buf[4:36]...)
 
-	// Field (2) 'Pubkey'
-	if cap(b.Pubkey) == 0 {
-		b.Pubkey = make([]byte, 0, len(buf[36:84]))
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:o1]
+		if b.Block == nil {
+			b.Block = new(BeaconBlockElectra)
+		}
+		if err = b.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
-	b.Pubkey = append(b.Pubkey, buf[36:84]...)
 
-	// Field (0) 'Header'
+	// Field (1) 'Blobs'
 	{
-		buf = tail[o0:]
-		if b.Header == nil {
-			b.Header = new(v1.ExecutionPayloadHeader)
-		}
-		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 131256, 6)
+		if err != nil {
 			return err
 		}
+		b.Blobs = make([]*BlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Blobs[ii] == nil {
+				b.Blobs[ii] = new(BlobSidecar)
+			}
+			if err = b.Blobs[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
+				return err
+			}
+		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BuilderBid object
-func (b *BuilderBid) SizeSSZ() (size int) {
-	size = 84
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockAndBlobsElectra object
+func (b *BeaconBlockAndBlobsElectra) SizeSSZ() (size int) {
+	size = 8
 
-	// Field (0) 'Header'
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeader)
+	// Field (0) 'Block'
+	if b.Block == nil {
+		b.Block = new(BeaconBlockElectra)
 	}
-	size += b.Header.SizeSSZ()
+	size += b.Block.SizeSSZ()
+
+	// Field (1) 'Blobs'
+	size += len(b.Blobs) * 131256
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BuilderBid object
-func (b *BuilderBid) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockAndBlobsElectra object
+func (b *BeaconBlockAndBlobsElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BuilderBid object with a hasher
-func (b *BuilderBid) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockAndBlobsElectra object with a hasher
+func (b *BeaconBlockAndBlobsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Header'
-	if err = b.Header.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (1) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+	// Field (0) 'Block'
+	if err = b.Block.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(b.Value)
 
-	// Field (2) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
-		return
+	// Field (1) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Blobs))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Blobs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
 	}
-	hh.PutBytes(b.Pubkey)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -9744,47 +10050,53 @@ func (b *BuilderBid) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BuilderBidCapella object
-func (b *BuilderBidCapella) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BuilderBidCapella object to a target array
-func (b *BuilderBidCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockElectra object to a target array
+func (b *BeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(84)
 
-	// Offset (0) 'Header'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeaderCapella)
-	}
-	offset += b.Header.SizeSSZ()
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
 
-	// Field (1) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
 		return
 	}
-	dst = append(dst, b.Value...)
+	dst = append(dst, b.ParentRoot...)
 
-	// Field (2) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
 		return
 	}
-	dst = append(dst, b.Pubkey...)
+	dst = append(dst, b.StateRoot...)
 
-	// Field (0) 'Header'
-	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyElectra)
+	}
+	offset += b.Body.SizeSSZ()
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BuilderBidCapella object
-func (b *BuilderBidCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockElectra object
+func (b *BeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 84 {
@@ -9792,82 +10104,94 @@ func (b *BuilderBidCapella) UnmarshalSSZ(buf []byte) error {
 	}
 
 	tail := buf
-	var o0 uint64
+	var o4 uint64
 
-	// Offset (0) 'Header'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
+	// Field (0) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
 	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
 
-	if o0 < 84 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
 	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
 
-	// Field (1) 'Value'
-	if cap(b.Value) == 0 {
-		b.Value = make([]byte, 0, len(buf[4:36]))
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
 	}
-	b.Value = append(b.Value, buf[4:36]...)
 
-	// Field (2) 'Pubkey'
-	if cap(b.Pubkey) == 0 {
-		b.Pubkey = make([]byte, 0, len(buf[36:84]))
+	if o4 < 84 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	b.Pubkey = append(b.Pubkey, buf[36:84]...)
 
-	// Field (0) 'Header'
+	// Field (4) 'Body'
 	{
-		buf = tail[o0:]
-		if b.Header == nil {
-			b.Header = new(v1.ExecutionPayloadHeaderCapella)
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBodyElectra)
 		}
-		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BuilderBidCapella object
-func (b *BuilderBidCapella) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockElectra object
+func (b *BeaconBlockElectra) SizeSSZ() (size int) {
 	size = 84
 
-	// Field (0) 'Header'
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeaderCapella)
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BeaconBlockBodyElectra)
 	}
-	size += b.Header.SizeSSZ()
+	size += b.Body.SizeSSZ()
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BuilderBidCapella object
-func (b *BuilderBidCapella) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconBlockElectra object
+func (b *BeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BuilderBidCapella object with a hasher
-func (b *BuilderBidCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconBlockElectra object with a hasher
+func (b *BeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Header'
-	if err = b.Header.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
 		return
 	}
+	hh.PutBytes(b.ParentRoot)
 
-	// Field (1) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
 		return
 	}
-	hh.PutBytes(b.Value)
+	hh.PutBytes(b.StateRoot)
 
-	// Field (2) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(b.Pubkey)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -9877,322 +10201,3792 @@ func (b *BuilderBidCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BuilderBidDeneb object
-func (b *BuilderBidDeneb) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BuilderBidDeneb object to a target array
-func (b *BuilderBidDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconBlockBodyElectra object to a target array
+func (b *BeaconBlockBodyElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(88)
+	offset := int(416)
 
-	// Offset (0) 'Header'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeaderDeneb)
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
 	}
-	offset += b.Header.SizeSSZ()
+	dst = append(dst, b.RandaoReveal...)
 
-	// Offset (1) 'BlindedBlobsBundle'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.BlindedBlobsBundle == nil {
-		b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-	offset += b.BlindedBlobsBundle.SizeSSZ()
-
-	// Field (2) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, b.Value...)
 
-	// Field (3) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
 		return
 	}
-	dst = append(dst, b.Pubkey...)
+	dst = append(dst, b.Graffiti...)
 
-	// Field (0) 'Header'
-	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
-		return
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
 	}
 
-	// Field (1) 'BlindedBlobsBundle'
-	if dst, err = b.BlindedBlobsBundle.MarshalSSZTo(dst); err != nil {
-		return
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
 	}
 
-	return
-}
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
 
-// UnmarshalSSZ ssz unmarshals the BuilderBidDeneb object
-func (b *BuilderBidDeneb) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 88 {
-		return ssz.ErrSize
-	}
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
 
-	tail := buf
-	var o0, o1 uint64
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
 
-	// Offset (0) 'Header'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
 
-	if o0 < 88 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
 
-	// Offset (1) 'BlindedBlobsBundle'
-	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
-		return ssz.ErrOffset
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
 	}
-
-	// Field (2) 'Value'
-	if cap(b.Value) == 0 {
-		b.Value = make([]byte, 0, len(buf[8:40]))
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
 	}
-	b.Value = append(b.Value, buf[8:40]...)
 
-	// Field (3) 'Pubkey'
-	if cap(b.Pubkey) == 0 {
-		b.Pubkey = make([]byte, 0, len(buf[40:88]))
+	// Offset (12) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v1.ExecutionPayloadDeneb)
 	}
-	b.Pubkey = append(b.Pubkey, buf[40:88]...)
+	offset += b.ExecutionPayload.SizeSSZ()
 
-	// Field (0) 'Header'
-	{
-		buf = tail[o0:o1]
-		if b.Header == nil {
-			b.Header = new(v1.ExecutionPayloadHeaderDeneb)
-		}
-		if err = b.Header.UnmarshalSSZ(buf); err != nil {
-			return err
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Offset (14) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
+
+	// Offset (15) 'ContractTransfers'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ContractTransfers) * 244
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
 		}
 	}
 
-	// Field (1) 'BlindedBlobsBundle'
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
 	{
-		buf = tail[o1:]
-		if b.BlindedBlobsBundle == nil {
-			b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
-		}
-		if err = b.BlindedBlobsBundle.UnmarshalSSZ(buf); err != nil {
-			return err
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
 		}
 	}
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the BuilderBidDeneb object
-func (b *BuilderBidDeneb) SizeSSZ() (size int) {
-	size = 88
-
-	// Field (0) 'Header'
-	if b.Header == nil {
-		b.Header = new(v1.ExecutionPayloadHeaderDeneb)
-	}
-	size += b.Header.SizeSSZ()
-
-	// Field (1) 'BlindedBlobsBundle'
-	if b.BlindedBlobsBundle == nil {
-		b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
-	size += b.BlindedBlobsBundle.SizeSSZ()
-
-	return
-}
-
-// HashTreeRoot ssz hashes the BuilderBidDeneb object
-func (b *BuilderBidDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
-
-// HashTreeRootWith ssz hashes the BuilderBidDeneb object with a hasher
-func (b *BuilderBidDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
 
-	// Field (0) 'Header'
-	if err = b.Header.HashTreeRootWith(hh); err != nil {
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
 		return
 	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (1) 'BlindedBlobsBundle'
-	if err = b.BlindedBlobsBundle.HashTreeRootWith(hh); err != nil {
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
 		return
 	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (2) 'Value'
-	if size := len(b.Value); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
 		return
 	}
-	hh.PutBytes(b.Value)
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (3) 'Pubkey'
-	if size := len(b.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
 		return
 	}
-	hh.PutBytes(b.Pubkey)
-
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 	}
-	return
-}
-
-// MarshalSSZ ssz marshals the Deposit_Data object
-func (d *Deposit_Data) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
-}
-
-// MarshalSSZTo ssz marshals the Deposit_Data object to a target array
-func (d *Deposit_Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
 
-	// Field (0) 'PublicKey'
-	if size := len(d.PublicKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
+	// Field (12) 'ExecutionPayload'
+	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, d.PublicKey...)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(d.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
 		return
 	}
-	dst = append(dst, d.WithdrawalCredentials...)
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
-	// Field (2) 'Contract'
-	if size := len(d.Contract); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+	// Field (14) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
 		return
 	}
-	dst = append(dst, d.Contract...)
-
-	// Field (3) 'Amount'
-	dst = ssz.MarshalUint64(dst, d.Amount)
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, b.BlobKzgCommitments[ii]...)
+	}
 
-	// Field (4) 'Signature'
-	if size := len(d.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+	// Field (15) 'ContractTransfers'
+	if size := len(b.ContractTransfers); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ContractTransfers", size, 16)
 		return
 	}
-	dst = append(dst, d.Signature...)
+	for ii := 0; ii < len(b.ContractTransfers); ii++ {
+		if dst, err = b.ContractTransfers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Deposit_Data object
-func (d *Deposit_Data) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 204 {
+	if size < 416 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'PublicKey'
-	if cap(d.PublicKey) == 0 {
-		d.PublicKey = make([]byte, 0, len(buf[0:48]))
-	}
-	d.PublicKey = append(d.PublicKey, buf[0:48]...)
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14, o15 uint64
 
-	// Field (1) 'WithdrawalCredentials'
-	if cap(d.WithdrawalCredentials) == 0 {
-		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
 	}
-	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
 
-	// Field (2) 'Contract'
-	if cap(d.Contract) == 0 {
-		d.Contract = make([]byte, 0, len(buf[80:100]))
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
 	}
-	d.Contract = append(d.Contract, buf[80:100]...)
-
-	// Field (3) 'Amount'
-	d.Amount = ssz.UnmarshallUint64(buf[100:108])
-
-	// Field (4) 'Signature'
-	if cap(d.Signature) == 0 {
-		d.Signature = make([]byte, 0, len(buf[108:204]))
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
 	}
-	d.Signature = append(d.Signature, buf[108:204]...)
-
-	return err
-}
-
-// SizeSSZ returns the ssz encoded size in bytes for the Deposit_Data object
-func (d *Deposit_Data) SizeSSZ() (size int) {
-	size = 204
-	return
-}
 
-// HashTreeRoot ssz hashes the Deposit_Data object
-func (d *Deposit_Data) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(d)
-}
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
 
-// HashTreeRootWith ssz hashes the Deposit_Data object with a hasher
-func (d *Deposit_Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (0) 'PublicKey'
-	if size := len(d.PublicKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
-		return
+	if o3 < 416 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	hh.PutBytes(d.PublicKey)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(d.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
-		return
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
 	}
-	hh.PutBytes(d.WithdrawalCredentials)
 
-	// Field (2) 'Contract'
-	if size := len(d.Contract); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
-		return
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
 	}
-	hh.PutBytes(d.Contract)
 
-	// Field (3) 'Amount'
-	hh.PutUint64(d.Amount)
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
 
-	// Field (4) 'Signature'
-	if size := len(d.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
-		return
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
 	}
-	hh.PutBytes(d.Signature)
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
 	}
-	return
-}
 
-// MarshalSSZ ssz marshals the BeaconState object
-func (b *BeaconState) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
 
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2687413)
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'ExecutionPayload'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'BlobKzgCommitments'
+	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (15) 'ContractTransfers'
+	if o15 = ssz.ReadOffset(buf[412:416]); o15 > size || o14 > o15 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayload'
+	{
+		buf = tail[o12:o13]
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(v1.ExecutionPayloadDeneb)
+		}
+		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		buf = tail[o14:o15]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobKzgCommitments[ii]) == 0 {
+				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DivideInt2(len(buf), 244, 16)
+		if err != nil {
+			return err
+		}
+		b.ContractTransfers = make([]*SignedContractTransfer, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ContractTransfers[ii] == nil {
+				b.ContractTransfers[ii] = new(SignedContractTransfer)
+			}
+			if err = b.ContractTransfers[ii].UnmarshalSSZ(buf[ii*244 : (ii+1)*244]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) SizeSSZ() (size int) {
+	size = 416
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	// Field (12) 'ExecutionPayload'
+	if b.ExecutionPayload == nil {
+		b.ExecutionPayload = new(v1.ExecutionPayloadDeneb)
+	}
+	size += b.ExecutionPayload.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	// Field (14) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	// Field (15) 'ContractTransfers'
+	size += len(b.ContractTransfers) * 244
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBodyElectra object
+func (b *BeaconBlockBodyElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBodyElectra object with a hasher
+func (b *BeaconBlockBodyElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'ExecutionPayload'
+	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractTransfers))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ContractTransfers {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockAndBlobsElectra object
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockAndBlobsElectra object to a target array
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'SignedBlindedBlock'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
+	}
+	offset += s.SignedBlindedBlock.SizeSSZ()
+
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.SignedBlindedBlobSidecars) * 312
+
+	// Field (0) 'SignedBlindedBlock'
+	if dst, err = s.SignedBlindedBlock.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'SignedBlindedBlobSidecars'
+	if size := len(s.SignedBlindedBlobSidecars); size > 6 {
+		err = ssz.ErrListTooBigFn("--.SignedBlindedBlobSidecars", size, 6)
+		return
+	}
+	for ii := 0; ii < len(s.SignedBlindedBlobSidecars); ii++ {
+		if dst, err = s.SignedBlindedBlobSidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockAndBlobsElectra object
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'SignedBlindedBlock'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'SignedBlindedBlobSidecars'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'SignedBlindedBlock'
+	{
+		buf = tail[o0:o1]
+		if s.SignedBlindedBlock == nil {
+			s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
+		}
+		if err = s.SignedBlindedBlock.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'SignedBlindedBlobSidecars'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 312, 6)
+		if err != nil {
+			return err
+		}
+		s.SignedBlindedBlobSidecars = make([]*SignedBlindedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if s.SignedBlindedBlobSidecars[ii] == nil {
+				s.SignedBlindedBlobSidecars[ii] = new(SignedBlindedBlobSidecar)
+			}
+			if err = s.SignedBlindedBlobSidecars[ii].UnmarshalSSZ(buf[ii*312 : (ii+1)*312]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockAndBlobsElectra object
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'SignedBlindedBlock'
+	if s.SignedBlindedBlock == nil {
+		s.SignedBlindedBlock = new(SignedBlindedBeaconBlockElectra)
+	}
+	size += s.SignedBlindedBlock.SizeSSZ()
+
+	// Field (1) 'SignedBlindedBlobSidecars'
+	size += len(s.SignedBlindedBlobSidecars) * 312
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockAndBlobsElectra object
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockAndBlobsElectra object with a hasher
+func (s *SignedBlindedBeaconBlockAndBlobsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SignedBlindedBlock'
+	if err = s.SignedBlindedBlock.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'SignedBlindedBlobSidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.SignedBlindedBlobSidecars))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.SignedBlindedBlobSidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockAndBlobsElectra object
+func (b *BlindedBeaconBlockAndBlobsElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockAndBlobsElectra object to a target array
+func (b *BlindedBeaconBlockAndBlobsElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Block == nil {
+		b.Block = new(BlindedBeaconBlockElectra)
+	}
+	offset += b.Block.SizeSSZ()
+
+	// Offset (1) 'Blobs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Blobs) * 216
+
+	// Field (0) 'Block'
+	if dst, err = b.Block.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Blobs'
+	if size := len(b.Blobs); size > 6 {
+		err = ssz.ErrListTooBigFn("--.Blobs", size, 6)
+		return
+	}
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		if dst, err = b.Blobs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockAndBlobsElectra object
+func (b *BlindedBeaconBlockAndBlobsElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Blobs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:o1]
+		if b.Block == nil {
+			b.Block = new(BlindedBeaconBlockElectra)
+		}
+		if err = b.Block.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Blobs'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 216, 6)
+		if err != nil {
+			return err
+		}
+		b.Blobs = make([]*BlindedBlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Blobs[ii] == nil {
+				b.Blobs[ii] = new(BlindedBlobSidecar)
+			}
+			if err = b.Blobs[ii].UnmarshalSSZ(buf[ii*216 : (ii+1)*216]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockAndBlobsElectra object
+func (b *BlindedBeaconBlockAndBlobsElectra) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'Block'
+	if b.Block == nil {
+		b.Block = new(BlindedBeaconBlockElectra)
+	}
+	size += b.Block.SizeSSZ()
+
+	// Field (1) 'Blobs'
+	size += len(b.Blobs) * 216
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockAndBlobsElectra object
+func (b *BlindedBeaconBlockAndBlobsElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockAndBlobsElectra object with a hasher
+func (b *BlindedBeaconBlockAndBlobsElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	if err = b.Block.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Blobs))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Blobs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockElectra object to a target array
+func (s *SignedBlindedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+	if s.Message == nil {
+		s.Message = new(BlindedBeaconBlockElectra)
+	}
+	offset += s.Message.SizeSSZ()
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[4:100]))
+	}
+	s.Signature = append(s.Signature, buf[4:100]...)
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(BlindedBeaconBlockElectra)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BlindedBeaconBlockElectra)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockElectra object
+func (s *SignedBlindedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockElectra object with a hasher
+func (s *SignedBlindedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockElectra object to a target array
+func (b *BlindedBeaconBlockElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Body == nil {
+		b.Body = new(BlindedBeaconBlockBodyElectra)
+	}
+	offset += b.Body.SizeSSZ()
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BlindedBeaconBlockBodyElectra)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BlindedBeaconBlockBodyElectra)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockElectra object
+func (b *BlindedBeaconBlockElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockElectra object with a hasher
+func (b *BlindedBeaconBlockElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyElectra object to a target array
+func (b *BlindedBeaconBlockBodyElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(416)
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	dst = append(dst, b.RandaoReveal...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	dst = append(dst, b.Graffiti...)
+
+	// Offset (3) 'ProposerSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ProposerSlashings) * 416
+
+	// Offset (4) 'AttesterSlashings'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		offset += 4
+		offset += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Offset (5) 'Attestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		offset += 4
+		offset += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Offset (6) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1260
+
+	// Offset (7) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (8) 'ActivityChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ActivityChanges) * 28
+
+	// Field (9) 'TransactionsCount'
+	dst = ssz.MarshalUint64(dst, b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	dst = ssz.MarshalUint64(dst, b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'ExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
+	}
+	offset += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Offset (13) 'BlsToExecutionChanges'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlsToExecutionChanges) * 172
+
+	// Offset (14) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobKzgCommitments) * 48
+
+	// Offset (15) 'ContractTransfers'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ContractTransfers) * 244
+
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	if size := len(b.AttesterSlashings); size > 2 {
+		err = ssz.ErrListTooBigFn("--.AttesterSlashings", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.AttesterSlashings)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'Attestations'
+	if size := len(b.Attestations); size > 128 {
+		err = ssz.ErrListTooBigFn("--.Attestations", size, 128)
+		return
+	}
+	{
+		offset = 4 * len(b.Attestations)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("--.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	if size := len(b.ActivityChanges); size > 1073741824 {
+		err = ssz.ErrListTooBigFn("--.ActivityChanges", size, 1073741824)
+		return
+	}
+	for ii := 0; ii < len(b.ActivityChanges); ii++ {
+		if dst, err = b.ActivityChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	if size := len(b.BlsToExecutionChanges); size > 16 {
+		err = ssz.ErrListTooBigFn("--.BlsToExecutionChanges", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		if size := len(b.BlobKzgCommitments[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.BlobKzgCommitments[ii]", size, 48)
+			return
+		}
+		dst = append(dst, b.BlobKzgCommitments[ii]...)
+	}
+
+	// Field (15) 'ContractTransfers'
+	if size := len(b.ContractTransfers); size > 16 {
+		err = ssz.ErrListTooBigFn("--.ContractTransfers", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ContractTransfers); ii++ {
+		if dst, err = b.ContractTransfers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 416 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5, o6, o7, o8, o12, o13, o14, o15 uint64
+
+	// Field (0) 'RandaoReveal'
+	if cap(b.RandaoReveal) == 0 {
+		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+	}
+	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	if cap(b.Graffiti) == 0 {
+		b.Graffiti = make([]byte, 0, len(buf[168:200]))
+	}
+	b.Graffiti = append(b.Graffiti, buf[168:200]...)
+
+	// Offset (3) 'ProposerSlashings'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 416 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'AttesterSlashings'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Attestations'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Deposits'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'VoluntaryExits'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ActivityChanges'
+	if o8 = ssz.ReadOffset(buf[220:224]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'TransactionsCount'
+	b.TransactionsCount = ssz.UnmarshallUint64(buf[224:232])
+
+	// Field (10) 'BaseFee'
+	b.BaseFee = ssz.UnmarshallUint64(buf[232:240])
+
+	// Field (11) 'SyncAggregate'
+	if b.SyncAggregate == nil {
+		b.SyncAggregate = new(SyncAggregate)
+	}
+	if err = b.SyncAggregate.UnmarshalSSZ(buf[240:400]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'ExecutionPayloadHeader'
+	if o12 = ssz.ReadOffset(buf[400:404]); o12 > size || o8 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'BlsToExecutionChanges'
+	if o13 = ssz.ReadOffset(buf[404:408]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'BlobKzgCommitments'
+	if o14 = ssz.ReadOffset(buf[408:412]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (15) 'ContractTransfers'
+	if o15 = ssz.ReadOffset(buf[412:416]); o15 > size || o14 > o15 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.AttesterSlashings = make([]*AttesterSlashing, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.AttesterSlashings[indx] == nil {
+				b.AttesterSlashings[indx] = new(AttesterSlashing)
+			}
+			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		buf = tail[o5:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 128)
+		if err != nil {
+			return err
+		}
+		b.Attestations = make([]*Attestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Attestations[indx] == nil {
+				b.Attestations[indx] = new(Attestation)
+			}
+			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		buf = tail[o6:o7]
+		num, err := ssz.DivideInt2(len(buf), 1260, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1260 : (ii+1)*1260]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		buf = tail[o7:o8]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		buf = tail[o8:o12]
+		num, err := ssz.DivideInt2(len(buf), 28, 1073741824)
+		if err != nil {
+			return err
+		}
+		b.ActivityChanges = make([]*ActivityChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ActivityChanges[ii] == nil {
+				b.ActivityChanges[ii] = new(ActivityChange)
+			}
+			if err = b.ActivityChanges[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	{
+		buf = tail[o12:o13]
+		if b.ExecutionPayloadHeader == nil {
+			b.ExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
+		}
+		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 172, 16)
+		if err != nil {
+			return err
+		}
+		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+		for ii := 0; ii < num; ii++ {
+			if b.BlsToExecutionChanges[ii] == nil {
+				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+			}
+			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		buf = tail[o14:o15]
+		num, err := ssz.DivideInt2(len(buf), 48, 4096)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobKzgCommitments[ii]) == 0 {
+				b.BlobKzgCommitments[ii] = make([]byte, 0, len(buf[ii*48:(ii+1)*48]))
+			}
+			b.BlobKzgCommitments[ii] = append(b.BlobKzgCommitments[ii], buf[ii*48:(ii+1)*48]...)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		buf = tail[o15:]
+		num, err := ssz.DivideInt2(len(buf), 244, 16)
+		if err != nil {
+			return err
+		}
+		b.ContractTransfers = make([]*SignedContractTransfer, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ContractTransfers[ii] == nil {
+				b.ContractTransfers[ii] = new(SignedContractTransfer)
+			}
+			if err = b.ContractTransfers[ii].UnmarshalSSZ(buf[ii*244 : (ii+1)*244]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) SizeSSZ() (size int) {
+	size = 416
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
+
+	// Field (4) 'AttesterSlashings'
+	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+		size += 4
+		size += b.AttesterSlashings[ii].SizeSSZ()
+	}
+
+	// Field (5) 'Attestations'
+	for ii := 0; ii < len(b.Attestations); ii++ {
+		size += 4
+		size += b.Attestations[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Deposits'
+	size += len(b.Deposits) * 1260
+
+	// Field (7) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (8) 'ActivityChanges'
+	size += len(b.ActivityChanges) * 28
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
+	}
+	size += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Field (13) 'BlsToExecutionChanges'
+	size += len(b.BlsToExecutionChanges) * 172
+
+	// Field (14) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	// Field (15) 'ContractTransfers'
+	size += len(b.ContractTransfers) * 244
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyElectra object
+func (b *BlindedBeaconBlockBodyElectra) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyElectra object with a hasher
+func (b *BlindedBeaconBlockBodyElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	if size := len(b.RandaoReveal); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.RandaoReveal", size, 96)
+		return
+	}
+	hh.PutBytes(b.RandaoReveal)
+
+	// Field (1) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	if size := len(b.Graffiti); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Graffiti", size, 32)
+		return
+	}
+	hh.PutBytes(b.Graffiti)
+
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (4) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AttesterSlashings))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AttesterSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+	}
+
+	// Field (5) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Attestations))
+		if num > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Attestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 128)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+	}
+
+	// Field (6) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (7) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (8) 'ActivityChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ActivityChanges))
+		if num > 1073741824 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ActivityChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741824)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741824)
+		}
+	}
+
+	// Field (9) 'TransactionsCount'
+	hh.PutUint64(b.TransactionsCount)
+
+	// Field (10) 'BaseFee'
+	hh.PutUint64(b.BaseFee)
+
+	// Field (11) 'SyncAggregate'
+	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'ExecutionPayloadHeader'
+	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (13) 'BlsToExecutionChanges'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.BlsToExecutionChanges))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.BlsToExecutionChanges {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	// Field (14) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobKzgCommitments", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+
+		numItems := uint64(len(b.BlobKzgCommitments))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+		}
+	}
+
+	// Field (15) 'ContractTransfers'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ContractTransfers))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ContractTransfers {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ValidatorRegistrationV1 object
+func (v *ValidatorRegistrationV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorRegistrationV1 object to a target array
+func (v *ValidatorRegistrationV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'FeeRecipient'
+	if size := len(v.FeeRecipient); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.FeeRecipient", size, 20)
+		return
+	}
+	dst = append(dst, v.FeeRecipient...)
+
+	// Field (1) 'GasLimit'
+	dst = ssz.MarshalUint64(dst, v.GasLimit)
+
+	// Field (2) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, v.Timestamp)
+
+	// Field (3) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, v.Pubkey...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorRegistrationV1 object
+func (v *ValidatorRegistrationV1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 84 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'FeeRecipient'
+	if cap(v.FeeRecipient) == 0 {
+		v.FeeRecipient = make([]byte, 0, len(buf[0:20]))
+	}
+	v.FeeRecipient = append(v.FeeRecipient, buf[0:20]...)
+
+	// Field (1) 'GasLimit'
+	v.GasLimit = ssz.UnmarshallUint64(buf[20:28])
+
+	// Field (2) 'Timestamp'
+	v.Timestamp = ssz.UnmarshallUint64(buf[28:36])
+
+	// Field (3) 'Pubkey'
+	if cap(v.Pubkey) == 0 {
+		v.Pubkey = make([]byte, 0, len(buf[36:84]))
+	}
+	v.Pubkey = append(v.Pubkey, buf[36:84]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorRegistrationV1 object
+func (v *ValidatorRegistrationV1) SizeSSZ() (size int) {
+	size = 84
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorRegistrationV1 object
+func (v *ValidatorRegistrationV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorRegistrationV1 object with a hasher
+func (v *ValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'FeeRecipient'
+	if size := len(v.FeeRecipient); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.FeeRecipient", size, 20)
+		return
+	}
+	hh.PutBytes(v.FeeRecipient)
+
+	// Field (1) 'GasLimit'
+	hh.PutUint64(v.GasLimit)
+
+	// Field (2) 'Timestamp'
+	hh.PutUint64(v.Timestamp)
+
+	// Field (3) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(v.Pubkey)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedValidatorRegistrationV1 object
+func (s *SignedValidatorRegistrationV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedValidatorRegistrationV1 object to a target array
+func (s *SignedValidatorRegistrationV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ValidatorRegistrationV1)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedValidatorRegistrationV1 object
+func (s *SignedValidatorRegistrationV1) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 180 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ValidatorRegistrationV1)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:84]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[84:180]))
+	}
+	s.Signature = append(s.Signature, buf[84:180]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedValidatorRegistrationV1 object
+func (s *SignedValidatorRegistrationV1) SizeSSZ() (size int) {
+	size = 180
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedValidatorRegistrationV1 object
+func (s *SignedValidatorRegistrationV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedValidatorRegistrationV1 object with a hasher
+func (s *SignedValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BuilderBid object
+func (b *BuilderBid) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BuilderBid object to a target array
+func (b *BuilderBid) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Offset (0) 'Header'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeader)
+	}
+	offset += b.Header.SizeSSZ()
+
+	// Field (1) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	// Field (2) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, b.Pubkey...)
+
+	// Field (0) 'Header'
+	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BuilderBid object
+func (b *BuilderBid) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Header'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[4:36]))
+	}
+	b.Value = append(b.Value, buf[4:36]...)
+
+	// Field (2) 'Pubkey'
+	if cap(b.Pubkey) == 0 {
+		b.Pubkey = make([]byte, 0, len(buf[36:84]))
+	}
+	b.Pubkey = append(b.Pubkey, buf[36:84]...)
+
+	// Field (0) 'Header'
+	{
+		buf = tail[o0:]
+		if b.Header == nil {
+			b.Header = new(v1.ExecutionPayloadHeader)
+		}
+		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BuilderBid object
+func (b *BuilderBid) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (0) 'Header'
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeader)
+	}
+	size += b.Header.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BuilderBid object
+func (b *BuilderBid) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BuilderBid object with a hasher
+func (b *BuilderBid) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = b.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	// Field (2) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(b.Pubkey)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BuilderBidCapella object
+func (b *BuilderBidCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BuilderBidCapella object to a target array
+func (b *BuilderBidCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Offset (0) 'Header'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeaderCapella)
+	}
+	offset += b.Header.SizeSSZ()
+
+	// Field (1) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	// Field (2) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, b.Pubkey...)
+
+	// Field (0) 'Header'
+	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BuilderBidCapella object
+func (b *BuilderBidCapella) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Header'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[4:36]))
+	}
+	b.Value = append(b.Value, buf[4:36]...)
+
+	// Field (2) 'Pubkey'
+	if cap(b.Pubkey) == 0 {
+		b.Pubkey = make([]byte, 0, len(buf[36:84]))
+	}
+	b.Pubkey = append(b.Pubkey, buf[36:84]...)
+
+	// Field (0) 'Header'
+	{
+		buf = tail[o0:]
+		if b.Header == nil {
+			b.Header = new(v1.ExecutionPayloadHeaderCapella)
+		}
+		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BuilderBidCapella object
+func (b *BuilderBidCapella) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (0) 'Header'
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeaderCapella)
+	}
+	size += b.Header.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BuilderBidCapella object
+func (b *BuilderBidCapella) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BuilderBidCapella object with a hasher
+func (b *BuilderBidCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = b.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	// Field (2) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(b.Pubkey)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BuilderBidDeneb object
+func (b *BuilderBidDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BuilderBidDeneb object to a target array
+func (b *BuilderBidDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(88)
+
+	// Offset (0) 'Header'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeaderDeneb)
+	}
+	offset += b.Header.SizeSSZ()
+
+	// Offset (1) 'BlindedBlobsBundle'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.BlindedBlobsBundle == nil {
+		b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
+	}
+	offset += b.BlindedBlobsBundle.SizeSSZ()
+
+	// Field (2) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	dst = append(dst, b.Value...)
+
+	// Field (3) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, b.Pubkey...)
+
+	// Field (0) 'Header'
+	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlindedBlobsBundle'
+	if dst, err = b.BlindedBlobsBundle.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BuilderBidDeneb object
+func (b *BuilderBidDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 88 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Header'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 88 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlindedBlobsBundle'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Value'
+	if cap(b.Value) == 0 {
+		b.Value = make([]byte, 0, len(buf[8:40]))
+	}
+	b.Value = append(b.Value, buf[8:40]...)
+
+	// Field (3) 'Pubkey'
+	if cap(b.Pubkey) == 0 {
+		b.Pubkey = make([]byte, 0, len(buf[40:88]))
+	}
+	b.Pubkey = append(b.Pubkey, buf[40:88]...)
+
+	// Field (0) 'Header'
+	{
+		buf = tail[o0:o1]
+		if b.Header == nil {
+			b.Header = new(v1.ExecutionPayloadHeaderDeneb)
+		}
+		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'BlindedBlobsBundle'
+	{
+		buf = tail[o1:]
+		if b.BlindedBlobsBundle == nil {
+			b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
+		}
+		if err = b.BlindedBlobsBundle.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BuilderBidDeneb object
+func (b *BuilderBidDeneb) SizeSSZ() (size int) {
+	size = 88
+
+	// Field (0) 'Header'
+	if b.Header == nil {
+		b.Header = new(v1.ExecutionPayloadHeaderDeneb)
+	}
+	size += b.Header.SizeSSZ()
+
+	// Field (1) 'BlindedBlobsBundle'
+	if b.BlindedBlobsBundle == nil {
+		b.BlindedBlobsBundle = new(v1.BlindedBlobsBundle)
+	}
+	size += b.BlindedBlobsBundle.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BuilderBidDeneb object
+func (b *BuilderBidDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BuilderBidDeneb object with a hasher
+func (b *BuilderBidDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = b.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlindedBlobsBundle'
+	if err = b.BlindedBlobsBundle.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Value'
+	if size := len(b.Value); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Value", size, 32)
+		return
+	}
+	hh.PutBytes(b.Value)
+
+	// Field (3) 'Pubkey'
+	if size := len(b.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(b.Pubkey)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the Deposit_Data object
+func (d *Deposit_Data) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Deposit_Data object to a target array
+func (d *Deposit_Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PublicKey'
+	if size := len(d.PublicKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
+		return
+	}
+	dst = append(dst, d.PublicKey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, d.WithdrawalCredentials...)
+
+	// Field (2) 'Contract'
+	if size := len(d.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	dst = append(dst, d.Contract...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	// Field (4) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, d.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Deposit_Data object
+func (d *Deposit_Data) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 204 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'PublicKey'
+	if cap(d.PublicKey) == 0 {
+		d.PublicKey = make([]byte, 0, len(buf[0:48]))
+	}
+	d.PublicKey = append(d.PublicKey, buf[0:48]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if cap(d.WithdrawalCredentials) == 0 {
+		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	}
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+
+	// Field (2) 'Contract'
+	if cap(d.Contract) == 0 {
+		d.Contract = make([]byte, 0, len(buf[80:100]))
+	}
+	d.Contract = append(d.Contract, buf[80:100]...)
+
+	// Field (3) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[100:108])
+
+	// Field (4) 'Signature'
+	if cap(d.Signature) == 0 {
+		d.Signature = make([]byte, 0, len(buf[108:204]))
+	}
+	d.Signature = append(d.Signature, buf[108:204]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Deposit_Data object
+func (d *Deposit_Data) SizeSSZ() (size int) {
+	size = 204
+	return
+}
+
+// HashTreeRoot ssz hashes the Deposit_Data object
+func (d *Deposit_Data) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the Deposit_Data object with a hasher
+func (d *Deposit_Data) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PublicKey'
+	if size := len(d.PublicKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
+		return
+	}
+	hh.PutBytes(d.PublicKey)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+		return
+	}
+	hh.PutBytes(d.WithdrawalCredentials)
+
+	// Field (2) 'Contract'
+	if size := len(d.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	hh.PutBytes(d.Contract)
+
+	// Field (3) 'Amount'
+	hh.PutUint64(d.Amount)
+
+	// Field (4) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(d.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconState object
+func (b *BeaconState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2687413)
+
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := len(b.BlockRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(b.BlockRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.BlockRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.BlockRoots[ii]...)
+	}
+
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(b.StateRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.StateRoots[ii]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Field (11) 'SharedActivity'
+	if b.SharedActivity == nil {
+		b.SharedActivity = new(SharedActivity)
+	}
+	if dst, err = b.SharedActivity.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 149
+
+	// Offset (13) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Offset (14) 'Activities'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Activities) * 8
+
+	// Field (15) 'RandaoMixes'
+	if size := len(b.RandaoMixes); size != 65536 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
+		return
+	}
+	for ii := 0; ii < 65536; ii++ {
+		if size := len(b.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.RandaoMixes[ii]...)
+	}
+
+	// Field (16) 'Slashings'
+	if size := len(b.Slashings); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+	}
+
+	// Offset (17) 'PreviousEpochAttestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		offset += 4
+		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+	}
+
+	// Offset (18) 'CurrentEpochAttestations'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		offset += 4
+		offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+	}
+
+	// Field (19) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
+
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (22) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'HistoricalRoots'
+	if size := len(b.HistoricalRoots); size > 16777216 {
+		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := len(b.HistoricalRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.HistoricalRoots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	if size := len(b.Eth1DataVotes); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (12) 'Validators'
+	if size := len(b.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (13) 'Balances'
+	if size := len(b.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Balances[ii])
+	}
+
+	// Field (14) 'Activities'
+	if size := len(b.Activities); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Activities); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Activities[ii])
+	}
+
+	// Field (17) 'PreviousEpochAttestations'
+	if size := len(b.PreviousEpochAttestations); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.PreviousEpochAttestations", size, 4096)
+		return
+	}
+	{
+		offset = 4 * len(b.PreviousEpochAttestations)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (18) 'CurrentEpochAttestations'
+	if size := len(b.CurrentEpochAttestations); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.CurrentEpochAttestations", size, 4096)
+		return
+	}
+	{
+		offset = 4 * len(b.CurrentEpochAttestations)
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 2687413 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o7, o9, o12, o13, o14, o17, o18 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if cap(b.GenesisValidatorsRoot) == 0 {
+		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
+	}
+	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+
+	// Field (2) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[40:48]))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
+		return err
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
+		return err
+	}
+
+	// Field (5) 'BlockRoots'
+	b.BlockRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(b.BlockRoots[ii]) == 0 {
+			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
+		}
+		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (6) 'StateRoots'
+	b.StateRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(b.StateRoots[ii]) == 0 {
+			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
+		}
+		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
+	}
+
+	// Offset (7) 'HistoricalRoots'
+	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+		return ssz.ErrOffset
+	}
+
+	if o7 < 2687413 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
+		return err
+	}
+
+	// Offset (9) 'Eth1DataVotes'
+	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+		return ssz.ErrOffset
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+	// Field (11) 'SharedActivity'
+	if b.SharedActivity == nil {
+		b.SharedActivity = new(SharedActivity)
+	}
+	if err = b.SharedActivity.UnmarshalSSZ(buf[524552:524584]); err != nil {
+		return err
+	}
+
+	// Offset (12) 'Validators'
+	if o12 = ssz.ReadOffset(buf[524584:524588]); o12 > size || o9 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'Balances'
+	if o13 = ssz.ReadOffset(buf[524588:524592]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'Activities'
+	if o14 = ssz.ReadOffset(buf[524592:524596]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Field (15) 'RandaoMixes'
+	b.RandaoMixes = make([][]byte, 65536)
+	for ii := 0; ii < 65536; ii++ {
+		if cap(b.RandaoMixes[ii]) == 0 {
+			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524596:2621748][ii*32:(ii+1)*32]))
+		}
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524596:2621748][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (16) 'Slashings'
+	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621748:2687284][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (17) 'PreviousEpochAttestations'
+	if o17 = ssz.ReadOffset(buf[2687284:2687288]); o17 > size || o14 > o17 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (18) 'CurrentEpochAttestations'
+	if o18 = ssz.ReadOffset(buf[2687288:2687292]); o18 > size || o17 > o18 {
+		return ssz.ErrOffset
+	}
+
+	// Field (19) 'JustificationBits'
+	if cap(b.JustificationBits) == 0 {
+		b.JustificationBits = make([]byte, 0, len(buf[2687292:2687293]))
+	}
+	b.JustificationBits = append(b.JustificationBits, buf[2687292:2687293]...)
+
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687293:2687333]); err != nil {
+		return err
+	}
+
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687333:2687373]); err != nil {
+		return err
+	}
+
+	// Field (22) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687373:2687413]); err != nil {
+		return err
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		buf = tail[o7:o9]
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		buf = tail[o9:o12]
+		num, err := ssz.DivideInt2(len(buf), 72, 2048)
+		if err != nil {
+			return err
+		}
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (12) 'Validators'
+	{
+		buf = tail[o12:o13]
+		num, err := ssz.DivideInt2(len(buf), 149, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Validators = make([]*Validator, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*149 : (ii+1)*149]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (13) 'Balances'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (14) 'Activities'
+	{
+		buf = tail[o14:o17]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Activities = ssz.ExtendUint64(b.Activities, num)
+		for ii := 0; ii < num; ii++ {
+			b.Activities[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (17) 'PreviousEpochAttestations'
+	{
+		buf = tail[o17:o18]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		b.PreviousEpochAttestations = make([]*PendingAttestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.PreviousEpochAttestations[indx] == nil {
+				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = b.PreviousEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (18) 'CurrentEpochAttestations'
+	{
+		buf = tail[o18:]
+		num, err := ssz.DecodeDynamicLength(buf, 4096)
+		if err != nil {
+			return err
+		}
+		b.CurrentEpochAttestations = make([]*PendingAttestation, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.CurrentEpochAttestations[indx] == nil {
+				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+			}
+			if err = b.CurrentEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	size = 2687413
+
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
+
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (12) 'Validators'
+	size += len(b.Validators) * 149
+
+	// Field (13) 'Balances'
+	size += len(b.Balances) * 8
+
+	// Field (14) 'Activities'
+	size += len(b.Activities) * 8
+
+	// Field (17) 'PreviousEpochAttestations'
+	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+		size += 4
+		size += b.PreviousEpochAttestations[ii].SizeSSZ()
+	}
+
+	// Field (18) 'CurrentEpochAttestations'
+	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+		size += 4
+		size += b.CurrentEpochAttestations[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.GenesisValidatorsRoot)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	{
+		if size := len(b.BlockRoots); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (6) 'StateRoots'
+	{
+		if size := len(b.StateRoots); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		numItems := uint64(len(b.HistoricalRoots))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 16777216)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
+	}
+
+	// Field (8) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Eth1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2048)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
+	}
+
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'SharedActivity'
+	if err = b.SharedActivity.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (12) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+	}
+
+	// Field (13) 'Balances'
+	{
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.Balances))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	// Field (14) 'Activities'
+	{
+		if size := len(b.Activities); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Activities {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.Activities))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	// Field (15) 'RandaoMixes'
+	{
+		if size := len(b.RandaoMixes); size != 65536 {
+			err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (16) 'Slashings'
+	{
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (17) 'PreviousEpochAttestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.PreviousEpochAttestations))
+		if num > 4096 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.PreviousEpochAttestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 4096)
+		}
+	}
+
+	// Field (18) 'CurrentEpochAttestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.CurrentEpochAttestations))
+		if num > 4096 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.CurrentEpochAttestations {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 4096)
+		}
+	}
+
+	// Field (19) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	hh.PutBytes(b.JustificationBits)
+
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (22) 'FinalizedCheckpoint'
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BeaconStateAltair object
+func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
+func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(2736665)
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalUint64(dst, b.GenesisTime)
@@ -10310,19 +14104,13 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
 	}
 
-	// Offset (17) 'PreviousEpochAttestations'
+	// Offset (17) 'PreviousEpochParticipation'
 	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		offset += 4
-		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
-	}
+	offset += len(b.PreviousEpochParticipation)
 
-	// Offset (18) 'CurrentEpochAttestations'
+	// Offset (18) 'CurrentEpochParticipation'
 	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		offset += 4
-		offset += b.CurrentEpochAttestations[ii].SizeSSZ()
-	}
+	offset += len(b.CurrentEpochParticipation)
 
 	// Field (19) 'JustificationBits'
 	if size := len(b.JustificationBits); size != 1 {
@@ -10355,6 +14143,26 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
+	// Offset (23) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (24) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (25) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
 	// Field (7) 'HistoricalRoots'
 	if size := len(b.HistoricalRoots); size > 16777216 {
 		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
@@ -10408,55 +14216,42 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Activities[ii])
 	}
 
-	// Field (17) 'PreviousEpochAttestations'
-	if size := len(b.PreviousEpochAttestations); size > 4096 {
-		err = ssz.ErrListTooBigFn("--.PreviousEpochAttestations", size, 4096)
+	// Field (17) 'PreviousEpochParticipation'
+	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("--.PreviousEpochParticipation", size, 1099511627776)
 		return
 	}
-	{
-		offset = 4 * len(b.PreviousEpochAttestations)
-		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	dst = append(dst, b.PreviousEpochParticipation...)
 
-	// Field (18) 'CurrentEpochAttestations'
-	if size := len(b.CurrentEpochAttestations); size > 4096 {
-		err = ssz.ErrListTooBigFn("--.CurrentEpochAttestations", size, 4096)
+	// Field (18) 'CurrentEpochParticipation'
+	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("--.CurrentEpochParticipation", size, 1099511627776)
 		return
 	}
-	{
-		offset = 4 * len(b.CurrentEpochAttestations)
-		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
-		}
+	dst = append(dst, b.CurrentEpochParticipation...)
+
+	// Field (23) 'InactivityScores'
+	if size := len(b.InactivityScores); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+		return
 	}
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
 	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateAltair object
+func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2687413 {
+	if size < 2736665 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o7, o9, o12, o13, o14, o17, o18 uint64
+	var o7, o9, o12, o13, o14, o17, o18, o23 uint64
 
 	// Field (0) 'GenesisTime'
 	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
@@ -10509,7 +14304,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2687413 {
+	if o7 < 2736665 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -10567,12 +14362,12 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621748:2687284][ii*8 : (ii+1)*8])
 	}
 
-	// Offset (17) 'PreviousEpochAttestations'
+	// Offset (17) 'PreviousEpochParticipation'
 	if o17 = ssz.ReadOffset(buf[2687284:2687288]); o17 > size || o14 > o17 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (18) 'CurrentEpochAttestations'
+	// Offset (18) 'CurrentEpochParticipation'
 	if o18 = ssz.ReadOffset(buf[2687288:2687292]); o18 > size || o17 > o18 {
 		return ssz.ErrOffset
 	}
@@ -10607,6 +14402,27 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
+	// Offset (23) 'InactivityScores'
+	if o23 = ssz.ReadOffset(buf[2687413:2687417]); o23 > size || o18 > o23 {
+		return ssz.ErrOffset
+	}
+
+	// Field (24) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687417:2712041]); err != nil {
+		return err
+	}
+
+	// Field (25) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712041:2736665]); err != nil {
+		return err
+	}
+
 	// Field (7) 'HistoricalRoots'
 	{
 		buf = tail[o7:o9]
@@ -10685,55 +14501,48 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (17) 'PreviousEpochAttestations'
+	// Field (17) 'PreviousEpochParticipation'
 	{
 		buf = tail[o17:o18]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
 		}
-		b.PreviousEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.PreviousEpochAttestations[indx] == nil {
-				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.PreviousEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
 		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
 	}
 
-	// Field (18) 'CurrentEpochAttestations'
+	// Field (18) 'CurrentEpochParticipation'
 	{
-		buf = tail[o18:]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
+		buf = tail[o18:o23]
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
 		}
-		b.CurrentEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.CurrentEpochAttestations[indx] == nil {
-				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.CurrentEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+	}
+
+	// Field (23) 'InactivityScores'
+	{
+		buf = tail[o23:]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
 		if err != nil {
 			return err
 		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
-	size = 2687413
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateAltair object
+func (b *BeaconStateAltair) SizeSSZ() (size int) {
+	size = 2736665
 
 	// Field (7) 'HistoricalRoots'
 	size += len(b.HistoricalRoots) * 32
@@ -10750,28 +14559,25 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	// Field (14) 'Activities'
 	size += len(b.Activities) * 8
 
-	// Field (17) 'PreviousEpochAttestations'
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		size += 4
-		size += b.PreviousEpochAttestations[ii].SizeSSZ()
-	}
+	// Field (17) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation)
 
-	// Field (18) 'CurrentEpochAttestations'
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		size += 4
-		size += b.CurrentEpochAttestations[ii].SizeSSZ()
-	}
+	// Field (18) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation)
+
+	// Field (23) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconState object
-func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconStateAltair object
+func (b *BeaconStateAltair) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconState object with a hasher
-func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateAltair object with a hasher
+func (b *BeaconStateAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'GenesisTime'
@@ -10997,43 +14803,35 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	// Field (17) 'PreviousEpochAttestations'
+	// Field (17) 'PreviousEpochParticipation'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.PreviousEpochAttestations))
-		if num > 4096 {
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.PreviousEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
+		hh.PutBytes(b.PreviousEpochParticipation)
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4096)
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 4096)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
 		}
 	}
 
-	// Field (18) 'CurrentEpochAttestations'
+	// Field (18) 'CurrentEpochParticipation'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.CurrentEpochAttestations))
-		if num > 4096 {
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.CurrentEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
+		hh.PutBytes(b.CurrentEpochParticipation)
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4096)
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 4096)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
 		}
 	}
 
@@ -11059,647 +14857,442 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		return
 	}
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
-	}
-	return
-}
-
-// MarshalSSZ ssz marshals the BeaconStateAltair object
-func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
-func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2736665)
-
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
-
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
-
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
-
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.BlockRoots[ii]", size, 32)
+	// Field (23) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
 			return
 		}
-		dst = append(dst, b.BlockRoots[ii]...)
-	}
-
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
-			return
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
 		}
-		dst = append(dst, b.StateRoots[ii]...)
-	}
-
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
-
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
-
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
-
-	// Field (11) 'SharedActivity'
-	if b.SharedActivity == nil {
-		b.SharedActivity = new(SharedActivity)
-	}
-	if dst, err = b.SharedActivity.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Offset (12) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 149
-
-	// Offset (13) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
-
-	// Offset (14) 'Activities'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Activities) * 8
+		hh.FillUpTo32()
 
-	// Field (15) 'RandaoMixes'
-	if size := len(b.RandaoMixes); size != 65536 {
-		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
-		return
-	}
-	for ii := 0; ii < 65536; ii++ {
-		if size := len(b.RandaoMixes[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
-			return
+		numItems := uint64(len(b.InactivityScores))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 		}
-		dst = append(dst, b.RandaoMixes[ii]...)
-	}
-
-	// Field (16) 'Slashings'
-	if size := len(b.Slashings); size != 8192 {
-		err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
-	}
-
-	// Offset (17) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation)
-
-	// Offset (18) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation)
-
-	// Field (19) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
-		return
-	}
-	dst = append(dst, b.JustificationBits...)
-
-	// Field (20) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
 	}
 
-	// Field (21) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (22) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Offset (23) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.InactivityScores) * 8
-
 	// Field (24) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
 	// Field (25) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
-		return
-	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := len(b.HistoricalRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("--.HistoricalRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 2048 {
-		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 2048)
-		return
-	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+// MarshalSSZ ssz marshals the Fork object
+func (f *Fork) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the Fork object to a target array
+func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (12) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
 		return
 	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	dst = append(dst, f.PreviousVersion...)
 
-	// Field (13) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
 		return
 	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
+	dst = append(dst, f.CurrentVersion...)
 
-	// Field (14) 'Activities'
-	if size := len(b.Activities); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
-		return
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, uint64(f.Epoch))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Fork object
+func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
 	}
-	for ii := 0; ii < len(b.Activities); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Activities[ii])
+
+	// Field (0) 'PreviousVersion'
+	if cap(f.PreviousVersion) == 0 {
+		f.PreviousVersion = make([]byte, 0, len(buf[0:4]))
 	}
+	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
 
-	// Field (17) 'PreviousEpochParticipation'
-	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("--.PreviousEpochParticipation", size, 1099511627776)
-		return
+	// Field (1) 'CurrentVersion'
+	if cap(f.CurrentVersion) == 0 {
+		f.CurrentVersion = make([]byte, 0, len(buf[4:8]))
 	}
-	dst = append(dst, b.PreviousEpochParticipation...)
+	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
 
-	// Field (18) 'CurrentEpochParticipation'
-	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("--.CurrentEpochParticipation", size, 1099511627776)
+	// Field (2) 'Epoch'
+	f.Epoch = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[8:16]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Fork object
+func (f *Fork) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the Fork object
+func (f *Fork) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the Fork object with a hasher
+func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
 		return
 	}
-	dst = append(dst, b.CurrentEpochParticipation...)
+	hh.PutBytes(f.PreviousVersion)
 
-	// Field (23) 'InactivityScores'
-	if size := len(b.InactivityScores); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
 		return
 	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+	hh.PutBytes(f.CurrentVersion)
+
+	// Field (2) 'Epoch'
+	hh.PutUint64(uint64(f.Epoch))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SharedActivity object
+func (s *SharedActivity) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SharedActivity object to a target array
+func (s *SharedActivity) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'TransactionsGasPerPeriod'
+	dst = ssz.MarshalUint64(dst, s.TransactionsGasPerPeriod)
+
+	// Field (1) 'TransactionsGasPerEpoch'
+	dst = ssz.MarshalUint64(dst, s.TransactionsGasPerEpoch)
+
+	// Field (2) 'BaseFeePerPeriod'
+	dst = ssz.MarshalUint64(dst, s.BaseFeePerPeriod)
+
+	// Field (3) 'BaseFeePerEpoch'
+	dst = ssz.MarshalUint64(dst, s.BaseFeePerEpoch)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateAltair object
-func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SharedActivity object
+func (s *SharedActivity) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2736665 {
+	if size != 32 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o7, o9, o12, o13, o14, o17, o18, o23 uint64
+	// Field (0) 'TransactionsGasPerPeriod'
+	s.TransactionsGasPerPeriod = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+	// Field (1) 'TransactionsGasPerEpoch'
+	s.TransactionsGasPerEpoch = ssz.UnmarshallUint64(buf[8:16])
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
-	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+	// Field (2) 'BaseFeePerPeriod'
+	s.BaseFeePerPeriod = ssz.UnmarshallUint64(buf[16:24])
 
-	// Field (2) 'Slot'
-	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[40:48]))
+	// Field (3) 'BaseFeePerEpoch'
+	s.BaseFeePerEpoch = ssz.UnmarshallUint64(buf[24:32])
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SharedActivity object
+func (s *SharedActivity) SizeSSZ() (size int) {
+	size = 32
+	return
+}
+
+// HashTreeRoot ssz hashes the SharedActivity object
+func (s *SharedActivity) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SharedActivity object with a hasher
+func (s *SharedActivity) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'TransactionsGasPerPeriod'
+	hh.PutUint64(s.TransactionsGasPerPeriod)
+
+	// Field (1) 'TransactionsGasPerEpoch'
+	hh.PutUint64(s.TransactionsGasPerEpoch)
+
+	// Field (2) 'BaseFeePerPeriod'
+	hh.PutUint64(s.BaseFeePerPeriod)
+
+	// Field (3) 'BaseFeePerEpoch'
+	hh.PutUint64(s.BaseFeePerEpoch)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+// MarshalSSZ ssz marshals the PendingAttestation object
+func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
+func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.AggregationBits)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
 	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
+	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.BlockRoots[ii]) == 0 {
-			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
-		}
-		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, uint64(p.InclusionDelay))
+
+	// Field (3) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(p.ProposerIndex))
+
+	// Field (0) 'AggregationBits'
+	if size := len(p.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("--.AggregationBits", size, 2048)
+		return
 	}
+	dst = append(dst, p.AggregationBits...)
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.StateRoots[ii]) == 0 {
-			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
-		}
-		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingAttestation object
+func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 148 {
+		return ssz.ErrSize
 	}
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736665 {
+	if o0 < 148 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
 	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
+	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
 		return err
 	}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
-	}
-
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
-
-	// Field (11) 'SharedActivity'
-	if b.SharedActivity == nil {
-		b.SharedActivity = new(SharedActivity)
-	}
-	if err = b.SharedActivity.UnmarshalSSZ(buf[524552:524584]); err != nil {
-		return err
-	}
+	// Field (2) 'InclusionDelay'
+	p.InclusionDelay = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[132:140]))
 
-	// Offset (12) 'Validators'
-	if o12 = ssz.ReadOffset(buf[524584:524588]); o12 > size || o9 > o12 {
-		return ssz.ErrOffset
-	}
+	// Field (3) 'ProposerIndex'
+	p.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[140:148]))
 
-	// Offset (13) 'Balances'
-	if o13 = ssz.ReadOffset(buf[524588:524592]); o13 > size || o12 > o13 {
-		return ssz.ErrOffset
+	// Field (0) 'AggregationBits'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(p.AggregationBits) == 0 {
+			p.AggregationBits = make([]byte, 0, len(buf))
+		}
+		p.AggregationBits = append(p.AggregationBits, buf...)
 	}
+	return err
+}
 
-	// Offset (14) 'Activities'
-	if o14 = ssz.ReadOffset(buf[524592:524596]); o14 > size || o13 > o14 {
-		return ssz.ErrOffset
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
+func (p *PendingAttestation) SizeSSZ() (size int) {
+	size = 148
 
-	// Field (15) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 65536)
-	for ii := 0; ii < 65536; ii++ {
-		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524596:2621748][ii*32:(ii+1)*32]))
-		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524596:2621748][ii*32:(ii+1)*32]...)
-	}
+	// Field (0) 'AggregationBits'
+	size += len(p.AggregationBits)
 
-	// Field (16) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621748:2687284][ii*8 : (ii+1)*8])
-	}
+	return
+}
 
-	// Offset (17) 'PreviousEpochParticipation'
-	if o17 = ssz.ReadOffset(buf[2687284:2687288]); o17 > size || o14 > o17 {
-		return ssz.ErrOffset
-	}
+// HashTreeRoot ssz hashes the PendingAttestation object
+func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
 
-	// Offset (18) 'CurrentEpochParticipation'
-	if o18 = ssz.ReadOffset(buf[2687288:2687292]); o18 > size || o17 > o18 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootWith ssz hashes the PendingAttestation object with a hasher
+func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
 
-	// Field (19) 'JustificationBits'
-	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[2687292:2687293]))
+	// Field (0) 'AggregationBits'
+	if len(p.AggregationBits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
 	}
-	b.JustificationBits = append(b.JustificationBits, buf[2687292:2687293]...)
+	hh.PutBitlist(p.AggregationBits, 2048)
 
-	// Field (20) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687293:2687333]); err != nil {
-		return err
+	// Field (1) 'Data'
+	if err = p.Data.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (21) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687333:2687373]); err != nil {
-		return err
-	}
+	// Field (2) 'InclusionDelay'
+	hh.PutUint64(uint64(p.InclusionDelay))
 
-	// Field (22) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687373:2687413]); err != nil {
-		return err
-	}
+	// Field (3) 'ProposerIndex'
+	hh.PutUint64(uint64(p.ProposerIndex))
 
-	// Offset (23) 'InactivityScores'
-	if o23 = ssz.ReadOffset(buf[2687413:2687417]); o23 > size || o18 > o23 {
-		return ssz.ErrOffset
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (24) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687417:2712041]); err != nil {
-		return err
-	}
+// MarshalSSZ ssz marshals the HistoricalBatch object
+func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
+}
 
-	// Field (25) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712041:2736665]); err != nil {
-		return err
-	}
+// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
+func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (7) 'HistoricalRoots'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.HistoricalRoots[ii]) == 0 {
-				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
-			}
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
-		}
+	// Field (0) 'BlockRoots'
+	if size := len(h.BlockRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
+		return
 	}
-
-	// Field (9) 'Eth1DataVotes'
-	{
-		buf = tail[o9:o12]
-		num, err := ssz.DivideInt2(len(buf), 72, 2048)
-		if err != nil {
-			return err
-		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(h.BlockRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.BlockRoots[ii]", size, 32)
+			return
 		}
+		dst = append(dst, h.BlockRoots[ii]...)
 	}
 
-	// Field (12) 'Validators'
-	{
-		buf = tail[o12:o13]
-		num, err := ssz.DivideInt2(len(buf), 149, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*149 : (ii+1)*149]); err != nil {
-				return err
-			}
-		}
+	// Field (1) 'StateRoots'
+	if size := len(h.StateRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
+		return
 	}
-
-	// Field (13) 'Balances'
-	{
-		buf = tail[o13:o14]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+	for ii := 0; ii < 8192; ii++ {
+		if size := len(h.StateRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
+			return
 		}
+		dst = append(dst, h.StateRoots[ii]...)
 	}
 
-	// Field (14) 'Activities'
-	{
-		buf = tail[o14:o17]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Activities = ssz.ExtendUint64(b.Activities, num)
-		for ii := 0; ii < num; ii++ {
-			b.Activities[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
-	}
+	return
+}
 
-	// Field (17) 'PreviousEpochParticipation'
-	{
-		buf = tail[o17:o18]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.PreviousEpochParticipation) == 0 {
-			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
-		}
-		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
+func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 524288 {
+		return ssz.ErrSize
 	}
 
-	// Field (18) 'CurrentEpochParticipation'
-	{
-		buf = tail[o18:o23]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.CurrentEpochParticipation) == 0 {
-			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+	// Field (0) 'BlockRoots'
+	h.BlockRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(h.BlockRoots[ii]) == 0 {
+			h.BlockRoots[ii] = make([]byte, 0, len(buf[0:262144][ii*32:(ii+1)*32]))
 		}
-		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+		h.BlockRoots[ii] = append(h.BlockRoots[ii], buf[0:262144][ii*32:(ii+1)*32]...)
 	}
 
-	// Field (23) 'InactivityScores'
-	{
-		buf = tail[o23:]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
-		for ii := 0; ii < num; ii++ {
-			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+	// Field (1) 'StateRoots'
+	h.StateRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(h.StateRoots[ii]) == 0 {
+			h.StateRoots[ii] = make([]byte, 0, len(buf[262144:524288][ii*32:(ii+1)*32]))
 		}
+		h.StateRoots[ii] = append(h.StateRoots[ii], buf[262144:524288][ii*32:(ii+1)*32]...)
 	}
+
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateAltair object
-func (b *BeaconStateAltair) SizeSSZ() (size int) {
-	size = 2736665
-
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
-
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
-
-	// Field (12) 'Validators'
-	size += len(b.Validators) * 149
-
-	// Field (13) 'Balances'
-	size += len(b.Balances) * 8
-
-	// Field (14) 'Activities'
-	size += len(b.Activities) * 8
-
-	// Field (17) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
-
-	// Field (18) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
-
-	// Field (23) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
-
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
+func (h *HistoricalBatch) SizeSSZ() (size int) {
+	size = 524288
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateAltair object
-func (b *BeaconStateAltair) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// HashTreeRoot ssz hashes the HistoricalBatch object
+func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateAltair object with a hasher
-func (b *BeaconStateAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
+func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
-
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
-
-	// Field (2) 'Slot'
-	hh.PutUint64(uint64(b.Slot))
-
-	// Field (3) 'Fork'
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (4) 'LatestBlockHeader'
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (5) 'BlockRoots'
+	// Field (0) 'BlockRoots'
 	{
-		if size := len(b.BlockRoots); size != 8192 {
+		if size := len(h.BlockRoots); size != 8192 {
 			err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
 			return
 		}
 		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
+		for _, i := range h.BlockRoots {
 			if len(i) != 32 {
 				err = ssz.ErrBytesLength
 				return
@@ -11714,14 +15307,14 @@ func (b *BeaconStateAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	// Field (6) 'StateRoots'
+	// Field (1) 'StateRoots'
 	{
-		if size := len(b.StateRoots); size != 8192 {
+		if size := len(h.StateRoots); size != 8192 {
 			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
 			return
 		}
 		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
+		for _, i := range h.StateRoots {
 			if len(i) != 32 {
 				err = ssz.ErrBytesLength
 				return
@@ -11736,245 +15329,177 @@ func (b *BeaconStateAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-
-		numItems := uint64(len(b.HistoricalRoots))
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 16777216)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-		}
-	}
-
-	// Field (8) 'Eth1Data'
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 2048 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2048)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 2048)
-		}
-	}
+// MarshalSSZ ssz marshals the SigningData object
+func (s *SigningData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+// MarshalSSZTo ssz marshals the SigningData object to a target array
+func (s *SigningData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (11) 'SharedActivity'
-	if err = b.SharedActivity.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
 		return
 	}
-
-	// Field (12) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
-		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
-		}
-	}
-
-	// Field (13) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-
-		numItems := uint64(len(b.Balances))
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		}
-	}
-
-	// Field (14) 'Activities'
-	{
-		if size := len(b.Activities); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Activities {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-
-		numItems := uint64(len(b.Activities))
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		}
-	}
-
-	// Field (15) 'RandaoMixes'
-	{
-		if size := len(b.RandaoMixes); size != 65536 {
-			err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeVectorizedHTR(subIndx)
-		} else {
-			hh.Merkleize(subIndx)
-		}
+	dst = append(dst, s.ObjectRoot...)
+
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Domain", size, 32)
+		return
 	}
+	dst = append(dst, s.Domain...)
 
-	// Field (16) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
+	return
+}
 
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeVectorizedHTR(subIndx)
-		} else {
-			hh.Merkleize(subIndx)
-		}
+// UnmarshalSSZ ssz unmarshals the SigningData object
+func (s *SigningData) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
 	}
 
-	// Field (17) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.PutBytes(b.PreviousEpochParticipation)
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
-		} else {
-			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-		}
+	// Field (0) 'ObjectRoot'
+	if cap(s.ObjectRoot) == 0 {
+		s.ObjectRoot = make([]byte, 0, len(buf[0:32]))
 	}
+	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
 
-	// Field (18) 'CurrentEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.PutBytes(b.CurrentEpochParticipation)
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
-		} else {
-			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-		}
+	// Field (1) 'Domain'
+	if cap(s.Domain) == 0 {
+		s.Domain = make([]byte, 0, len(buf[32:64]))
 	}
+	s.Domain = append(s.Domain, buf[32:64]...)
 
-	// Field (19) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SigningData object
+func (s *SigningData) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the SigningData object
+func (s *SigningData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SigningData object with a hasher
+func (s *SigningData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
 		return
 	}
-	hh.PutBytes(b.JustificationBits)
+	hh.PutBytes(s.ObjectRoot)
 
-	// Field (20) 'PreviousJustifiedCheckpoint'
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Domain", size, 32)
 		return
 	}
+	hh.PutBytes(s.Domain)
 
-	// Field (21) 'CurrentJustifiedCheckpoint'
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ForkData object
+func (f *ForkData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkData object to a target array
+func (f *ForkData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
 		return
 	}
+	dst = append(dst, f.CurrentVersion...)
 
-	// Field (22) 'FinalizedCheckpoint'
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(f.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
 		return
 	}
+	dst = append(dst, f.GenesisValidatorsRoot...)
 
-	// Field (23) 'InactivityScores'
-	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
+	return
+}
 
-		numItems := uint64(len(b.InactivityScores))
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-		}
+// UnmarshalSSZ ssz unmarshals the ForkData object
+func (f *ForkData) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 36 {
+		return ssz.ErrSize
 	}
 
-	// Field (24) 'CurrentSyncCommittee'
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'CurrentVersion'
+	if cap(f.CurrentVersion) == 0 {
+		f.CurrentVersion = make([]byte, 0, len(buf[0:4]))
+	}
+	f.CurrentVersion = append(f.CurrentVersion, buf[0:4]...)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if cap(f.GenesisValidatorsRoot) == 0 {
+		f.GenesisValidatorsRoot = make([]byte, 0, len(buf[4:36]))
+	}
+	f.GenesisValidatorsRoot = append(f.GenesisValidatorsRoot, buf[4:36]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkData object
+func (f *ForkData) SizeSSZ() (size int) {
+	size = 36
+	return
+}
+
+// HashTreeRoot ssz hashes the ForkData object
+func (f *ForkData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkData object with a hasher
+func (f *ForkData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
 		return
 	}
+	hh.PutBytes(f.CurrentVersion)
 
-	// Field (25) 'NextSyncCommittee'
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(f.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
 		return
 	}
+	hh.PutBytes(f.GenesisValidatorsRoot)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -11984,92 +15509,112 @@ func (b *BeaconStateAltair) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the Fork object
-func (f *Fork) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(f)
+// MarshalSSZ ssz marshals the DepositMessage object
+func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
 }
 
-// MarshalSSZTo ssz marshals the Fork object to a target array
-func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the DepositMessage object to a target array
+func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'PreviousVersion'
-	if size := len(f.PreviousVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
+	// Field (0) 'PublicKey'
+	if size := len(d.PublicKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
 		return
 	}
-	dst = append(dst, f.PreviousVersion...)
+	dst = append(dst, d.PublicKey...)
 
-	// Field (1) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
 		return
 	}
-	dst = append(dst, f.CurrentVersion...)
+	dst = append(dst, d.WithdrawalCredentials...)
 
-	// Field (2) 'Epoch'
-	dst = ssz.MarshalUint64(dst, uint64(f.Epoch))
+	// Field (2) 'Contract'
+	if size := len(d.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	dst = append(dst, d.Contract...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Fork object
-func (f *Fork) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the DepositMessage object
+func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 16 {
+	if size != 108 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'PreviousVersion'
-	if cap(f.PreviousVersion) == 0 {
-		f.PreviousVersion = make([]byte, 0, len(buf[0:4]))
+	// Field (0) 'PublicKey'
+	if cap(d.PublicKey) == 0 {
+		d.PublicKey = make([]byte, 0, len(buf[0:48]))
 	}
-	f.PreviousVersion = append(f.PreviousVersion, buf[0:4]...)
+	d.PublicKey = append(d.PublicKey, buf[0:48]...)
 
-	// Field (1) 'CurrentVersion'
-	if cap(f.CurrentVersion) == 0 {
-		f.CurrentVersion = make([]byte, 0, len(buf[4:8]))
+	// Field (1) 'WithdrawalCredentials'
+	if cap(d.WithdrawalCredentials) == 0 {
+		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
+	}
+	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+
+	// Field (2) 'Contract'
+	if cap(d.Contract) == 0 {
+		d.Contract = make([]byte, 0, len(buf[80:100]))
 	}
-	f.CurrentVersion = append(f.CurrentVersion, buf[4:8]...)
+	d.Contract = append(d.Contract, buf[80:100]...)
 
-	// Field (2) 'Epoch'
-	f.Epoch = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[8:16]))
+	// Field (3) 'Amount'
+	d.Amount = ssz.UnmarshallUint64(buf[100:108])
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the Fork object
-func (f *Fork) SizeSSZ() (size int) {
-	size = 16
+// SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
+func (d *DepositMessage) SizeSSZ() (size int) {
+	size = 108
 	return
 }
 
-// HashTreeRoot ssz hashes the Fork object
-func (f *Fork) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(f)
+// HashTreeRoot ssz hashes the DepositMessage object
+func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
 }
 
-// HashTreeRootWith ssz hashes the Fork object with a hasher
-func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the DepositMessage object with a hasher
+func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'PreviousVersion'
-	if size := len(f.PreviousVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.PreviousVersion", size, 4)
+	// Field (0) 'PublicKey'
+	if size := len(d.PublicKey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
 		return
 	}
-	hh.PutBytes(f.PreviousVersion)
+	hh.PutBytes(d.PublicKey)
 
-	// Field (1) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
 		return
 	}
-	hh.PutBytes(f.CurrentVersion)
+	hh.PutBytes(d.WithdrawalCredentials)
 
-	// Field (2) 'Epoch'
-	hh.PutUint64(uint64(f.Epoch))
+	// Field (2) 'Contract'
+	if size := len(d.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	hh.PutBytes(d.Contract)
+
+	// Field (3) 'Amount'
+	hh.PutUint64(d.Amount)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -12079,79 +15624,107 @@ func (f *Fork) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the SharedActivity object
-func (s *SharedActivity) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the SyncCommittee object
+func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the SharedActivity object to a target array
-func (s *SharedActivity) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
+func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'TransactionsGasPerPeriod'
-	dst = ssz.MarshalUint64(dst, s.TransactionsGasPerPeriod)
-
-	// Field (1) 'TransactionsGasPerEpoch'
-	dst = ssz.MarshalUint64(dst, s.TransactionsGasPerEpoch)
-
-	// Field (2) 'BaseFeePerPeriod'
-	dst = ssz.MarshalUint64(dst, s.BaseFeePerPeriod)
+	// Field (0) 'Pubkeys'
+	if size := len(s.Pubkeys); size != 512 {
+		err = ssz.ErrVectorLengthFn("--.Pubkeys", size, 512)
+		return
+	}
+	for ii := 0; ii < 512; ii++ {
+		if size := len(s.Pubkeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("--.Pubkeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.Pubkeys[ii]...)
+	}
 
-	// Field (3) 'BaseFeePerEpoch'
-	dst = ssz.MarshalUint64(dst, s.BaseFeePerEpoch)
+	// Field (1) 'AggregatePubkey'
+	if size := len(s.AggregatePubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.AggregatePubkey", size, 48)
+		return
+	}
+	dst = append(dst, s.AggregatePubkey...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SharedActivity object
-func (s *SharedActivity) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SyncCommittee object
+func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 32 {
+	if size != 24624 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'TransactionsGasPerPeriod'
-	s.TransactionsGasPerPeriod = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'TransactionsGasPerEpoch'
-	s.TransactionsGasPerEpoch = ssz.UnmarshallUint64(buf[8:16])
-
-	// Field (2) 'BaseFeePerPeriod'
-	s.BaseFeePerPeriod = ssz.UnmarshallUint64(buf[16:24])
+	// Field (0) 'Pubkeys'
+	s.Pubkeys = make([][]byte, 512)
+	for ii := 0; ii < 512; ii++ {
+		if cap(s.Pubkeys[ii]) == 0 {
+			s.Pubkeys[ii] = make([]byte, 0, len(buf[0:24576][ii*48:(ii+1)*48]))
+		}
+		s.Pubkeys[ii] = append(s.Pubkeys[ii], buf[0:24576][ii*48:(ii+1)*48]...)
+	}
 
-	// Field (3) 'BaseFeePerEpoch'
-	s.BaseFeePerEpoch = ssz.UnmarshallUint64(buf[24:32])
+	// Field (1) 'AggregatePubkey'
+	if cap(s.AggregatePubkey) == 0 {
+		s.AggregatePubkey = make([]byte, 0, len(buf[24576:24624]))
+	}
+	s.AggregatePubkey = append(s.AggregatePubkey, buf[24576:24624]...)
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SharedActivity object
-func (s *SharedActivity) SizeSSZ() (size int) {
-	size = 32
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) SizeSSZ() (size int) {
+	size = 24624
 	return
 }
 
-// HashTreeRoot ssz hashes the SharedActivity object
-func (s *SharedActivity) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the SyncCommittee object
+func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the SharedActivity object with a hasher
-func (s *SharedActivity) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
+func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'TransactionsGasPerPeriod'
-	hh.PutUint64(s.TransactionsGasPerPeriod)
-
-	// Field (1) 'TransactionsGasPerEpoch'
-	hh.PutUint64(s.TransactionsGasPerEpoch)
+	// Field (0) 'Pubkeys'
+	{
+		if size := len(s.Pubkeys); size != 512 {
+			err = ssz.ErrVectorLengthFn("--.Pubkeys", size, 512)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.Pubkeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
 
-	// Field (2) 'BaseFeePerPeriod'
-	hh.PutUint64(s.BaseFeePerPeriod)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
 
-	// Field (3) 'BaseFeePerEpoch'
-	hh.PutUint64(s.BaseFeePerEpoch)
+	// Field (1) 'AggregatePubkey'
+	if size := len(s.AggregatePubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.AggregatePubkey", size, 48)
+		return
+	}
+	hh.PutBytes(s.AggregatePubkey)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -12161,128 +15734,61 @@ func (s *SharedActivity) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the PendingAttestation object
-func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(p)
+// MarshalSSZ ssz marshals the SyncAggregatorSelectionData object
+func (s *SyncAggregatorSelectionData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
 }
 
-// MarshalSSZTo ssz marshals the PendingAttestation object to a target array
-func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the SyncAggregatorSelectionData object to a target array
+func (s *SyncAggregatorSelectionData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(148)
-
-	// Offset (0) 'AggregationBits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(p.AggregationBits)
-
-	// Field (1) 'Data'
-	if p.Data == nil {
-		p.Data = new(AttestationData)
-	}
-	if dst, err = p.Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	// Field (2) 'InclusionDelay'
-	dst = ssz.MarshalUint64(dst, uint64(p.InclusionDelay))
 
-	// Field (3) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, uint64(p.ProposerIndex))
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(s.Slot))
 
-	// Field (0) 'AggregationBits'
-	if size := len(p.AggregationBits); size > 2048 {
-		err = ssz.ErrBytesLengthFn("--.AggregationBits", size, 2048)
-		return
-	}
-	dst = append(dst, p.AggregationBits...)
+	// Field (1) 'SubcommitteeIndex'
+	dst = ssz.MarshalUint64(dst, s.SubcommitteeIndex)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the PendingAttestation object
-func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SyncAggregatorSelectionData object
+func (s *SyncAggregatorSelectionData) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 148 {
+	if size != 16 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0 uint64
-
-	// Offset (0) 'AggregationBits'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
-
-	if o0 < 148 {
-		return ssz.ErrInvalidVariableOffset
-	}
-
-	// Field (1) 'Data'
-	if p.Data == nil {
-		p.Data = new(AttestationData)
-	}
-	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
-		return err
-	}
-
-	// Field (2) 'InclusionDelay'
-	p.InclusionDelay = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[132:140]))
-
-	// Field (3) 'ProposerIndex'
-	p.ProposerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[140:148]))
-
-	// Field (0) 'AggregationBits'
-	{
-		buf = tail[o0:]
-		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
-			return err
-		}
-		if cap(p.AggregationBits) == 0 {
-			p.AggregationBits = make([]byte, 0, len(buf))
-		}
-		p.AggregationBits = append(p.AggregationBits, buf...)
-	}
-	return err
-}
+	// Field (0) 'Slot'
+	s.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
 
-// SizeSSZ returns the ssz encoded size in bytes for the PendingAttestation object
-func (p *PendingAttestation) SizeSSZ() (size int) {
-	size = 148
+	// Field (1) 'SubcommitteeIndex'
+	s.SubcommitteeIndex = ssz.UnmarshallUint64(buf[8:16])
 
-	// Field (0) 'AggregationBits'
-	size += len(p.AggregationBits)
+	return err
+}
 
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregatorSelectionData object
+func (s *SyncAggregatorSelectionData) SizeSSZ() (size int) {
+	size = 16
 	return
 }
 
-// HashTreeRoot ssz hashes the PendingAttestation object
-func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(p)
+// HashTreeRoot ssz hashes the SyncAggregatorSelectionData object
+func (s *SyncAggregatorSelectionData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
 }
 
-// HashTreeRootWith ssz hashes the PendingAttestation object with a hasher
-func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the SyncAggregatorSelectionData object with a hasher
+func (s *SyncAggregatorSelectionData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'AggregationBits'
-	if len(p.AggregationBits) == 0 {
-		err = ssz.ErrEmptyBitlist
-		return
-	}
-	hh.PutBitlist(p.AggregationBits, 2048)
-
-	// Field (1) 'Data'
-	if err = p.Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (2) 'InclusionDelay'
-	hh.PutUint64(uint64(p.InclusionDelay))
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(s.Slot))
 
-	// Field (3) 'ProposerIndex'
-	hh.PutUint64(uint64(p.ProposerIndex))
+	// Field (1) 'SubcommitteeIndex'
+	hh.PutUint64(s.SubcommitteeIndex)
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -12292,513 +15798,838 @@ func (p *PendingAttestation) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the HistoricalBatch object
-func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(h)
+// MarshalSSZ ssz marshals the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the HistoricalBatch object to a target array
-func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
+func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := int(2736669)
 
-	// Field (0) 'BlockRoots'
-	if size := len(h.BlockRoots); size != 8192 {
+	// Field (0) 'GenesisTime'
+	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.GenesisValidatorsRoot...)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlockRoots'
+	if size := len(b.BlockRoots); size != 8192 {
 		err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
 		return
 	}
 	for ii := 0; ii < 8192; ii++ {
-		if size := len(h.BlockRoots[ii]); size != 32 {
+		if size := len(b.BlockRoots[ii]); size != 32 {
 			err = ssz.ErrBytesLengthFn("--.BlockRoots[ii]", size, 32)
 			return
 		}
-		dst = append(dst, h.BlockRoots[ii]...)
+		dst = append(dst, b.BlockRoots[ii]...)
 	}
 
-	// Field (1) 'StateRoots'
-	if size := len(h.StateRoots); size != 8192 {
+	// Field (6) 'StateRoots'
+	if size := len(b.StateRoots); size != 8192 {
 		err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
 		return
 	}
 	for ii := 0; ii < 8192; ii++ {
-		if size := len(h.StateRoots[ii]); size != 32 {
+		if size := len(b.StateRoots[ii]); size != 32 {
 			err = ssz.ErrBytesLengthFn("--.StateRoots[ii]", size, 32)
 			return
 		}
-		dst = append(dst, h.StateRoots[ii]...)
+		dst = append(dst, b.StateRoots[ii]...)
 	}
 
-	return
-}
+	// Offset (7) 'HistoricalRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalRoots) * 32
 
-// UnmarshalSSZ ssz unmarshals the HistoricalBatch object
-func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 524288 {
-		return ssz.ErrSize
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
 	}
 
-	// Field (0) 'BlockRoots'
-	h.BlockRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(h.BlockRoots[ii]) == 0 {
-			h.BlockRoots[ii] = make([]byte, 0, len(buf[0:262144][ii*32:(ii+1)*32]))
+	// Offset (9) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (10) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Field (11) 'SharedActivity'
+	if b.SharedActivity == nil {
+		b.SharedActivity = new(SharedActivity)
+	}
+	if dst, err = b.SharedActivity.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (12) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * 149
+
+	// Offset (13) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * 8
+
+	// Offset (14) 'Activities'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Activities) * 8
+
+	// Field (15) 'RandaoMixes'
+	if size := len(b.RandaoMixes); size != 65536 {
+		err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
+		return
+	}
+	for ii := 0; ii < 65536; ii++ {
+		if size := len(b.RandaoMixes[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.RandaoMixes[ii]", size, 32)
+			return
 		}
-		h.BlockRoots[ii] = append(h.BlockRoots[ii], buf[0:262144][ii*32:(ii+1)*32]...)
+		dst = append(dst, b.RandaoMixes[ii]...)
 	}
 
-	// Field (1) 'StateRoots'
-	h.StateRoots = make([][]byte, 8192)
+	// Field (16) 'Slashings'
+	if size := len(b.Slashings); size != 8192 {
+		err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
+		return
+	}
 	for ii := 0; ii < 8192; ii++ {
-		if cap(h.StateRoots[ii]) == 0 {
-			h.StateRoots[ii] = make([]byte, 0, len(buf[262144:524288][ii*32:(ii+1)*32]))
-		}
-		h.StateRoots[ii] = append(h.StateRoots[ii], buf[262144:524288][ii*32:(ii+1)*32]...)
+		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
 	}
 
-	return err
-}
+	// Offset (17) 'PreviousEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PreviousEpochParticipation)
 
-// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch object
-func (h *HistoricalBatch) SizeSSZ() (size int) {
-	size = 524288
-	return
-}
+	// Offset (18) 'CurrentEpochParticipation'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.CurrentEpochParticipation)
 
-// HashTreeRoot ssz hashes the HistoricalBatch object
-func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(h)
-}
+	// Field (19) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
+	}
+	dst = append(dst, b.JustificationBits...)
 
-// HashTreeRootWith ssz hashes the HistoricalBatch object with a hasher
-func (h *HistoricalBatch) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
 
-	// Field (0) 'BlockRoots'
-	{
-		if size := len(h.BlockRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range h.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (22) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (23) 'InactivityScores'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.InactivityScores) * 8
+
+	// Field (24) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (25) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (26) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+	}
+	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
+
+	// Field (7) 'HistoricalRoots'
+	if size := len(b.HistoricalRoots); size > 16777216 {
+		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+		if size := len(b.HistoricalRoots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.HistoricalRoots[ii]", size, 32)
+			return
 		}
+		dst = append(dst, b.HistoricalRoots[ii]...)
+	}
 
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeVectorizedHTR(subIndx)
-		} else {
-			hh.Merkleize(subIndx)
+	// Field (9) 'Eth1DataVotes'
+	if size := len(b.Eth1DataVotes); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Eth1DataVotes", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
 		}
 	}
 
-	// Field (1) 'StateRoots'
-	{
-		if size := len(h.StateRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
+	// Field (12) 'Validators'
+	if size := len(b.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Validators); ii++ {
+		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range h.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
+	}
 
-		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeVectorizedHTR(subIndx)
-		} else {
-			hh.Merkleize(subIndx)
-		}
+	// Field (13) 'Balances'
+	if size := len(b.Balances); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Balances[ii])
 	}
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+	// Field (14) 'Activities'
+	if size := len(b.Activities); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.Activities); ii++ {
+		dst = ssz.MarshalUint64(dst, b.Activities[ii])
 	}
-	return
-}
 
-// MarshalSSZ ssz marshals the SigningData object
-func (s *SigningData) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+	// Field (17) 'PreviousEpochParticipation'
+	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("--.PreviousEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.PreviousEpochParticipation...)
 
-// MarshalSSZTo ssz marshals the SigningData object to a target array
-func (s *SigningData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (18) 'CurrentEpochParticipation'
+	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+		err = ssz.ErrBytesLengthFn("--.CurrentEpochParticipation", size, 1099511627776)
+		return
+	}
+	dst = append(dst, b.CurrentEpochParticipation...)
 
-	// Field (0) 'ObjectRoot'
-	if size := len(s.ObjectRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
+	// Field (23) 'InactivityScores'
+	if size := len(b.InactivityScores); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
 		return
 	}
-	dst = append(dst, s.ObjectRoot...)
+	for ii := 0; ii < len(b.InactivityScores); ii++ {
+		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+	}
 
-	// Field (1) 'Domain'
-	if size := len(s.Domain); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Domain", size, 32)
+	// Field (26) 'LatestExecutionPayloadHeader'
+	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	dst = append(dst, s.Domain...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SigningData object
-func (s *SigningData) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 64 {
+	if size < 2736669 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'ObjectRoot'
-	if cap(s.ObjectRoot) == 0 {
-		s.ObjectRoot = make([]byte, 0, len(buf[0:32]))
+	tail := buf
+	var o7, o9, o12, o13, o14, o17, o18, o23, o26 uint64
+
+	// Field (0) 'GenesisTime'
+	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if cap(b.GenesisValidatorsRoot) == 0 {
+		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
 	}
-	s.ObjectRoot = append(s.ObjectRoot, buf[0:32]...)
+	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
 
-	// Field (1) 'Domain'
-	if cap(s.Domain) == 0 {
-		s.Domain = make([]byte, 0, len(buf[32:64]))
+	// Field (2) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[40:48]))
+
+	// Field (3) 'Fork'
+	if b.Fork == nil {
+		b.Fork = new(Fork)
+	}
+	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
+		return err
 	}
-	s.Domain = append(s.Domain, buf[32:64]...)
 
-	return err
-}
+	// Field (4) 'LatestBlockHeader'
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(BeaconBlockHeader)
+	}
+	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
+		return err
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the SigningData object
-func (s *SigningData) SizeSSZ() (size int) {
-	size = 64
-	return
-}
+	// Field (5) 'BlockRoots'
+	b.BlockRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(b.BlockRoots[ii]) == 0 {
+			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
+		}
+		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
+	}
 
-// HashTreeRoot ssz hashes the SigningData object
-func (s *SigningData) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+	// Field (6) 'StateRoots'
+	b.StateRoots = make([][]byte, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		if cap(b.StateRoots[ii]) == 0 {
+			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
+		}
+		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
+	}
 
-// HashTreeRootWith ssz hashes the SigningData object with a hasher
-func (s *SigningData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+	// Offset (7) 'HistoricalRoots'
+	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (0) 'ObjectRoot'
-	if size := len(s.ObjectRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.ObjectRoot", size, 32)
-		return
+	if o7 < 2736669 {
+		return ssz.ErrInvalidVariableOffset
 	}
-	hh.PutBytes(s.ObjectRoot)
 
-	// Field (1) 'Domain'
-	if size := len(s.Domain); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.Domain", size, 32)
-		return
+	// Field (8) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
+		return err
 	}
-	hh.PutBytes(s.Domain)
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+	// Offset (9) 'Eth1DataVotes'
+	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+		return ssz.ErrOffset
 	}
-	return
-}
 
-// MarshalSSZ ssz marshals the ForkData object
-func (f *ForkData) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(f)
-}
+	// Field (10) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
 
-// MarshalSSZTo ssz marshals the ForkData object to a target array
-func (f *ForkData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (11) 'SharedActivity'
+	if b.SharedActivity == nil {
+		b.SharedActivity = new(SharedActivity)
+	}
+	if err = b.SharedActivity.UnmarshalSSZ(buf[524552:524584]); err != nil {
+		return err
+	}
 
-	// Field (0) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
-		return
+	// Offset (12) 'Validators'
+	if o12 = ssz.ReadOffset(buf[524584:524588]); o12 > size || o9 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (13) 'Balances'
+	if o13 = ssz.ReadOffset(buf[524588:524592]); o13 > size || o12 > o13 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (14) 'Activities'
+	if o14 = ssz.ReadOffset(buf[524592:524596]); o14 > size || o13 > o14 {
+		return ssz.ErrOffset
+	}
+
+	// Field (15) 'RandaoMixes'
+	b.RandaoMixes = make([][]byte, 65536)
+	for ii := 0; ii < 65536; ii++ {
+		if cap(b.RandaoMixes[ii]) == 0 {
+			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524596:2621748][ii*32:(ii+1)*32]))
+		}
+		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524596:2621748][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (16) 'Slashings'
+	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+	for ii := 0; ii < 8192; ii++ {
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621748:2687284][ii*8 : (ii+1)*8])
+	}
+
+	// Offset (17) 'PreviousEpochParticipation'
+	if o17 = ssz.ReadOffset(buf[2687284:2687288]); o17 > size || o14 > o17 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (18) 'CurrentEpochParticipation'
+	if o18 = ssz.ReadOffset(buf[2687288:2687292]); o18 > size || o17 > o18 {
+		return ssz.ErrOffset
 	}
-	dst = append(dst, f.CurrentVersion...)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(f.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
-		return
+	// Field (19) 'JustificationBits'
+	if cap(b.JustificationBits) == 0 {
+		b.JustificationBits = make([]byte, 0, len(buf[2687292:2687293]))
 	}
-	dst = append(dst, f.GenesisValidatorsRoot...)
+	b.JustificationBits = append(b.JustificationBits, buf[2687292:2687293]...)
 
-	return
-}
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if b.PreviousJustifiedCheckpoint == nil {
+		b.PreviousJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687293:2687333]); err != nil {
+		return err
+	}
 
-// UnmarshalSSZ ssz unmarshals the ForkData object
-func (f *ForkData) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 36 {
-		return ssz.ErrSize
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if b.CurrentJustifiedCheckpoint == nil {
+		b.CurrentJustifiedCheckpoint = new(Checkpoint)
+	}
+	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687333:2687373]); err != nil {
+		return err
 	}
 
-	// Field (0) 'CurrentVersion'
-	if cap(f.CurrentVersion) == 0 {
-		f.CurrentVersion = make([]byte, 0, len(buf[0:4]))
+	// Field (22) 'FinalizedCheckpoint'
+	if b.FinalizedCheckpoint == nil {
+		b.FinalizedCheckpoint = new(Checkpoint)
+	}
+	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687373:2687413]); err != nil {
+		return err
 	}
-	f.CurrentVersion = append(f.CurrentVersion, buf[0:4]...)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(f.GenesisValidatorsRoot) == 0 {
-		f.GenesisValidatorsRoot = make([]byte, 0, len(buf[4:36]))
+	// Offset (23) 'InactivityScores'
+	if o23 = ssz.ReadOffset(buf[2687413:2687417]); o23 > size || o18 > o23 {
+		return ssz.ErrOffset
 	}
-	f.GenesisValidatorsRoot = append(f.GenesisValidatorsRoot, buf[4:36]...)
 
-	return err
-}
+	// Field (24) 'CurrentSyncCommittee'
+	if b.CurrentSyncCommittee == nil {
+		b.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687417:2712041]); err != nil {
+		return err
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the ForkData object
-func (f *ForkData) SizeSSZ() (size int) {
-	size = 36
-	return
-}
+	// Field (25) 'NextSyncCommittee'
+	if b.NextSyncCommittee == nil {
+		b.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712041:2736665]); err != nil {
+		return err
+	}
 
-// HashTreeRoot ssz hashes the ForkData object
-func (f *ForkData) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(f)
-}
+	// Offset (26) 'LatestExecutionPayloadHeader'
+	if o26 = ssz.ReadOffset(buf[2736665:2736669]); o26 > size || o23 > o26 {
+		return ssz.ErrOffset
+	}
 
-// HashTreeRootWith ssz hashes the ForkData object with a hasher
-func (f *ForkData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+	// Field (7) 'HistoricalRoots'
+	{
+		buf = tail[o7:o9]
+		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalRoots = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.HistoricalRoots[ii]) == 0 {
+				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+			}
+			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		}
+	}
 
-	// Field (0) 'CurrentVersion'
-	if size := len(f.CurrentVersion); size != 4 {
-		err = ssz.ErrBytesLengthFn("--.CurrentVersion", size, 4)
-		return
+	// Field (9) 'Eth1DataVotes'
+	{
+		buf = tail[o9:o12]
+		num, err := ssz.DivideInt2(len(buf), 72, 2048)
+		if err != nil {
+			return err
+		}
+		b.Eth1DataVotes = make([]*Eth1Data, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(Eth1Data)
+			}
+			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+				return err
+			}
+		}
 	}
-	hh.PutBytes(f.CurrentVersion)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(f.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
-		return
+	// Field (12) 'Validators'
+	{
+		buf = tail[o12:o13]
+		num, err := ssz.DivideInt2(len(buf), 149, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Validators = make([]*Validator, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Validators[ii] == nil {
+				b.Validators[ii] = new(Validator)
+			}
+			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*149 : (ii+1)*149]); err != nil {
+				return err
+			}
+		}
 	}
-	hh.PutBytes(f.GenesisValidatorsRoot)
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+	// Field (13) 'Balances'
+	{
+		buf = tail[o13:o14]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Balances = ssz.ExtendUint64(b.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
 	}
-	return
-}
 
-// MarshalSSZ ssz marshals the DepositMessage object
-func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
-}
+	// Field (14) 'Activities'
+	{
+		buf = tail[o14:o17]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.Activities = ssz.ExtendUint64(b.Activities, num)
+		for ii := 0; ii < num; ii++ {
+			b.Activities[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
 
-// MarshalSSZTo ssz marshals the DepositMessage object to a target array
-func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (17) 'PreviousEpochParticipation'
+	{
+		buf = tail[o17:o18]
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.PreviousEpochParticipation) == 0 {
+			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+	}
 
-	// Field (0) 'PublicKey'
-	if size := len(d.PublicKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
-		return
+	// Field (18) 'CurrentEpochParticipation'
+	{
+		buf = tail[o18:o23]
+		if len(buf) > 1099511627776 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.CurrentEpochParticipation) == 0 {
+			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		}
+		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
 	}
-	dst = append(dst, d.PublicKey...)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(d.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
-		return
+	// Field (23) 'InactivityScores'
+	{
+		buf = tail[o23:o26]
+		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+		for ii := 0; ii < num; ii++ {
+			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
 	}
-	dst = append(dst, d.WithdrawalCredentials...)
 
-	// Field (2) 'Contract'
-	if size := len(d.Contract); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
-		return
+	// Field (26) 'LatestExecutionPayloadHeader'
+	{
+		buf = tail[o26:]
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+		}
+		if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
 	}
-	dst = append(dst, d.Contract...)
+	return err
+}
 
-	// Field (3) 'Amount'
-	dst = ssz.MarshalUint64(dst, d.Amount)
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
+	size = 2736669
 
-	return
-}
+	// Field (7) 'HistoricalRoots'
+	size += len(b.HistoricalRoots) * 32
 
-// UnmarshalSSZ ssz unmarshals the DepositMessage object
-func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 108 {
-		return ssz.ErrSize
-	}
+	// Field (9) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (12) 'Validators'
+	size += len(b.Validators) * 149
+
+	// Field (13) 'Balances'
+	size += len(b.Balances) * 8
 
-	// Field (0) 'PublicKey'
-	if cap(d.PublicKey) == 0 {
-		d.PublicKey = make([]byte, 0, len(buf[0:48]))
-	}
-	d.PublicKey = append(d.PublicKey, buf[0:48]...)
+	// Field (14) 'Activities'
+	size += len(b.Activities) * 8
 
-	// Field (1) 'WithdrawalCredentials'
-	if cap(d.WithdrawalCredentials) == 0 {
-		d.WithdrawalCredentials = make([]byte, 0, len(buf[48:80]))
-	}
-	d.WithdrawalCredentials = append(d.WithdrawalCredentials, buf[48:80]...)
+	// Field (17) 'PreviousEpochParticipation'
+	size += len(b.PreviousEpochParticipation)
 
-	// Field (2) 'Contract'
-	if cap(d.Contract) == 0 {
-		d.Contract = make([]byte, 0, len(buf[80:100]))
-	}
-	d.Contract = append(d.Contract, buf[80:100]...)
+	// Field (18) 'CurrentEpochParticipation'
+	size += len(b.CurrentEpochParticipation)
 
-	// Field (3) 'Amount'
-	d.Amount = ssz.UnmarshallUint64(buf[100:108])
+	// Field (23) 'InactivityScores'
+	size += len(b.InactivityScores) * 8
 
-	return err
-}
+	// Field (26) 'LatestExecutionPayloadHeader'
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+	}
+	size += b.LatestExecutionPayloadHeader.SizeSSZ()
 
-// SizeSSZ returns the ssz encoded size in bytes for the DepositMessage object
-func (d *DepositMessage) SizeSSZ() (size int) {
-	size = 108
 	return
 }
 
-// HashTreeRoot ssz hashes the DepositMessage object
-func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(d)
+// HashTreeRoot ssz hashes the BeaconStateBellatrix object
+func (b *BeaconStateBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the DepositMessage object with a hasher
-func (d *DepositMessage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateBellatrix object with a hasher
+func (b *BeaconStateBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'PublicKey'
-	if size := len(d.PublicKey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.PublicKey", size, 48)
+	// Field (0) 'GenesisTime'
+	hh.PutUint64(b.GenesisTime)
+
+	// Field (1) 'GenesisValidatorsRoot'
+	if size := len(b.GenesisValidatorsRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.GenesisValidatorsRoot", size, 32)
 		return
 	}
-	hh.PutBytes(d.PublicKey)
+	hh.PutBytes(b.GenesisValidatorsRoot)
 
-	// Field (1) 'WithdrawalCredentials'
-	if size := len(d.WithdrawalCredentials); size != 32 {
-		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+	// Field (2) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (3) 'Fork'
+	if err = b.Fork.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(d.WithdrawalCredentials)
 
-	// Field (2) 'Contract'
-	if size := len(d.Contract); size != 20 {
-		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+	// Field (4) 'LatestBlockHeader'
+	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	hh.PutBytes(d.Contract)
 
-	// Field (3) 'Amount'
-	hh.PutUint64(d.Amount)
+	// Field (5) 'BlockRoots'
+	{
+		if size := len(b.BlockRoots); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.BlockRoots", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlockRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
 	}
-	return
-}
-
-// MarshalSSZ ssz marshals the SyncCommittee object
-func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
 
-// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
-func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (6) 'StateRoots'
+	{
+		if size := len(b.StateRoots); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.StateRoots", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.StateRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
 
-	// Field (0) 'Pubkeys'
-	if size := len(s.Pubkeys); size != 512 {
-		err = ssz.ErrVectorLengthFn("--.Pubkeys", size, 512)
-		return
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
 	}
-	for ii := 0; ii < 512; ii++ {
-		if size := len(s.Pubkeys[ii]); size != 48 {
-			err = ssz.ErrBytesLengthFn("--.Pubkeys[ii]", size, 48)
+
+	// Field (7) 'HistoricalRoots'
+	{
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
 			return
 		}
-		dst = append(dst, s.Pubkeys[ii]...)
+		subIndx := hh.Index()
+		for _, i := range b.HistoricalRoots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		numItems := uint64(len(b.HistoricalRoots))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, 16777216)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
 	}
 
-	// Field (1) 'AggregatePubkey'
-	if size := len(s.AggregatePubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.AggregatePubkey", size, 48)
+	// Field (8) 'Eth1Data'
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
 		return
 	}
-	dst = append(dst, s.AggregatePubkey...)
 
-	return
-}
-
-// UnmarshalSSZ ssz unmarshals the SyncCommittee object
-func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 24624 {
-		return ssz.ErrSize
+	// Field (9) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Eth1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 2048)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
 	}
 
-	// Field (0) 'Pubkeys'
-	s.Pubkeys = make([][]byte, 512)
-	for ii := 0; ii < 512; ii++ {
-		if cap(s.Pubkeys[ii]) == 0 {
-			s.Pubkeys[ii] = make([]byte, 0, len(buf[0:24576][ii*48:(ii+1)*48]))
-		}
-		s.Pubkeys[ii] = append(s.Pubkeys[ii], buf[0:24576][ii*48:(ii+1)*48]...)
+	// Field (10) 'Eth1DepositIndex'
+	hh.PutUint64(b.Eth1DepositIndex)
+
+	// Field (11) 'SharedActivity'
+	if err = b.SharedActivity.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (1) 'AggregatePubkey'
-	if cap(s.AggregatePubkey) == 0 {
-		s.AggregatePubkey = make([]byte, 0, len(buf[24576:24624]))
+	// Field (12) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
 	}
-	s.AggregatePubkey = append(s.AggregatePubkey, buf[24576:24624]...)
 
-	return err
-}
+	// Field (13) 'Balances'
+	{
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Balances", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
-func (s *SyncCommittee) SizeSSZ() (size int) {
-	size = 24624
-	return
-}
+		numItems := uint64(len(b.Balances))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
 
-// HashTreeRoot ssz hashes the SyncCommittee object
-func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+	// Field (14) 'Activities'
+	{
+		if size := len(b.Activities); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.Activities", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Activities {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
 
-// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
-func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+		numItems := uint64(len(b.Activities))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
 
-	// Field (0) 'Pubkeys'
+	// Field (15) 'RandaoMixes'
 	{
-		if size := len(s.Pubkeys); size != 512 {
-			err = ssz.ErrVectorLengthFn("--.Pubkeys", size, 512)
+		if size := len(b.RandaoMixes); size != 65536 {
+			err = ssz.ErrVectorLengthFn("--.RandaoMixes", size, 65536)
 			return
 		}
 		subIndx := hh.Index()
-		for _, i := range s.Pubkeys {
-			if len(i) != 48 {
+		for _, i := range b.RandaoMixes {
+			if len(i) != 32 {
 				err = ssz.ErrBytesLength
 				return
 			}
-			hh.PutBytes(i)
+			hh.Append(i)
 		}
 
 		if ssz.EnableVectorizedHTR {
@@ -12808,76 +16639,112 @@ func (s *SyncCommittee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		}
 	}
 
-	// Field (1) 'AggregatePubkey'
-	if size := len(s.AggregatePubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("--.AggregatePubkey", size, 48)
-		return
-	}
-	hh.PutBytes(s.AggregatePubkey)
+	// Field (16) 'Slashings'
+	{
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("--.Slashings", size, 8192)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Slashings {
+			hh.AppendUint64(i)
+		}
 
-	if ssz.EnableVectorizedHTR {
-		hh.MerkleizeVectorizedHTR(indx)
-	} else {
-		hh.Merkleize(indx)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
 	}
-	return
-}
-
-// MarshalSSZ ssz marshals the SyncAggregatorSelectionData object
-func (s *SyncAggregatorSelectionData) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
-
-// MarshalSSZTo ssz marshals the SyncAggregatorSelectionData object to a target array
-func (s *SyncAggregatorSelectionData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, uint64(s.Slot))
-
-	// Field (1) 'SubcommitteeIndex'
-	dst = ssz.MarshalUint64(dst, s.SubcommitteeIndex)
+	// Field (17) 'PreviousEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.PreviousEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(b.PreviousEpochParticipation)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
+		} else {
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+	}
 
-	return
-}
+	// Field (18) 'CurrentEpochParticipation'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.CurrentEpochParticipation))
+		if byteLen > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(b.CurrentEpochParticipation)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (1099511627776+31)/32)
+		} else {
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+	}
 
-// UnmarshalSSZ ssz unmarshals the SyncAggregatorSelectionData object
-func (s *SyncAggregatorSelectionData) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 16 {
-		return ssz.ErrSize
+	// Field (19) 'JustificationBits'
+	if size := len(b.JustificationBits); size != 1 {
+		err = ssz.ErrBytesLengthFn("--.JustificationBits", size, 1)
+		return
 	}
+	hh.PutBytes(b.JustificationBits)
 
-	// Field (0) 'Slot'
-	s.Slot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+	// Field (20) 'PreviousJustifiedCheckpoint'
+	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (1) 'SubcommitteeIndex'
-	s.SubcommitteeIndex = ssz.UnmarshallUint64(buf[8:16])
+	// Field (21) 'CurrentJustifiedCheckpoint'
+	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	return err
-}
+	// Field (22) 'FinalizedCheckpoint'
+	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregatorSelectionData object
-func (s *SyncAggregatorSelectionData) SizeSSZ() (size int) {
-	size = 16
-	return
-}
+	// Field (23) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
 
-// HashTreeRoot ssz hashes the SyncAggregatorSelectionData object
-func (s *SyncAggregatorSelectionData) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
-}
+		numItems := uint64(len(b.InactivityScores))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
 
-// HashTreeRootWith ssz hashes the SyncAggregatorSelectionData object with a hasher
-func (s *SyncAggregatorSelectionData) HashTreeRootWith(hh *ssz.Hasher) (err error) {
-	indx := hh.Index()
+	// Field (24) 'CurrentSyncCommittee'
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (0) 'Slot'
-	hh.PutUint64(uint64(s.Slot))
+	// Field (25) 'NextSyncCommittee'
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (1) 'SubcommitteeIndex'
-	hh.PutUint64(s.SubcommitteeIndex)
+	// Field (26) 'LatestExecutionPayloadHeader'
+	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
@@ -12887,15 +16754,15 @@ func (s *SyncAggregatorSelectionData) HashTreeRootWith(hh *ssz.Hasher) (err erro
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconStateCapella object
+func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
-func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconStateCapella object to a target array
+func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(2736669)
+	offset := int(2736689)
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalUint64(dst, b.GenesisTime)
@@ -13075,10 +16942,20 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	// Offset (26) 'LatestExecutionPayloadHeader'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
 	}
 	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
 
+	// Field (27) 'NextWithdrawalIndex'
+	dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
+
+	// Field (28) 'NextWithdrawalValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.NextWithdrawalValidatorIndex))
+
+	// Offset (29) 'HistoricalSummaries'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.HistoricalSummaries) * 64
+
 	// Field (7) 'HistoricalRoots'
 	if size := len(b.HistoricalRoots); size > 16777216 {
 		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
@@ -13160,19 +17037,30 @@ func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		return
 	}
 
+	// Field (29) 'HistoricalSummaries'
+	if size := len(b.HistoricalSummaries); size > 16777216 {
+		err = ssz.ErrListTooBigFn("--.HistoricalSummaries", size, 16777216)
+		return
+	}
+	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
+		if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateCapella object
+func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2736669 {
+	if size < 2736689 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o7, o9, o12, o13, o14, o17, o18, o23, o26 uint64
+	var o7, o9, o12, o13, o14, o17, o18, o23, o26, o29 uint64
 
 	// Field (0) 'GenesisTime'
 	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
@@ -13225,7 +17113,7 @@ func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736669 {
+	if o7 < 2736689 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -13349,6 +17237,17 @@ func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Field (27) 'NextWithdrawalIndex'
+	b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[2736669:2736677])
+
+	// Field (28) 'NextWithdrawalValidatorIndex'
+	b.NextWithdrawalValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[2736677:2736685]))
+
+	// Offset (29) 'HistoricalSummaries'
+	if o29 = ssz.ReadOffset(buf[2736685:2736689]); o29 > size || o26 > o29 {
+		return ssz.ErrOffset
+	}
+
 	// Field (7) 'HistoricalRoots'
 	{
 		buf = tail[o7:o9]
@@ -13466,21 +17365,39 @@ func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
 
 	// Field (26) 'LatestExecutionPayloadHeader'
 	{
-		buf = tail[o26:]
+		buf = tail[o26:o29]
 		if b.LatestExecutionPayloadHeader == nil {
-			b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+			b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
 		}
 		if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
 			return err
 		}
 	}
+
+	// Field (29) 'HistoricalSummaries'
+	{
+		buf = tail[o29:]
+		num, err := ssz.DivideInt2(len(buf), 64, 16777216)
+		if err != nil {
+			return err
+		}
+		b.HistoricalSummaries = make([]*HistoricalSummary, num)
+		for ii := 0; ii < num; ii++ {
+			if b.HistoricalSummaries[ii] == nil {
+				b.HistoricalSummaries[ii] = new(HistoricalSummary)
+			}
+			if err = b.HistoricalSummaries[ii].UnmarshalSSZ(buf[ii*64 : (ii+1)*64]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
-	size = 2736669
-
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateCapella object
+func (b *BeaconStateCapella) SizeSSZ() (size int) {
+	size = 2736689
+
 	// Field (7) 'HistoricalRoots'
 	size += len(b.HistoricalRoots) * 32
 
@@ -13507,20 +17424,23 @@ func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
 
 	// Field (26) 'LatestExecutionPayloadHeader'
 	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeader)
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
 	}
 	size += b.LatestExecutionPayloadHeader.SizeSSZ()
 
+	// Field (29) 'HistoricalSummaries'
+	size += len(b.HistoricalSummaries) * 64
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconStateCapella object
+func (b *BeaconStateCapella) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateBellatrix object with a hasher
-func (b *BeaconStateBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateCapella object with a hasher
+func (b *BeaconStateCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'GenesisTime'
@@ -13835,6 +17755,32 @@ func (b *BeaconStateBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		return
 	}
 
+	// Field (27) 'NextWithdrawalIndex'
+	hh.PutUint64(b.NextWithdrawalIndex)
+
+	// Field (28) 'NextWithdrawalValidatorIndex'
+	hh.PutUint64(uint64(b.NextWithdrawalValidatorIndex))
+
+	// Field (29) 'HistoricalSummaries'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.HistoricalSummaries))
+		if num > 16777216 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.HistoricalSummaries {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16777216)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16777216)
+		}
+	}
+
 	if ssz.EnableVectorizedHTR {
 		hh.MerkleizeVectorizedHTR(indx)
 	} else {
@@ -13843,13 +17789,13 @@ func (b *BeaconStateBellatrix) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconStateCapella object
-func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconStateDeneb object
+func (b *BeaconStateDeneb) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateCapella object to a target array
-func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconStateDeneb object to a target array
+func (b *BeaconStateDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 	offset := int(2736689)
 
@@ -14031,7 +17977,7 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Offset (26) 'LatestExecutionPayloadHeader'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
 	}
 	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
 
@@ -14140,8 +18086,8 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateCapella object
-func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateDeneb object
+func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 2736689 {
@@ -14456,7 +18402,7 @@ func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
 	{
 		buf = tail[o26:o29]
 		if b.LatestExecutionPayloadHeader == nil {
-			b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
+			b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
 		}
 		if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
 			return err
@@ -14483,8 +18429,8 @@ func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateCapella object
-func (b *BeaconStateCapella) SizeSSZ() (size int) {
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateDeneb object
+func (b *BeaconStateDeneb) SizeSSZ() (size int) {
 	size = 2736689
 
 	// Field (7) 'HistoricalRoots'
@@ -14513,7 +18459,7 @@ func (b *BeaconStateCapella) SizeSSZ() (size int) {
 
 	// Field (26) 'LatestExecutionPayloadHeader'
 	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderCapella)
+		b.LatestExecutionPayloadHeader = new(v1.ExecutionPayloadHeaderDeneb)
 	}
 	size += b.LatestExecutionPayloadHeader.SizeSSZ()
 
@@ -14523,13 +18469,13 @@ func (b *BeaconStateCapella) SizeSSZ() (size int) {
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateCapella object
-func (b *BeaconStateCapella) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconStateDeneb object
+func (b *BeaconStateDeneb) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateCapella object with a hasher
-func (b *BeaconStateCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateDeneb object with a hasher
+func (b *BeaconStateDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'GenesisTime'
@@ -14878,15 +18824,15 @@ func (b *BeaconStateCapella) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
-// MarshalSSZ ssz marshals the BeaconStateDeneb object
-func (b *BeaconStateDeneb) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the BeaconStateElectra object
+func (b *BeaconStateElectra) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateDeneb object to a target array
-func (b *BeaconStateDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BeaconStateElectra object to a target array
+func (b *BeaconStateElectra) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(2736689)
+	offset := int(2736705)
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalUint64(dst, b.GenesisTime)
@@ -15080,6 +19026,22 @@ func (b *BeaconStateDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.HistoricalSummaries) * 64
 
+	// Offset (30) 'AdditionalContracts'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.AdditionalContracts) * 28
+
+	// Offset (31) 'PendingActivityCredits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.PendingActivityCredits) * 16
+
+	// Offset (32) 'RejectedContractBindings'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.RejectedContractBindings) * 44
+
+	// Offset (33) 'ContractTransferNonces'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.ContractTransferNonces) * 16
+
 	// Field (7) 'HistoricalRoots'
 	if size := len(b.HistoricalRoots); size > 16777216 {
 		err = ssz.ErrListTooBigFn("--.HistoricalRoots", size, 16777216)
@@ -15172,19 +19134,63 @@ func (b *BeaconStateDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
+	// Field (30) 'AdditionalContracts'
+	if size := len(b.AdditionalContracts); size > 8796093022208 {
+		err = ssz.ErrListTooBigFn("--.AdditionalContracts", size, 8796093022208)
+		return
+	}
+	for ii := 0; ii < len(b.AdditionalContracts); ii++ {
+		if dst, err = b.AdditionalContracts[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (31) 'PendingActivityCredits'
+	if size := len(b.PendingActivityCredits); size > 65536 {
+		err = ssz.ErrListTooBigFn("--.PendingActivityCredits", size, 65536)
+		return
+	}
+	for ii := 0; ii < len(b.PendingActivityCredits); ii++ {
+		if dst, err = b.PendingActivityCredits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (32) 'RejectedContractBindings'
+	if size := len(b.RejectedContractBindings); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.RejectedContractBindings", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.RejectedContractBindings); ii++ {
+		if dst, err = b.RejectedContractBindings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (33) 'ContractTransferNonces'
+	if size := len(b.ContractTransferNonces); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.ContractTransferNonces", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(b.ContractTransferNonces); ii++ {
+		if dst, err = b.ContractTransferNonces[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateDeneb object
-func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconStateElectra object
+func (b *BeaconStateElectra) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2736689 {
+	if size < 2736705 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o7, o9, o12, o13, o14, o17, o18, o23, o26, o29 uint64
+	var o7, o9, o12, o13, o14, o17, o18, o23, o26, o29, o30, o31, o32, o33 uint64
 
 	// Field (0) 'GenesisTime'
 	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
@@ -15237,7 +19243,7 @@ func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736689 {
+	if o7 < 2736705 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -15372,6 +19378,26 @@ func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (30) 'AdditionalContracts'
+	if o30 = ssz.ReadOffset(buf[2736689:2736693]); o30 > size || o29 > o30 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (31) 'PendingActivityCredits'
+	if o31 = ssz.ReadOffset(buf[2736693:2736697]); o31 > size || o30 > o31 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (32) 'RejectedContractBindings'
+	if o32 = ssz.ReadOffset(buf[2736697:2736701]); o32 > size || o31 > o32 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (33) 'ContractTransferNonces'
+	if o33 = ssz.ReadOffset(buf[2736701:2736705]); o33 > size || o32 > o33 {
+		return ssz.ErrOffset
+	}
+
 	// Field (7) 'HistoricalRoots'
 	{
 		buf = tail[o7:o9]
@@ -15500,7 +19526,7 @@ func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
 
 	// Field (29) 'HistoricalSummaries'
 	{
-		buf = tail[o29:]
+		buf = tail[o29:o30]
 		num, err := ssz.DivideInt2(len(buf), 64, 16777216)
 		if err != nil {
 			return err
@@ -15515,12 +19541,84 @@ func (b *BeaconStateDeneb) UnmarshalSSZ(buf []byte) error {
 			}
 		}
 	}
+
+	// Field (30) 'AdditionalContracts'
+	{
+		buf = tail[o30:o31]
+		num, err := ssz.DivideInt2(len(buf), 28, 8796093022208)
+		if err != nil {
+			return err
+		}
+		b.AdditionalContracts = make([]*ValidatorContract, num)
+		for ii := 0; ii < num; ii++ {
+			if b.AdditionalContracts[ii] == nil {
+				b.AdditionalContracts[ii] = new(ValidatorContract)
+			}
+			if err = b.AdditionalContracts[ii].UnmarshalSSZ(buf[ii*28 : (ii+1)*28]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (31) 'PendingActivityCredits'
+	{
+		buf = tail[o31:o32]
+		num, err := ssz.DivideInt2(len(buf), 16, 65536)
+		if err != nil {
+			return err
+		}
+		b.PendingActivityCredits = make([]*PendingActivityCredit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.PendingActivityCredits[ii] == nil {
+				b.PendingActivityCredits[ii] = new(PendingActivityCredit)
+			}
+			if err = b.PendingActivityCredits[ii].UnmarshalSSZ(buf[ii*16 : (ii+1)*16]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (32) 'RejectedContractBindings'
+	{
+		buf = tail[o32:o33]
+		num, err := ssz.DivideInt2(len(buf), 44, 4096)
+		if err != nil {
+			return err
+		}
+		b.RejectedContractBindings = make([]*RejectedContractBinding, num)
+		for ii := 0; ii < num; ii++ {
+			if b.RejectedContractBindings[ii] == nil {
+				b.RejectedContractBindings[ii] = new(RejectedContractBinding)
+			}
+			if err = b.RejectedContractBindings[ii].UnmarshalSSZ(buf[ii*44 : (ii+1)*44]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (33) 'ContractTransferNonces'
+	{
+		buf = tail[o33:]
+		num, err := ssz.DivideInt2(len(buf), 16, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.ContractTransferNonces = make([]*ContractTransferNonce, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ContractTransferNonces[ii] == nil {
+				b.ContractTransferNonces[ii] = new(ContractTransferNonce)
+			}
+			if err = b.ContractTransferNonces[ii].UnmarshalSSZ(buf[ii*16 : (ii+1)*16]); err != nil {
+				return err
+			}
+		}
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateDeneb object
-func (b *BeaconStateDeneb) SizeSSZ() (size int) {
-	size = 2736689
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateElectra object
+func (b *BeaconStateElectra) SizeSSZ() (size int) {
+	size = 2736705
 
 	// Field (7) 'HistoricalRoots'
 	size += len(b.HistoricalRoots) * 32
@@ -15555,16 +19653,28 @@ func (b *BeaconStateDeneb) SizeSSZ() (size int) {
 	// Field (29) 'HistoricalSummaries'
 	size += len(b.HistoricalSummaries) * 64
 
+	// Field (30) 'AdditionalContracts'
+	size += len(b.AdditionalContracts) * 28
+
+	// Field (31) 'PendingActivityCredits'
+	size += len(b.PendingActivityCredits) * 16
+
+	// Field (32) 'RejectedContractBindings'
+	size += len(b.RejectedContractBindings) * 44
+
+	// Field (33) 'ContractTransferNonces'
+	size += len(b.ContractTransferNonces) * 16
+
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateDeneb object
-func (b *BeaconStateDeneb) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the BeaconStateElectra object
+func (b *BeaconStateElectra) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateDeneb object with a hasher
-func (b *BeaconStateDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the BeaconStateElectra object with a hasher
+func (b *BeaconStateElectra) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
 	// Field (0) 'GenesisTime'
@@ -15844,64 +19954,144 @@ func (b *BeaconStateDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		return
 	}
 
-	// Field (23) 'InactivityScores'
+	// Field (23) 'InactivityScores'
+	{
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.InactivityScores {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(b.InactivityScores))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+	}
+
+	// Field (24) 'CurrentSyncCommittee'
+	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (25) 'NextSyncCommittee'
+	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (26) 'LatestExecutionPayloadHeader'
+	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (27) 'NextWithdrawalIndex'
+	hh.PutUint64(b.NextWithdrawalIndex)
+
+	// Field (28) 'NextWithdrawalValidatorIndex'
+	hh.PutUint64(uint64(b.NextWithdrawalValidatorIndex))
+
+	// Field (29) 'HistoricalSummaries'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.HistoricalSummaries))
+		if num > 16777216 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.HistoricalSummaries {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16777216)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16777216)
+		}
+	}
+
+	// Field (30) 'AdditionalContracts'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.AdditionalContracts))
+		if num > 8796093022208 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.AdditionalContracts {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 8796093022208)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 8796093022208)
+		}
+	}
+
+	// Field (31) 'PendingActivityCredits'
 	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("--.InactivityScores", size, 1099511627776)
+		subIndx := hh.Index()
+		num := uint64(len(b.PendingActivityCredits))
+		if num > 65536 {
+			err = ssz.ErrIncorrectListSize
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
+		for _, elem := range b.PendingActivityCredits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
 		}
-		hh.FillUpTo32()
-
-		numItems := uint64(len(b.InactivityScores))
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 65536)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+			hh.MerkleizeWithMixin(subIndx, num, 65536)
 		}
 	}
 
-	// Field (24) 'CurrentSyncCommittee'
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (25) 'NextSyncCommittee'
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
-	}
-
-	// Field (26) 'LatestExecutionPayloadHeader'
-	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
+	// Field (32) 'RejectedContractBindings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.RejectedContractBindings))
+		if num > 4096 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.RejectedContractBindings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4096)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 4096)
+		}
 	}
 
-	// Field (27) 'NextWithdrawalIndex'
-	hh.PutUint64(b.NextWithdrawalIndex)
-
-	// Field (28) 'NextWithdrawalValidatorIndex'
-	hh.PutUint64(uint64(b.NextWithdrawalValidatorIndex))
-
-	// Field (29) 'HistoricalSummaries'
+	// Field (33) 'ContractTransferNonces'
 	{
 		subIndx := hh.Index()
-		num := uint64(len(b.HistoricalSummaries))
-		if num > 16777216 {
+		num := uint64(len(b.ContractTransferNonces))
+		if num > 1099511627776 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
-		for _, elem := range b.HistoricalSummaries {
+		for _, elem := range b.ContractTransferNonces {
 			if err = elem.HashTreeRootWith(hh); err != nil {
 				return
 			}
 		}
 		if ssz.EnableVectorizedHTR {
-			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16777216)
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1099511627776)
 		} else {
-			hh.MerkleizeWithMixin(subIndx, num, 16777216)
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 		}
 	}
 
@@ -16105,6 +20295,302 @@ func (h *HistoricalSummary) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the ValidatorContract object
+func (v *ValidatorContract) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorContract object to a target array
+func (v *ValidatorContract) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(v.ValidatorIndex))
+
+	// Field (1) 'Contract'
+	if size := len(v.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	dst = append(dst, v.Contract...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorContract object
+func (v *ValidatorContract) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 28 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	v.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Contract'
+	if cap(v.Contract) == 0 {
+		v.Contract = make([]byte, 0, len(buf[8:28]))
+	}
+	v.Contract = append(v.Contract, buf[8:28]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorContract object
+func (v *ValidatorContract) SizeSSZ() (size int) {
+	size = 28
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorContract object
+func (v *ValidatorContract) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorContract object with a hasher
+func (v *ValidatorContract) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(v.ValidatorIndex))
+
+	// Field (1) 'Contract'
+	if size := len(v.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	hh.PutBytes(v.Contract)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the PendingActivityCredit object
+func (p *PendingActivityCredit) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the PendingActivityCredit object to a target array
+func (p *PendingActivityCredit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(p.ValidatorIndex))
+
+	// Field (1) 'Activity'
+	dst = ssz.MarshalUint64(dst, p.Activity)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the PendingActivityCredit object
+func (p *PendingActivityCredit) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	p.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Activity'
+	p.Activity = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the PendingActivityCredit object
+func (p *PendingActivityCredit) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the PendingActivityCredit object
+func (p *PendingActivityCredit) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the PendingActivityCredit object with a hasher
+func (p *PendingActivityCredit) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(p.ValidatorIndex))
+
+	// Field (1) 'Activity'
+	hh.PutUint64(p.Activity)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the RejectedContractBinding object
+func (r *RejectedContractBinding) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(r)
+}
+
+// MarshalSSZTo ssz marshals the RejectedContractBinding object to a target array
+func (r *RejectedContractBinding) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(r.ValidatorIndex))
+
+	// Field (1) 'Contract'
+	if size := len(r.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	dst = append(dst, r.Contract...)
+
+	// Field (2) 'OwnerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(r.OwnerIndex))
+
+	// Field (3) 'Epoch'
+	dst = ssz.MarshalUint64(dst, uint64(r.Epoch))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the RejectedContractBinding object
+func (r *RejectedContractBinding) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 44 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	r.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Contract'
+	if cap(r.Contract) == 0 {
+		r.Contract = make([]byte, 0, len(buf[8:28]))
+	}
+	r.Contract = append(r.Contract, buf[8:28]...)
+
+	// Field (2) 'OwnerIndex'
+	r.OwnerIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[28:36]))
+
+	// Field (3) 'Epoch'
+	r.Epoch = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[36:44]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the RejectedContractBinding object
+func (r *RejectedContractBinding) SizeSSZ() (size int) {
+	size = 44
+	return
+}
+
+// HashTreeRoot ssz hashes the RejectedContractBinding object
+func (r *RejectedContractBinding) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(r)
+}
+
+// HashTreeRootWith ssz hashes the RejectedContractBinding object with a hasher
+func (r *RejectedContractBinding) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(r.ValidatorIndex))
+
+	// Field (1) 'Contract'
+	if size := len(r.Contract); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.Contract", size, 20)
+		return
+	}
+	hh.PutBytes(r.Contract)
+
+	// Field (2) 'OwnerIndex'
+	hh.PutUint64(uint64(r.OwnerIndex))
+
+	// Field (3) 'Epoch'
+	hh.PutUint64(uint64(r.Epoch))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ContractTransferNonce object
+func (c *ContractTransferNonce) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the ContractTransferNonce object to a target array
+func (c *ContractTransferNonce) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.ValidatorIndex))
+
+	// Field (1) 'Nonce'
+	dst = ssz.MarshalUint64(dst, c.Nonce)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ContractTransferNonce object
+func (c *ContractTransferNonce) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	c.ValidatorIndex = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Nonce'
+	c.Nonce = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ContractTransferNonce object
+func (c *ContractTransferNonce) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the ContractTransferNonce object
+func (c *ContractTransferNonce) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the ContractTransferNonce object with a hasher
+func (c *ContractTransferNonce) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(c.ValidatorIndex))
+
+	// Field (1) 'Nonce'
+	hh.PutUint64(c.Nonce)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the BlobSidecar object
 func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -30,6 +30,7 @@ type componentHandler struct {
 	eth1Miner                e2etypes.ComponentRunner
 	builders                 e2etypes.MultipleComponentRunners
 	eth1Proxy                e2etypes.MultipleComponentRunners
+	executionServers         e2etypes.MultipleComponentRunners
 	eth1Nodes                e2etypes.MultipleComponentRunners
 	beaconNodes              e2etypes.MultipleComponentRunners
 	validatorNodes           e2etypes.MultipleComponentRunners
@@ -141,6 +142,7 @@ func (c *componentHandler) setup() {
 
 	var builders *components.BuilderSet
 	var proxies *eth1.ProxySet
+	var executionServers *eth1.ExecutionServerSet
 	if config.UseBuilder {
 		// Builder
 		builders = components.NewBuilderSet()
@@ -154,6 +156,19 @@ func (c *componentHandler) setup() {
 			return nil
 		})
 		c.builders = builders
+	} else if config.UseExecutionServer {
+		// Execution servers
+		executionServers = eth1.NewExecutionServerSet()
+		g.Go(func() error {
+			if err := helpers.ComponentsStarted(ctx, []e2etypes.ComponentRunner{eth1Nodes}); err != nil {
+				return errors.Wrap(err, "execution servers require execution nodes to run")
+			}
+			if err := executionServers.Start(ctx); err != nil {
+				return errors.Wrap(err, "failed to start execution servers")
+			}
+			return nil
+		})
+		c.executionServers = executionServers
 	} else {
 		// Proxies
 		proxies = eth1.NewProxySet()
@@ -175,6 +190,8 @@ func (c *componentHandler) setup() {
 		wantedComponents := []e2etypes.ComponentRunner{eth1Nodes, bootNode}
 		if config.UseBuilder {
 			wantedComponents = append(wantedComponents, builders)
+		} else if config.UseExecutionServer {
+			wantedComponents = append(wantedComponents, executionServers)
 		} else {
 			wantedComponents = append(wantedComponents, proxies)
 		}
@@ -244,6 +261,8 @@ func (c *componentHandler) required() []e2etypes.ComponentRunner {
 	}
 	if c.cfg.UseBuilder {
 		requiredComponents = append(requiredComponents, c.builders)
+	} else if c.cfg.UseExecutionServer {
+		requiredComponents = append(requiredComponents, c.executionServers)
 	} else {
 		requiredComponents = append(requiredComponents, c.eth1Proxy)
 	}
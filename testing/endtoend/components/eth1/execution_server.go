@@ -0,0 +1,194 @@
+package eth1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/v4/testing/endtoend/params"
+	e2etypes "github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// ExecutionServerSet represents a set of in-process execution servers, which serve the engine-api
+// to the beacon nodes in place of the engine-api proxies.
+type ExecutionServerSet struct {
+	e2etypes.ComponentRunner
+	started chan struct{}
+	servers []e2etypes.ComponentRunner
+}
+
+// NewExecutionServerSet creates and returns a set of execution servers.
+func NewExecutionServerSet() *ExecutionServerSet {
+	return &ExecutionServerSet{
+		started: make(chan struct{}, 1),
+	}
+}
+
+// Start starts all the execution servers in set.
+func (s *ExecutionServerSet) Start(ctx context.Context) error {
+	totalNodeCount := e2e.TestParams.BeaconNodeCount + e2e.TestParams.LighthouseBeaconNodeCount
+	nodes := make([]e2etypes.ComponentRunner, totalNodeCount)
+	for i := 0; i < totalNodeCount; i++ {
+		nodes[i] = NewExecutionServer(i)
+	}
+	s.servers = nodes
+
+	// Wait for all nodes to finish their job (blocking).
+	// Once nodes are ready passed in handler function will be called.
+	return helpers.WaitOnNodes(ctx, nodes, func() {
+		// All nodes started, close channel, so that all services waiting on a set, can proceed.
+		close(s.started)
+	})
+}
+
+// Started checks whether execution server set is started and all servers are ready to be queried.
+func (s *ExecutionServerSet) Started() <-chan struct{} {
+	return s.started
+}
+
+// Pause pauses the component and its underlying process.
+func (s *ExecutionServerSet) Pause() error {
+	for _, n := range s.servers {
+		if err := n.Pause(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resume resumes the component and its underlying process.
+func (s *ExecutionServerSet) Resume() error {
+	for _, n := range s.servers {
+		if err := n.Resume(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop stops the component and its underlying process.
+func (s *ExecutionServerSet) Stop() error {
+	for _, n := range s.servers {
+		if err := n.Stop(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PauseAtIndex pauses the component and its underlying process at the desired index.
+func (s *ExecutionServerSet) PauseAtIndex(i int) error {
+	if i >= len(s.servers) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.servers))
+	}
+	return s.servers[i].Pause()
+}
+
+// ResumeAtIndex resumes the component and its underlying process at the desired index.
+func (s *ExecutionServerSet) ResumeAtIndex(i int) error {
+	if i >= len(s.servers) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.servers))
+	}
+	return s.servers[i].Resume()
+}
+
+// StopAtIndex stops the component and its underlying process at the desired index.
+func (s *ExecutionServerSet) StopAtIndex(i int) error {
+	if i >= len(s.servers) {
+		return errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.servers))
+	}
+	return s.servers[i].Stop()
+}
+
+// ComponentAtIndex returns the component at the provided index.
+func (s *ExecutionServerSet) ComponentAtIndex(i int) (e2etypes.ComponentRunner, error) {
+	if i >= len(s.servers) {
+		return nil, errors.Errorf("provided index exceeds slice size: %d >= %d", i, len(s.servers))
+	}
+	return s.servers[i], nil
+}
+
+// ExecutionServer represents an in-process execution server. It extends the execution genesis block
+// of the eth1 miner, and listens on the port the beacon node expects its engine-api proxy on.
+type ExecutionServer struct {
+	e2etypes.ComponentRunner
+	started chan struct{}
+	index   int
+	server  *mockExecution.ExecutionServer
+	cancel  func()
+}
+
+// NewExecutionServer creates and returns an execution server.
+func NewExecutionServer(index int) *ExecutionServer {
+	return &ExecutionServer{
+		started: make(chan struct{}, 1),
+		index:   index,
+	}
+}
+
+// Start runs an execution server.
+func (node *ExecutionServer) Start(ctx context.Context) error {
+	if e2e.TestParams.Eth1GenesisBlock == nil {
+		return errors.New("execution server requires e2e.TestParams.Eth1GenesisBlock to be set")
+	}
+	server, err := mockExecution.NewExecutionServer(
+		mockExecution.WithGenesisHeader(e2e.TestParams.Eth1GenesisBlock.Header()),
+	)
+	if err != nil {
+		return err
+	}
+	addr := fmt.Sprintf("127.0.0.1:%d", e2e.TestParams.Ports.Eth1ProxyPort+node.index)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: server, ReadHeaderTimeout: time.Second}
+	log.Infof("Starting execution server %d with address: %s", node.index, addr)
+
+	// Set cancel into context.
+	ctx, cancel := context.WithCancel(ctx)
+	node.cancel = cancel
+	node.server = server
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+		if err := srv.Close(); err != nil {
+			log.WithError(err).Error("Could not close execution server")
+		}
+	}()
+	// Mark node as ready.
+	close(node.started)
+	if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Started checks whether the execution server is started and ready to be queried.
+func (node *ExecutionServer) Started() <-chan struct{} {
+	return node.started
+}
+
+// Pause pauses the component and its underlying process.
+func (node *ExecutionServer) Pause() error {
+	// no-op
+	return nil
+}
+
+// Resume resumes the component and its underlying process.
+func (node *ExecutionServer) Resume() error {
+	// no-op
+	return nil
+}
+
+// Stop kills the component and its underlying process.
+func (node *ExecutionServer) Stop() error {
+	node.cancel()
+	return nil
+}
@@ -63,6 +63,10 @@ func e2eMinimal(t *testing.T, v int, cfgo ...types.E2EConfigOpt) *testRunner {
 		ev.AllNodesHaveSameHead,
 		ev.ValidatorSyncParticipation,
 		ev.FeeRecipientIsPresent,
+		ev.ActivityChangesMatchExecution,
+		ev.EffectiveActivityFollowsPeriod,
+		ev.ProposerFrequencyTracksPower,
+		ev.ExitedContractOwnersStopAccruing,
 		//ev.TransactionsPresent, TODO: Re-enable Transaction evaluator once it tx pool issues are fixed.
 	}
 	testConfig := &types.E2EConfig{
@@ -139,6 +143,10 @@ func e2eMainnet(t *testing.T, usePrysmSh, useMultiClient bool, cfg *params.Beaco
 		ev.FinishedSyncing,
 		ev.AllNodesHaveSameHead,
 		ev.FeeRecipientIsPresent,
+		ev.ActivityChangesMatchExecution,
+		ev.EffectiveActivityFollowsPeriod,
+		ev.ProposerFrequencyTracksPower,
+		ev.ExitedContractOwnersStopAccruing,
 		//ev.TransactionsPresent, TODO: Re-enable Transaction evaluator once it tx pool issues are fixed.
 	}
 	testConfig := &types.E2EConfig{
@@ -199,6 +207,31 @@ func scenarioEvals() []types.Evaluator {
 	}
 }
 
+// executionServerEvals are the evaluators of runs that serve the engine-api from execution servers. The
+// evaluators relying on deposits or on the geth RPC are left out, as the servers only extend the chain
+// from the genesis block of the eth1 miner.
+func executionServerEvals() []types.Evaluator {
+	return []types.Evaluator{
+		ev.PeersConnect,
+		ev.HealthzCheck,
+		ev.MetricsCheck,
+		ev.ValidatorsAreActive,
+		ev.ValidatorsParticipatingAtEpoch(2),
+		ev.FinalizationOccurs(3),
+		ev.VerifyBlockGraffiti,
+		ev.PeersCheck,
+		ev.ColdStateCheckpoint,
+		ev.APIMiddlewareVerifyIntegrity,
+		ev.APIGatewayV1Alpha1VerifyIntegrity,
+		ev.FinishedSyncing,
+		ev.AllNodesHaveSameHead,
+		ev.ValidatorSyncParticipation,
+		ev.ActivityChangesMatchExecutionServer,
+		ev.EffectiveActivityFollowsPeriod,
+		ev.ProposerFrequencyTracksPower,
+	}
+}
+
 func scenarioEvalsMulti() []types.Evaluator {
 	return []types.Evaluator{
 		ev.PeersConnect,
@@ -0,0 +1,421 @@
+package evaluators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	corehelpers "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/v4/testing/endtoend/params"
+	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/policies"
+	e2etypes "github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// proposerFrequencyEpochs is the number of epochs over which proposer frequency is compared with effective power.
+const proposerFrequencyEpochs = 4
+
+// ActivityChangesMatchExecution checks that the activity changes, transactions count and base fee of the
+// blocks in the previous epoch match the block activities reported by the execution client.
+var ActivityChangesMatchExecution = e2etypes.Evaluator{
+	Name:       "activity_changes_match_execution_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + 1),
+	Evaluation: activityChangesMatchExecution,
+}
+
+// ActivityChangesMatchExecutionServer checks the same as ActivityChangesMatchExecution against the
+// execution server of the first beacon node, for runs that serve the engine-api from execution servers.
+var ActivityChangesMatchExecutionServer = e2etypes.Evaluator{
+	Name:       "activity_changes_match_execution_server_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + 1),
+	Evaluation: activityChangesMatchExecutionServer,
+}
+
+// EffectiveActivityFollowsPeriod checks that the effective activities at the start of the previous epoch
+// follow the EpochsPerActivityPeriod moving average of the activities accumulated in the epoch before it.
+var EffectiveActivityFollowsPeriod = e2etypes.Evaluator{
+	Name:       "effective_activity_follows_period_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + 1),
+	Evaluation: effectiveActivityFollowsPeriod,
+}
+
+// ProposerFrequencyTracksPower checks that validators propose blocks in proportion to their effective power.
+var ProposerFrequencyTracksPower = e2etypes.Evaluator{
+	Name:       "proposer_frequency_tracks_power_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + proposerFrequencyEpochs),
+	Evaluation: proposerFrequencyTracksPower,
+}
+
+// ExitedContractOwnersStopAccruing checks that validators which exited no longer accrue activity
+// for their contracts.
+var ExitedContractOwnersStopAccruing = e2etypes.Evaluator{
+	Name:       "exited_contract_owners_stop_accruing_epoch_%d",
+	Policy:     policies.AfterNthEpoch(exitSubmissionEpoch),
+	Evaluation: exitedContractOwnersStopAccruing,
+}
+
+func activityChangesMatchExecution(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	return activityChangesMatchEndpoint(e2e.TestParams.Ports.Eth1RPCPort, conns...)
+}
+
+func activityChangesMatchExecutionServer(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	return activityChangesMatchEndpoint(e2e.TestParams.Ports.Eth1ProxyPort, conns...)
+}
+
+// activityChangesMatchEndpoint compares the activity changes of the blocks in the previous epoch with
+// the block activities served over JSON-RPC on the given local port.
+func activityChangesMatchEndpoint(port int, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewBeaconChainClient(conn)
+	ctx := context.Background()
+	chainHead, err := client.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: chainHead.HeadEpoch.Sub(1)}}
+	blks, err := client.ListBeaconBlocks(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to list blocks")
+	}
+
+	rpcclient, err := rpc.DialHTTP(fmt.Sprintf("http://127.0.0.1:%d", port))
+	if err != nil {
+		return err
+	}
+	defer rpcclient.Close()
+
+	for _, ctr := range blks.BlockContainers {
+		b, err := blocks.BeaconBlockContainerToSignedBeaconBlock(ctr)
+		if err != nil {
+			return err
+		}
+		// The activities of a block are taken from the execution payload of its parent block.
+		parentHash, err := parentPayloadHash(ctx, client, b)
+		if err != nil {
+			return err
+		}
+		if parentHash == (common.Hash{}) {
+			continue
+		}
+		reported := &ethpb.BlockActivities{}
+		if err := rpcclient.CallContext(ctx, reported, execution.GetBlockActivitiesMethod, parentHash); err != nil {
+			return errors.Wrapf(err, "could not get block activities of execution block %#x", parentHash)
+		}
+
+		body := b.Block().Body()
+		slot := b.Block().Slot()
+		if body.TransactionsCount() != reported.TxCount {
+			return fmt.Errorf("block at slot %d has transactions count %d, execution client reported %d", slot, body.TransactionsCount(), reported.TxCount)
+		}
+		if body.BaseFee() != reported.BaseFee {
+			return fmt.Errorf("block at slot %d has base fee %d, execution client reported %d", slot, body.BaseFee(), reported.BaseFee)
+		}
+		changes := body.ActivityChanges()
+		if len(changes) != len(reported.Activities) {
+			return fmt.Errorf("block at slot %d has %d activity changes, execution client reported %d", slot, len(changes), len(reported.Activities))
+		}
+		for i := range changes {
+			if !proto.Equal(changes[i], reported.Activities[i]) {
+				return fmt.Errorf("activity change %d of block at slot %d does not match the execution client: %v != %v", i, slot, changes[i], reported.Activities[i])
+			}
+		}
+	}
+	return nil
+}
+
+// parentPayloadHash returns the execution block hash of the parent of the given block, or an empty hash when
+// the parent does not carry an execution payload.
+func parentPayloadHash(ctx context.Context, client ethpb.BeaconChainClient, b interfaces.ReadOnlySignedBeaconBlock) (common.Hash, error) {
+	parentRoot := b.Block().ParentRoot()
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: parentRoot[:]}}
+	blks, err := client.ListBeaconBlocks(ctx, req)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to get parent block")
+	}
+	if len(blks.BlockContainers) != 1 {
+		return common.Hash{}, fmt.Errorf("expected a single parent block with root %#x, got %d", parentRoot, len(blks.BlockContainers))
+	}
+	parent, err := blocks.BeaconBlockContainerToSignedBeaconBlock(blks.BlockContainers[0])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if b.Version() < version.Capella || parent.Version() < version.Bellatrix {
+		return common.Hash{}, nil
+	}
+	payload, err := parent.Block().Body().Execution()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(payload.BlockHash()), nil
+}
+
+func effectiveActivityFollowsPeriod(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewBeaconChainClient(conn)
+	debugClient := ethpb.NewDebugClient(conn)
+	ctx := context.Background()
+	chainHead, err := client.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+	boundary, err := slots.EpochStart(chainHead.HeadEpoch.Sub(1))
+	if err != nil {
+		return err
+	}
+	pre, err := beaconStateAtSlot(ctx, debugClient, boundary-1)
+	if err != nil {
+		return err
+	}
+	post, err := beaconStateAtSlot(ctx, debugClient, boundary)
+	if err != nil {
+		return err
+	}
+
+	missed, err := missedProposals(ctx, pre)
+	if err != nil {
+		return err
+	}
+	period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
+	activities := pre.Activities()
+	for i, val := range pre.Validators() {
+		idx := primitives.ValidatorIndex(i)
+		postVal, err := post.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return err
+		}
+		// Validators which missed proposals lose the activity of the epoch and have their effective
+		// activity halved for every missed slot.
+		activity := activities[i]
+		shift := missed[idx]
+		if shift > 0 {
+			activity = 0
+		}
+		want, err := corehelpers.ActivityMovingAverage(slots.ToEpoch(pre.Slot()), "effective activity", val.EffectiveActivity, activity, period)
+		if err != nil {
+			return errors.Wrapf(err, "could not compute effective activity of validator %d", idx)
+		}
+		want >>= shift
+		if got := postVal.EffectiveActivity(); got != want {
+			return fmt.Errorf(
+				"validator %d has effective activity %d at slot %d, expected %d from effective activity %d, activity %d and %d missed proposals",
+				idx, got, boundary, want, val.EffectiveActivity, activities[i], shift,
+			)
+		}
+	}
+	return nil
+}
+
+// missedProposals returns the number of blocks each proposer of the current epoch of the given state did not
+// propose, derived from the block roots the same way the epoch processing does.
+func missedProposals(ctx context.Context, st state.BeaconState) (map[primitives.ValidatorIndex]uint64, error) {
+	missed := make(map[primitives.ValidatorIndex]uint64)
+	epochStart, err := slots.EpochStart(slots.ToEpoch(st.Slot()))
+	if err != nil {
+		return nil, err
+	}
+	if epochStart == 0 {
+		return missed, nil
+	}
+	proposers, err := corehelpers.GetProposerIndices(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer indices")
+	}
+	prevRoot, err := corehelpers.BlockRootAtSlot(st, epochStart-1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block root at slot %d", epochStart-1)
+	}
+	for i, slot := 0, epochStart; !slots.IsEpochEnd(slot); i, slot = i+1, slot+1 {
+		root, err := corehelpers.BlockRootAtSlot(st, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block root at slot %d", slot)
+		}
+		if bytes.Equal(prevRoot, root) && i < len(proposers) {
+			missed[proposers[i]]++
+		}
+		prevRoot = root
+	}
+	return missed, nil
+}
+
+func proposerFrequencyTracksPower(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewBeaconChainClient(conn)
+	debugClient := ethpb.NewDebugClient(conn)
+	ctx := context.Background()
+	chainHead, err := client.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+
+	expected := make(map[primitives.ValidatorIndex]float64)
+	observed := make(map[primitives.ValidatorIndex]uint64)
+	for e := chainHead.HeadEpoch.Sub(proposerFrequencyEpochs); e < chainHead.HeadEpoch; e++ {
+		start, err := slots.EpochStart(e)
+		if err != nil {
+			return err
+		}
+		st, err := beaconStateAtSlot(ctx, debugClient, start)
+		if err != nil {
+			return err
+		}
+		req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: e}}
+		blks, err := client.ListBeaconBlocks(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to list blocks")
+		}
+		for _, ctr := range blks.BlockContainers {
+			b, err := blocks.BeaconBlockContainerToSignedBeaconBlock(ctr)
+			if err != nil {
+				return err
+			}
+			observed[b.Block().ProposerIndex()]++
+		}
+
+		powers, total, err := effectivePowers(ctx, st, e)
+		if err != nil {
+			return err
+		}
+		if total == 0 {
+			continue
+		}
+		for idx, p := range powers {
+			expected[idx] += float64(len(blks.BlockContainers)) * float64(p) / float64(total)
+		}
+	}
+
+	// Proposals follow a binomial distribution with a small success probability, so the bound is a few
+	// standard deviations of the equivalent Poisson distribution plus a constant for validators with a
+	// very small expectation.
+	for idx, count := range observed {
+		exp := expected[idx]
+		if math.Abs(float64(count)-exp) > 4*math.Sqrt(exp)+2 {
+			return fmt.Errorf("validator %d proposed %d blocks in the last %d epochs, expected %.2f from its effective power", idx, count, proposerFrequencyEpochs, exp)
+		}
+	}
+	for idx, exp := range expected {
+		if _, ok := observed[idx]; !ok && exp > 4*math.Sqrt(exp)+2 {
+			return fmt.Errorf("validator %d proposed no blocks in the last %d epochs, expected %.2f from its effective power", idx, proposerFrequencyEpochs, exp)
+		}
+	}
+	return nil
+}
+
+// effectivePowers returns the effective power of every active validator along with the total effective power.
+func effectivePowers(ctx context.Context, st state.ReadOnlyBeaconState, epoch primitives.Epoch) (map[primitives.ValidatorIndex]uint64, uint64, error) {
+	indices, err := corehelpers.ActiveValidatorIndices(ctx, st, epoch)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(indices) == 0 {
+		return nil, 0, nil
+	}
+	sharedActivity := st.SharedActivity()
+	if sharedActivity == nil {
+		return nil, 0, errors.New("nil shared activity in state")
+	}
+	transactionsGas := sharedActivity.TransactionsGasPerPeriod / uint64(len(indices))
+	powers := make(map[primitives.ValidatorIndex]uint64, len(indices))
+	var total uint64
+	for _, idx := range indices {
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, 0, err
+		}
+		p, err := corehelpers.EffectivePower(val, transactionsGas, epoch)
+		if err != nil {
+			return nil, 0, err
+		}
+		powers[idx] = p
+		total += p
+	}
+	return powers, total, nil
+}
+
+func exitedContractOwnersStopAccruing(ec *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewBeaconChainClient(conn)
+	debugClient := ethpb.NewDebugClient(conn)
+	ctx := context.Background()
+	chainHead, err := client.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+	prevEpoch := chainHead.HeadEpoch.Sub(1)
+	boundary, err := slots.EpochStart(chainHead.HeadEpoch)
+	if err != nil {
+		return err
+	}
+	pre, err := beaconStateAtSlot(ctx, debugClient, boundary-1)
+	if err != nil {
+		return err
+	}
+	post, err := beaconStateAtSlot(ctx, debugClient, boundary)
+	if err != nil {
+		return err
+	}
+
+	for key := range ec.ExitedVals {
+		idx, ok := pre.ValidatorIndexByPubkey(key)
+		if !ok {
+			return errors.Errorf("pubkey %#x does not exist in our state", key)
+		}
+		val, err := pre.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return err
+		}
+		if val.ExitEpoch() > prevEpoch {
+			continue
+		}
+		activity, err := pre.ActivityAtIndex(idx)
+		if err != nil {
+			return err
+		}
+		if activity != 0 {
+			return fmt.Errorf("validator %d exited at epoch %d but accrued activity %d in epoch %d", idx, val.ExitEpoch(), activity, prevEpoch)
+		}
+		postVal, err := post.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return err
+		}
+		if postVal.EffectiveActivity() > val.EffectiveActivity() {
+			return fmt.Errorf(
+				"validator %d exited at epoch %d but its effective activity grew from %d to %d",
+				idx, val.ExitEpoch(), val.EffectiveActivity(), postVal.EffectiveActivity(),
+			)
+		}
+	}
+	return nil
+}
+
+func beaconStateAtSlot(ctx context.Context, debugClient ethpb.DebugClient, slot primitives.Slot) (state.BeaconState, error) {
+	stObj, err := debugClient.GetBeaconState(ctx, &ethpb.BeaconStateRequest{QueryFilter: &ethpb.BeaconStateRequest_Slot{Slot: slot}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state object at slot %d", slot)
+	}
+	versionedMarshaler, err := detect.FromState(stObj.Encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state marshaler")
+	}
+	st, err := versionedMarshaler.UnmarshalBeaconState(stObj.Encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state")
+	}
+	return st, nil
+}
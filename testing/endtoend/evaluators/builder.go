@@ -1,15 +1,30 @@
 package evaluators
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/helpers"
+	e2e "github.com/prysmaticlabs/prysm/v4/testing/endtoend/params"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/policies"
 	e2etypes "github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -21,6 +36,26 @@ var BuilderIsActive = e2etypes.Evaluator{
 	Evaluation: builderActive,
 }
 
+// BuilderPayloadActivitiesMatchExecution checks that the builder payloads of the previous epoch carry the
+// activities root and transactions count the execution client computes for them, so the blocks built on
+// top of them can satisfy their activity changes.
+var BuilderPayloadActivitiesMatchExecution = e2etypes.Evaluator{
+	Name:       "builder_payload_activities_match_execution_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + 1),
+	Evaluation: builderPayloadActivitiesMatchExecution,
+}
+
+// BuilderPayloadActivitiesVerified checks that the beacon nodes could verify the activities of every builder
+// payload they proposed with their execution client once the unblinded block was imported.
+var BuilderPayloadActivitiesVerified = e2etypes.Evaluator{
+	Name:       "builder_payload_activities_verified_epoch_%d",
+	Policy:     policies.AfterNthEpoch(helpers.CapellaE2EForkEpoch + 1),
+	Evaluation: builderPayloadActivitiesVerified,
+}
+
+// builderPayloadActivitiesFailure is logged by the proposer when a builder payload fails the activities check.
+const builderPayloadActivitiesFailure = "Could not verify builder payload activities"
+
 func builderActive(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
 	conn := conns[0]
 	client := ethpb.NewNodeClient(conn)
@@ -104,3 +139,105 @@ func builderActive(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) err
 	}
 	return nil
 }
+
+func builderPayloadActivitiesMatchExecution(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewBeaconChainClient(conn)
+	ctx := context.Background()
+	chainHead, err := client.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain head")
+	}
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: chainHead.HeadEpoch.Sub(1)}}
+	blks, err := client.ListBeaconBlocks(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to list blocks")
+	}
+
+	rpcclient, err := rpc.DialHTTP(fmt.Sprintf("http://127.0.0.1:%d", e2e.TestParams.Ports.Eth1RPCPort))
+	if err != nil {
+		return err
+	}
+	defer rpcclient.Close()
+
+	for _, ctr := range blks.BlockContainers {
+		b, err := blocks.BeaconBlockContainerToSignedBeaconBlock(ctr)
+		if err != nil {
+			return err
+		}
+		if b.Version() < version.Capella {
+			continue
+		}
+		payload, err := b.Block().Body().Execution()
+		if err != nil {
+			return err
+		}
+		if string(payload.ExtraData()) != "prysm-builder" {
+			continue
+		}
+		reported := &ethpb.BlockActivities{}
+		if err := rpcclient.CallContext(ctx, reported, execution.GetBlockActivitiesMethod, common.BytesToHash(payload.BlockHash())); err != nil {
+			return errors.Wrapf(err, "could not get block activities of builder payload %#x", payload.BlockHash())
+		}
+
+		slot := b.Block().Slot()
+		activitiesRoot, err := payload.ActivitiesRoot()
+		if err != nil {
+			return err
+		}
+		activities := make(gethtypes.Activities, len(reported.Activities))
+		for i, change := range reported.Activities {
+			activities[i] = &gethtypes.Activity{
+				Address:       common.BytesToAddress(change.ContractAddress),
+				DeltaActivity: change.DeltaActivity,
+			}
+		}
+		reportedRoot := gethtypes.DeriveSha(activities, trie.NewStackTrie(nil))
+		if !bytes.Equal(activitiesRoot, reportedRoot.Bytes()) {
+			return fmt.Errorf("builder payload of block at slot %d has activities root %#x, execution client reported %#x", slot, activitiesRoot, reportedRoot)
+		}
+		txCount, err := payload.TransactionsCount()
+		if err != nil {
+			return err
+		}
+		if txCount != reported.TxCount {
+			return fmt.Errorf("builder payload of block at slot %d has transactions count %d, execution client reported %d", slot, txCount, reported.TxCount)
+		}
+	}
+	return nil
+}
+
+func builderPayloadActivitiesVerified(_ *e2etypes.EvaluationContext, _ ...*grpc.ClientConn) error {
+	for i := 0; i < e2e.TestParams.BeaconNodeCount; i++ {
+		line, err := findInBeaconNodeLog(i, builderPayloadActivitiesFailure)
+		if err != nil {
+			return err
+		}
+		if line != "" {
+			return fmt.Errorf("beacon node %d could not verify a builder payload: %s", i, line)
+		}
+	}
+	return nil
+}
+
+// findInBeaconNodeLog returns the first line of the log of the given beacon node containing text, or an empty
+// string if there is none.
+func findInBeaconNodeLog(index int, text string) (string, error) {
+	f, err := os.Open(path.Join(e2e.TestParams.LogPath, fmt.Sprintf(e2e.BeaconNodeLogFileName, index)))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node log file")
+		}
+	}()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if strings.Contains(scanner.Text(), text) {
+			return scanner.Text(), nil
+		}
+	}
+	return "", scanner.Err()
+}
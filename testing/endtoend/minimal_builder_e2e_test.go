@@ -4,10 +4,11 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	ev "github.com/prysmaticlabs/prysm/v4/testing/endtoend/evaluators"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
 )
 
 func TestEndToEnd_MinimalConfig_WithBuilder(t *testing.T) {
-	r := e2eMinimal(t, version.Phase0, types.WithCheckpointSync(), types.WithBuilder())
+	r := e2eMinimal(t, version.Phase0, types.WithCheckpointSync(), types.WithBuilder(), types.WithEvaluators(ev.BuilderPayloadActivitiesMatchExecution, ev.BuilderPayloadActivitiesVerified))
 	r.run()
 }
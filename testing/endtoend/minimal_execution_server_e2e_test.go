@@ -0,0 +1,19 @@
+package endtoend
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
+)
+
+// The execution servers serve the engine-api from Capella on, so the run starts at Capella.
+func TestEndToEnd_MinimalConfig_WithExecutionServer(t *testing.T) {
+	runner := e2eMinimal(t, version.Capella, types.WithExecutionServer())
+
+	runner.config.Evaluators = executionServerEvals()
+	// The servers neither serve deposit logs nor share blocks with geth, which deposits and the sync node rely on.
+	runner.config.TestDeposits = false
+	runner.config.TestSync = false
+	runner.run()
+}
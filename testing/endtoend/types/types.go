@@ -50,6 +50,20 @@ func WithBuilder() E2EConfigOpt {
 	}
 }
 
+// WithExecutionServer serves the engine-api to the beacon nodes from in-process execution servers
+// instead of the geth nodes behind the engine-api proxies.
+func WithExecutionServer() E2EConfigOpt {
+	return func(cfg *E2EConfig) {
+		cfg.UseExecutionServer = true
+	}
+}
+
+func WithEvaluators(evals ...Evaluator) E2EConfigOpt {
+	return func(cfg *E2EConfig) {
+		cfg.Evaluators = append(cfg.Evaluators, evals...)
+	}
+}
+
 // E2EConfig defines the struct for all configurations needed for E2E testing.
 type E2EConfig struct {
 	TestCheckpointSync      bool
@@ -63,6 +77,7 @@ type E2EConfig struct {
 	UseValidatorCrossClient bool
 	UseBeaconRestApi        bool
 	UseBuilder              bool
+	UseExecutionServer      bool
 	EpochsToRun             uint64
 	Seed                    int64
 	TracingSinkEndpoint     string
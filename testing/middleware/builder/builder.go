@@ -648,6 +648,8 @@ func executableDataToBlock(params engine.ExecutableData) (*gethTypes.Block, erro
 		Extra:           []byte("prysm-builder"), // add in extra data
 		MixDigest:       params.Random,
 		WithdrawalsHash: withdrawalsRoot,
+		ActivitiesHash:  params.ActivitiesRoot,
+		TxCount:         params.TxCount,
 	}
 	block := gethTypes.NewBlockWithHeader(header).WithBody(txs, nil /* uncles */).WithWithdrawals(params.Withdrawals)
 	return block, nil
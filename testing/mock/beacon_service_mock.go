@@ -158,6 +158,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetValidatorActiveSetChanges(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorActiveSetChanges", reflect.TypeOf((*MockBeaconChainClient)(nil).GetValidatorActiveSetChanges), varargs...)
 }
 
+// GetValidatorActivities mocks base method.
+func (m *MockBeaconChainClient) GetValidatorActivities(arg0 context.Context, arg1 *eth.ValidatorActivitiesRequest, arg2 ...grpc.CallOption) (*eth.ValidatorActivitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidatorActivities", varargs...)
+	ret0, _ := ret[0].(*eth.ValidatorActivitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorActivities indicates an expected call of GetValidatorActivities.
+func (mr *MockBeaconChainClientMockRecorder) GetValidatorActivities(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorActivities", reflect.TypeOf((*MockBeaconChainClient)(nil).GetValidatorActivities), varargs...)
+}
+
 // GetValidatorParticipation mocks base method.
 func (m *MockBeaconChainClient) GetValidatorParticipation(arg0 context.Context, arg1 *eth.GetValidatorParticipationRequest, arg2 ...grpc.CallOption) (*eth.ValidatorParticipationResponse, error) {
 	m.ctrl.T.Helper()
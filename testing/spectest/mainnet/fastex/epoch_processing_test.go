@@ -0,0 +1,15 @@
+package fastex
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/fastex/epoch_processing"
+)
+
+func TestMainnet_Fastex_EpochProcessing_EffectiveActivityUpdates(t *testing.T) {
+	epoch_processing.RunEffectiveActivityUpdatesTests(t, "mainnet")
+}
+
+func TestMainnet_Fastex_EpochProcessing_SharedActivityUpdates(t *testing.T) {
+	epoch_processing.RunSharedActivityUpdatesTests(t, "mainnet")
+}
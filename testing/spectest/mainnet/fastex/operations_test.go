@@ -0,0 +1,19 @@
+package fastex
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/fastex/operations"
+)
+
+func TestMainnet_Fastex_Operations_ActivityChanges(t *testing.T) {
+	operations.RunActivityChangesTest(t, "mainnet")
+}
+
+func TestMainnet_Fastex_Operations_TransactionsCount(t *testing.T) {
+	operations.RunTransactionsCountTest(t, "mainnet")
+}
+
+func TestMainnet_Fastex_Operations_BaseFee(t *testing.T) {
+	operations.RunBaseFeeTest(t, "mainnet")
+}
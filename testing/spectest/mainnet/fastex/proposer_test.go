@@ -0,0 +1,11 @@
+package fastex
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/fastex/proposer"
+)
+
+func TestMainnet_Fastex_Proposer_ComputeProposerIndex(t *testing.T) {
+	proposer.RunComputeProposerIndexTests(t, "mainnet")
+}
@@ -0,0 +1,42 @@
+package epoch_processing
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	capellaepoch "github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/capella/epoch_processing"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+)
+
+// RunEffectiveActivityUpdatesTests executes "epoch_processing/effective_activity_updates" tests.
+func RunEffectiveActivityUpdatesTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "fastex", "epoch_processing/effective_activity_updates/fastex_tests")
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, "fastex", "epoch_processing/effective_activity_updates/fastex_tests")
+	}
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			capellaepoch.RunEpochOperationTest(t, folderPath, processEffectiveActivityUpdatesWrapper)
+		})
+	}
+}
+
+func processEffectiveActivityUpdatesWrapper(t *testing.T, st state.BeaconState) (state.BeaconState, error) {
+	// Missed slots are attributed through the proposer selection, which must not be served from the
+	// caches filled by a previous test case. The proposer indices of the epoch are cached by the block
+	// processing before the epoch is processed, so they are cached the same way here.
+	helpers.ClearCache()
+	require.NoError(t, helpers.UpdateProposerIndicesInCache(context.Background(), st, time.CurrentEpoch(st)))
+	st, err := epoch.ProcessEffectiveActivityUpdates(context.Background(), st)
+	require.NoError(t, err, "Could not process effective activity updates")
+	return st, nil
+}
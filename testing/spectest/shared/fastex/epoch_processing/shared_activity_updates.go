@@ -0,0 +1,34 @@
+package epoch_processing
+
+import (
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	capellaepoch "github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/capella/epoch_processing"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+)
+
+// RunSharedActivityUpdatesTests executes "epoch_processing/shared_activity_updates" tests.
+func RunSharedActivityUpdatesTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "fastex", "epoch_processing/shared_activity_updates/fastex_tests")
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, "fastex", "epoch_processing/shared_activity_updates/fastex_tests")
+	}
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			capellaepoch.RunEpochOperationTest(t, folderPath, processSharedActivityUpdatesWrapper)
+		})
+	}
+}
+
+func processSharedActivityUpdatesWrapper(t *testing.T, st state.BeaconState) (state.BeaconState, error) {
+	st, err := epoch.ProcessSharedActivityUpdates(st)
+	require.NoError(t, err, "Could not process shared activity updates")
+	return st, nil
+}
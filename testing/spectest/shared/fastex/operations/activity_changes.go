@@ -0,0 +1,17 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+)
+
+// RunActivityChangesTest executes "operations/activity_changes" tests.
+func RunActivityChangesTest(t *testing.T, config string) {
+	runBodyOperationTests(t, config, "activity_changes", func(ctx context.Context, s state.BeaconState, b interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error) {
+		return blocks.ProcessActivityChanges(ctx, s, b.Block().Body().ActivityChanges())
+	})
+}
@@ -0,0 +1,17 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+)
+
+// RunBaseFeeTest executes "operations/base_fee" tests.
+func RunBaseFeeTest(t *testing.T, config string) {
+	runBodyOperationTests(t, config, "base_fee", func(ctx context.Context, s state.BeaconState, b interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error) {
+		return blocks.ProcessBaseFee(ctx, s, b.Block().Body().BaseFee())
+	})
+}
@@ -0,0 +1,38 @@
+package operations
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	capellaoperations "github.com/prysmaticlabs/prysm/v4/testing/spectest/shared/capella/operations"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// runBodyOperationTests executes the Fastex "operations/<handler>" tests, whose input is a full Capella
+// block body, against the given block operation.
+func runBodyOperationTests(t *testing.T, config, handler string, operationFn func(context.Context, state.BeaconState, interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error)) {
+	require.NoError(t, utils.SetConfig(t, config))
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "fastex", path.Join("operations", handler, "fastex_tests"))
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, "fastex", path.Join("operations", handler, "fastex_tests"))
+	}
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			bodyFile, err := util.BazelFileBytes(folderPath, "body.ssz_snappy")
+			require.NoError(t, err)
+			bodySSZ, err := snappy.Decode(nil /* dst */, bodyFile)
+			require.NoError(t, err, "Failed to decompress")
+			body := &ethpb.BeaconBlockBodyCapella{}
+			require.NoError(t, body.UnmarshalSSZ(bodySSZ), "Failed to unmarshal")
+			capellaoperations.RunBlockOperationTest(t, folderPath, body, operationFn)
+		})
+	}
+}
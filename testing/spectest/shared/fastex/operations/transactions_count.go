@@ -0,0 +1,17 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+)
+
+// RunTransactionsCountTest executes "operations/transactions_count" tests.
+func RunTransactionsCountTest(t *testing.T, config string) {
+	runBodyOperationTests(t, config, "transactions_count", func(ctx context.Context, s state.BeaconState, b interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, error) {
+		return blocks.ProcessTransactionsCount(ctx, s, b.Block().Body().TransactionsCount())
+	})
+}
@@ -0,0 +1,57 @@
+// Package proposer contains the conformity tests for the power weighted proposer selection of the Fastex chain.
+package proposer
+
+import (
+	"context"
+	"encoding/hex"
+	"path"
+	"testing"
+
+	"github.com/go-yaml/yaml"
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// RunComputeProposerIndexTests executes "proposer/compute_proposer_index" tests.
+func RunComputeProposerIndexTests(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "fastex", "proposer/compute_proposer_index/fastex_tests")
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, "fastex", "proposer/compute_proposer_index/fastex_tests")
+	}
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			folderPath := path.Join(testsFolderPath, folder.Name())
+			testCaseFile, err := util.BazelFileBytes(folderPath, "proposer.yaml")
+			require.NoError(t, err, "Could not read YAML tests directory")
+			testCase := &ProposerTestCase{}
+			require.NoError(t, yaml.Unmarshal(testCaseFile, testCase), "Could not unmarshal YAML file into test struct")
+
+			preBeaconStateFile, err := util.BazelFileBytes(folderPath, "pre.ssz_snappy")
+			require.NoError(t, err)
+			preBeaconStateSSZ, err := snappy.Decode(nil /* dst */, preBeaconStateFile)
+			require.NoError(t, err, "Failed to decompress")
+			preStateBase := &ethpb.BeaconStateCapella{}
+			require.NoError(t, preStateBase.UnmarshalSSZ(preBeaconStateSSZ), "Failed to unmarshal")
+			st, err := state_native.InitializeFromProtoCapella(preStateBase)
+			require.NoError(t, err)
+
+			seed, err := hex.DecodeString(testCase.Seed[2:])
+			require.NoError(t, err)
+			helpers.ClearCache()
+			indices, err := helpers.ActiveValidatorIndices(context.Background(), st, time.CurrentEpoch(st))
+			require.NoError(t, err)
+			idx, err := helpers.ComputeProposerIndex(st, indices, bytesutil.ToBytes32(seed))
+			require.NoError(t, err)
+			require.Equal(t, testCase.ProposerIndex, idx)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package proposer
+
+import "github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+
+// ProposerTestCase --
+type ProposerTestCase struct {
+	Seed          string                    `yaml:"seed"`
+	ProposerIndex primitives.ValidatorIndex `yaml:"proposer_index"`
+}
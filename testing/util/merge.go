@@ -43,6 +43,16 @@ func NewBlindedBeaconBlockDeneb() *ethpb.SignedBlindedBeaconBlockDeneb {
 	return HydrateSignedBlindedBeaconBlockDeneb(&ethpb.SignedBlindedBeaconBlockDeneb{})
 }
 
+// NewBeaconBlockElectra creates a beacon block with minimum marshalable fields.
+func NewBeaconBlockElectra() *ethpb.SignedBeaconBlockElectra {
+	return HydrateSignedBeaconBlockElectra(&ethpb.SignedBeaconBlockElectra{})
+}
+
+// NewBlindedBeaconBlockElectra creates a blinded beacon block with minimum marshalable fields.
+func NewBlindedBeaconBlockElectra() *ethpb.SignedBlindedBeaconBlockElectra {
+	return HydrateSignedBlindedBeaconBlockElectra(&ethpb.SignedBlindedBeaconBlockElectra{})
+}
+
 // NewBlindedBlobSidecar creates a signed blinded blob sidecar with minimum marshalable fields.
 func NewBlindedBlobSidecar() *ethpb.SignedBlindedBlobSidecar {
 	return HydrateSignedBlindedBlobSidecar(&ethpb.SignedBlindedBlobSidecar{})
@@ -106,3 +116,57 @@ func NewBlindedBeaconBlockContentsDeneb(numOfBlobs uint64) (*v2.SignedBlindedBea
 		SignedBlindedBlobSidecars: blobs,
 	}, nil
 }
+
+// NewBeaconBlockContentsElectra creates a beacon block content including blobs with minimum marshalable fields.
+func NewBeaconBlockContentsElectra(numOfBlobs uint64) (*v2.SignedBeaconBlockContentsElectra, error) {
+	if numOfBlobs > fieldparams.MaxBlobsPerBlock {
+		return nil, fmt.Errorf("declared too many blobs: %v", numOfBlobs)
+	}
+	blobs := make([]*v2.SignedBlobSidecar, numOfBlobs)
+	for i := range blobs {
+		blobs[i] = &v2.SignedBlobSidecar{
+			Message: &v2.BlobSidecar{
+				BlockRoot:       make([]byte, fieldparams.RootLength),
+				Index:           0,
+				Slot:            0,
+				BlockParentRoot: make([]byte, fieldparams.RootLength),
+				ProposerIndex:   0,
+				Blob:            make([]byte, fieldparams.BlobLength),
+				KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
+				KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	return &v2.SignedBeaconBlockContentsElectra{
+		SignedBlock:        HydrateV2SignedBeaconBlockElectra(&v2.SignedBeaconBlockElectra{}),
+		SignedBlobSidecars: blobs,
+	}, nil
+}
+
+// NewBlindedBeaconBlockContentsElectra creates a blinded beacon block content including blobs with minimum marshalable fields.
+func NewBlindedBeaconBlockContentsElectra(numOfBlobs uint64) (*v2.SignedBlindedBeaconBlockContentsElectra, error) {
+	if numOfBlobs > fieldparams.MaxBlobsPerBlock {
+		return nil, fmt.Errorf("declared too many blobs: %v", numOfBlobs)
+	}
+	blobs := make([]*v2.SignedBlindedBlobSidecar, numOfBlobs)
+	for i := range blobs {
+		blobs[i] = &v2.SignedBlindedBlobSidecar{
+			Message: &v2.BlindedBlobSidecar{
+				BlockRoot:       make([]byte, fieldparams.RootLength),
+				Index:           0,
+				Slot:            0,
+				BlockParentRoot: make([]byte, fieldparams.RootLength),
+				ProposerIndex:   0,
+				BlobRoot:        make([]byte, fieldparams.RootLength),
+				KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
+				KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	return &v2.SignedBlindedBeaconBlockContentsElectra{
+		SignedBlindedBlock:        HydrateV2SignedBlindedBeaconBlockElectra(&v2.SignedBlindedBeaconBlockElectra{}),
+		SignedBlindedBlobSidecars: blobs,
+	}, nil
+}
@@ -51,6 +51,21 @@ func (mr *MockBeaconChainClientMockRecorder) GetChainHead(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainHead", reflect.TypeOf((*MockBeaconChainClient)(nil).GetChainHead), arg0, arg1)
 }
 
+// GetValidatorActivities mocks base method.
+func (m *MockBeaconChainClient) GetValidatorActivities(arg0 context.Context, arg1 *eth.ValidatorActivitiesRequest) (*eth.ValidatorActivitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorActivities", arg0, arg1)
+	ret0, _ := ret[0].(*eth.ValidatorActivitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorActivities indicates an expected call of GetValidatorActivities.
+func (mr *MockBeaconChainClientMockRecorder) GetValidatorActivities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorActivities", reflect.TypeOf((*MockBeaconChainClient)(nil).GetValidatorActivities), arg0, arg1)
+}
+
 // GetValidatorParticipation mocks base method.
 func (m *MockBeaconChainClient) GetValidatorParticipation(arg0 context.Context, arg1 *eth.GetValidatorParticipationRequest) (*eth.ValidatorParticipationResponse, error) {
 	m.ctrl.T.Helper()
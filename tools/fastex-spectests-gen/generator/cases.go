@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+func bodyWith(fn func(b *ethpb.BeaconBlockBodyCapella)) *ethpb.BeaconBlockBodyCapella {
+	b := emptyBody()
+	fn(b)
+	return b
+}
+
+func change(contract []byte, delta uint64) *ethpb.ActivityChange {
+	return &ethpb.ActivityChange{ContractAddress: contract, DeltaActivity: delta}
+}
+
+func activityChangesCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, pre *ethpb.BeaconStateCapella, changes ...*ethpb.ActivityChange) {
+		body := bodyWith(func(b *ethpb.BeaconBlockBodyCapella) { b.ActivityChanges = changes })
+		cases = append(cases, &testCase{name: name, pre: pre, body: body, apply: func(st *ethpb.BeaconStateCapella) error {
+			return processActivityChanges(st, body)
+		}})
+	}
+
+	add("no_changes", baseState())
+	add("single_change", baseState(), change(contractAddress(0), 21_000))
+	add("changes_of_several_owners", baseState(),
+		change(contractAddress(1), 21_000), change(contractAddress(2), 42_000), change(contractAddress(63), 1))
+	add("repeated_contract_accumulates", baseState(),
+		change(contractAddress(3), 100), change(contractAddress(3), 250))
+
+	withActivity := baseState()
+	withActivity.Activities[4] = 1_000_000
+	add("adds_to_epoch_activity", withActivity, change(contractAddress(4), 500))
+
+	add("unknown_contract_is_ignored", baseState(), change(bytesutil.PadTo([]byte{0xde, 0xad}, 20), 5_000))
+
+	exited := baseState()
+	exited.Validators[5].ExitEpoch = stateEpoch
+	exited.Validators[5].WithdrawableEpoch = stateEpoch + 1
+	add("exited_owner_is_ignored", exited, change(contractAddress(5), 5_000))
+
+	pending := baseState()
+	pending.Validators[6].ActivationEpoch = stateEpoch + 1
+	add("pending_owner_is_ignored", pending, change(contractAddress(6), 5_000))
+
+	add("zero_delta", baseState(), change(contractAddress(7), 0))
+	return cases
+}
+
+func transactionsCountCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, pre *ethpb.BeaconStateCapella, count uint64) {
+		body := bodyWith(func(b *ethpb.BeaconBlockBodyCapella) { b.TransactionsCount = count })
+		cases = append(cases, &testCase{name: name, pre: pre, body: body, apply: func(st *ethpb.BeaconStateCapella) error {
+			return processTransactionsCount(st, body)
+		}})
+	}
+
+	add("no_transactions", baseState(), 0)
+	add("single_transaction", baseState(), 1)
+	add("many_transactions", baseState(), 1<<20)
+	empty := baseState()
+	empty.SharedActivity = &ethpb.SharedActivity{}
+	add("first_of_epoch", empty, 15)
+	return cases
+}
+
+func baseFeeCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, pre *ethpb.BeaconStateCapella, fee uint64) {
+		body := bodyWith(func(b *ethpb.BeaconBlockBodyCapella) { b.BaseFee = fee })
+		cases = append(cases, &testCase{name: name, pre: pre, body: body, apply: func(st *ethpb.BeaconStateCapella) error {
+			return processBaseFee(st, body)
+		}})
+	}
+
+	add("zero_base_fee", baseState(), 0)
+	add("base_fee", baseState(), 7*params.BeaconConfig().WeiPerGwei)
+	empty := baseState()
+	empty.SharedActivity = &ethpb.SharedActivity{}
+	add("first_of_epoch", empty, 1_000_000_007)
+	return cases
+}
+
+func effectiveActivityUpdatesCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, pre *ethpb.BeaconStateCapella) {
+		cases = append(cases, &testCase{name: name, pre: pre, apply: processEffectiveActivityUpdates})
+	}
+
+	add("no_activity", baseState())
+
+	active := baseState()
+	for i := range active.Activities {
+		active.Activities[i] = uint64(i) * 21_000
+	}
+	add("epoch_activity", active)
+
+	fresh := baseState()
+	for i := range fresh.Validators {
+		fresh.Validators[i].EffectiveActivity = 0
+	}
+	fresh.Activities[0] = 1_000_000
+	add("first_activity", fresh)
+
+	add("missed_slot", withMissedSlots(active, 1))
+	add("missed_slots", withMissedSlots(active, 3))
+	return cases
+}
+
+// withMissedSlots returns a copy of the state where the given number of slots at the end of the current
+// epoch repeat the block root of the slot before them, as if their proposers did not propose.
+func withMissedSlots(st *ethpb.BeaconStateCapella, missed uint64) *ethpb.BeaconStateCapella {
+	pre := baseState()
+	copy(pre.Activities, st.Activities)
+	n := uint64(params.BeaconConfig().SlotsPerHistoricalRoot)
+	last := uint64(pre.Slot)
+	for s := last - missed; s < last; s++ {
+		pre.BlockRoots[s%n] = pre.BlockRoots[(s-1)%n]
+	}
+	return pre
+}
+
+func sharedActivityUpdatesCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, shared *ethpb.SharedActivity) {
+		pre := baseState()
+		pre.SharedActivity = shared
+		cases = append(cases, &testCase{name: name, pre: pre, apply: processSharedActivityUpdates})
+	}
+
+	gwei := params.BeaconConfig().WeiPerGwei
+	add("empty", &ethpb.SharedActivity{})
+	add("first_epoch", &ethpb.SharedActivity{TransactionsGasPerEpoch: 21_000 * 100, BaseFeePerEpoch: 3 * gwei})
+	add("moving_average", &ethpb.SharedActivity{
+		TransactionsGasPerPeriod: 21_000 * 5_000,
+		TransactionsGasPerEpoch:  21_000 * 100,
+		BaseFeePerPeriod:         12,
+		BaseFeePerEpoch:          9 * gwei,
+	})
+	add("base_fee_below_one_gwei", &ethpb.SharedActivity{BaseFeePerPeriod: 5, BaseFeePerEpoch: gwei - 1})
+	return cases
+}
+
+func computeProposerIndexCases() []*testCase {
+	var cases []*testCase
+	add := func(name string, pre *ethpb.BeaconStateCapella) {
+		seed := bytesutil.ToBytes32(root("proposer-seed", uint64(len(cases))))
+		cases = append(cases, &testCase{name: name, pre: pre, result: func(st *ethpb.BeaconStateCapella) (string, interface{}, error) {
+			idx, err := computeProposerIndex(st, activeIndices(st, currentEpoch(st)), seed)
+			if err != nil {
+				return "", nil, err
+			}
+			return ProposerFileName, &ProposerVector{Seed: fmt.Sprintf("%#x", seed), ProposerIndex: uint64(idx)}, nil
+		}})
+	}
+
+	add("distinct_activity", baseState())
+
+	uniform := baseState()
+	for _, v := range uniform.Validators {
+		v.EffectiveActivity = 1_000_000
+	}
+	add("uniform_activity", uniform)
+
+	noActivity := baseState()
+	for _, v := range noActivity.Validators {
+		v.EffectiveActivity = 0
+	}
+	noActivity.SharedActivity.TransactionsGasPerPeriod = 0
+	add("no_activity", noActivity)
+
+	dominant := baseState()
+	dominant.Validators[17].EffectiveActivity = 1 << 50
+	add("dominant_validator", dominant)
+
+	lowBalance := baseState()
+	for i := 0; i < numValidators; i += 2 {
+		lowBalance.Validators[i].EffectiveBalance = params.BeaconConfig().EjectionBalance
+	}
+	add("half_balance", lowBalance)
+
+	exited := baseState()
+	for i := 0; i < numValidators/2; i++ {
+		exited.Validators[i].ExitEpoch = stateEpoch
+	}
+	add("half_exited", exited)
+
+	for i := 0; i < 4; i++ {
+		add(fmt.Sprintf("random_%d", i), baseState())
+	}
+	return cases
+}
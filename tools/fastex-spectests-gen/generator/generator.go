@@ -0,0 +1,152 @@
+// Package generator produces conformance vectors for the consensus rules specific to the Fastex chain,
+// which are not covered by the upstream Ethereum consensus spec tests. The vectors are laid out like
+// the upstream ones:
+//
+//	tests/<preset>/fastex/<runner>/<handler>/fastex_tests/<case>/
+//
+// Every case holds a snappy compressed SSZ pre-state, the input of the rule and the snappy compressed
+// SSZ post-state or a YAML file with the expected result. The expected results are computed by a
+// reference implementation of the rules, independent of the beacon chain packages.
+//
+// The mainnet vectors are committed under testing/spectest/mainnet/fastex and are regenerated with
+//
+//	go run ./tools/fastex-spectests-gen --output-dir testing/spectest/mainnet/fastex
+package generator
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// Fork is the name of the directory holding the Fastex vectors of a preset, in place of an upstream fork name.
+const Fork = "fastex"
+
+// Test case file names.
+const (
+	PreStateFileName  = "pre.ssz_snappy"
+	PostStateFileName = "post.ssz_snappy"
+	BodyFileName      = "body.ssz_snappy"
+	ProposerFileName  = "proposer.yaml"
+)
+
+// ProposerVector is the YAML content of the compute_proposer_index vectors. The proposer index is computed
+// from the active validators of the current epoch of the pre-state and the given seed.
+type ProposerVector struct {
+	Seed          string `json:"seed"`
+	ProposerIndex uint64 `json:"proposer_index"`
+}
+
+// Generate writes all vectors for the given preset, "minimal" or "mainnet", under outputDir.
+// The preset configuration is active while the vectors are generated.
+func Generate(outputDir, preset string) error {
+	var cfg *params.BeaconChainConfig
+	switch preset {
+	case "minimal":
+		cfg = params.MinimalSpecConfig()
+	case "mainnet":
+		cfg = params.MainnetConfig()
+	default:
+		return fmt.Errorf("unknown preset %q", preset)
+	}
+	undo, err := params.SetActiveWithUndo(cfg.Copy())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := undo(); err != nil {
+			log.WithError(err).Error("Could not restore the active config")
+		}
+	}()
+
+	root := path.Join(outputDir, "tests", preset, Fork)
+	for _, h := range handlers() {
+		for _, c := range h.cases() {
+			dir := path.Join(root, h.runner, h.name, "fastex_tests", c.name)
+			if err := c.write(dir); err != nil {
+				return errors.Wrapf(err, "could not write %s/%s/%s", h.runner, h.name, c.name)
+			}
+		}
+		log.WithField("handler", path.Join(h.runner, h.name)).Debug("Generated vectors")
+	}
+	return nil
+}
+
+type handler struct {
+	runner string
+	name   string
+	cases  func() []*testCase
+}
+
+func handlers() []*handler {
+	return []*handler{
+		{runner: "operations", name: "activity_changes", cases: activityChangesCases},
+		{runner: "operations", name: "transactions_count", cases: transactionsCountCases},
+		{runner: "operations", name: "base_fee", cases: baseFeeCases},
+		{runner: "epoch_processing", name: "effective_activity_updates", cases: effectiveActivityUpdatesCases},
+		{runner: "epoch_processing", name: "shared_activity_updates", cases: sharedActivityUpdatesCases},
+		{runner: "proposer", name: "compute_proposer_index", cases: computeProposerIndexCases},
+	}
+}
+
+// testCase is a single vector. Apply computes the expected post-state from a copy of the pre-state, every
+// case is expected to produce one.
+type testCase struct {
+	name  string
+	pre   *ethpb.BeaconStateCapella
+	body  *ethpb.BeaconBlockBodyCapella
+	apply func(st *ethpb.BeaconStateCapella) error
+	// result returns the YAML file name and content written instead of a post-state.
+	result func(st *ethpb.BeaconStateCapella) (string, interface{}, error)
+}
+
+func (c *testCase) write(dir string) error {
+	if err := file.MkdirAll(dir); err != nil {
+		return err
+	}
+	if err := writeSSZ(path.Join(dir, PreStateFileName), c.pre); err != nil {
+		return err
+	}
+	if c.body != nil {
+		if err := writeSSZ(path.Join(dir, BodyFileName), c.body); err != nil {
+			return err
+		}
+	}
+
+	st := proto.Clone(c.pre).(*ethpb.BeaconStateCapella)
+	if c.result != nil {
+		name, v, err := c.result(st)
+		if err != nil {
+			return err
+		}
+		enc, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return file.WriteFile(path.Join(dir, name), enc)
+	}
+	if err := c.apply(st); err != nil {
+		return errors.Wrap(err, "case produced no post-state")
+	}
+	return writeSSZ(path.Join(dir, PostStateFileName), st)
+}
+
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+func writeSSZ(fPath string, obj sszMarshaler) error {
+	enc, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return file.WriteFile(fPath, snappy.Encode(nil, enc))
+}
@@ -0,0 +1,315 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// The functions of this file compute the expected outcome of the vectors. They follow the pseudocode of
+// the Fastex rules on the protobuf state and share no code with the beacon chain implementation, so the
+// vectors catch a regression of the implementation instead of reproducing it. Any arithmetic overflow is
+// an error, as no vector is meant to overflow.
+
+var errOverflow = errors.New("arithmetic overflow")
+
+func currentEpoch(st *ethpb.BeaconStateCapella) primitives.Epoch {
+	return primitives.Epoch(uint64(st.Slot) / uint64(params.BeaconConfig().SlotsPerEpoch))
+}
+
+func isActive(v *ethpb.Validator, epoch primitives.Epoch) bool {
+	return v.ActivationEpoch <= epoch && epoch < v.ExitEpoch
+}
+
+func activeIndices(st *ethpb.BeaconStateCapella, epoch primitives.Epoch) []primitives.ValidatorIndex {
+	var indices []primitives.ValidatorIndex
+	for i, v := range st.Validators {
+		if isActive(v, epoch) {
+			indices = append(indices, primitives.ValidatorIndex(i))
+		}
+	}
+	return indices
+}
+
+func add(a, b uint64) (uint64, error) {
+	sum := a + b
+	if sum < a {
+		return 0, errOverflow
+	}
+	return sum, nil
+}
+
+func toUint64(x *big.Int) (uint64, error) {
+	if !x.IsUint64() {
+		return 0, errOverflow
+	}
+	return x.Uint64(), nil
+}
+
+// movingAverage returns ((average + delta) * period - average) / period.
+func movingAverage(average, delta, period uint64) (uint64, error) {
+	x := new(big.Int).SetUint64(average)
+	x.Add(x, new(big.Int).SetUint64(delta))
+	x.Mul(x, new(big.Int).SetUint64(period))
+	x.Sub(x, new(big.Int).SetUint64(average))
+	x.Quo(x, new(big.Int).SetUint64(period))
+	return toUint64(x)
+}
+
+// def process_activity_change(state: BeaconState, activity_change: ActivityChange) -> None:
+//
+//	owners = [i for i, v in enumerate(state.validators) if v.contract == activity_change.contract_address]
+//	if len(owners) == 0 or not is_active_validator(state.validators[owners[0]], get_current_epoch(state)):
+//	    return
+//	state.activities[owners[0]] += activity_change.delta_activity
+func processActivityChanges(st *ethpb.BeaconStateCapella, body *ethpb.BeaconBlockBodyCapella) error {
+	epoch := currentEpoch(st)
+	for _, c := range body.ActivityChanges {
+		if c == nil || c.ContractAddress == nil {
+			return errors.New("nil activity change")
+		}
+		for i, v := range st.Validators {
+			if !bytes.Equal(v.Contract, c.ContractAddress) {
+				continue
+			}
+			if isActive(v, epoch) {
+				activity, err := add(st.Activities[i], c.DeltaActivity)
+				if err != nil {
+					return err
+				}
+				st.Activities[i] = activity
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// def process_transactions_count(state: BeaconState, transactions_count: uint64) -> None:
+//
+//	state.shared_activity.transactions_gas_per_epoch += transactions_count * BASE_TRANSACTION_COST
+func processTransactionsCount(st *ethpb.BeaconStateCapella, body *ethpb.BeaconBlockBodyCapella) error {
+	gas := new(big.Int).SetUint64(body.TransactionsCount)
+	gas.Mul(gas, new(big.Int).SetUint64(params.BeaconConfig().BaseTransactionCost))
+	gas.Add(gas, new(big.Int).SetUint64(st.SharedActivity.TransactionsGasPerEpoch))
+	perEpoch, err := toUint64(gas)
+	if err != nil {
+		return err
+	}
+	st.SharedActivity.TransactionsGasPerEpoch = perEpoch
+	return nil
+}
+
+// def process_base_fee(state: BeaconState, base_fee: uint64) -> None:
+//
+//	state.shared_activity.base_fee_per_epoch += base_fee
+func processBaseFee(st *ethpb.BeaconStateCapella, body *ethpb.BeaconBlockBodyCapella) error {
+	perEpoch, err := add(st.SharedActivity.BaseFeePerEpoch, body.BaseFee)
+	if err != nil {
+		return err
+	}
+	st.SharedActivity.BaseFeePerEpoch = perEpoch
+	return nil
+}
+
+// def process_effective_activity_updates(state: BeaconState) -> None:
+//
+//	for index, validator in enumerate(state.validators):
+//	    missed = missed_proposals(state, index)
+//	    activity = 0 if missed > 0 else state.activities[index]
+//	    effective_activity = ((validator.effective_activity + activity) * PERIOD - validator.effective_activity) // PERIOD
+//	    validator.effective_activity = effective_activity >> missed
+func processEffectiveActivityUpdates(st *ethpb.BeaconStateCapella) error {
+	missed, err := missedProposals(st)
+	if err != nil {
+		return err
+	}
+	period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
+	for i, v := range st.Validators {
+		activity := st.Activities[i]
+		shift := missed[primitives.ValidatorIndex(i)]
+		if shift > 0 {
+			activity = 0
+		}
+		effectiveActivity, err := movingAverage(v.EffectiveActivity, activity, period)
+		if err != nil {
+			return err
+		}
+		v.EffectiveActivity = effectiveActivity >> shift
+	}
+	return nil
+}
+
+// missedProposals returns the number of slots of the current epoch every proposer did not propose at. A slot
+// is missed when its block root repeats the root of the slot before it. The last slot of the epoch is not
+// accounted, as its block root is not part of the history before the epoch transition.
+func missedProposals(st *ethpb.BeaconStateCapella) (map[primitives.ValidatorIndex]uint64, error) {
+	cfg := params.BeaconConfig()
+	missed := make(map[primitives.ValidatorIndex]uint64)
+	epochStart := uint64(currentEpoch(st)) * uint64(cfg.SlotsPerEpoch)
+	if epochStart == 0 {
+		return missed, nil
+	}
+	n := uint64(cfg.SlotsPerHistoricalRoot)
+	for slot := epochStart; slot < epochStart+uint64(cfg.SlotsPerEpoch)-1; slot++ {
+		if !bytes.Equal(st.BlockRoots[slot%n], st.BlockRoots[(slot-1)%n]) {
+			continue
+		}
+		proposer, err := beaconProposerIndex(st, primitives.Slot(slot))
+		if err != nil {
+			return nil, err
+		}
+		missed[proposer]++
+	}
+	return missed, nil
+}
+
+// def process_shared_activity_updates(state: BeaconState) -> None:
+//
+//	shared = state.shared_activity
+//	base_fee_per_epoch = max(1, shared.base_fee_per_epoch // WEI_PER_GWEI)
+//	shared.transactions_gas_per_period = ((shared.transactions_gas_per_period + shared.transactions_gas_per_epoch) * PERIOD - shared.transactions_gas_per_period) // PERIOD
+//	shared.base_fee_per_period = ((shared.base_fee_per_period + base_fee_per_epoch) * PERIOD - shared.base_fee_per_period) // PERIOD
+//	shared.transactions_gas_per_epoch = 0
+//	shared.base_fee_per_epoch = 0
+func processSharedActivityUpdates(st *ethpb.BeaconStateCapella) error {
+	period := uint64(params.BeaconConfig().EpochsPerActivityPeriod)
+	shared := st.SharedActivity
+	baseFeePerEpoch := shared.BaseFeePerEpoch / params.BeaconConfig().WeiPerGwei
+	if baseFeePerEpoch < 1 {
+		baseFeePerEpoch = 1
+	}
+	gasPerPeriod, err := movingAverage(shared.TransactionsGasPerPeriod, shared.TransactionsGasPerEpoch, period)
+	if err != nil {
+		return err
+	}
+	baseFeePerPeriod, err := movingAverage(shared.BaseFeePerPeriod, baseFeePerEpoch, period)
+	if err != nil {
+		return err
+	}
+	shared.TransactionsGasPerPeriod = gasPerPeriod
+	shared.TransactionsGasPerEpoch = 0
+	shared.BaseFeePerPeriod = baseFeePerPeriod
+	shared.BaseFeePerEpoch = 0
+	return nil
+}
+
+// def get_beacon_proposer_index_at(state: BeaconState, slot: Slot) -> ValidatorIndex:
+//
+//	epoch = compute_epoch_at_slot(slot)
+//	seed = hash(get_seed(state, epoch, DOMAIN_BEACON_PROPOSER) + uint_to_bytes(slot))
+//	indices = get_active_validator_indices(state, epoch)
+//	return compute_proposer_index(state, indices, seed)
+func beaconProposerIndex(st *ethpb.BeaconStateCapella, slot primitives.Slot) (primitives.ValidatorIndex, error) {
+	cfg := params.BeaconConfig()
+	epoch := primitives.Epoch(uint64(slot) / uint64(cfg.SlotsPerEpoch))
+	mix := st.RandaoMixes[uint64(epoch+cfg.EpochsPerHistoricalVector-cfg.MinSeedLookahead-1)%uint64(cfg.EpochsPerHistoricalVector)]
+	domain := cfg.DomainBeaconProposer
+	epochSeed := hash.Hash(append(append(domain[:], bytesutil.Bytes8(uint64(epoch))...), mix...))
+	seed := hash.Hash(append(epochSeed[:], bytesutil.Bytes8(uint64(slot))...))
+	return computeProposerIndex(st, activeIndices(st, epoch), seed)
+}
+
+// def compute_proposer_index(state: BeaconState, indices: Sequence[ValidatorIndex], seed: Bytes32) -> ValidatorIndex:
+//
+//	transactions_gas = state.shared_activity.transactions_gas_per_period // len(indices)
+//	powers = [effective_power(state.validators[i], transactions_gas) for i in indices]
+//	random = random_below(seed, sum(powers))
+//	total = 0
+//	for i in range(len(indices)):
+//	    candidate = indices[compute_shuffled_index(i, len(indices), seed)]
+//	    total += powers[candidate]
+//	    if total >= random:
+//	        return candidate
+func computeProposerIndex(st *ethpb.BeaconStateCapella, indices []primitives.ValidatorIndex, seed [32]byte) (primitives.ValidatorIndex, error) {
+	n := uint64(len(indices))
+	if n == 0 {
+		return 0, errors.New("no active validators")
+	}
+	transactionsGas := st.SharedActivity.TransactionsGasPerPeriod / n
+	powers := make(map[primitives.ValidatorIndex]uint64, n)
+	var total uint64
+	for _, idx := range indices {
+		power, err := effectivePower(st.Validators[idx], transactionsGas)
+		if err != nil {
+			return 0, err
+		}
+		powers[idx] = power
+		if total, err = add(total, power); err != nil {
+			return 0, err
+		}
+	}
+	random := randomBelow(seed, total)
+	var accumulated uint64
+	for i := uint64(0); i < n; i++ {
+		candidate := indices[computeShuffledIndex(i, n, seed)]
+		accumulated += powers[candidate]
+		if accumulated >= random {
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("no proposer reaches %d out of a total power of %d", random, total)
+}
+
+// def effective_power(validator: Validator, transactions_gas: uint64) -> uint64:
+//
+//	return (validator.effective_activity + transactions_gas) * (validator.effective_balance // EFFECTIVE_BALANCE_INCREMENT) // (MAX_EFFECTIVE_BALANCE // EFFECTIVE_BALANCE_INCREMENT)
+func effectivePower(v *ethpb.Validator, transactionsGas uint64) (uint64, error) {
+	cfg := params.BeaconConfig()
+	power := new(big.Int).SetUint64(v.EffectiveActivity)
+	power.Add(power, new(big.Int).SetUint64(transactionsGas))
+	power.Mul(power, new(big.Int).SetUint64(v.EffectiveBalance/cfg.EffectiveBalanceIncrement))
+	power.Quo(power, new(big.Int).SetUint64(cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement))
+	return toUint64(power)
+}
+
+// def random_below(seed: Bytes32, bound: uint64) -> uint64:
+//
+//	if bound == 0:
+//	    return 0
+//	i = 0
+//	while True:
+//	    random = bytes_to_uint64(hash(seed + uint_to_bytes(uint64(i)))[0:8])
+//	    if random < (2**64 - 1) // bound * bound:
+//	        return random % bound
+//	    i += 1
+func randomBelow(seed [32]byte, bound uint64) uint64 {
+	if bound == 0 {
+		return 0
+	}
+	for i := uint64(0); ; i++ {
+		h := hash.Hash(append(seed[:], bytesutil.Bytes8(i)...))
+		random := bytesutil.FromBytes8(h[:8])
+		if random < (1<<64-1)/bound*bound {
+			return random % bound
+		}
+	}
+}
+
+// computeShuffledIndex is compute_shuffled_index of the phase0 spec.
+func computeShuffledIndex(index, count uint64, seed [32]byte) uint64 {
+	for round := uint64(0); round < params.BeaconConfig().ShuffleRoundCount; round++ {
+		h := hash.Hash(append(seed[:], byte(round)))
+		pivot := bytesutil.FromBytes8(h[:8]) % count
+		flip := (pivot + count - index) % count
+		position := index
+		if flip > position {
+			position = flip
+		}
+		positionBytes := make([]byte, 4)
+		binary.LittleEndian.PutUint32(positionBytes, uint32(position/256))
+		source := hash.Hash(append(append(seed[:], byte(round)), positionBytes...))
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index
+}
@@ -0,0 +1,188 @@
+package generator
+
+import (
+	"encoding/binary"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// numValidators is the size of the validator registry of every generated state.
+const numValidators = 64
+
+// stateEpoch is the current epoch of the generated states. It is far enough from genesis for
+// the previous epoch and the proposer lookahead to be well defined.
+const stateEpoch = primitives.Epoch(4)
+
+// contractAddress returns the contract bound to the validator at the given index. Every validator
+// owns exactly one contract, with an address derived from its index.
+func contractAddress(idx uint64) []byte {
+	c := make([]byte, 20)
+	c[0] = 0xfa
+	binary.BigEndian.PutUint64(c[12:], idx+1)
+	return c
+}
+
+func root(seed string, i uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, i)
+	h := hash.Hash(append([]byte(seed), b...))
+	return h[:]
+}
+
+// pubkey returns a deterministic 48 byte public key for the validator at the given index. Vectors never
+// verify signatures, so the key does not need to be a valid BLS point.
+func pubkey(idx uint64) []byte {
+	return append(root("pubkey", idx), root("pubkey-tail", idx)[:16]...)
+}
+
+// historyRoots returns n roots of which only the first filled ones are set, so the history vectors of the
+// mainnet preset stay small once compressed. The roots past the state slot or epoch are never read.
+func historyRoots(n, filled uint64, seed string) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		if uint64(i) < filled {
+			roots[i] = root(seed, uint64(i))
+		} else {
+			roots[i] = make([]byte, 32)
+		}
+	}
+	return roots
+}
+
+// baseState returns a Capella state at the last slot of stateEpoch with an active registry of numValidators
+// validators. Every validator owns a contract and has a distinct effective activity, and every slot of the
+// current epoch has a distinct block root, so no proposal is considered missing.
+func baseState() *ethpb.BeaconStateCapella {
+	cfg := params.BeaconConfig()
+	slot := primitives.Slot(uint64(stateEpoch+1)*uint64(cfg.SlotsPerEpoch) - 1)
+
+	validators := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:                  pubkey(uint64(i)),
+			WithdrawalCredentials:      root("withdrawal", uint64(i)),
+			Contract:                   contractAddress(uint64(i)),
+			EffectiveBalance:           cfg.MaxEffectiveBalance,
+			EffectiveActivity:          uint64(i+1) * 1_000_000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  cfg.FarFutureEpoch,
+			WithdrawableEpoch:          cfg.FarFutureEpoch,
+		}
+		balances[i] = cfg.MaxEffectiveBalance
+	}
+
+	syncPubkeys := make([][]byte, cfg.SyncCommitteeSize)
+	for i := range syncPubkeys {
+		syncPubkeys[i] = validators[uint64(i)%numValidators].PublicKey
+	}
+	syncCommittee := &ethpb.SyncCommittee{
+		Pubkeys:         syncPubkeys,
+		AggregatePubkey: make([]byte, 48),
+	}
+
+	blockRoots := historyRoots(uint64(cfg.SlotsPerHistoricalRoot), uint64(slot)+1, "block")
+	return &ethpb.BeaconStateCapella{
+		GenesisTime:           1_700_000_000,
+		GenesisValidatorsRoot: root("genesis-validators", 0),
+		Slot:                  slot,
+		Fork: &ethpb.Fork{
+			PreviousVersion: cfg.BellatrixForkVersion,
+			CurrentVersion:  cfg.CapellaForkVersion,
+			Epoch:           0,
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: blockRoots[uint64(slot-1)%uint64(cfg.SlotsPerHistoricalRoot)],
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   root("body", uint64(slot)),
+		},
+		BlockRoots:      blockRoots,
+		StateRoots:      historyRoots(uint64(cfg.SlotsPerHistoricalRoot), uint64(slot)+1, "state"),
+		HistoricalRoots: [][]byte{},
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot:  make([]byte, 32),
+			DepositCount: numValidators,
+			BlockHash:    root("eth1", 0),
+		},
+		Eth1DataVotes:    []*ethpb.Eth1Data{},
+		Eth1DepositIndex: numValidators,
+		SharedActivity: &ethpb.SharedActivity{
+			TransactionsGasPerPeriod: 21_000 * 1_000,
+			TransactionsGasPerEpoch:  21_000 * 10,
+			BaseFeePerPeriod:         7,
+			BaseFeePerEpoch:          7 * cfg.WeiPerGwei,
+		},
+		Validators:                 validators,
+		Balances:                   balances,
+		Activities:                 make([]uint64, numValidators),
+		RandaoMixes:                historyRoots(uint64(cfg.EpochsPerHistoricalVector), uint64(stateEpoch)+1, "randao"),
+		Slashings:                  make([]uint64, cfg.EpochsPerSlashingsVector),
+		PreviousEpochParticipation: make([]byte, numValidators),
+		CurrentEpochParticipation:  make([]byte, numValidators),
+		JustificationBits:          bitfield.Bitvector4{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{
+			Root: make([]byte, 32),
+		},
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{
+			Root: make([]byte, 32),
+		},
+		FinalizedCheckpoint: &ethpb.Checkpoint{
+			Root: make([]byte, 32),
+		},
+		InactivityScores:     make([]uint64, numValidators),
+		CurrentSyncCommittee: syncCommittee,
+		NextSyncCommittee:    syncCommittee,
+		LatestExecutionPayloadHeader: &enginev1.ExecutionPayloadHeaderCapella{
+			ParentHash:       make([]byte, 32),
+			FeeRecipient:     make([]byte, 20),
+			StateRoot:        make([]byte, 32),
+			ReceiptsRoot:     make([]byte, 32),
+			LogsBloom:        make([]byte, 256),
+			PrevRandao:       make([]byte, 32),
+			BlockNumber:      uint64(slot),
+			ExtraData:        []byte{},
+			BaseFeePerGas:    make([]byte, 32),
+			BlockHash:        root("execution-block", uint64(slot)),
+			TransactionsRoot: make([]byte, 32),
+			WithdrawalsRoot:  make([]byte, 32),
+			ActivitiesRoot:   make([]byte, 32),
+		},
+		HistoricalSummaries: []*ethpb.HistoricalSummary{},
+	}
+}
+
+// emptyBody returns a Capella block body without operations, with every fixed size field set so it
+// can be SSZ encoded.
+func emptyBody() *ethpb.BeaconBlockBodyCapella {
+	cfg := params.BeaconConfig()
+	return &ethpb.BeaconBlockBodyCapella{
+		RandaoReveal: make([]byte, 96),
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		Graffiti: make([]byte, 32),
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, cfg.SyncCommitteeSize/8),
+			SyncCommitteeSignature: make([]byte, 96),
+		},
+		ExecutionPayload: &enginev1.ExecutionPayloadCapella{
+			ParentHash:     make([]byte, 32),
+			FeeRecipient:   make([]byte, 20),
+			StateRoot:      make([]byte, 32),
+			ReceiptsRoot:   make([]byte, 32),
+			LogsBloom:      make([]byte, 256),
+			PrevRandao:     make([]byte, 32),
+			BaseFeePerGas:  make([]byte, 32),
+			BlockHash:      make([]byte, 32),
+			ActivitiesRoot: make([]byte, 32),
+		},
+	}
+}
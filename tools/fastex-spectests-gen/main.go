@@ -0,0 +1,27 @@
+// Binary fastex-spectests-gen writes the Fastex consensus spec test vectors. The minimal preset vectors
+// must be generated by a binary built with the minimal SSZ configuration, e.g. with
+// --//proto:network=minimal, so the SSZ vector sizes match the preset.
+package main
+
+import (
+	"flag"
+
+	"github.com/prysmaticlabs/prysm/v4/tools/fastex-spectests-gen/generator"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	outputDir = flag.String("output-dir", "", "Directory to write the test vectors to")
+	preset    = flag.String("preset", "mainnet", "Preset of the test vectors, minimal or mainnet")
+)
+
+func main() {
+	flag.Parse()
+	if *outputDir == "" {
+		log.Fatal("Please specify --output-dir to write the test vectors to")
+	}
+	log.Printf("Generating %s vectors in %s", *preset, *outputDir)
+	if err := generator.Generate(*outputDir, *preset); err != nil {
+		log.WithError(err).Fatal("Could not generate test vectors")
+	}
+}
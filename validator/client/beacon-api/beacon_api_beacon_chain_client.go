@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	neturl "net/url"
 	"reflect"
 	"strconv"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/beacon"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/prysm/validator"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
@@ -26,7 +28,10 @@ type beaconApiBeaconChainClient struct {
 	stateValidatorsProvider stateValidatorsProvider
 }
 
-const getValidatorPerformanceEndpoint = "/prysm/validators/performance"
+const (
+	getValidatorPerformanceEndpoint = "/prysm/validators/performance"
+	getValidatorPowersEndpoint      = "/eth/v1/beacon/states/head/validator_powers"
+)
 
 func (c beaconApiBeaconChainClient) getHeadBlockHeaders(ctx context.Context) (*beacon.GetBlockHeaderResponse, error) {
 	blockHeader := beacon.GetBlockHeaderResponse{}
@@ -368,6 +373,112 @@ func (c beaconApiBeaconChainClient) GetValidatorPerformance(ctx context.Context,
 	}, nil
 }
 
+func (c beaconApiBeaconChainClient) GetValidatorActivities(ctx context.Context, in *ethpb.ValidatorActivitiesRequest) (*ethpb.ValidatorActivitiesResponse, error) {
+	blockHeader, err := c.getHeadBlockHeaders(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get head block header")
+	}
+	headSlot, err := strconv.ParseUint(blockHeader.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse head block slot `%s`", blockHeader.Data.Header.Message.Slot)
+	}
+
+	pubKeys := make([]string, len(in.PublicKeys))
+	params := neturl.Values{}
+	for i, pubKey := range in.PublicKeys {
+		pubKeys[i] = hexutil.Encode(pubKey)
+		params.Add("id", pubKeys[i])
+	}
+	stateValidators, err := c.stateValidatorsProvider.GetStateValidators(ctx, pubKeys, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state validators")
+	}
+
+	powers := beacon.GetValidatorPowersResponse{}
+	query := buildURL(getValidatorPowersEndpoint, params)
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, query, &powers); err != nil {
+		return nil, errors.Wrapf(err, "failed to query %s", query)
+	}
+	if powers.Data == nil {
+		return nil, errors.New("validator powers data is nil")
+	}
+	totalEffectivePower, err := strconv.ParseUint(powers.Data.TotalEffectivePower, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse total effective power `%s`", powers.Data.TotalEffectivePower)
+	}
+	// Validators that are not active are not listed and have no power.
+	effectivePowers := make(map[string]string, len(powers.Data.Powers))
+	for _, power := range powers.Data.Powers {
+		if power == nil {
+			continue
+		}
+		effectivePowers[power.Index] = power.EffectivePower
+	}
+
+	activitiesByPubKey := make(map[string]*ethpb.ValidatorActivitiesResponse_ValidatorActivity, len(stateValidators.Data))
+	for _, data := range stateValidators.Data {
+		if data == nil || data.Validator == nil {
+			return nil, errors.New("state validator is nil")
+		}
+		index, err := strconv.ParseUint(data.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", data.Index)
+		}
+		activity, err := strconv.ParseUint(data.Activity, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse activity `%s`", data.Activity)
+		}
+		effectiveActivity, err := strconv.ParseUint(data.Validator.EffectiveActivity, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse effective activity `%s`", data.Validator.EffectiveActivity)
+		}
+		var effectivePower uint64
+		if power, ok := effectivePowers[data.Index]; ok {
+			effectivePower, err = strconv.ParseUint(power, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse effective power `%s`", power)
+			}
+		}
+		pubKey, err := hexutil.Decode(data.Validator.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode validator pubkey `%s`", data.Validator.Pubkey)
+		}
+		contract := make([]byte, fieldparams.ContractAddressLength)
+		if data.Validator.Contract != "" {
+			contract, err = hexutil.Decode(data.Validator.Contract)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode validator contract `%s`", data.Validator.Contract)
+			}
+		}
+		activitiesByPubKey[hexutil.Encode(pubKey)] = &ethpb.ValidatorActivitiesResponse_ValidatorActivity{
+			PublicKey:         pubKey,
+			Index:             primitives.ValidatorIndex(index),
+			Contract:          contract,
+			Activity:          activity,
+			EffectiveActivity: effectiveActivity,
+			EffectivePower:    effectivePower,
+		}
+	}
+
+	activities := make([]*ethpb.ValidatorActivitiesResponse_ValidatorActivity, 0, len(in.PublicKeys))
+	missingValidators := make([][]byte, 0)
+	for i, pubKey := range in.PublicKeys {
+		activity, ok := activitiesByPubKey[pubKeys[i]]
+		if !ok {
+			missingValidators = append(missingValidators, pubKey)
+			continue
+		}
+		activities = append(activities, activity)
+	}
+
+	return &ethpb.ValidatorActivitiesResponse{
+		Epoch:               slots.ToEpoch(primitives.Slot(headSlot)),
+		Activities:          activities,
+		TotalEffectivePower: totalEffectivePower,
+		MissingValidators:   missingValidators,
+	}, nil
+}
+
 func (c beaconApiBeaconChainClient) GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest) (*ethpb.ValidatorParticipationResponse, error) {
 	if c.fallbackClient != nil {
 		return c.fallbackClient.GetValidatorParticipation(ctx, in)
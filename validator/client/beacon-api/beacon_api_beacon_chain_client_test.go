@@ -972,3 +972,99 @@ func Test_beaconApiBeaconChainClient_GetValidatorPerformance(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, want.PublicKeys, got.PublicKeys)
 }
+
+func Test_beaconApiBeaconChainClient_GetValidatorActivities(t *testing.T) {
+	const headBlockHeadersEndpoint = "/eth/v1/beacon/headers/head"
+
+	bound := bytesutil.PadTo([]byte{1}, 48)
+	unbound := bytesutil.PadTo([]byte{2}, 48)
+	missing := bytesutil.PadTo([]byte{3}, 48)
+	contract := bytesutil.PadTo([]byte{0xaa}, 20)
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+	headBlockHeadersResponse := beacon.GetBlockHeaderResponse{}
+	jsonRestHandler.EXPECT().GetRestJsonResponse(ctx, headBlockHeadersEndpoint, &headBlockHeadersResponse).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		beacon.GetBlockHeaderResponse{
+			Data: &shared.SignedBeaconBlockHeaderContainer{
+				Header: &shared.SignedBeaconBlockHeader{
+					Message: &shared.BeaconBlockHeader{Slot: "70"},
+				},
+			},
+		},
+	)
+	powersResponse := beacon.GetValidatorPowersResponse{}
+	powersQuery := fmt.Sprintf("%s?id=%s&id=%s&id=%s", getValidatorPowersEndpoint, hexutil.Encode(bound), hexutil.Encode(unbound), hexutil.Encode(missing))
+	jsonRestHandler.EXPECT().GetRestJsonResponse(ctx, powersQuery, &powersResponse).Return(
+		nil,
+		nil,
+	).SetArg(
+		2,
+		beacon.GetValidatorPowersResponse{
+			Data: &beacon.ValidatorPowersContainer{
+				Powers: []*beacon.ValidatorPower{
+					{Index: "4", Power: "400", EffectivePower: "300"},
+					{Index: "5", Power: "100", EffectivePower: "100"},
+				},
+				TotalEffectivePower: "1000",
+			},
+		},
+	)
+
+	stateValidatorsProvider := mock.NewMockstateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().GetStateValidators(
+		ctx,
+		[]string{hexutil.Encode(bound), hexutil.Encode(unbound), hexutil.Encode(missing)},
+		nil,
+		nil,
+	).Return(
+		&beacon.GetValidatorsResponse{
+			Data: []*beacon.ValidatorContainer{
+				{
+					Index:    "5",
+					Activity: "0",
+					Validator: &beacon.Validator{
+						Pubkey:            hexutil.Encode(unbound),
+						EffectiveActivity: "0",
+					},
+				},
+				{
+					Index:    "4",
+					Activity: "42",
+					Validator: &beacon.Validator{
+						Pubkey:            hexutil.Encode(bound),
+						Contract:          hexutil.Encode(contract),
+						EffectiveActivity: "4200",
+					},
+				},
+			},
+		},
+		nil,
+	)
+
+	c := beaconApiBeaconChainClient{
+		jsonRestHandler:         jsonRestHandler,
+		stateValidatorsProvider: stateValidatorsProvider,
+	}
+	got, err := c.GetValidatorActivities(ctx, &ethpb.ValidatorActivitiesRequest{
+		PublicKeys: [][]byte{bound, unbound, missing},
+	})
+	require.NoError(t, err)
+	want := &ethpb.ValidatorActivitiesResponse{
+		Epoch: slots.ToEpoch(70),
+		Activities: []*ethpb.ValidatorActivitiesResponse_ValidatorActivity{
+			{PublicKey: bound, Index: 4, Contract: contract, Activity: 42, EffectiveActivity: 4200, EffectivePower: 300},
+			{PublicKey: unbound, Index: 5, Contract: make([]byte, 20), EffectivePower: 100},
+		},
+		TotalEffectivePower: 1000,
+		MissingValidators:   [][]byte{missing},
+	}
+	assert.DeepEqual(t, want, got)
+}
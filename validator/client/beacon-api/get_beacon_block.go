@@ -109,6 +109,16 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 			return nil, errors.Wrap(err, "could not convert deneb block contents to generic block")
 		}
 		response = genericBlock
+	case "electra":
+		jsonElectraBlockContents := shared.BeaconBlockContentsElectra{}
+		if err := decoder.Decode(&jsonElectraBlockContents); err != nil {
+			return nil, errors.Wrap(err, "failed to decode electra block response json")
+		}
+		genericBlock, err := jsonElectraBlockContents.ToGeneric()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert electra block contents to generic block")
+		}
+		response = genericBlock
 	default:
 		return nil, errors.Errorf("unsupported consensus version `%s`", produceBlockResponseJson.Version)
 	}
@@ -204,6 +204,26 @@ func (c beaconApiValidatorClient) getHeadSignedBeaconBlock(ctx context.Context)
 
 		slot = denebBlock.Block.Slot
 
+	case "electra":
+		jsonElectraBlock := shared.SignedBeaconBlockElectra{}
+		if err := decoder.Decode(&jsonElectraBlock); err != nil {
+			return nil, errors.Wrap(err, "failed to decode signed electra block response json")
+		}
+
+		electraBlock, err := jsonElectraBlock.ToConsensus()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signed electra block")
+		}
+
+		response.Block = &ethpb.StreamBlocksResponse_ElectraBlock{
+			ElectraBlock: &ethpb.SignedBeaconBlockElectra{
+				Signature: electraBlock.Signature,
+				Block:     electraBlock.Block,
+			},
+		}
+
+		slot = electraBlock.Block.Slot
+
 	default:
 		return nil, errors.Errorf("unsupported consensus version `%s`", signedBlockResponseJson.Version)
 	}
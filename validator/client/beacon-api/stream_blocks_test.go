@@ -978,3 +978,148 @@ func TestStreamBlocks_DenebValid(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamBlocks_ElectraValid(t *testing.T) {
+	testCases := []struct {
+		name         string
+		verifiedOnly bool
+	}{
+		{
+			name:         "verified optional",
+			verifiedOnly: false,
+		},
+		{
+			name:         "verified only",
+			verifiedOnly: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+
+			signedBlockResponseJson := abstractSignedBlockResponseJson{}
+			jsonRestHandler := mock.NewMockjsonRestHandler(ctrl)
+			beaconBlockConverter := mock.NewMockbeaconBlockConverter(ctrl)
+
+			// For the first call, return a block that satisfies the verifiedOnly condition. This block should be returned by the first Recv().
+			// For the second call, return the same block as the previous one. This block shouldn't be returned by the second Recv().
+			var blockContents shared.SignedBeaconBlockContentsElectra
+			err := json.Unmarshal([]byte(rpctesting.ElectraBlockContents), &blockContents)
+			require.NoError(t, err)
+			sig := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"
+			electraBlock := blockContents.SignedBlock
+			electraBlock.Message.Slot = "1"
+			electraBlock.Signature = sig
+
+			marshalledSignedBeaconBlockContainer1, err := json.Marshal(electraBlock)
+			require.NoError(t, err)
+			jsonRestHandler.EXPECT().GetRestJsonResponse(
+				ctx,
+				"/eth/v2/beacon/blocks/head",
+				&signedBlockResponseJson,
+			).Return(
+				nil,
+				nil,
+			).SetArg(
+				2,
+				abstractSignedBlockResponseJson{
+					Version:             "electra",
+					ExecutionOptimistic: false,
+					Data:                marshalledSignedBeaconBlockContainer1,
+				},
+			).Times(2)
+
+			// For the third call, return a block with a different slot than the previous one, but with the verifiedOnly condition not satisfied.
+			// If verifiedOnly == false, this block will be returned by the second Recv(); otherwise, another block will be requested.
+
+			var blockContents2 shared.SignedBeaconBlockContentsElectra
+			err = json.Unmarshal([]byte(rpctesting.ElectraBlockContents), &blockContents2)
+			require.NoError(t, err)
+			sig2 := "0x2b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"
+			electraBlock2 := blockContents.SignedBlock
+			electraBlock2.Message.Slot = "2"
+			electraBlock2.Signature = sig2
+
+			marshalledSignedBeaconBlockContainer2, err := json.Marshal(electraBlock2)
+			require.NoError(t, err)
+
+			jsonRestHandler.EXPECT().GetRestJsonResponse(
+				ctx,
+				"/eth/v2/beacon/blocks/head",
+				&signedBlockResponseJson,
+			).Return(
+				nil,
+				nil,
+			).SetArg(
+				2,
+				abstractSignedBlockResponseJson{
+					Version:             "electra",
+					ExecutionOptimistic: true,
+					Data:                marshalledSignedBeaconBlockContainer2,
+				},
+			).Times(1)
+
+			// The fourth call is only necessary when verifiedOnly == true since the previous block was optimistic
+			if testCase.verifiedOnly {
+				jsonRestHandler.EXPECT().GetRestJsonResponse(
+					ctx,
+					"/eth/v2/beacon/blocks/head",
+					&signedBlockResponseJson,
+				).Return(
+					nil,
+					nil,
+				).SetArg(
+					2,
+					abstractSignedBlockResponseJson{
+						Version:             "electra",
+						ExecutionOptimistic: false,
+						Data:                marshalledSignedBeaconBlockContainer2,
+					},
+				).Times(1)
+			}
+
+			validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler, beaconBlockConverter: beaconBlockConverter}
+			streamBlocksClient := validatorClient.streamBlocks(ctx, &eth.StreamBlocksRequest{VerifiedOnly: testCase.verifiedOnly}, time.Millisecond*100)
+
+			// Get the first block
+			streamBlocksResponse1, err := streamBlocksClient.Recv()
+			require.NoError(t, err)
+			consensusBlock, err := electraBlock.Message.ToConsensus()
+			consensusBlock.Slot = 1
+			require.NoError(t, err)
+			sigBytes, err := hexutil.Decode(sig)
+			require.NoError(t, err)
+			expectedStreamBlocksResponse1 := &eth.StreamBlocksResponse{
+				Block: &eth.StreamBlocksResponse_ElectraBlock{
+					ElectraBlock: &eth.SignedBeaconBlockElectra{
+						Block:     consensusBlock,
+						Signature: sigBytes,
+					},
+				},
+			}
+
+			assert.DeepEqual(t, expectedStreamBlocksResponse1, streamBlocksResponse1)
+
+			// Get the second block
+			streamBlocksResponse2, err := streamBlocksClient.Recv()
+			require.NoError(t, err)
+			consensusBlock.Slot = 2
+			sig2Bytes, err := hexutil.Decode(sig2)
+			require.NoError(t, err)
+			expectedStreamBlocksResponse2 := &eth.StreamBlocksResponse{
+				Block: &eth.StreamBlocksResponse_ElectraBlock{
+					ElectraBlock: &eth.SignedBeaconBlockElectra{
+						Block:     consensusBlock,
+						Signature: sig2Bytes,
+					},
+				},
+			}
+
+			assert.DeepEqual(t, expectedStreamBlocksResponse2, streamBlocksResponse2)
+		})
+	}
+}
@@ -33,6 +33,10 @@ func (c *grpcBeaconChainClient) GetValidatorPerformance(ctx context.Context, in
 	return c.beaconChainClient.GetValidatorPerformance(ctx, in)
 }
 
+func (c *grpcBeaconChainClient) GetValidatorActivities(ctx context.Context, in *ethpb.ValidatorActivitiesRequest) (*ethpb.ValidatorActivitiesResponse, error) {
+	return c.beaconChainClient.GetValidatorActivities(ctx, in)
+}
+
 func (c *grpcBeaconChainClient) GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest) (*ethpb.ValidatorParticipationResponse, error) {
 	return c.beaconChainClient.GetValidatorParticipation(ctx, in)
 }
@@ -13,5 +13,6 @@ type BeaconChainClient interface {
 	ListValidators(ctx context.Context, in *ethpb.ListValidatorsRequest) (*ethpb.Validators, error)
 	GetValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error)
 	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest) (*ethpb.ValidatorPerformanceResponse, error)
+	GetValidatorActivities(ctx context.Context, in *ethpb.ValidatorActivitiesRequest) (*ethpb.ValidatorActivitiesResponse, error)
 	GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest) (*ethpb.ValidatorParticipationResponse, error)
 }
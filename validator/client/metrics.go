@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -212,6 +213,52 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorActivityGaugeVec used to track the activity of the validator contracts in the current epoch.
+	ValidatorActivityGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "activity",
+			Help:      "Activity accumulated by the validator contracts in the current epoch",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorEffectiveActivityGaugeVec used to track validator effective activities.
+	ValidatorEffectiveActivityGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "effective_activity",
+			Help:      "Effective activity of the validator, the moving average of its per-epoch activity",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPowerShareGaugeVec used to track the share of the validator in the total effective power.
+	ValidatorPowerShareGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "power_share",
+			Help:      "Share of the validator effective power in the total effective power of active validators, from 0 to 1",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorContractGaugeVec used to track the contract bound to a validator. The gauge is set to 1
+	// for the bound contract, the contract label is empty when no contract is bound.
+	ValidatorContractGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "contract",
+			Help:      "Contract bound to the validator",
+		},
+		[]string{
+			"pubkey",
+			"contract",
+		},
+	)
 	// ValidatorInactivityScoreGaugeVec used to track validator inactivity scores.
 	ValidatorInactivityScoreGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -277,6 +324,64 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot primiti
 	v.prevBalanceLock.Unlock()
 
 	v.UpdateLogAggregateStats(resp, slot)
+	if err := v.logValidatorActivities(ctx, pubKeys); err != nil {
+		log.WithError(err).Error("Could not log validator activities")
+	}
+	return nil
+}
+
+// logValidatorActivities logs and exports the contract, activity and power share of each validator.
+// It warns about validators without a bound contract, as they only earn the minimum power.
+func (v *validator) logValidatorActivities(ctx context.Context, pubKeys [][]byte) error {
+	resp, err := v.beaconClient.GetValidatorActivities(ctx, &ethpb.ValidatorActivitiesRequest{PublicKeys: pubKeys})
+	if err != nil {
+		return err
+	}
+
+	if v.emitAccountMetrics {
+		for _, missingPubKey := range resp.MissingValidators {
+			fmtKey := fmt.Sprintf("%#x", missingPubKey)
+			ValidatorActivityGaugeVec.WithLabelValues(fmtKey).Set(0)
+			ValidatorEffectiveActivityGaugeVec.WithLabelValues(fmtKey).Set(0)
+			ValidatorPowerShareGaugeVec.WithLabelValues(fmtKey).Set(0)
+		}
+	}
+
+	zeroContract := params.BeaconConfig().ZeroContract
+	for _, activity := range resp.Activities {
+		truncatedKey := fmt.Sprintf("%#x", bytesutil.Trunc(activity.PublicKey))
+		hasContract := len(activity.Contract) != 0 && !bytes.Equal(activity.Contract, zeroContract[:])
+		var powerShare float64
+		if resp.TotalEffectivePower > 0 {
+			powerShare = float64(activity.EffectivePower) / float64(resp.TotalEffectivePower)
+		}
+
+		contract := ""
+		if hasContract {
+			contract = fmt.Sprintf("%#x", activity.Contract)
+		}
+		log.WithFields(logrus.Fields{
+			"pubKey":            truncatedKey,
+			"epoch":             resp.Epoch,
+			"contract":          contract,
+			"activity":          activity.Activity,
+			"effectiveActivity": activity.EffectiveActivity,
+			"powerShare":        fmt.Sprintf("%.5f%%", powerShare*100),
+		}).Info("Validator activity summary")
+		if !hasContract {
+			log.WithField("pubKey", truncatedKey).Warn("No contract is bound to the validator, it only earns the minimum power")
+		}
+
+		if v.emitAccountMetrics {
+			fmtKey := fmt.Sprintf("%#x", activity.PublicKey)
+			ValidatorActivityGaugeVec.WithLabelValues(fmtKey).Set(float64(activity.Activity))
+			ValidatorEffectiveActivityGaugeVec.WithLabelValues(fmtKey).Set(float64(activity.EffectiveActivity))
+			ValidatorPowerShareGaugeVec.WithLabelValues(fmtKey).Set(powerShare)
+			// Drop the previously bound contract, if any.
+			ValidatorContractGaugeVec.DeletePartialMatch(prometheus.Labels{"pubkey": fmtKey})
+			ValidatorContractGaugeVec.WithLabelValues(fmtKey, contract).Set(1)
+		}
+	}
 	return nil
 }
 
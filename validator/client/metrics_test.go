@@ -1,14 +1,17 @@
 package client
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v4/testing/validator-mock"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -163,3 +166,36 @@ func TestUpdateLogAltairAggregateStats(t *testing.T) {
 		"correctlyVotedHeadPct=\"86%\" correctlyVotedSourcePct=\"100%\" "+
 		"correctlyVotedTargetPct=\"71%\" numberOfEpochs=3 pctChangeCombinedBalance=\"0.20555%\"")
 }
+
+func TestLogValidatorActivities(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := validatormock.NewMockBeaconChainClient(ctrl)
+	v := &validator{
+		beaconClient:       beaconClient,
+		emitAccountMetrics: true,
+	}
+
+	bound := bytesutil.PadTo([]byte{'b'}, fieldparams.BLSPubkeyLength)
+	unbound := bytesutil.PadTo([]byte{'u'}, fieldparams.BLSPubkeyLength)
+	missing := bytesutil.PadTo([]byte{'m'}, fieldparams.BLSPubkeyLength)
+	contract := bytesutil.PadTo([]byte{0xaa}, fieldparams.ContractAddressLength)
+	beaconClient.EXPECT().GetValidatorActivities(
+		gomock.Any(),
+		&ethpb.ValidatorActivitiesRequest{PublicKeys: [][]byte{bound, unbound, missing}},
+	).Return(&ethpb.ValidatorActivitiesResponse{
+		Epoch: 5,
+		Activities: []*ethpb.ValidatorActivitiesResponse_ValidatorActivity{
+			{PublicKey: bound, Index: 1, Contract: contract, Activity: 42, EffectiveActivity: 4200, EffectivePower: 300},
+			{PublicKey: unbound, Index: 2, Contract: make([]byte, fieldparams.ContractAddressLength), EffectivePower: 100},
+		},
+		TotalEffectivePower: 1000,
+		MissingValidators:   [][]byte{missing},
+	}, nil)
+
+	require.NoError(t, v.logValidatorActivities(context.Background(), [][]byte{bound, unbound, missing}))
+	require.LogsContain(t, hook, "Validator activity summary")
+	require.LogsContain(t, hook, `powerShare="30.00000%"`)
+	require.LogsContain(t, hook, "No contract is bound to the validator")
+}
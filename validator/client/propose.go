@@ -122,7 +122,47 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	}
 
 	var genericSignedBlock *ethpb.GenericSignedBeaconBlock
-	if blk.Version() >= version.Deneb {
+	if blk.Version() >= version.Electra {
+		if !blk.IsBlinded() {
+			signedBlobs, err := v.signDenebBlobs(ctx, b.GetElectra().Blobs, pubKey)
+			if err != nil {
+				log.WithError(err).Error("Failed to sign blobs")
+				return
+			}
+			electraBlock, err := blk.PbElectraBlock()
+			if err != nil {
+				log.WithError(err).Error("Failed to get electra block")
+				return
+			}
+			genericSignedBlock = &ethpb.GenericSignedBeaconBlock{
+				Block: &ethpb.GenericSignedBeaconBlock_Electra{
+					Electra: &ethpb.SignedBeaconBlockAndBlobsElectra{
+						Block: electraBlock,
+						Blobs: signedBlobs,
+					},
+				},
+			}
+		} else {
+			signedBlindBlobs, err := v.signBlindedDenebBlobs(ctx, b.GetBlindedElectra().Blobs, pubKey)
+			if err != nil {
+				log.WithError(err).Error("Failed to sign blinded blob sidecar")
+				return
+			}
+			blindedElectraBlock, err := blk.PbBlindedElectraBlock()
+			if err != nil {
+				log.WithError(err).Error("Failed to get blinded electra block")
+				return
+			}
+			genericSignedBlock = &ethpb.GenericSignedBeaconBlock{
+				Block: &ethpb.GenericSignedBeaconBlock_BlindedElectra{
+					BlindedElectra: &ethpb.SignedBlindedBeaconBlockAndBlobsElectra{
+						SignedBlindedBlock:        blindedElectraBlock,
+						SignedBlindedBlobSidecars: signedBlindBlobs,
+					},
+				},
+			}
+		}
+	} else if blk.Version() >= version.Deneb {
 		if !blk.IsBlinded() {
 			signedBlobs, err := v.signDenebBlobs(ctx, b.GetDeneb().Blobs, pubKey)
 			if err != nil {
@@ -351,6 +351,8 @@ func (v *validator) ReceiveBlocks(ctx context.Context, connectionErrorChannel ch
 			blk, err = blocks.NewSignedBeaconBlock(b.CapellaBlock)
 		case *ethpb.StreamBlocksResponse_DenebBlock:
 			blk, err = blocks.NewSignedBeaconBlock(b.DenebBlock)
+		case *ethpb.StreamBlocksResponse_ElectraBlock:
+			blk, err = blocks.NewSignedBeaconBlock(b.ElectraBlock)
 		}
 		if err != nil {
 			log.WithError(err).Error("Failed to wrap signed block")